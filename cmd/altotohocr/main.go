@@ -0,0 +1,56 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+// altotohocr converts an ALTO XML file to hOCR
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"rescribe.xyz/utils/pkg/alto"
+	"rescribe.xyz/utils/pkg/hocr"
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: altotohocr altofile\n")
+		fmt.Fprintf(os.Stderr, "Converts an ALTO XML file to hOCR, printing it to stdout.\n")
+		fmt.Fprintf(os.Stderr, "Coordinates which aren't in pixels are converted using -dpi.\n")
+		flag.PrintDefaults()
+	}
+	dpi := flag.Float64("dpi", 0, "Resolution of the page image, to convert coordinates in mm10 or inch1200 to pixels")
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	f, err := os.Open(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	a, err := alto.Read(f)
+	if err != nil {
+		log.Fatalf("Error parsing %s: %v", flag.Arg(0), err)
+	}
+
+	err = a.ToPixels(*dpi)
+	if err != nil {
+		log.Fatalf("Error converting %s: %v", flag.Arg(0), err)
+	}
+	h, err := alto.ToHocr(a)
+	if err != nil {
+		log.Fatalf("Error converting %s: %v", flag.Arg(0), err)
+	}
+
+	err = hocr.Write(os.Stdout, h)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -6,23 +6,14 @@ package main
 
 import (
 	"fmt"
+	"html"
 	"os"
 	"path/filepath"
 
 	"rescribe.xyz/utils/pkg/line"
 )
 
-func copylineimg(fn string, l line.Detail) error {
-	f, err := os.Create(fn)
-	if err != nil {
-		return err
-	}
-	defer f.Close()
-
-	return l.Img.CopyLineTo(f)
-}
-
-func htmlout(dir string, lines line.Details) error {
+func htmlout(dir string, lines line.Details, chars int) error {
 	err := os.MkdirAll(dir, 0700)
 	if err != nil {
 		return err
@@ -42,16 +33,20 @@ func htmlout(dir string, lines line.Details) error {
 	}
 	for _, l := range lines {
 		fn = filepath.Base(l.OcrName) + "_" + l.Name + ".png"
-		err = copylineimg(filepath.Join(dir, fn), l)
+		err = line.SaveImg(filepath.Join(dir, fn), l.Img)
 		if err != nil {
 			return err
 		}
+		var lowest string
+		if chars > 0 {
+			lowest = "<br />" + html.EscapeString(glyphsummary(l.LowestGlyphs(chars)))
+		}
 		_, err = fmt.Fprintf(f, "<tr>\n"+
 			"<td><h1>%.4f%%</h1></td>\n"+
 			"<td>%s %s</td>\n"+
-			"<td><img src='%s' width='100%%' /><br />%s</td>\n"+
+			"<td><img src='%s' width='100%%' /><br />%s%s</td>\n"+
 			"</tr>\n",
-			l.Avgconf, l.OcrName, l.Name, fn, l.Text)
+			l.Avgconf, l.OcrName, l.Name, fn, l.Text, lowest)
 		if err != nil {
 			return err
 		}
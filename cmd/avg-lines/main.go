@@ -13,31 +13,63 @@ import (
 	"os"
 	"path/filepath"
 	"sort"
+	"strings"
 
+	"rescribe.xyz/utils/pkg/alto"
 	"rescribe.xyz/utils/pkg/hocr"
 	"rescribe.xyz/utils/pkg/line"
+	"rescribe.xyz/utils/pkg/normalize"
+	"rescribe.xyz/utils/pkg/page"
 	"rescribe.xyz/utils/pkg/prob"
 )
 
+// glyphsummary formats a list of glyphs and their confidences
+func glyphsummary(glyphs []line.Glyph) string {
+	var s []string
+	for _, g := range glyphs {
+		s = append(s, fmt.Sprintf("'%s' %.2f", g.Text, g.Conf))
+	}
+	return "[" + strings.Join(s, ", ") + "]"
+}
+
 func main() {
 	flag.Usage = func() {
-		fmt.Fprintf(os.Stderr, "Usage: avg-lines [-html dir] [-nosort] [prob1] [hocr1] [prob2] [...]\n")
+		fmt.Fprintf(os.Stderr, "Usage: avg-lines [-chars n] [-html dir] [-lenient] [-normalize profile] [-nosort] [prob1] [hocr1] [prob2] [...]\n")
 		fmt.Fprintf(os.Stderr, "Prints a report of the average confidence for each line, sorted\n")
 		fmt.Fprintf(os.Stderr, "from worst to best.\n")
-		fmt.Fprintf(os.Stderr, "Both .hocr and .prob files can be processed.\n")
+		fmt.Fprintf(os.Stderr, "ALTO or PAGE XML (.xml), .hocr and .prob files can be processed.\n")
 		fmt.Fprintf(os.Stderr, "For .hocr files, the x_wconf data is used to calculate confidence.\n")
+		fmt.Fprintf(os.Stderr, "For PAGE XML files, the TextEquiv conf data is used, and for ALTO\n")
+		fmt.Fprintf(os.Stderr, "files the WC data.\n")
 		fmt.Fprintf(os.Stderr, "The .prob files are generated using ocropy-rpred's --probabilities\n")
-		fmt.Fprintf(os.Stderr, "option.\n\n")
+		fmt.Fprintf(os.Stderr, "option.\n")
+		fmt.Fprintf(os.Stderr, "For .hocr files with per-character confidences, such as tesseract's\n")
+		fmt.Fprintf(os.Stderr, "ocrx_cinfo or x_confs, the lowest confidence characters of each line\n")
+		fmt.Fprintf(os.Stderr, "can also be reported with -chars.\n")
+		fmt.Fprintf(os.Stderr, "With -normalize, the text of each line and character is normalized\n")
+		fmt.Fprintf(os.Stderr, "with a profile or mapping file before it is reported.\n\n")
 		flag.PrintDefaults()
 	}
+	var chars = flag.Int("chars", 0, "Also report the n lowest confidence characters of each line")
 	var html = flag.String("html", "", "Output in html format to the specified directory")
 	var nosort = flag.Bool("nosort", false, "Don't sort lines by confidence")
+	var lenient = flag.Bool("lenient", false, "Skip words and lines with missing confidences or boxes, and page images which can't be decoded, rather than stopping")
+	var normal = flag.String("normalize", "", "Normalize text with a profile: nfc, nfd, diplomatic, modernised, or the path of a mapping file")
 	flag.Parse()
 	if flag.NArg() < 1 {
 		flag.Usage()
 		os.Exit(1)
 	}
 
+	var profile *normalize.Profile
+	if *normal != "" {
+		var err error
+		profile, err = normalize.Get(*normal)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
 	var err error
 	lines := make(line.Details, 0)
 
@@ -47,9 +79,27 @@ func main() {
 		case ".prob":
 			newlines, err = prob.GetLineDetails(f)
 		case ".hocr":
-			newlines, err = hocr.GetLineDetails(f)
+			newlines, err = hocr.OpenLineDetails(f, "", func(r *hocr.Reader) {
+				r.Lenient = *lenient
+				r.Warn = func(err error) { log.Printf("Warning: %v\n", err) }
+			})
+		case ".xml":
+			// ALTO and PAGE XML share a suffix, so they are told
+			// apart by their root element
+			var isalto bool
+			isalto, err = alto.IsAltoFile(f)
+			switch {
+			case err != nil:
+			case isalto:
+				newlines, err = alto.GetLineDetails(f)
+			default:
+				newlines, err = page.OpenLineDetails(f, "", func(o *page.Options) {
+					o.Lenient = *lenient
+					o.Warn = func(err error) { log.Printf("Warning: %v\n", err) }
+				})
+			}
 		default:
-			log.Printf("Skipping file '%s' as it isn't a .prob or .hocr\n", f)
+			log.Printf("Skipping file '%s' as it isn't a .prob, .hocr or .xml\n", f)
 			continue
 		}
 		if err != nil {
@@ -57,6 +107,16 @@ func main() {
 		}
 
 		for _, l := range newlines {
+			if l.Avgconf < 0 {
+				// without a confidence there is nothing to report
+				continue
+			}
+			if profile != nil {
+				l.Text = profile.String(l.Text)
+				for i := range l.Glyphs {
+					l.Glyphs[i].Text = profile.String(l.Glyphs[i].Text)
+				}
+			}
 			lines = append(lines, l)
 		}
 	}
@@ -67,9 +127,16 @@ func main() {
 
 	if *html == "" {
 		for _, l := range lines {
-			fmt.Printf("%s %s: %.2f%%\n", l.OcrName, l.Name, l.Avgconf)
+			fmt.Printf("%s %s: %.2f%%", l.OcrName, l.Name, l.Avgconf)
+			if *chars > 0 {
+				fmt.Printf(" %s", glyphsummary(l.LowestGlyphs(*chars)))
+			}
+			fmt.Printf("\n")
 		}
 	} else {
-		htmlout(*html, lines)
+		err = htmlout(*html, lines, *chars)
+		if err != nil {
+			log.Fatal(err)
+		}
 	}
 }
@@ -12,19 +12,31 @@ import (
 	"log"
 	"os"
 	"strings"
+
+	"rescribe.xyz/utils/pkg/normalize"
 )
 
 func main() {
 	flag.Usage = func() {
-		fmt.Fprintf(os.Stderr, "Usage: boxtotxt in.box\n")
+		fmt.Fprintf(os.Stderr, "Usage: boxtotxt [-normalize profile] in.box\n")
 		flag.PrintDefaults()
 	}
+	normal := flag.String("normalize", "", "Normalize text with a profile: nfc, nfd, diplomatic, modernised, or the path of a mapping file")
 	flag.Parse()
 	if flag.NArg() != 1 {
 		flag.Usage()
 		os.Exit(1)
 	}
 
+	var profile *normalize.Profile
+	if *normal != "" {
+		var err error
+		profile, err = normalize.Get(*normal)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
 	f, err := os.Open(flag.Arg(0))
 	defer f.Close()
 	if err != nil {
@@ -33,6 +45,7 @@ func main() {
 
 	scanner := bufio.NewScanner(f)
 
+	var text strings.Builder
 	for scanner.Scan() {
 		t := scanner.Text()
 		s := strings.Split(t, "")
@@ -42,8 +55,12 @@ func main() {
 		if s[0] == "\t" {
 			continue
 		}
-		fmt.Printf("%s", s[0])
+		text.WriteString(s[0])
 	}
 
-	fmt.Printf("\n")
+	out := text.String()
+	if profile != nil {
+		out = profile.String(out)
+	}
+	fmt.Printf("%s\n", out)
 }
@@ -15,8 +15,11 @@ import (
 	"os"
 	"path/filepath"
 
+	"rescribe.xyz/utils/pkg/alto"
 	"rescribe.xyz/utils/pkg/hocr"
 	"rescribe.xyz/utils/pkg/line"
+	"rescribe.xyz/utils/pkg/normalize"
+	"rescribe.xyz/utils/pkg/page"
 	"rescribe.xyz/utils/pkg/prob"
 )
 
@@ -29,11 +32,13 @@ func main() {
 	}
 
 	flag.Usage = func() {
-		fmt.Fprintf(os.Stderr, "Usage: bucket-lines [-d dir] [-s specs.json] [hocr1] [prob1] [hocr2] [...]\n")
+		fmt.Fprintf(os.Stderr, "Usage: bucket-lines [-d dir] [-deskew] [-lenient] [-mask] [-normalize profile] [-pad n] [-s specs.json] [hocr1] [prob1] [hocr2] [...]\n")
 		fmt.Fprintf(os.Stderr, "Copies image-text line pairs into different directories according\n")
 		fmt.Fprintf(os.Stderr, "to the average character probability for the line.\n")
-		fmt.Fprintf(os.Stderr, "Both .hocr and .prob files can be processed.\n")
+		fmt.Fprintf(os.Stderr, "ALTO or PAGE XML (.xml), .hocr and .prob files can be processed.\n")
 		fmt.Fprintf(os.Stderr, "For .hocr files, the x_wconf data is used to calculate confidence.\n")
+		fmt.Fprintf(os.Stderr, "For PAGE XML files, the TextEquiv conf data is used, and for ALTO\n")
+		fmt.Fprintf(os.Stderr, "files the WC data.\n")
 		fmt.Fprintf(os.Stderr, "The .prob files are generated using ocropy-rpred's --probabilities\n")
 		fmt.Fprintf(os.Stderr, "option.\n")
 		fmt.Fprintf(os.Stderr, "The .prob and .hocr files are assumed to be in the same directory\n")
@@ -44,6 +49,11 @@ func main() {
 	}
 	dir := flag.String("d", "buckets", "Directory to store the buckets")
 	specs := flag.String("s", "", "JSON file describing specs to bucket into")
+	lenient := flag.Bool("lenient", false, "Skip words and lines with missing confidences or boxes, and page images which can't be decoded, rather than stopping")
+	deskew := flag.Bool("deskew", false, "Straighten hocr line images using their baseline and textangle")
+	pad := flag.Int("pad", 0, "Pixels of padding to add around hocr line images")
+	mask := flag.Bool("mask", false, "White out parts of hocr line images which are inside other lines")
+	normal := flag.String("normalize", "", "Normalize text with a profile: nfc, nfd, diplomatic, modernised, or the path of a mapping file")
 	flag.Parse()
 	if flag.NArg() < 1 {
 		flag.Usage()
@@ -61,6 +71,27 @@ func main() {
 		}
 	}
 
+	var profile *normalize.Profile
+	if *normal != "" {
+		var err error
+		profile, err = normalize.Get(*normal)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	setup := func(r *hocr.Reader) {
+		r.Lenient = *lenient
+		r.Deskew = *deskew
+		r.Padding = *pad
+		r.MaskNeighbours = *mask
+		r.Warn = func(err error) { log.Printf("Warning: %v\n", err) }
+	}
+	pagesetup := func(o *page.Options) {
+		o.Lenient = *lenient
+		o.Warn = func(err error) { log.Printf("Warning: %v\n", err) }
+	}
+
 	var err error
 	lines := make(line.Details, 0)
 
@@ -70,9 +101,21 @@ func main() {
 		case ".prob":
 			newlines, err = prob.GetLineDetails(f)
 		case ".hocr":
-			newlines, err = hocr.GetLineDetails(f)
+			newlines, err = hocr.OpenLineDetails(f, "", setup)
+		case ".xml":
+			// ALTO and PAGE XML share a suffix, so they are told
+			// apart by their root element
+			var isalto bool
+			isalto, err = alto.IsAltoFile(f)
+			switch {
+			case err != nil:
+			case isalto:
+				newlines, err = alto.GetLineDetails(f)
+			default:
+				newlines, err = page.OpenLineDetails(f, "", pagesetup)
+			}
 		default:
-			log.Printf("Skipping file '%s' as it isn't a .prob or .hocr\n", f)
+			log.Printf("Skipping file '%s' as it isn't a .prob, .hocr or .xml\n", f)
 			continue
 		}
 		if err != nil {
@@ -80,9 +123,15 @@ func main() {
 		}
 
 		for _, l := range newlines {
-			if l.Img != nil {
-				lines = append(lines, l)
+			if l.Img == nil || l.Avgconf < 0 {
+				// lines without an image or a confidence can't be
+				// bucketed
+				continue
+			}
+			if profile != nil {
+				l.Text = profile.String(l.Text)
 			}
+			lines = append(lines, l)
 		}
 	}
 
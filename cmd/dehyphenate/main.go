@@ -6,7 +6,6 @@
 package main
 
 import (
-	"encoding/xml"
 	"flag"
 	"fmt"
 	"io"
@@ -16,12 +15,10 @@ import (
 	"strings"
 
 	"rescribe.xyz/utils/pkg/hocr"
+	"rescribe.xyz/utils/pkg/normalize"
 )
 
 // BUGS:
-// - loses all elements not captured in hocr structure such as html headings
-//   might be best to copy the header and footer separately and put the hocr in between, but would still need to ensure all elements are captured
-// - loses any formatting; doesn't need to be identical, but e.g. linebreaks after elements would be handy
 // - need to handle OcrChar
 
 // dehyphenateString replaces hyphens at the end of a line
@@ -50,19 +47,39 @@ func dehyphenateString(in string) string {
 	return strings.Join(newlines, "\n")
 }
 
+// normalizeChars normalizes the text of chars and their children
+func normalizeChars(chars []hocr.OcrChar, profile *normalize.Profile) {
+	for i := range chars {
+		chars[i].Text = profile.String(chars[i].Text)
+		normalizeChars(chars[i].Chars, profile)
+	}
+}
+
 func main() {
 	flag.Usage = func() {
-		fmt.Fprintf(os.Stderr, "Usage: dehyphenate [-hocr] in out\n")
+		fmt.Fprintf(os.Stderr, "Usage: dehyphenate [-hocr] [-normalize profile] in out\n")
 		fmt.Fprintf(os.Stderr, "Dehyphenates a file.\n")
+		fmt.Fprintf(os.Stderr, "With -normalize, the text is normalized with a profile or mapping\n")
+		fmt.Fprintf(os.Stderr, "file before it is dehyphenated.\n")
 		flag.PrintDefaults()
 	}
 	usehocr := flag.Bool("hocr", false, "process hocr files, rather than plain text")
+	normal := flag.String("normalize", "", "Normalize text with a profile: nfc, nfd, diplomatic, modernised, or the path of a mapping file")
 	flag.Parse()
 	if flag.NArg() != 2 {
 		flag.Usage()
 		os.Exit(1)
 	}
 
+	var profile *normalize.Profile
+	if *normal != "" {
+		var err error
+		profile, err = normalize.Get(*normal)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
 	in, err := ioutil.ReadFile(flag.Arg(0))
 	if err != nil {
 		log.Fatalf("Error reading %s: %v", flag.Arg(1), err)
@@ -78,12 +95,27 @@ func main() {
 		}
 
 		for _, p := range h.Pages {
-			for i, l := range p.Lines {
+			lines := p.Lines()
+			if profile != nil {
+				for _, l := range lines {
+					l.Text = profile.String(l.Text)
+					for j := range l.Words {
+						l.Words[j].Text = profile.String(l.Words[j].Text)
+						normalizeChars(l.Words[j].Chars, profile)
+					}
+				}
+			}
+			for i, l := range lines {
+				// lines without words, and the last line of a
+				// page, have nothing to join
+				if len(l.Words) == 0 || i+1 >= len(lines) || len(lines[i+1].Words) == 0 {
+					continue
+				}
 				w := l.Words[len(l.Words)-1]
 				if len(w.Chars) == 0 {
 					if len(w.Text) > 0 && w.Text[len(w.Text)-1] == '-' {
-						p.Lines[i].Words[len(l.Words)-1].Text = w.Text[0:len(w.Text)-1] + p.Lines[i+1].Words[0].Text
-						p.Lines[i+1].Words[0].Text = ""
+						l.Words[len(l.Words)-1].Text = w.Text[0:len(w.Text)-1] + lines[i+1].Words[0].Text
+						lines[i+1].Words[0].Text = ""
 					}
 				} else {
 					log.Printf("TODO: handle OcrChar")
@@ -91,7 +123,11 @@ func main() {
 			}
 		}
 	} else {
-		finaltxt = dehyphenateString(string(in))
+		txt := string(in)
+		if profile != nil {
+			txt = profile.String(txt)
+		}
+		finaltxt = dehyphenateString(txt)
 	}
 
 	f, err := os.Create(flag.Arg(1))
@@ -101,10 +137,9 @@ func main() {
 	defer f.Close()
 
 	if *usehocr {
-		e := xml.NewEncoder(f)
-		err = e.Encode(h)
+		err = hocr.Write(f, h)
 		if err != nil {
-			log.Fatalf("Error encoding XML: %v", err)
+			log.Fatalf("Error writing hOCR: %v", err)
 		}
 	} else {
 		_, err := io.WriteString(f, finaltxt)
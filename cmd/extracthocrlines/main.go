@@ -16,15 +16,31 @@ import (
 	"path/filepath"
 	"strings"
 
+	"rescribe.xyz/utils/pkg/alto"
 	"rescribe.xyz/utils/pkg/hocr"
 	"rescribe.xyz/utils/pkg/line"
+	"rescribe.xyz/utils/pkg/normalize"
+	"rescribe.xyz/utils/pkg/page"
 )
 
-const usage = `Usage: extracthocrlines [-b] [-d] file.hocr [file.hocr]
+const usage = `Usage: extracthocrlines [-b] [-d] [-deskew] [-lenient] [-mask] [-normalize profile] [-pad n] file.hocr [file.hocr]
 
 Copies the text and corresponding image section for each line
 of a HOCR file into separate files, which is useful for OCR
 training.
+
+ALTO and PAGE XML files, with a .xml suffix, can also be used.
+For PAGE XML each line image is cropped to the line's polygon.
+
+For hOCR files, -deskew straightens each line image using the
+line's baseline and textangle, -pad adds a margin around it, and
+-mask whites out any parts of it which are inside other lines.
+
+With -normalize, the text of each line is normalized with one of
+the profiles nfc, nfd, diplomatic (which keeps characters such as
+long s and ligatures) or modernised (which replaces them), or
+with a mapping file, which has a string to replace and its
+replacement on each line, such as "ſ s".
 `
 
 // saveline saves the text and image for a line in a directory
@@ -66,22 +82,66 @@ func main() {
 		fmt.Fprintf(flag.CommandLine.Output(), usage)
 		flag.PrintDefaults()
 	}
-	usebasepath := flag.Bool("b", false, "Use the image path of the .hocr with the .hocr suffix stripped and replaced with .png, rather than the path embedded in the .hocr")
+	usebasepath := flag.Bool("b", false, "Use the image path of the .hocr or .xml with the suffix stripped and replaced with .png, rather than the path embedded in the file")
 	dir := flag.String("d", ".", "Directory to save lines in")
+	lenient := flag.Bool("lenient", false, "Skip words and lines with missing confidences or boxes, and page images which can't be decoded, rather than stopping")
+	deskew := flag.Bool("deskew", false, "Straighten hocr line images using their baseline and textangle")
+	pad := flag.Int("pad", 0, "Pixels of padding to add around hocr line images")
+	mask := flag.Bool("mask", false, "White out parts of hocr line images which are inside other lines")
+	normal := flag.String("normalize", "", "Normalize text with a profile: nfc, nfd, diplomatic, modernised, or the path of a mapping file")
 	flag.Parse()
 	if flag.NArg() < 1 {
 		flag.Usage()
 		os.Exit(1)
 	}
 
+	var profile *normalize.Profile
+	if *normal != "" {
+		var err error
+		profile, err = normalize.Get(*normal)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	setup := func(r *hocr.Reader) {
+		r.Lenient = *lenient
+		r.Deskew = *deskew
+		r.Padding = *pad
+		r.MaskNeighbours = *mask
+		r.Warn = func(err error) { log.Printf("Warning: %v\n", err) }
+	}
+	pagesetup := func(o *page.Options) {
+		o.Lenient = *lenient
+		o.Warn = func(err error) { log.Printf("Warning: %v\n", err) }
+	}
+
 	for _, f := range flag.Args() {
 		var err error
 		var newlines line.Details
-		if *usebasepath {
-			imgName := strings.TrimSuffix(f, ".hocr") + ".png"
-			newlines, err = hocr.GetLineDetailsCustomImg(f, imgName)
-		} else {
-			newlines, err = hocr.GetLineDetails(f)
+		ext := filepath.Ext(f)
+		// ALTO and PAGE XML share a suffix, so they are told apart
+		// by their root element
+		isalto := false
+		if ext == ".xml" {
+			isalto, err = alto.IsAltoFile(f)
+			if err != nil {
+				log.Fatal(err)
+			}
+		}
+		switch {
+		case isalto && *usebasepath:
+			newlines, err = alto.GetLineDetailsCustomImg(f, strings.TrimSuffix(f, ext)+".png")
+		case isalto:
+			newlines, err = alto.GetLineDetails(f)
+		case ext == ".xml" && *usebasepath:
+			newlines, err = page.OpenLineDetails(f, filepath.Base(strings.TrimSuffix(f, ext)+".png"), pagesetup)
+		case ext == ".xml":
+			newlines, err = page.OpenLineDetails(f, "", pagesetup)
+		case *usebasepath:
+			newlines, err = hocr.OpenLineDetails(f, filepath.Base(strings.TrimSuffix(f, ext)+".png"), setup)
+		default:
+			newlines, err = hocr.OpenLineDetails(f, "", setup)
 		}
 		if err != nil {
 			log.Fatal(err)
@@ -94,6 +154,9 @@ func main() {
 			if l.Text == "" {
 				continue
 			}
+			if profile != nil {
+				l.Text = profile.String(l.Text)
+			}
 			err = saveline(l, *dir)
 			if err != nil {
 				log.Fatal(err)
@@ -0,0 +1,216 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"image"
+	"io"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"rescribe.xyz/utils/pkg/hocr"
+)
+
+// severity is how serious a problem is. Errors are problems which
+// will cause tools to fail or produce wrong results, and warnings
+// are problems which may do.
+type severity int
+
+const (
+	warning severity = iota
+	failure
+)
+
+func (s severity) String() string {
+	if s == failure {
+		return "error"
+	}
+	return "warning"
+}
+
+// problem is a problem found with a hOCR element
+type problem struct {
+	sev  severity
+	elem string // id of the element, or a description if it has none
+	msg  string
+}
+
+func (p problem) String() string {
+	return fmt.Sprintf("%s: %s: %s", p.sev, p.elem, p.msg)
+}
+
+// linter checks the elements of a hOCR document, collecting any
+// problems found
+type linter struct {
+	imgs     fs.FS
+	ids      map[string]bool
+	problems []problem
+	// pagebox is the bbox of the current page
+	pagebox image.Rectangle
+}
+
+// lint checks a hOCR document read from r, with any page images it
+// refers to checked for in imgs
+func lint(r io.Reader, imgs fs.FS) ([]problem, error) {
+	l := linter{imgs: imgs, ids: make(map[string]bool)}
+	hr := hocr.NewReader(r)
+	for n := 1; ; n++ {
+		p, err := hr.Next()
+		if err == io.EOF {
+			return l.problems, nil
+		}
+		if err != nil {
+			return l.problems, err
+		}
+		l.page(p, n)
+	}
+}
+
+func (l *linter) report(sev severity, elem string, format string, a ...interface{}) {
+	l.problems = append(l.problems, problem{sev, elem, fmt.Sprintf(format, a...)})
+}
+
+// name returns the id of an element, or a description of it if it
+// has none, as the nth element of its kind in its parent
+func name(id string, kind string, n int, parent string) string {
+	if id != "" {
+		return id
+	}
+	if parent == "" {
+		return fmt.Sprintf("%s %d", kind, n)
+	}
+	return fmt.Sprintf("%s %d of %s", kind, n, parent)
+}
+
+// element checks the id and title of an element, returning its
+// properties. parent is the bbox of its parent element, if any.
+func (l *linter) element(elem string, id string, title string, parent image.Rectangle) hocr.Properties {
+	if id != "" {
+		if l.ids[id] {
+			l.report(failure, elem, "duplicate id")
+		}
+		l.ids[id] = true
+	}
+
+	props, err := hocr.ParseProperties(title)
+	if err != nil {
+		l.report(failure, elem, "%v in title '%s'", err, title)
+		return props
+	}
+	if !props.Has("bbox") {
+		return props
+	}
+
+	b := props.Bbox
+	switch {
+	case b.Dx() < 0 || b.Dy() < 0:
+		l.report(failure, elem, "bbox %v has negative size", b)
+		return props
+	case b.Dx() == 0 || b.Dy() == 0:
+		l.report(warning, elem, "bbox %v has zero area", b)
+		return props
+	}
+	switch {
+	case !l.pagebox.Empty() && !b.In(l.pagebox):
+		l.report(failure, elem, "bbox %v is outside of the page bbox %v", b, l.pagebox)
+	case !parent.Empty() && !b.In(parent):
+		l.report(warning, elem, "bbox %v is not inside its parent's bbox %v", b, parent)
+	}
+	return props
+}
+
+// box returns the bbox of an element, or the bbox of its parent if
+// it doesn't have a valid one, to check its children against
+func box(props hocr.Properties, parent image.Rectangle) image.Rectangle {
+	if props.Bbox.Empty() {
+		return parent
+	}
+	return props.Bbox
+}
+
+func (l *linter) page(p hocr.Page, n int) {
+	elem := name(p.Id, "page", n, "")
+	l.pagebox = image.Rectangle{}
+	props := l.element(elem, p.Id, p.Title, image.Rectangle{})
+	l.pagebox = props.Bbox
+
+	if props.Image == "" {
+		l.report(warning, elem, "no image")
+	} else if l.imgs != nil {
+		img := path.Base(filepath.ToSlash(props.Image))
+		_, err := fs.Stat(l.imgs, img)
+		if err != nil {
+			l.report(failure, elem, "image %s not found", img)
+		}
+	}
+
+	lines := 0
+	for ai, a := range p.Areas {
+		abox := l.pagebox
+		if a.Class != "" {
+			aprops := l.element(name(a.Id, "area", ai+1, elem), a.Id, a.Title, l.pagebox)
+			abox = box(aprops, l.pagebox)
+		}
+		for pi, par := range a.Paragraphs {
+			pbox := abox
+			if par.Class != "" {
+				pprops := l.element(name(par.Id, "paragraph", pi+1, elem), par.Id, par.Title, abox)
+				pbox = box(pprops, abox)
+			}
+			for _, ln := range par.Lines {
+				lines++
+				l.line(ln, name(ln.Id, "line", lines, elem), pbox)
+			}
+		}
+	}
+}
+
+func (l *linter) line(ln hocr.OcrLine, elem string, parent image.Rectangle) {
+	props := l.element(elem, ln.Id, ln.Title, parent)
+	if !props.Has("bbox") {
+		l.report(warning, elem, "no bbox")
+	}
+	lbox := box(props, parent)
+
+	if len(ln.Words) == 0 && strings.TrimSpace(ln.Text) == "" {
+		l.report(warning, elem, "no text")
+	}
+	for i, w := range ln.Words {
+		l.word(w, name(w.Id, "word", i+1, elem), lbox)
+	}
+}
+
+func (l *linter) word(w hocr.OcrWord, elem string, parent image.Rectangle) {
+	props := l.element(elem, w.Id, w.Title, parent)
+	if !props.Has("bbox") {
+		l.report(warning, elem, "no bbox")
+	}
+	if !props.Has("x_wconf") {
+		l.report(warning, elem, "no x_wconf")
+	} else if props.Wconf < 0 || props.Wconf > 100 {
+		l.report(failure, elem, "x_wconf %g is outside of the range 0-100", props.Wconf)
+	}
+
+	wbox := box(props, parent)
+	for i, c := range w.Chars {
+		l.element(name(c.Id, "character", i+1, elem), c.Id, c.Title, wbox)
+	}
+
+	glyphs, err := w.Glyphs()
+	if err != nil {
+		l.report(failure, elem, "%v", err)
+		return
+	}
+	var txt string
+	for _, g := range glyphs {
+		txt += g.Text
+	}
+	if strings.TrimSpace(txt) == "" {
+		l.report(warning, elem, "no text")
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+// hocrlint checks hOCR files for problems with their structure
+// and geometry
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+const usage = `Usage: hocrlint [-errors] file.hocr [file.hocr]
+
+Checks hOCR files for problems, such as duplicate ids, boxes
+which are empty, outside of the page or outside of their parent
+element, words with missing or invalid x_wconf or no text, and
+missing page images.
+
+Each problem is printed as a warning or an error, and the exit
+status is 1 if any were found.
+`
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	errorsonly := flag.Bool("errors", false, "Only report errors, not warnings")
+	flag.Parse()
+	if flag.NArg() < 1 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	found := false
+	for _, fn := range flag.Args() {
+		f, err := os.Open(fn)
+		if err != nil {
+			log.Fatalf("Error opening %s: %v", fn, err)
+		}
+		problems, err := lint(f, os.DirFS(filepath.Dir(fn)))
+		f.Close()
+		for _, p := range problems {
+			if *errorsonly && p.sev != failure {
+				continue
+			}
+			found = true
+			fmt.Printf("%s: %s\n", fn, p)
+		}
+		if err != nil {
+			found = true
+			fmt.Printf("%s: %s: %v\n", fn, failure, err)
+		}
+	}
+
+	if found {
+		os.Exit(1)
+	}
+}
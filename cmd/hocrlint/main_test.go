@@ -0,0 +1,63 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+const badHocr = `<html><body>
+<div class='ocr_page' id='page_1' title='image "p1.png"; bbox 0 0 600 800'>
+ <div class='ocr_carea' id='block_1' title='bbox 10 10 590 100'>
+  <p class='ocr_par' id='par_1' title='bbox 10 10 590 100'>
+   <span class='ocr_line' id='line_1' title='bbox 10 10 590 40'>
+    <span class='ocrx_word' id='word_1' title='bbox 10 10 200 40; x_wconf 91'>Lorem</span>
+    <span class='ocrx_word' id='word_1' title='bbox 210 10 400 40; x_wconf 85'>ipsum</span>
+    <span class='ocrx_word' id='word_3' title='bbox 410 10 700 40; x_wconf 85'>dolor</span>
+    <span class='ocrx_word' id='word_4' title='bbox 500 20 450 40; x_wconf 85'>sit</span>
+   </span>
+   <span class='ocr_line' id='line_2' title='bbox 10 50 590 120'>
+    <span class='ocrx_word' id='word_5' title='bbox 10 50 200 90; x_wconf 120'>amet</span>
+    <span class='ocrx_word' id='word_6' title='bbox 210 50 210 90'> </span>
+    <span class='ocrx_word' title='bbox 220 50 300 90; x_wconf 80; x_confs 90 80'>abc</span>
+   </span>
+  </p>
+ </div>
+</div>
+<div class='ocr_page' id='page_2' title='bbox 0 0 600 800'>
+ <span class='ocr_line' id='line_3' title='bbox 10 10 590 40; x_size abc'>ok</span>
+</div>
+</body></html>
+`
+
+func TestLint(t *testing.T) {
+	problems, err := lint(strings.NewReader(badHocr), fstest.MapFS{})
+	if err != nil {
+		t.Fatalf("Error linting: %v", err)
+	}
+	var got []string
+	for _, p := range problems {
+		got = append(got, p.String())
+	}
+	expected := []string{
+		"error: page_1: image p1.png not found",
+		"error: word_1: duplicate id",
+		"error: word_3: bbox (410,10)-(700,40) is outside of the page bbox (0,0)-(600,800)",
+		"error: word_4: bbox (500,20)-(450,40) has negative size",
+		"warning: line_2: bbox (10,50)-(590,120) is not inside its parent's bbox (10,10)-(590,100)",
+		"error: word_5: x_wconf 120 is outside of the range 0-100",
+		"warning: word_6: bbox (210,50)-(210,90) has zero area",
+		"warning: word_6: no x_wconf",
+		"warning: word_6: no text",
+		"error: word 3 of line_2: x_confs has 2 values for 3 characters",
+		"warning: page_2: no image",
+		"error: line_3: Error parsing property ' x_size abc': strconv.ParseFloat: parsing \"abc\": invalid syntax in title 'bbox 10 10 590 40; x_size abc'",
+	}
+	if strings.Join(got, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("Unexpected problems:\n%s\nExpected:\n%s", strings.Join(got, "\n"), strings.Join(expected, "\n"))
+	}
+}
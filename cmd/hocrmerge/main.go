@@ -0,0 +1,58 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+// hocrmerge joins hOCR files, such as one for each page of a
+// book, into a single hOCR file
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"rescribe.xyz/utils/pkg/hocr"
+)
+
+const usage = `Usage: hocrmerge file.hocr [file.hocr]
+
+Joins hOCR files, such as one for each page of a book, into a
+single hOCR file, which is printed to stdout. The pages are put
+in the order the files are given, their ids are renumbered so
+that they are unique, and their ppageno is set to their position
+in the book, counting from 0. Any different original ppageno,
+which selects the page of a multi-page TIFF image, is kept as
+x_frame, and is restored by hocrsplit.
+`
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() < 1 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	var docs []hocr.Hocr
+	for _, fn := range flag.Args() {
+		in, err := ioutil.ReadFile(fn)
+		if err != nil {
+			log.Fatalf("Error reading %s: %v", fn, err)
+		}
+		h, err := hocr.Parse(in)
+		if err != nil {
+			log.Fatalf("Error parsing %s: %v", fn, err)
+		}
+		docs = append(docs, h)
+	}
+
+	err := hocr.Write(os.Stdout, hocr.Merge(docs))
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+// hocrsplit splits a hOCR file, such as one for a whole book, into
+// a hOCR file for each page
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"rescribe.xyz/utils/pkg/hocr"
+)
+
+const usage = `Usage: hocrsplit [-d dir] book.hocr
+
+Splits a hOCR file, such as one for a whole book, into a hOCR
+file for each page. Each file is named after the image of its
+page, with the suffix replaced by .hocr, or page_0001.hocr and
+so on for pages with no image. Any ppageno changed by hocrmerge
+is restored.
+`
+
+// pageName returns the name for the hOCR file of page n, which is
+// made unique if it is already in use
+func pageName(p hocr.Page, n int, used map[string]bool) (string, error) {
+	props, err := p.Properties()
+	if err != nil {
+		return "", err
+	}
+	name := path.Base(filepath.ToSlash(props.Image))
+	name = strings.TrimSuffix(name, path.Ext(name))
+	switch {
+	case props.Image == "":
+		name = fmt.Sprintf("page_%04d", n)
+	case used[name]:
+		// such as for pages of a multi-page TIFF
+		name = fmt.Sprintf("%s_%04d", name, n)
+	}
+	used[name] = true
+	return name + ".hocr", nil
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	dir := flag.String("d", ".", "Directory to save pages in")
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	in, err := ioutil.ReadFile(flag.Arg(0))
+	if err != nil {
+		log.Fatalf("Error reading %s: %v", flag.Arg(0), err)
+	}
+	h, err := hocr.Parse(in)
+	if err != nil {
+		log.Fatalf("Error parsing %s: %v", flag.Arg(0), err)
+	}
+
+	err = os.MkdirAll(*dir, 0755)
+	if err != nil {
+		log.Fatalf("Error creating directory %s: %v", *dir, err)
+	}
+
+	used := make(map[string]bool)
+	for i, d := range hocr.Split(h) {
+		name, err := pageName(d.Pages[0], i+1, used)
+		if err != nil {
+			log.Fatalf("Error parsing properties of page %s: %v", d.Pages[0].Id, err)
+		}
+		fn := filepath.Join(*dir, name)
+		f, err := os.Create(fn)
+		if err != nil {
+			log.Fatalf("Error creating %s: %v", fn, err)
+		}
+		err = hocr.Write(f, d)
+		if err != nil {
+			log.Fatalf("Error writing %s: %v", fn, err)
+		}
+		err = f.Close()
+		if err != nil {
+			log.Fatalf("Error closing %s: %v", fn, err)
+		}
+	}
+}
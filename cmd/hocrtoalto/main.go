@@ -0,0 +1,50 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+// hocrtoalto converts a hOCR file to ALTO XML
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"rescribe.xyz/utils/pkg/alto"
+	"rescribe.xyz/utils/pkg/hocr"
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: hocrtoalto hocrfile\n")
+		fmt.Fprintf(os.Stderr, "Converts a hOCR file to ALTO XML, printing it to stdout.\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	in, err := ioutil.ReadFile(flag.Arg(0))
+	if err != nil {
+		log.Fatalf("Error reading %s: %v", flag.Arg(0), err)
+	}
+
+	h, err := hocr.Parse(in)
+	if err != nil {
+		log.Fatalf("Error parsing %s: %v", flag.Arg(0), err)
+	}
+
+	a, err := alto.FromHocr(h)
+	if err != nil {
+		log.Fatalf("Error converting %s: %v", flag.Arg(0), err)
+	}
+
+	err = alto.Write(os.Stdout, a)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,183 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+// hocrtoepub creates an EPUB book from a directory of hOCR pages
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode"
+
+	"rescribe.xyz/utils/pkg/epub"
+	"rescribe.xyz/utils/pkg/hocr"
+	"rescribe.xyz/utils/pkg/normalize"
+)
+
+const usage = `Usage: hocrtoepub [-author name] [-headings] [-images] [-lang code] [-normalize profile] [-title title] [-toc file] [-year year] bookdir out.epub
+
+Creates an EPUB book from a directory of hOCR files, one for each
+page, read in natural order of their names, so that 2.hocr comes
+before 10.hocr.
+
+Paragraphs are joined across lines and pages, removing hyphens
+from words which are split across them.
+
+The title, author and year are taken from the name of the
+directory if it is in the YEAR_AUTHOR_Title form which dlgbook
+creates, unless they are given as flags.
+
+A table of contents can be given with -toc, as a file with the
+page number (counting from 1) and the heading of each chapter on
+each line, such as "5 Chapter I". Otherwise, with -headings, any
+paragraphs which look like headings, because they are short and
+in larger text than usual, are used. Being marked as ocr_header
+isn't enough, as that is also used for the running header at the
+top of each page.
+`
+
+func leadingDigits(s string) string {
+	i := 0
+	for i < len(s) && s[i] >= '0' && s[i] <= '9' {
+		i++
+	}
+	return s[:i]
+}
+
+// dirMetadata returns the metadata in a directory name of the form
+// YEAR_AUTHOR_Title_bookid, as dlgbook creates
+func dirMetadata(dir string) epub.Metadata {
+	var m epub.Metadata
+	parts := strings.Split(filepath.Base(filepath.Clean(dir)), "_")
+	if len(parts) < 3 || len(leadingDigits(parts[0])) != 4 {
+		return m
+	}
+	m.Year = parts[0]
+	m.Author = parts[1]
+	if strings.ToUpper(m.Author) == m.Author {
+		m.Author = strings.Title(strings.ToLower(m.Author))
+	}
+	// the title has its spaces removed, so add them back before
+	// each capital letter
+	var title []rune
+	for i, r := range parts[2] {
+		if i > 0 && unicode.IsUpper(r) {
+			title = append(title, ' ')
+		}
+		title = append(title, r)
+	}
+	m.Title = string(title)
+	return m
+}
+
+// readPages reads the pages of each hOCR file in a directory
+func readPages(dir string) ([]epub.Page, error) {
+	fns, err := hocr.Files(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var pages []epub.Page
+	imgs := os.DirFS(dir)
+	for _, fn := range fns {
+		f, err := os.Open(fn)
+		if err != nil {
+			return pages, err
+		}
+		r := hocr.NewReader(f)
+		for {
+			p, err := r.Next()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				f.Close()
+				return pages, fmt.Errorf("Error reading %s: %v", fn, err)
+			}
+			pages = append(pages, epub.Page{Page: p, Images: imgs})
+		}
+		f.Close()
+	}
+	return pages, nil
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	author := flag.String("author", "", "Set author, rather than taking it from the directory name")
+	headings := flag.Bool("headings", false, "Find headings in the text for the table of contents")
+	images := flag.Bool("images", false, "Include the image of each page")
+	lang := flag.String("lang", "", "Set language, rather than taking it from the hOCR")
+	normal := flag.String("normalize", "", "Normalize text with a profile: nfc, nfd, diplomatic, modernised, or the path of a mapping file")
+	title := flag.String("title", "", "Set title, rather than taking it from the directory name")
+	tocfn := flag.String("toc", "", "File containing the table of contents")
+	year := flag.String("year", "", "Set year, rather than taking it from the directory name")
+	flag.Parse()
+	if flag.NArg() != 2 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	dir, out := flag.Arg(0), flag.Arg(1)
+
+	opts := epub.Options{Metadata: dirMetadata(dir), Headings: *headings, Images: *images}
+	for _, v := range []struct{ flag, field *string }{
+		{author, &opts.Author}, {lang, &opts.Language}, {title, &opts.Title}, {year, &opts.Year},
+	} {
+		if *v.flag != "" {
+			*v.field = *v.flag
+		}
+	}
+
+	if *tocfn != "" {
+		f, err := os.Open(*tocfn)
+		if err != nil {
+			log.Fatalf("Error opening table of contents %s: %v", *tocfn, err)
+		}
+		opts.TOC, err = epub.ReadTOC(f)
+		f.Close()
+		if err != nil {
+			log.Fatalf("Error reading table of contents %s: %v", *tocfn, err)
+		}
+	}
+
+	if *normal != "" {
+		var err error
+		opts.Profile, err = normalize.Get(*normal)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	pages, err := readPages(dir)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(pages) == 0 {
+		log.Fatalf("No hOCR pages found in %s", dir)
+	}
+
+	f, err := os.Create(out)
+	if err != nil {
+		log.Fatalf("Error creating %s: %v", out, err)
+	}
+
+	err = epub.Write(f, pages, opts)
+	if err == nil {
+		err = f.Close()
+	} else {
+		f.Close()
+	}
+	if err != nil {
+		// don't leave a truncated book behind
+		os.Remove(out)
+		log.Fatalf("Error writing %s: %v", out, err)
+	}
+}
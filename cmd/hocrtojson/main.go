@@ -0,0 +1,91 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+// hocrtojson converts hOCR files to JSON, and back
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"rescribe.xyz/utils/pkg/hocr"
+)
+
+const usage = `Usage: hocrtojson [-indent] [-r] file [file]
+
+Converts hOCR files, such as one for each page of a book, to a
+single JSON document, which is printed to stdout.
+
+The JSON contains the pages of the document, each of which
+contains areas, paragraphs, lines, words and chars, with their
+class, id, language and title. The bbox, baseline and confidence
+(x_wconf or x_conf) are also given as parsed values, for example:
+
+  {"class": "ocrx_word", "id": "word_1_3",
+   "title": "bbox 210 50 590 100; x_wconf 73",
+   "bbox": [210, 50, 590, 100], "conf": 73, "text": "ipsum"}
+
+With -r, JSON files in this form are converted back to hOCR. Any
+bbox, baseline or conf which has been edited is set in the title,
+so the JSON can be edited and turned back into hOCR. Any markup
+inside elements, such as <em>, is not kept.
+`
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	indent := flag.Bool("indent", false, "Indent the JSON output")
+	reverse := flag.Bool("r", false, "Convert JSON files to hOCR")
+	flag.Parse()
+	if flag.NArg() < 1 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	var docs []hocr.Hocr
+	for _, fn := range flag.Args() {
+		in, err := ioutil.ReadFile(fn)
+		if err != nil {
+			log.Fatalf("Error reading %s: %v", fn, err)
+		}
+		var h hocr.Hocr
+		if *reverse {
+			err = json.Unmarshal(in, &h)
+		} else {
+			h, err = hocr.Parse(in)
+		}
+		if err != nil {
+			log.Fatalf("Error parsing %s: %v", fn, err)
+		}
+		docs = append(docs, h)
+	}
+	h := docs[0]
+	if len(docs) > 1 {
+		h = hocr.Merge(docs)
+	}
+
+	if *reverse {
+		err := hocr.Write(os.Stdout, h)
+		if err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetEscapeHTML(false)
+	if *indent {
+		enc.SetIndent("", "  ")
+	}
+	err := enc.Encode(h)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,70 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+// hocrtomd converts hOCR files to Markdown
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"rescribe.xyz/utils/pkg/hocr"
+	"rescribe.xyz/utils/pkg/markdown"
+	"rescribe.xyz/utils/pkg/normalize"
+)
+
+const usage = `Usage: hocrtomd [-dehyphenate] [-normalize profile] [-pages] file.hocr [file.hocr]
+
+Converts hOCR files, such as one for each page of a book, to a
+single Markdown document, which is printed to stdout.
+
+Each paragraph is written as a single line, separated by a blank
+line, and any characters which Markdown would treat as formatting
+are escaped.
+`
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	dehyphenate := flag.Bool("dehyphenate", false, "Remove the hyphen from words which are hyphenated across lines")
+	normal := flag.String("normalize", "", "Normalize text with a profile: nfc, nfd, diplomatic, modernised, or the path of a mapping file")
+	pages := flag.Bool("pages", false, "Add a comment with the page number and image at the start of each page")
+	flag.Parse()
+	if flag.NArg() < 1 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	opts := markdown.Options{Dehyphenate: *dehyphenate, PageMarkers: *pages}
+	if *normal != "" {
+		var err error
+		opts.Profile, err = normalize.Get(*normal)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	var docs []hocr.Hocr
+	for _, fn := range flag.Args() {
+		in, err := ioutil.ReadFile(fn)
+		if err != nil {
+			log.Fatalf("Error reading %s: %v", fn, err)
+		}
+		h, err := hocr.Parse(in)
+		if err != nil {
+			log.Fatalf("Error parsing %s: %v", fn, err)
+		}
+		docs = append(docs, h)
+	}
+
+	err := markdown.Write(os.Stdout, hocr.Merge(docs), opts)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+// hocrtopdf creates a searchable PDF from page images and hOCR
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"rescribe.xyz/utils/pkg/pdf"
+)
+
+const usage = `Usage: hocrtopdf [-b] [-dpi n] out.pdf file.hocr [file.hocr]
+
+Creates a searchable PDF from page images and hOCR. Each page
+image is added as a page of the PDF, with the text from the hOCR
+laid invisibly over it, so that it can be searched and copied.
+
+The hOCR files can each be a single page or a whole book. The
+page images are found in the same directory as the hOCR file,
+using the image names embedded in the hOCR.
+`
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	usebasepath := flag.Bool("b", false, "Use the image path of the .hocr with the .hocr suffix stripped and replaced with .png, rather than the path embedded in the .hocr")
+	dpi := flag.Float64("dpi", pdf.DefaultDPI, "Resolution of the page images, if not given by scan_res in the hOCR")
+	flag.Parse()
+	if flag.NArg() < 2 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	p, err := pdf.New()
+	if err != nil {
+		log.Fatalf("Error creating PDF: %v", err)
+	}
+	p.DPI = *dpi
+
+	for _, fn := range flag.Args()[1:] {
+		f, err := os.Open(fn)
+		if err != nil {
+			log.Fatalf("Error opening %s: %v", fn, err)
+		}
+		imgs := os.DirFS(filepath.Dir(fn))
+		if *usebasepath {
+			imgname := filepath.Base(strings.TrimSuffix(fn, ".hocr") + ".png")
+			err = p.AddHocrCustomImg(f, imgs, imgname)
+		} else {
+			err = p.AddHocr(f, imgs)
+		}
+		f.Close()
+		if err != nil {
+			log.Fatalf("Error adding %s to PDF: %v", fn, err)
+		}
+	}
+
+	f, err := os.Create(flag.Arg(0))
+	if err != nil {
+		log.Fatalf("Error creating %s: %v", flag.Arg(0), err)
+	}
+
+	err = p.Write(f)
+	if err == nil {
+		err = f.Close()
+	} else {
+		f.Close()
+	}
+	if err != nil {
+		// don't leave a truncated PDF behind
+		os.Remove(flag.Arg(0))
+		log.Fatalf("Error writing %s: %v", flag.Arg(0), err)
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+// hocrtotei converts hOCR files to TEI XML
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+
+	"rescribe.xyz/utils/pkg/hocr"
+	"rescribe.xyz/utils/pkg/normalize"
+	"rescribe.xyz/utils/pkg/tei"
+)
+
+const usage = `Usage: hocrtotei [-normalize profile] [-title title] [-unclear conf] file.hocr [file.hocr]
+
+Converts hOCR files, such as one for each page of a book, to a
+single TEI XML document, which is printed to stdout.
+
+Each page becomes a <pb/>, with the name of the page image as its
+facs, each paragraph a <p>, and each line starts with a <lb/>.
+Words with an x_wconf below the -unclear confidence, 50 unless it
+is set, are marked with <unclear>, and with -unclear 0 none are.
+`
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	normal := flag.String("normalize", "", "Normalize text with a profile: nfc, nfd, diplomatic, modernised, or the path of a mapping file")
+	title := flag.String("title", "", "Title of the document")
+	unclear := flag.Float64("unclear", 50, "Confidence below which words are marked as unclear")
+	flag.Parse()
+	if flag.NArg() < 1 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	opts := tei.Options{Title: *title, Unclear: *unclear}
+	if *normal != "" {
+		var err error
+		opts.Profile, err = normalize.Get(*normal)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	var docs []hocr.Hocr
+	for _, fn := range flag.Args() {
+		in, err := ioutil.ReadFile(fn)
+		if err != nil {
+			log.Fatalf("Error reading %s: %v", fn, err)
+		}
+		h, err := hocr.Parse(in)
+		if err != nil {
+			log.Fatalf("Error parsing %s: %v", fn, err)
+		}
+		docs = append(docs, h)
+	}
+
+	err := tei.Write(os.Stdout, hocr.Merge(docs), opts)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
@@ -2,34 +2,167 @@
 // Use of this source code is governed by the GPLv3
 // license that can be found in the LICENSE file.
 
-// hocrtotxt prints the text from a hocr file
+// hocrtotxt prints the text from hocr files
 package main
 
 import (
+	"bufio"
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"os"
 
 	"rescribe.xyz/utils/pkg/hocr"
+	"rescribe.xyz/utils/pkg/normalize"
 )
 
+const usage = `Usage: hocrtotxt [-dehyphenate] [-ff] [-mode mode] [-normalize profile] [-pagebreak marker] [hocrfile | dir]...
+
+Prints the text from hocr files. Directories are searched for
+.hocr files, which are read in natural order of their names, and
+if no files are given, or a file is -, the hocr is read from stdin.
+
+The mode sets how the text is laid out:
+  lines       each line of text on its own line
+  paragraphs  with a blank line between paragraphs
+  layout      placed on a grid of characters according to the
+              position of each line and word on the page, which
+              keeps the layout of pages with several columns
+
+Pages are separated by nothing unless -ff or -pagebreak are used.
+`
+
+// hocrFiles returns the hocr files to read for an argument, which
+// is either a file or a directory
+func hocrFiles(arg string) ([]string, error) {
+	if arg == "-" {
+		return []string{arg}, nil
+	}
+	info, err := os.Stat(arg)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{arg}, nil
+	}
+	return hocr.Files(arg)
+}
+
+// textWriter writes the text of pages, with a separator between
+// them
+type textWriter struct {
+	w         io.Writer
+	opts      hocr.TextOptions
+	profile   *normalize.Profile
+	separator string
+	pages     int
+}
+
+// write writes the text of each page read from r
+func (t *textWriter) write(r io.Reader) error {
+	hr := hocr.NewReader(r)
+	for {
+		p, err := hr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		text := hocr.PageText(p, t.opts)
+		if t.profile != nil {
+			text = t.profile.String(text)
+		}
+		if t.pages > 0 {
+			text = t.separator + text
+		}
+		t.pages++
+		_, err = io.WriteString(t.w, text)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// writeFile writes the text of each page in a hocr file
+func (t *textWriter) writeFile(fn string) error {
+	f, err := os.Open(fn)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return t.write(f)
+}
+
 func main() {
 	flag.Usage = func() {
-		fmt.Fprintf(os.Stderr, "Usage: hocrtotxt hocrfile\n")
-		fmt.Fprintf(os.Stderr, "Prints the text from a hocr file.\n")
+		fmt.Fprintf(flag.CommandLine.Output(), usage)
 		flag.PrintDefaults()
 	}
+	dehyphenate := flag.Bool("dehyphenate", false, "Join words which are hyphenated at the end of a line (not in layout mode)")
+	ff := flag.Bool("ff", false, "Separate pages with a form feed")
+	mode := flag.String("mode", "lines", "Layout of the text: lines, paragraphs or layout")
+	normal := flag.String("normalize", "", "Normalize text with a profile: nfc, nfd, diplomatic, modernised, or the path of a mapping file")
+	pagebreak := flag.String("pagebreak", "", "Separate pages with a line containing this marker")
 	flag.Parse()
-	if flag.NArg() != 1 {
-		flag.Usage()
-		os.Exit(1)
+
+	w := bufio.NewWriter(os.Stdout)
+	t := textWriter{w: w, opts: hocr.TextOptions{Dehyphenate: *dehyphenate}}
+
+	switch *mode {
+	case "lines":
+		t.opts.Mode = hocr.TextLines
+	case "paragraphs":
+		t.opts.Mode = hocr.TextParagraphs
+	case "layout":
+		t.opts.Mode = hocr.TextLayout
+	default:
+		log.Fatalf("Unknown mode %s", *mode)
+	}
+
+	if *pagebreak != "" {
+		t.separator += *pagebreak + "\n"
+	}
+	if *ff {
+		t.separator += "\f"
 	}
 
-	text, err := hocr.GetText(flag.Arg(0))
+	if *normal != "" {
+		var err error
+		t.profile, err = normalize.Get(*normal)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	args := flag.Args()
+	if len(args) == 0 {
+		args = []string{"-"}
+	}
+	var files []string
+	for _, arg := range args {
+		f, err := hocrFiles(arg)
+		if err != nil {
+			log.Fatal(err)
+		}
+		files = append(files, f...)
+	}
+
+	for _, fn := range files {
+		var err error
+		if fn == "-" {
+			err = t.write(os.Stdin)
+		} else {
+			err = t.writeFile(fn)
+		}
+		if err != nil {
+			log.Fatalf("Error reading %s: %v", fn, err)
+		}
+	}
+
+	err := w.Flush()
 	if err != nil {
 		log.Fatal(err)
 	}
-
-	fmt.Printf("%s\n", text)
 }
@@ -0,0 +1,89 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"html"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"rescribe.xyz/utils/pkg/eval"
+	"rescribe.xyz/utils/pkg/line"
+)
+
+// unsafeName matches characters which shouldn't be used in the name
+// of an image file
+var unsafeName = regexp.MustCompile(`[^a-zA-Z0-9_.-]+`)
+
+// htmlConfusions writes an HTML page to dir listing the confusions,
+// with the image, OCR and ground truth of each example line. Lines
+// are found in lines by the example id.
+func htmlConfusions(dir string, confusions []eval.ConfusionCount, lines map[string]lineResult) error {
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return err
+	}
+
+	fn := filepath.Join(dir, "index.html")
+	f, err := os.Create(fn)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	_, err = fmt.Fprintf(f, "<!DOCTYPE html><html><head><meta charset='UTF-8'><title></title>"+
+		"<style>td {border: 1px solid #444}</style></head><body>\n<table>\n")
+	if err != nil {
+		return err
+	}
+	copied := make(map[string]string)
+	for _, c := range confusions {
+		var examples []string
+		for _, id := range c.Examples {
+			l, ok := lines[id]
+			if !ok {
+				// the whole file was compared, so there is no line
+				examples = append(examples, html.EscapeString(id))
+				continue
+			}
+			var img string
+			if l.img != nil {
+				img, ok = copied[id]
+				if !ok {
+					// lines in different directories can have the
+					// same name, so each image is numbered too
+					img = fmt.Sprintf("%d_%s.png", len(copied), unsafeName.ReplaceAllString(filepath.Base(id), "_"))
+					err = line.SaveImg(filepath.Join(dir, img), l.img)
+					if err != nil {
+						return err
+					}
+					copied[id] = img
+				}
+				img = fmt.Sprintf("<img src='%s' width='100%%' /><br />", html.EscapeString(img))
+			}
+			examples = append(examples, fmt.Sprintf("%s%s<br />OCR: %s<br />GT: %s",
+				img, html.EscapeString(id), html.EscapeString(l.OCR), html.EscapeString(l.GT)))
+		}
+		_, err = fmt.Fprintf(f, "<tr>\n"+
+			"<td><h1>%d</h1></td>\n"+
+			"<td>%s<br />%s &rarr; %s</td>\n"+
+			"<td>%s</td>\n"+
+			"</tr>\n",
+			c.Count, c.Op(), html.EscapeString(c.GT), html.EscapeString(c.OCR),
+			strings.Join(examples, "<hr />"))
+		if err != nil {
+			return err
+		}
+	}
+	_, err = fmt.Fprintf(f, "</table>\n</body></html>\n")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
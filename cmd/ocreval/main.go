@@ -0,0 +1,275 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+// ocreval compares OCR output with ground truth, giving the
+// character and word error rates for each line, page and book
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"rescribe.xyz/utils/pkg/eval"
+	"rescribe.xyz/utils/pkg/hocr"
+	"rescribe.xyz/utils/pkg/line"
+	"rescribe.xyz/utils/pkg/normalize"
+	"rescribe.xyz/utils/pkg/prob"
+)
+
+const usage = `Usage: ocreval [-confusions n] [-examples n] [-f format] [-html dir] [-i] [-lines] [-normalize profile] [-p] file [file]
+
+Compares OCR output with ground truth, giving the character error
+rate (CER) and word error rate (WER) for each file, and for all of
+them together.
+
+The OCR can be .hocr, .prob or plain text files, and the ground
+truth for each is the file with the same name but the suffix
+replaced with .gt.txt. If the OCR and ground truth have the same
+number of lines, each line is compared separately, otherwise the
+whole text of the file is compared. For OCR with more than one
+page, such as the hOCR of a whole book, each page is given too.
+
+The results can be printed as text, csv or json.
+
+Text is normalized to Unicode NFC before it is compared, or with
+-normalize it can be normalized with one of the profiles nfc, nfd,
+diplomatic or modernised, or with a mapping file. The modernised
+profile replaces characters such as long s and ligatures, so it
+can be used to compare OCR with modernised ground truth.
+
+With -confusions, the most frequent confusions between the OCR
+and ground truth are reported instead, such as ſ recognised as f,
+or rn as m, along with some example lines for each. With -html,
+a page showing the confusions, with the image of each example
+line where it can be found, is also saved to a directory.
+`
+
+// textLine is a named line of text, with the name of its page and
+// its image if known
+type textLine struct {
+	name string
+	page string
+	text string
+	img  line.CopyableImg
+}
+
+// ocrLines returns the lines of text of an OCR file
+func ocrLines(fn string) ([]textLine, error) {
+	var lines []textLine
+	switch filepath.Ext(fn) {
+	case ".hocr":
+		f, err := os.Open(fn)
+		if err != nil {
+			return lines, err
+		}
+		defer f.Close()
+		// line images are only used for examples of confusions, so
+		// any problems with them can be ignored
+		r := hocr.NewReader(f)
+		r.Lenient = true
+		r.Warn = func(error) {}
+		err = r.LineDetails(os.DirFS(filepath.Dir(fn)), func(l line.Detail) error {
+			lines = append(lines, textLine{l.Name, l.OcrName, l.Text, l.Img})
+			return nil
+		})
+		return lines, err
+	case ".prob":
+		details, err := prob.GetLineDetails(fn)
+		if err != nil {
+			return lines, err
+		}
+		for _, l := range details {
+			lines = append(lines, textLine{l.Name, l.OcrName, l.Text, l.Img})
+		}
+		return lines, nil
+	default:
+		lines, err := textLines(fn)
+		// a single line, such as from extracthocrlines, may have
+		// its image alongside it
+		if len(nonEmpty(lines)) == 1 {
+			base := strings.TrimSuffix(fn, filepath.Ext(fn))
+			for _, img := range []string{base + ".png", base + ".bin.png"} {
+				if _, err := os.Stat(img); err == nil {
+					lines = nonEmpty(lines)
+					lines[0].img = line.ImgPath{Path: img}
+					break
+				}
+			}
+		}
+		return lines, err
+	}
+}
+
+// textLines returns the lines of a plain text file
+func textLines(fn string) ([]textLine, error) {
+	var lines []textLine
+	b, err := ioutil.ReadFile(fn)
+	if err != nil {
+		return lines, err
+	}
+	for i, l := range strings.Split(string(b), "\n") {
+		lines = append(lines, textLine{name: fmt.Sprintf("line%d", i+1), text: l})
+	}
+	return lines, nil
+}
+
+// nonEmpty returns the lines which contain some text
+func nonEmpty(lines []textLine) []textLine {
+	var n []textLine
+	for _, l := range lines {
+		if strings.TrimSpace(l.text) != "" {
+			n = append(n, l)
+		}
+	}
+	return n
+}
+
+// gtPath returns the path of the ground truth for an OCR file
+func gtPath(fn string) string {
+	return strings.TrimSuffix(fn, filepath.Ext(fn)) + ".gt.txt"
+}
+
+// compareFile compares an OCR file with its ground truth
+func compareFile(fn string, opts eval.Options) (fileResult, error) {
+	res := fileResult{File: fn}
+	ocr, err := ocrLines(fn)
+	if err != nil {
+		return res, fmt.Errorf("Error reading %s: %v", fn, err)
+	}
+	gt, err := textLines(gtPath(fn))
+	if err != nil {
+		return res, fmt.Errorf("Error reading ground truth for %s: %v", fn, err)
+	}
+	ocr, gt = nonEmpty(ocr), nonEmpty(gt)
+
+	if len(ocr) != len(gt) {
+		log.Printf("Warning: %s has %d lines but its ground truth has %d, so comparing the whole text\n", fn, len(ocr), len(gt))
+		res.ocr, res.gt = joinLines(ocr), joinLines(gt)
+		res.Result = eval.Compare(res.ocr, res.gt, opts)
+		return res, nil
+	}
+	for i := range ocr {
+		r := eval.Compare(ocr[i].text, gt[i].text, opts)
+		l := lineResult{ocr[i].name, ocr[i].page, ocr[i].text, gt[i].text, r, ocr[i].img}
+		res.Lines = append(res.Lines, l)
+		res.Result.Add(r)
+
+		// lines are in page order, so a new page starts whenever
+		// the page name changes
+		if n := len(res.Pages); n == 0 || res.Pages[n-1].Name != l.Page {
+			res.Pages = append(res.Pages, pageResult{Name: l.Page})
+		}
+		pg := &res.Pages[len(res.Pages)-1]
+		pg.Lines = append(pg.Lines, l)
+		pg.Result.Add(r)
+	}
+	if len(res.Pages) < 2 {
+		res.Pages = nil
+	}
+	return res, nil
+}
+
+func joinLines(lines []textLine) string {
+	var s []string
+	for _, l := range lines {
+		s = append(s, l.text)
+	}
+	return strings.Join(s, "\n")
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	format := flag.String("f", "text", "Output format: text, csv or json")
+	ignorecase := flag.Bool("i", false, "Ignore differences in case")
+	showlines := flag.Bool("lines", false, "Show results for each line, as well as each file")
+	ignorepunct := flag.Bool("p", false, "Ignore punctuation")
+	normal := flag.String("normalize", "", "Normalize text with a profile: nfc, nfd, diplomatic, modernised, or the path of a mapping file")
+	confusions := flag.Int("confusions", 0, "Report the n most frequent confusions, rather than error rates")
+	examples := flag.Int("examples", 5, "Number of example lines to give for each confusion")
+	htmldir := flag.String("html", "", "Directory to save an HTML page of the confusions to")
+	flag.Parse()
+	if flag.NArg() < 1 {
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	var out func(io.Writer, bookResult, bool) error
+	var confout func(io.Writer, []eval.ConfusionCount) error
+	switch *format {
+	case "text":
+		out, confout = writeText, writeConfusionsText
+	case "csv":
+		out, confout = writeCSV, writeConfusionsCSV
+	case "json":
+		out, confout = writeJSON, writeConfusionsJSON
+	default:
+		log.Fatalf("Unknown format %s", *format)
+	}
+
+	opts := eval.Options{IgnoreCase: *ignorecase, IgnorePunctuation: *ignorepunct}
+	if *normal != "" {
+		var err error
+		opts.Profile, err = normalize.Get(*normal)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	var book bookResult
+	for _, fn := range flag.Args() {
+		if strings.HasSuffix(fn, ".gt.txt") {
+			continue
+		}
+		res, err := compareFile(fn, opts)
+		if err != nil {
+			log.Fatal(err)
+		}
+		book.Files = append(book.Files, res)
+		book.Result.Add(res.Result)
+	}
+
+	if *confusions == 0 && *htmldir == "" {
+		err := out(os.Stdout, book, *showlines)
+		if err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	table := eval.NewConfusionTable(*examples)
+	examplelines := make(map[string]lineResult)
+	for _, f := range book.Files {
+		if len(f.Lines) == 0 {
+			table.Add(f.ocr, f.gt, f.File, opts)
+			continue
+		}
+		for _, l := range f.Lines {
+			id := f.File + " " + l.Name
+			table.Add(l.OCR, l.GT, id, opts)
+			examplelines[id] = l
+		}
+	}
+	top := table.Top(*confusions)
+
+	if *confusions > 0 {
+		err := confout(os.Stdout, top)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	if *htmldir != "" {
+		err := htmlConfusions(*htmldir, top, examplelines)
+		if err != nil {
+			log.Fatalf("Error writing HTML to %s: %v", *htmldir, err)
+		}
+	}
+}
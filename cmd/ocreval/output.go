@@ -0,0 +1,216 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"rescribe.xyz/utils/pkg/eval"
+	"rescribe.xyz/utils/pkg/line"
+)
+
+type lineResult struct {
+	Name   string
+	Page   string
+	OCR    string
+	GT     string
+	Result eval.Result
+	img    line.CopyableImg
+}
+
+// pageResult is the result for a page of a file with more than
+// one page
+type pageResult struct {
+	Name   string
+	Lines  []lineResult
+	Result eval.Result
+}
+
+type fileResult struct {
+	File   string
+	Lines  []lineResult
+	Pages  []pageResult
+	Result eval.Result
+	// ocr and gt are the whole text of the file and its ground
+	// truth, if they couldn't be compared line by line
+	ocr, gt string
+}
+
+type bookResult struct {
+	Files  []fileResult
+	Result eval.Result
+}
+
+// writeText writes the results as an aligned table
+func writeText(w io.Writer, book bookResult, lines bool) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	row := func(name string, r eval.Result) {
+		fmt.Fprintf(tw, "%s\t%.2f%%\t%.2f%%\t%d\t%d\n", name, r.CER()*100, r.WER()*100, r.Chars, r.Words)
+	}
+	fmt.Fprintf(tw, "File\tCER\tWER\tChars\tWords\n")
+	for _, f := range book.Files {
+		row(f.File, f.Result)
+		if len(f.Pages) == 0 && lines {
+			for _, l := range f.Lines {
+				row("  "+l.Name, l.Result)
+			}
+		}
+		for _, p := range f.Pages {
+			row("  "+p.Name, p.Result)
+			if !lines {
+				continue
+			}
+			for _, l := range p.Lines {
+				row("    "+l.Name, l.Result)
+			}
+		}
+	}
+	row("Total", book.Result)
+	return tw.Flush()
+}
+
+// writeCSV writes the results as CSV, with a row for each file and
+// page, and each line if lines is set, and a final row for the
+// total
+func writeCSV(w io.Writer, book bookResult, lines bool) error {
+	cw := csv.NewWriter(w)
+	row := func(file, page, line string, r eval.Result) {
+		cw.Write([]string{
+			file, page, line,
+			strconv.Itoa(r.Chars), strconv.Itoa(r.CharErrors), strconv.FormatFloat(r.CER(), 'f', 4, 64),
+			strconv.Itoa(r.Words), strconv.Itoa(r.WordErrors), strconv.FormatFloat(r.WER(), 'f', 4, 64),
+		})
+	}
+	cw.Write([]string{"file", "page", "line", "chars", "char_errors", "cer", "words", "word_errors", "wer"})
+	for _, f := range book.Files {
+		if len(f.Pages) == 0 && lines {
+			for _, l := range f.Lines {
+				row(f.File, l.Page, l.Name, l.Result)
+			}
+		}
+		for _, p := range f.Pages {
+			if lines {
+				for _, l := range p.Lines {
+					row(f.File, p.Name, l.Name, l.Result)
+				}
+			}
+			row(f.File, p.Name, "", p.Result)
+		}
+		row(f.File, "", "", f.Result)
+	}
+	row("total", "", "", book.Result)
+	cw.Flush()
+	return cw.Error()
+}
+
+// jsonResult is an eval.Result with its rates, for JSON output
+type jsonResult struct {
+	Chars      int     `json:"chars"`
+	CharErrors int     `json:"char_errors"`
+	CER        float64 `json:"cer"`
+	Words      int     `json:"words"`
+	WordErrors int     `json:"word_errors"`
+	WER        float64 `json:"wer"`
+}
+
+func toJSON(r eval.Result) jsonResult {
+	return jsonResult{r.Chars, r.CharErrors, r.CER(), r.Words, r.WordErrors, r.WER()}
+}
+
+// writeJSON writes the results as JSON
+func writeJSON(w io.Writer, book bookResult, lines bool) error {
+	type jsonLine struct {
+		Name string `json:"name"`
+		OCR  string `json:"ocr"`
+		GT   string `json:"gt"`
+		jsonResult
+	}
+	type jsonPage struct {
+		Page  string     `json:"page"`
+		Lines []jsonLine `json:"lines,omitempty"`
+		jsonResult
+	}
+	type jsonFile struct {
+		File  string     `json:"file"`
+		Pages []jsonPage `json:"pages,omitempty"`
+		Lines []jsonLine `json:"lines,omitempty"`
+		jsonResult
+	}
+	var out struct {
+		Files []jsonFile `json:"files"`
+		Total jsonResult `json:"total"`
+	}
+	out.Files = []jsonFile{}
+	for _, f := range book.Files {
+		jf := jsonFile{File: f.File, jsonResult: toJSON(f.Result)}
+		if len(f.Pages) == 0 && lines {
+			for _, l := range f.Lines {
+				jf.Lines = append(jf.Lines, jsonLine{l.Name, l.OCR, l.GT, toJSON(l.Result)})
+			}
+		}
+		for _, p := range f.Pages {
+			jp := jsonPage{Page: p.Name, jsonResult: toJSON(p.Result)}
+			if lines {
+				for _, l := range p.Lines {
+					jp.Lines = append(jp.Lines, jsonLine{l.Name, l.OCR, l.GT, toJSON(l.Result)})
+				}
+			}
+			jf.Pages = append(jf.Pages, jp)
+		}
+		out.Files = append(out.Files, jf)
+	}
+	out.Total = toJSON(book.Result)
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
+
+// writeConfusionsText writes the confusions as an aligned table
+func writeConfusionsText(w io.Writer, confusions []eval.ConfusionCount) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "Count\tType\tGround truth\tOCR\tExamples\n")
+	for _, c := range confusions {
+		fmt.Fprintf(tw, "%d\t%s\t%q\t%q\t%s\n", c.Count, c.Op(), c.GT, c.OCR, strings.Join(c.Examples, ", "))
+	}
+	return tw.Flush()
+}
+
+// writeConfusionsCSV writes the confusions as CSV, with the examples
+// separated by semicolons
+func writeConfusionsCSV(w io.Writer, confusions []eval.ConfusionCount) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"count", "type", "gt", "ocr", "examples"})
+	for _, c := range confusions {
+		cw.Write([]string{strconv.Itoa(c.Count), c.Op().String(), c.GT, c.OCR, strings.Join(c.Examples, ";")})
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// writeConfusionsJSON writes the confusions as JSON
+func writeConfusionsJSON(w io.Writer, confusions []eval.ConfusionCount) error {
+	type jsonConfusion struct {
+		Count    int      `json:"count"`
+		Type     string   `json:"type"`
+		GT       string   `json:"gt"`
+		OCR      string   `json:"ocr"`
+		Examples []string `json:"examples"`
+	}
+	out := []jsonConfusion{}
+	for _, c := range confusions {
+		out = append(out, jsonConfusion{c.Count, c.Op().String(), c.GT, c.OCR, c.Examples})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
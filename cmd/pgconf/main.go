@@ -2,34 +2,181 @@
 // Use of this source code is governed by the GPLv3
 // license that can be found in the LICENSE file.
 
-// pgconf prints the total confidence for a page of hOCR
+// pgconf prints the confidence of pages of hOCR, and statistics of it
 package main
 
 import (
 	"flag"
 	"fmt"
+	"io"
 	"log"
 	"os"
+	"sort"
+	"strconv"
+	"strings"
 
 	"rescribe.xyz/utils/pkg/hocr"
 )
 
+const usage = `Usage: pgconf [-bins n] [-f format] [-lenient] [-lines] [-percentiles list] [-threshold conf] hocr | dir...
+
+Prints the confidence of the words in each hOCR file, based on
+their x_wconf values, and of all of them together. Directories are
+searched for .hocr files, which are read in natural order of their
+names, so a whole book can be checked at once.
+
+By default just the mean confidence is printed, rounded to a whole
+number, so for a single file the output is only that number. The
+full statistics are printed as text, csv or json with -f.
+
+For each file the full statistics are the number of words, the
+mean, median and standard deviation of their confidence, the
+lowest confidence of any word, the chosen percentiles, the
+fraction of words below the threshold, the number of lines
+containing a word below the threshold, and a histogram of the
+confidences. With -lines, the lowest confidence of each line is
+also given, which shows pages which are mostly fine but have a few
+bad lines.
+`
+
+// options control which statistics are reported
+type options struct {
+	bins        int
+	lines       bool
+	percentiles []float64
+	threshold   float64
+}
+
+// fileStats are the confidence statistics of a file
+type fileStats struct {
+	File  string
+	Stats hocr.ConfStats
+}
+
+// linesBelow returns the number of lines with a word below t
+func linesBelow(s hocr.ConfStats, t float64) int {
+	n := 0
+	for _, l := range s.Lines {
+		if l.Min < t {
+			n++
+		}
+	}
+	return n
+}
+
+// binName returns the range of confidences in bin i of n
+func binName(i, n int) string {
+	width := 100 / float64(n)
+	return strconv.FormatFloat(float64(i)*width, 'g', 4, 64) + "-" + strconv.FormatFloat(float64(i+1)*width, 'g', 4, 64)
+}
+
+// parsePercentiles parses a comma separated list of percentiles
+func parsePercentiles(s string) ([]float64, error) {
+	var percentiles []float64
+	for _, f := range strings.Split(s, ",") {
+		f = strings.TrimSpace(f)
+		if f == "" {
+			continue
+		}
+		p, err := strconv.ParseFloat(f, 64)
+		if err != nil || p < 0 || p > 100 {
+			return nil, fmt.Errorf("Invalid percentile %s", f)
+		}
+		percentiles = append(percentiles, p)
+	}
+	return percentiles, nil
+}
+
+// hocrFiles returns the hocr files to read for an argument, which
+// is either a file or a directory
+func hocrFiles(arg string) ([]string, error) {
+	info, err := os.Stat(arg)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return []string{arg}, nil
+	}
+	return hocr.Files(arg)
+}
+
+// confStats reads the confidence statistics of a hOCR file
+func confStats(fn string, lenient bool) (hocr.ConfStats, error) {
+	f, err := os.Open(fn)
+	if err != nil {
+		return hocr.ConfStats{}, fmt.Errorf("Error opening %s: %v", fn, err)
+	}
+	defer f.Close()
+
+	r := hocr.NewReader(f)
+	r.Lenient = lenient
+	r.Warn = func(err error) { log.Printf("Warning: %s: %v\n", fn, err) }
+	s, err := r.ConfStats()
+	if err != nil {
+		return s, fmt.Errorf("Error retrieving confidence for %s: %v", fn, err)
+	}
+	return s, nil
+}
+
 func main() {
 	flag.Usage = func() {
-		fmt.Fprintf(os.Stderr, "Usage: pgconf hocr\n")
-		fmt.Fprintf(os.Stderr, "Prints the total confidence for a page, as an average of the confidence of each word.\n")
+		fmt.Fprintf(flag.CommandLine.Output(), usage)
 		flag.PrintDefaults()
 	}
+	bins := flag.Int("bins", 10, "Number of bins in the histogram")
+	format := flag.String("f", "mean", "Output format: mean, text, csv or json")
+	lenient := flag.Bool("lenient", false, "Skip words with missing confidences, rather than stopping")
+	lines := flag.Bool("lines", false, "Show the lowest confidence of each line")
+	percentiles := flag.String("percentiles", "10,25,75,90", "Comma separated list of percentiles to show")
+	threshold := flag.Float64("threshold", 50, "Confidence below which words are counted as bad")
 	flag.Parse()
-	if flag.NArg() != 1 {
+	if flag.NArg() < 1 || *bins < 1 {
 		flag.Usage()
 		os.Exit(1)
 	}
 
-	avg, err := hocr.GetAvgConf(flag.Arg(0))
+	var out func(io.Writer, []fileStats, hocr.ConfStats, options) error
+	switch *format {
+	case "mean":
+		out = writeMean
+	case "text":
+		out = writeText
+	case "csv":
+		out = writeCSV
+	case "json":
+		out = writeJSON
+	default:
+		log.Fatalf("Unknown format %s", *format)
+	}
+
+	opts := options{bins: *bins, lines: *lines, threshold: *threshold}
+	var err error
+	opts.percentiles, err = parsePercentiles(*percentiles)
 	if err != nil {
-		log.Fatalf("Error retreiving confidence for %s: %v\n", flag.Arg(0), err)
+		log.Fatal(err)
 	}
 
-	fmt.Printf("%0.0f\n", avg)
+	var files []fileStats
+	var total hocr.ConfStats
+	for _, arg := range flag.Args() {
+		fns, err := hocrFiles(arg)
+		if err != nil {
+			log.Fatalf("Error finding hocr files in %s: %v", arg, err)
+		}
+		for _, fn := range fns {
+			s, err := confStats(fn, *lenient)
+			if err != nil {
+				log.Fatal(err)
+			}
+			files = append(files, fileStats{fn, s})
+			total.Confs = append(total.Confs, s.Confs...)
+			total.Lines = append(total.Lines, s.Lines...)
+		}
+	}
+	sort.Float64s(total.Confs)
+
+	err = out(os.Stdout, files, total, opts)
+	if err != nil {
+		log.Fatal(err)
+	}
 }
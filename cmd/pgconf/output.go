@@ -0,0 +1,205 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"rescribe.xyz/utils/pkg/hocr"
+)
+
+// formatConf formats a confidence for text and csv output
+func formatConf(c float64) string {
+	return strconv.FormatFloat(c, 'f', 2, 64)
+}
+
+// formatPercentile formats a percentile for column names
+func formatPercentile(p float64) string {
+	return strconv.FormatFloat(p, 'g', -1, 64)
+}
+
+// minConf returns the lowest confidence of any word
+func minConf(s hocr.ConfStats) float64 {
+	if len(s.Confs) == 0 {
+		return 0
+	}
+	return s.Confs[0]
+}
+
+// writeMean writes the rounded mean confidence of each file and of
+// them all, or just the mean if there is only one file
+func writeMean(w io.Writer, files []fileStats, total hocr.ConfStats, opts options) error {
+	if len(files) == 1 {
+		_, err := fmt.Fprintf(w, "%0.0f\n", files[0].Stats.Mean())
+		return err
+	}
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	for _, f := range files {
+		fmt.Fprintf(tw, "%s\t%0.0f\n", f.File, f.Stats.Mean())
+	}
+	fmt.Fprintf(tw, "Total\t%0.0f\n", total.Mean())
+	return tw.Flush()
+}
+
+// writeText writes the statistics as an aligned table
+func writeText(w io.Writer, files []fileStats, total hocr.ConfStats, opts options) error {
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	t := formatPercentile(opts.threshold)
+	fmt.Fprintf(tw, "File\tWords\tMean\tMedian\tStdDev\tMin")
+	for _, p := range opts.percentiles {
+		fmt.Fprintf(tw, "\tP%s", formatPercentile(p))
+	}
+	fmt.Fprintf(tw, "\tBelow %s\tLines below %s\tHistogram\n", t, t)
+	row := func(name string, s hocr.ConfStats) {
+		fmt.Fprintf(tw, "%s\t%d\t%s\t%s\t%s\t%s", name, s.Words(),
+			formatConf(s.Mean()), formatConf(s.Median()), formatConf(s.StdDev()), formatConf(minConf(s)))
+		for _, p := range opts.percentiles {
+			fmt.Fprintf(tw, "\t%s", formatConf(s.Percentile(p)))
+		}
+		var hist []string
+		for _, n := range s.Histogram(opts.bins) {
+			hist = append(hist, strconv.Itoa(n))
+		}
+		fmt.Fprintf(tw, "\t%.2f%%\t%d\t%s\n", s.Below(opts.threshold)*100, linesBelow(s, opts.threshold), strings.Join(hist, " "))
+	}
+	// empty pads line rows to the full width of the table, so that
+	// they don't break up the alignment of the columns after them
+	empty := strings.Repeat("\t", len(opts.percentiles)+3)
+	for _, f := range files {
+		row(f.File, f.Stats)
+		if !opts.lines {
+			continue
+		}
+		for _, l := range f.Stats.Lines {
+			fmt.Fprintf(tw, "  %s %s\t%d\t\t\t\t%s%s\n", l.Page, l.Line, l.Words, formatConf(l.Min), empty)
+		}
+	}
+	row("Total", total)
+	return tw.Flush()
+}
+
+// writeCSV writes the statistics as CSV, with a row for each file,
+// and each line if opts.lines is set, and a final row for the total
+func writeCSV(w io.Writer, files []fileStats, total hocr.ConfStats, opts options) error {
+	cw := csv.NewWriter(w)
+	t := formatPercentile(opts.threshold)
+	header := []string{"file", "page", "line", "words", "mean", "median", "stddev", "min"}
+	for _, p := range opts.percentiles {
+		header = append(header, "p"+formatPercentile(p))
+	}
+	header = append(header, "below_"+t, "lines_below_"+t)
+	for i := 0; i < opts.bins; i++ {
+		header = append(header, "hist_"+binName(i, opts.bins))
+	}
+	cw.Write(header)
+
+	row := func(name string, s hocr.ConfStats) {
+		r := []string{name, "", "", strconv.Itoa(s.Words()),
+			formatConf(s.Mean()), formatConf(s.Median()), formatConf(s.StdDev()), formatConf(minConf(s))}
+		for _, p := range opts.percentiles {
+			r = append(r, formatConf(s.Percentile(p)))
+		}
+		r = append(r, strconv.FormatFloat(s.Below(opts.threshold), 'f', 4, 64), strconv.Itoa(linesBelow(s, opts.threshold)))
+		for _, n := range s.Histogram(opts.bins) {
+			r = append(r, strconv.Itoa(n))
+		}
+		cw.Write(r)
+	}
+	for _, f := range files {
+		if opts.lines {
+			for _, l := range f.Stats.Lines {
+				r := make([]string, len(header))
+				r[0], r[1], r[2], r[3], r[7] = f.File, l.Page, l.Line, strconv.Itoa(l.Words), formatConf(l.Min)
+				cw.Write(r)
+			}
+		}
+		row(f.File, f.Stats)
+	}
+	row("total", total)
+	cw.Flush()
+	return cw.Error()
+}
+
+type jsonLine struct {
+	Page  string  `json:"page"`
+	Line  string  `json:"line"`
+	Words int     `json:"words"`
+	Min   float64 `json:"min"`
+}
+
+type jsonBin struct {
+	Range string `json:"range"`
+	Words int    `json:"words"`
+}
+
+// jsonStats is a hocr.ConfStats with its statistics, for JSON
+// output
+type jsonStats struct {
+	Words       int                `json:"words"`
+	Mean        float64            `json:"mean"`
+	Median      float64            `json:"median"`
+	StdDev      float64            `json:"stddev"`
+	Min         float64            `json:"min"`
+	Percentiles map[string]float64 `json:"percentiles"`
+	Below       float64            `json:"below"`
+	LinesBelow  int                `json:"lines_below"`
+	Histogram   []jsonBin          `json:"histogram"`
+}
+
+func toJSON(s hocr.ConfStats, opts options) jsonStats {
+	j := jsonStats{
+		Words:       s.Words(),
+		Mean:        s.Mean(),
+		Median:      s.Median(),
+		StdDev:      s.StdDev(),
+		Min:         minConf(s),
+		Percentiles: make(map[string]float64),
+		Below:       s.Below(opts.threshold),
+		LinesBelow:  linesBelow(s, opts.threshold),
+	}
+	for _, p := range opts.percentiles {
+		j.Percentiles[formatPercentile(p)] = s.Percentile(p)
+	}
+	for i, n := range s.Histogram(opts.bins) {
+		j.Histogram = append(j.Histogram, jsonBin{binName(i, opts.bins), n})
+	}
+	return j
+}
+
+// writeJSON writes the statistics as JSON
+func writeJSON(w io.Writer, files []fileStats, total hocr.ConfStats, opts options) error {
+	type jsonFile struct {
+		File  string     `json:"file"`
+		Lines []jsonLine `json:"lines,omitempty"`
+		jsonStats
+	}
+	var out struct {
+		Threshold float64    `json:"threshold"`
+		Files     []jsonFile `json:"files"`
+		Total     jsonStats  `json:"total"`
+	}
+	out.Threshold = opts.threshold
+	out.Files = []jsonFile{}
+	for _, f := range files {
+		jf := jsonFile{File: f.File, jsonStats: toJSON(f.Stats, opts)}
+		if opts.lines {
+			for _, l := range f.Stats.Lines {
+				jf.Lines = append(jf.Lines, jsonLine{l.Page, l.Line, l.Words, l.Min})
+			}
+		}
+		out.Files = append(out.Files, jf)
+	}
+	out.Total = toJSON(total, opts)
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(out)
+}
@@ -0,0 +1,116 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+// pgtriage checks the quality of the hOCR pages of a book, and
+// lists the pages which should be recognised again
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"rescribe.xyz/utils/pkg/hocr"
+)
+
+const usage = `Usage: pgtriage [-bad fraction] [-garbage fraction] [-image fraction] [-list file] [-median conf] [-minwords n] [-threshold conf] bookdir
+
+Checks each hOCR page in a book directory, scoring it on the
+confidence of its words, the number of words, and any anomalies
+in its layout, and flags pages which are likely to be:
+
+  blank         with fewer than -minwords words
+  illustration  mostly covered by illustrations
+  failed        with a median confidence below -median, more than
+                -bad of the words below -threshold, or more than
+                -garbage of the words having no letters or digits,
+                or which can't be read
+  layout        with lines far taller than the others, or lines
+                outside of the page
+
+A table of the pages is printed, and with -list the failed and
+layout pages are written to a file to be recognised again, with
+one filename on each line, like a -best file.
+`
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), usage)
+		flag.PrintDefaults()
+	}
+	var c criteria
+	flag.Float64Var(&c.maxBad, "bad", 0.25, "Fraction of words below the threshold for a page to have failed")
+	flag.Float64Var(&c.maxGarbage, "garbage", 0.3, "Fraction of words with no letters or digits for a page to have failed")
+	flag.Float64Var(&c.minImage, "image", 0.4, "Fraction of the page covered by illustrations for it to be an illustration")
+	list := flag.String("list", "", "File to write the pages to recognise again to")
+	flag.Float64Var(&c.minMedian, "median", 70, "Median confidence below which a page has failed")
+	flag.IntVar(&c.minWords, "minwords", 10, "Number of words below which a page is blank")
+	flag.Float64Var(&c.threshold, "threshold", 50, "Confidence below which words are counted as bad")
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(1)
+	}
+	dir := flag.Arg(0)
+
+	files, err := hocr.Files(dir)
+	if err != nil {
+		log.Fatalf("Error finding hocr files in %s: %v", dir, err)
+	}
+
+	var reocr []string
+	tw := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "File\tWords\tMedian\tBelow %g\tScore\tFlags\tReasons\n", c.threshold)
+	for _, fn := range files {
+		f, err := os.Open(fn)
+		if err != nil {
+			log.Fatalf("Error opening %s: %v", fn, err)
+		}
+		s, err := measure(f)
+		f.Close()
+		name := filepath.Base(fn)
+		if err != nil {
+			// a page which can't be read is as bad as one whose
+			// recognition failed, so it is recognised again
+			fmt.Fprintf(tw, "%s\t\t\t\t\tfailed\t%v\n", name, err)
+			reocr = append(reocr, name)
+			continue
+		}
+		v := s.triage(c)
+		fmt.Fprintf(tw, "%s\t%d\t%.2f\t%.2f%%\t%.2f\t%s\t%s\n", name, s.words, s.conf.Median(),
+			s.conf.Below(c.threshold)*100, s.score(c), strings.Join(v.flags, ","), strings.Join(v.reasons, "; "))
+		if v.reocr() {
+			reocr = append(reocr, name)
+		}
+	}
+	err = tw.Flush()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *list == "" {
+		return
+	}
+	f, err := os.Create(*list)
+	if err != nil {
+		log.Fatalf("Error creating %s: %v", *list, err)
+	}
+	w := bufio.NewWriter(f)
+	for _, fn := range reocr {
+		fmt.Fprintln(w, fn)
+	}
+	err = w.Flush()
+	if err != nil {
+		log.Fatalf("Error writing %s: %v", *list, err)
+	}
+	err = f.Close()
+	if err != nil {
+		log.Fatalf("Error closing %s: %v", *list, err)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// testPage returns a hOCR page with a line for each set of word
+// confidences, and any extra elements given
+func testPage(lines [][]int, words []string, extra string) string {
+	var s strings.Builder
+	s.WriteString("<html><body>\n<div class='ocr_page' id='page_1' title='bbox 0 0 1000 1000'>\n")
+	n := 0
+	for i, l := range lines {
+		fmt.Fprintf(&s, "<span class='ocr_line' id='line_%d' title='bbox 10 %d 900 %d'>", i, i*40, i*40+30)
+		for _, c := range l {
+			fmt.Fprintf(&s, "<span class='ocrx_word' title='x_wconf %d'>%s</span> ", c, words[n%len(words)])
+			n++
+		}
+		s.WriteString("</span>\n")
+	}
+	s.WriteString(extra + "</div>\n</body></html>")
+	return s.String()
+}
+
+func TestTriage(t *testing.T) {
+	c := criteria{minWords: 10, threshold: 50, minMedian: 70, maxBad: 0.25, maxGarbage: 0.3, minImage: 0.4}
+	good := [][]int{{90, 92, 95, 88}, {91, 93, 30, 96}, {94, 90, 92, 89}}
+	latin := []string{"lorem", "ipsum", "dolor", "sit"}
+
+	cases := []struct {
+		name  string
+		hocr  string
+		flags string
+		reocr bool
+	}{
+		{"good", testPage(good, latin, ""), "", false},
+		{"blank", testPage([][]int{{40, 30}}, []string{"."}, ""), "blank", false},
+		{"illustration", testPage([][]int{{20, 30, 25}}, []string{"~"},
+			"<div class='ocr_photo' title='bbox 0 100 1000 900'></div>\n"), "illustration", false},
+		{"lowconf", testPage([][]int{{60, 40, 65, 30}, {50, 62, 45, 70}, {55, 66, 40, 20}}, latin, ""), "failed", true},
+		{"garbage", testPage(good, []string{"lorem", ".,", "~", "|"}, ""), "failed", true},
+		{"layout", testPage(good, latin,
+			"<span class='ocr_line' id='line_tall' title='bbox 10 200 900 600'><span class='ocrx_word' title='x_wconf 90'>tall</span></span>\n"+
+				"<span class='ocr_line' id='line_out' title='bbox 10 950 900 1050'><span class='ocrx_word' title='x_wconf 90'>out</span></span>\n"),
+			"layout", true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := measure(strings.NewReader(tc.hocr))
+			if err != nil {
+				t.Fatalf("Error measuring page: %v", err)
+			}
+			v := s.triage(c)
+			if strings.Join(v.flags, ",") != tc.flags || v.reocr() != tc.reocr {
+				t.Errorf("Unexpected verdict: %v %v, expected %s", v.flags, v.reasons, tc.flags)
+			}
+			score := s.score(c)
+			if score < 0 || score > 100 || (tc.name == "good" && score < 80) || (tc.reocr && score >= 80) {
+				t.Errorf("Unexpected score %f", score)
+			}
+		})
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+
+	"rescribe.xyz/utils/pkg/hocr"
+)
+
+// pageStats are the measurements of a page used to triage it
+type pageStats struct {
+	conf    hocr.ConfStats
+	words   int // words with any text
+	garbage int // words with no letters or digits
+	tall    int // lines much taller than most on the page
+	outside int // lines outside of the page bbox
+	area    int // area of the page
+	text    int // area of the lines
+	image   int // area of any illustrations
+}
+
+// criteria are the limits used to triage pages
+type criteria struct {
+	minWords   int     // fewer words than this is a blank page
+	threshold  float64 // words below this confidence are bad
+	minMedian  float64 // a lower median confidence is a failure
+	maxBad     float64 // a greater fraction of bad words is a failure
+	maxGarbage float64 // a greater fraction of garbage words is a failure
+	minImage   float64 // a greater fraction of the page in illustrations is an illustration
+}
+
+// tallLine is how many times taller than the median line a line
+// must be to be counted as too tall
+const tallLine = 3
+
+// isImage reports whether an area is an illustration
+func isImage(class string) bool {
+	return class == "ocr_photo" || class == "ocr_image" || class == "ocr_graphic"
+}
+
+// isGarbage reports whether a word has no letters or digits, as is
+// common when recognition fails, for example on an illustration
+func isGarbage(s string) bool {
+	for _, r := range s {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// measure reads the pages of a hOCR document from r, and measures
+// them together. Words without an x_wconf are counted, but aren't
+// included in the confidences.
+func measure(r io.Reader) (pageStats, error) {
+	var s pageStats
+	hr := hocr.NewReader(r)
+	for {
+		p, err := hr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return s, err
+		}
+		err = s.page(p)
+		if err != nil {
+			return s, err
+		}
+	}
+	sort.Float64s(s.conf.Confs)
+	return s, nil
+}
+
+// page adds the measurements of a page
+func (s *pageStats) page(p hocr.Page) error {
+	props, err := p.Properties()
+	if err != nil {
+		return err
+	}
+	page := props.Bbox
+	s.area += page.Dx() * page.Dy()
+
+	for _, a := range p.Areas {
+		if !isImage(a.Class) {
+			continue
+		}
+		props, err := a.Properties()
+		if err != nil {
+			return err
+		}
+		s.image += props.Bbox.Dx() * props.Bbox.Dy()
+	}
+
+	var heights []int
+	for _, l := range p.Lines() {
+		props, err := l.Properties()
+		if err != nil {
+			return err
+		}
+		if props.Has("bbox") {
+			b := props.Bbox
+			s.text += b.Dx() * b.Dy()
+			heights = append(heights, b.Dy())
+			if !page.Empty() && !b.In(page) {
+				s.outside++
+			}
+		}
+
+		if len(l.Words) == 0 {
+			// lines with no words, such as from ocropus, have no
+			// confidences, but their words still count
+			for _, w := range strings.Fields(l.Text) {
+				s.words++
+				if isGarbage(w) {
+					s.garbage++
+				}
+			}
+			continue
+		}
+
+		lc := hocr.LineConf{Page: p.Id, Line: l.Id}
+		for _, w := range l.Words {
+			text := strings.TrimSpace(hocr.WordText(w))
+			if text == "" {
+				continue
+			}
+			s.words++
+			if isGarbage(text) {
+				s.garbage++
+			}
+			props, err := w.Properties()
+			if err != nil || !props.Has("x_wconf") {
+				continue
+			}
+			if lc.Words == 0 || props.Wconf < lc.Min {
+				lc.Min = props.Wconf
+			}
+			lc.Words++
+			s.conf.Confs = append(s.conf.Confs, props.Wconf)
+		}
+		if lc.Words > 0 {
+			s.conf.Lines = append(s.conf.Lines, lc)
+		}
+	}
+
+	if len(heights) >= 3 {
+		sorted := append([]int(nil), heights...)
+		sort.Ints(sorted)
+		median := sorted[len(sorted)/2]
+		for _, h := range heights {
+			if h > median*tallLine {
+				s.tall++
+			}
+		}
+	}
+	return nil
+}
+
+// fraction returns n as a fraction of total, or 0 if total is 0
+func fraction(n, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(n) / float64(total)
+}
+
+// score rates the quality of the recognition of a page from 0 to
+// 100, based on the median confidence of its words, reduced by
+// the fraction of bad and garbage words and by any layout
+// anomalies
+func (s pageStats) score(c criteria) float64 {
+	if s.conf.Words() == 0 {
+		return 0
+	}
+	score := s.conf.Median()
+	score *= 1 - s.conf.Below(c.threshold)
+	score *= 1 - fraction(s.garbage, s.words)
+	score -= 10 * float64(s.tall+s.outside)
+	if score < 0 {
+		score = 0
+	}
+	return score
+}
+
+// verdict is the outcome of triaging a page
+type verdict struct {
+	flags   []string // blank, illustration, failed or layout
+	reasons []string
+}
+
+// reocr reports whether a page should be recognised again
+func (v verdict) reocr() bool {
+	for _, f := range v.flags {
+		if f == "failed" || f == "layout" {
+			return true
+		}
+	}
+	return false
+}
+
+// triage decides whether a page is likely to be blank, an
+// illustration, or to have failed recognition or a bad layout
+func (s pageStats) triage(c criteria) verdict {
+	var v verdict
+	flag := func(f string, format string, a ...interface{}) {
+		if len(v.flags) == 0 || v.flags[len(v.flags)-1] != f {
+			v.flags = append(v.flags, f)
+		}
+		v.reasons = append(v.reasons, fmt.Sprintf(format, a...))
+	}
+
+	image := fraction(s.image, s.area)
+	switch {
+	case image >= c.minImage && s.image > s.text:
+		flag("illustration", "%.0f%% of page is illustrations", image*100)
+	case s.words < c.minWords:
+		flag("blank", "%d words", s.words)
+	default:
+		if s.conf.Words() > 0 && s.conf.Median() < c.minMedian {
+			flag("failed", "median confidence %.1f", s.conf.Median())
+		}
+		if bad := s.conf.Below(c.threshold); bad > c.maxBad {
+			flag("failed", "%.0f%% of words below %g", bad*100, c.threshold)
+		}
+		if garbage := fraction(s.garbage, s.words); garbage > c.maxGarbage {
+			flag("failed", "%.0f%% of words have no letters or digits", garbage*100)
+		}
+	}
+	if s.tall > 0 {
+		flag("layout", "%d lines over %d times the usual height", s.tall, tallLine)
+	}
+	if s.outside > 0 {
+		flag("layout", "%d lines outside of the page", s.outside)
+	}
+	return v
+}
@@ -1,3 +1,9 @@
 module rescribe.xyz/utils
 
-go 1.14
+go 1.16
+
+require (
+	github.com/jung-kurt/gofpdf v1.16.2
+	golang.org/x/image v0.18.0
+	golang.org/x/text v0.16.0
+)
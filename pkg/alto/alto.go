@@ -0,0 +1,376 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+// alto contains structures and functions for parsing, writing and
+// converting ALTO XML files
+package alto
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"image"
+	"io"
+	"math"
+	"strings"
+)
+
+// Namespaces of the ALTO versions which are commonly found.
+// Documents of any version can be parsed, as elements are matched
+// by their local name, and Namespace4 is used when writing if no
+// other namespace is set.
+const (
+	Namespace2 = "http://www.loc.gov/standards/alto/ns-v2#"
+	Namespace3 = "http://www.loc.gov/standards/alto/ns-v3#"
+	Namespace4 = "http://www.loc.gov/standards/alto/ns-v4#"
+)
+
+// Alto is an ALTO XML document. Coordinates are kept in the
+// MeasurementUnit they are given in, which ToPixels converts from
+// if it isn't "pixel", as it is for documents written by this
+// package.
+type Alto struct {
+	XMLName     xml.Name    `xml:"alto"`
+	Xmlns       string      `xml:"xmlns,attr,omitempty"`
+	Description Description `xml:"Description"`
+	Pages       []Page      `xml:"Layout>Page"`
+}
+
+type Description struct {
+	MeasurementUnit string `xml:"MeasurementUnit"`
+	FileName        string `xml:"sourceImageInformation>fileName"`
+}
+
+// Box is the position and size of an element
+type Box struct {
+	Hpos   float64 `xml:"HPOS,attr"`
+	Vpos   float64 `xml:"VPOS,attr"`
+	Width  float64 `xml:"WIDTH,attr"`
+	Height float64 `xml:"HEIGHT,attr"`
+}
+
+type Page struct {
+	ID            string  `xml:"ID,attr"`
+	PhysicalImgNr int     `xml:"PHYSICAL_IMG_NR,attr"`
+	Width         float64 `xml:"WIDTH,attr"`
+	Height        float64 `xml:"HEIGHT,attr"`
+	TopMargin     *Space  `xml:"TopMargin"`
+	LeftMargin    *Space  `xml:"LeftMargin"`
+	RightMargin   *Space  `xml:"RightMargin"`
+	BottomMargin  *Space  `xml:"BottomMargin"`
+	PrintSpace    *Space  `xml:"PrintSpace"`
+}
+
+// Space is a PrintSpace or margin of a page
+type Space struct {
+	Box
+	Blocks []Block `xml:",any"`
+}
+
+// Block is a TextBlock, ComposedBlock, Illustration or
+// GraphicalElement, distinguished by XMLName.Local. Only
+// TextBlocks have lines, and only ComposedBlocks have blocks.
+type Block struct {
+	XMLName xml.Name
+	ID      string `xml:"ID,attr"`
+	Box
+	TextLines []TextLine `xml:"TextLine"`
+	Blocks    []Block    `xml:",any"`
+}
+
+type TextLine struct {
+	ID string `xml:"ID,attr"`
+	Box
+	Items []Item `xml:",any"`
+}
+
+// Item is a String, SP (space) or HYP (hyphen) in a line,
+// distinguished by XMLName.Local. The position and size are
+// optional for items, so are omitted if zero.
+type Item struct {
+	XMLName xml.Name
+	ID      string  `xml:"ID,attr,omitempty"`
+	Hpos    float64 `xml:"HPOS,attr,omitempty"`
+	Vpos    float64 `xml:"VPOS,attr,omitempty"`
+	Width   float64 `xml:"WIDTH,attr,omitempty"`
+	Height  float64 `xml:"HEIGHT,attr,omitempty"`
+	Content string  `xml:"CONTENT,attr,omitempty"`
+	WC      float64 `xml:"WC,attr,omitempty"` // word confidence, from 0 to 1
+	CC      string  `xml:"CC,attr,omitempty"` // character confidences, from 0 (sure) to 9 (unsure)
+}
+
+// unitsPerInch is the number of each MeasurementUnit other than
+// pixel in an inch
+var unitsPerInch = map[string]float64{
+	"mm10":     254,
+	"inch1200": 1200,
+}
+
+// isPixel reports whether a MeasurementUnit is pixels, which is
+// assumed if it isn't set
+func isPixel(unit string) bool {
+	return unit == "" || unit == "pixel"
+}
+
+// ToPixels converts all coordinates in the document to pixels of a
+// page image with a resolution of dpi, and sets the MeasurementUnit
+// to "pixel". The resolution isn't needed if the coordinates are
+// already in pixels. The document is changed in place, including
+// any pages, blocks and lines shared with copies of it.
+func (a *Alto) ToPixels(dpi float64) error {
+	unit := a.Description.MeasurementUnit
+	if isPixel(unit) {
+		return nil
+	}
+	per, ok := unitsPerInch[unit]
+	if !ok {
+		return fmt.Errorf("unknown measurement unit %q", unit)
+	}
+	if dpi <= 0 {
+		return fmt.Errorf("image resolution needed to convert %s to pixels", unit)
+	}
+	a.scale(dpi / per)
+	a.Description.MeasurementUnit = "pixel"
+	return nil
+}
+
+// scale multiplies all coordinates in the document by s
+func (a *Alto) scale(s float64) {
+	for i := range a.Pages {
+		p := &a.Pages[i]
+		p.Width *= s
+		p.Height *= s
+		for _, sp := range p.Spaces() {
+			sp.Box = sp.Box.scale(s)
+		}
+		for _, b := range p.Blocks() {
+			b.Box = b.Box.scale(s)
+			for j := range b.TextLines {
+				l := &b.TextLines[j]
+				l.Box = l.Box.scale(s)
+				for k := range l.Items {
+					it := &l.Items[k]
+					it.Hpos *= s
+					it.Vpos *= s
+					it.Width *= s
+					it.Height *= s
+				}
+			}
+		}
+	}
+}
+
+// scale returns the box with its coordinates multiplied by s
+func (b Box) scale(s float64) Box {
+	return Box{b.Hpos * s, b.Vpos * s, b.Width * s, b.Height * s}
+}
+
+// Rect returns the box as a rectangle, rounding to the nearest pixel
+func (b Box) Rect() image.Rectangle {
+	x, y := math.Round(b.Hpos), math.Round(b.Vpos)
+	return image.Rect(int(x), int(y), int(math.Round(b.Hpos+b.Width)), int(math.Round(b.Vpos+b.Height)))
+}
+
+// Rect returns the box of the item as a rectangle
+func (i Item) Rect() image.Rectangle {
+	return Box{i.Hpos, i.Vpos, i.Width, i.Height}.Rect()
+}
+
+// boxFromRect returns the box for a rectangle
+func boxFromRect(r image.Rectangle) Box {
+	return Box{float64(r.Min.X), float64(r.Min.Y), float64(r.Dx()), float64(r.Dy())}
+}
+
+// Spaces returns the margins and print space of a page, in order
+func (p *Page) Spaces() []*Space {
+	var spaces []*Space
+	for _, s := range []*Space{p.TopMargin, p.LeftMargin, p.RightMargin, p.BottomMargin, p.PrintSpace} {
+		if s != nil {
+			spaces = append(spaces, s)
+		}
+	}
+	return spaces
+}
+
+// Blocks returns all blocks on the page, including those nested in
+// ComposedBlocks, in order
+func (p *Page) Blocks() []*Block {
+	var blocks []*Block
+	var add func(b []Block)
+	add = func(b []Block) {
+		for i := range b {
+			blocks = append(blocks, &b[i])
+			add(b[i].Blocks)
+		}
+	}
+	for _, s := range p.Spaces() {
+		add(s.Blocks)
+	}
+	return blocks
+}
+
+// Lines returns all lines on the page, in order
+func (p *Page) Lines() []*TextLine {
+	var lines []*TextLine
+	for _, b := range p.Blocks() {
+		for i := range b.TextLines {
+			lines = append(lines, &b.TextLines[i])
+		}
+	}
+	return lines
+}
+
+// Strings returns the String items of the line, in order
+func (l TextLine) Strings() []Item {
+	var s []Item
+	for _, i := range l.Items {
+		if i.XMLName.Local == "String" {
+			s = append(s, i)
+		}
+	}
+	return s
+}
+
+// Text returns the text of the line, with a space between each
+// String, and any hyphen at the end
+func (l TextLine) Text() string {
+	var s string
+	for _, i := range l.Items {
+		switch i.XMLName.Local {
+		case "String":
+			if s != "" && !strings.HasSuffix(s, " ") {
+				s += " "
+			}
+			s += i.Content
+		case "HYP":
+			s += i.Content
+		}
+	}
+	return s
+}
+
+// Conf returns the average confidence of the Strings in the line
+// which have a WC value, from 0 to 1, or 0 if none do
+func (l TextLine) Conf() float64 {
+	var total, num float64
+	for _, s := range l.Strings() {
+		if s.WC != 0 {
+			total += s.WC
+			num++
+		}
+	}
+	if num == 0 {
+		return 0
+	}
+	return total / num
+}
+
+// Read parses an ALTO XML document from r
+func Read(r io.Reader) (Alto, error) {
+	var a Alto
+	err := xml.NewDecoder(r).Decode(&a)
+	if err != nil {
+		return a, fmt.Errorf("Error parsing ALTO XML: %v", err)
+	}
+	for i := range a.Pages {
+		for _, s := range a.Pages[i].Spaces() {
+			s.Blocks = pruneBlocks(s.Blocks)
+		}
+	}
+	return a, nil
+}
+
+// pruneBlocks removes any elements which were read as blocks or
+// items but aren't, such as Shape elements, as they aren't
+// modelled and can't be written correctly
+func pruneBlocks(blocks []Block) []Block {
+	var pruned []Block
+	for _, b := range blocks {
+		switch b.XMLName.Local {
+		case "TextBlock", "ComposedBlock", "Illustration", "GraphicalElement":
+		default:
+			continue
+		}
+		b.Blocks = pruneBlocks(b.Blocks)
+		for j, l := range b.TextLines {
+			var items []Item
+			for _, i := range l.Items {
+				switch i.XMLName.Local {
+				case "String", "SP", "HYP":
+					items = append(items, i)
+				}
+			}
+			b.TextLines[j].Items = items
+		}
+		pruned = append(pruned, b)
+	}
+	return pruned
+}
+
+// Parse parses an ALTO XML document
+func Parse(b []byte) (Alto, error) {
+	return Read(bytes.NewReader(b))
+}
+
+// Write writes an ALTO XML document to w
+func Write(w io.Writer, a Alto) error {
+	if a.Xmlns == "" {
+		a.Xmlns = Namespace4
+	}
+	// ensure any namespaces from parsing aren't written on every
+	// element, as they are covered by Xmlns
+	a.XMLName = xml.Name{}
+	a.Pages = append([]Page{}, a.Pages...)
+	for i := range a.Pages {
+		a.Pages[i] = clearPageSpaces(a.Pages[i])
+	}
+
+	_, err := io.WriteString(w, xml.Header)
+	if err != nil {
+		return err
+	}
+	e := xml.NewEncoder(w)
+	e.Indent("", "  ")
+	err = e.Encode(a)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\n")
+	return err
+}
+
+// clearPageSpaces returns a copy of a page with the namespaces of
+// all element names cleared
+func clearPageSpaces(p Page) Page {
+	for _, s := range []**Space{&p.TopMargin, &p.LeftMargin, &p.RightMargin, &p.BottomMargin, &p.PrintSpace} {
+		if *s == nil {
+			continue
+		}
+		c := **s
+		c.Blocks = clearBlockSpaces(c.Blocks)
+		*s = &c
+	}
+	return p
+}
+
+func clearBlockSpaces(blocks []Block) []Block {
+	var cleared []Block
+	for _, b := range blocks {
+		b.XMLName.Space = ""
+		b.Blocks = clearBlockSpaces(b.Blocks)
+		var lines []TextLine
+		for _, l := range b.TextLines {
+			var items []Item
+			for _, i := range l.Items {
+				i.XMLName.Space = ""
+				items = append(items, i)
+			}
+			l.Items = items
+			lines = append(lines, l)
+		}
+		b.TextLines = lines
+		cleared = append(cleared, b)
+	}
+	return cleared
+}
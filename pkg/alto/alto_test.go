@@ -0,0 +1,235 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package alto
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"math"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"rescribe.xyz/utils/pkg/hocr"
+	"rescribe.xyz/utils/pkg/line"
+)
+
+const altoV2 = `<?xml version="1.0" encoding="UTF-8"?>
+<alto xmlns="http://www.loc.gov/standards/alto/ns-v2#">
+  <Description>
+    <MeasurementUnit>pixel</MeasurementUnit>
+    <sourceImageInformation><fileName>test.png</fileName></sourceImageInformation>
+  </Description>
+  <Layout>
+    <Page ID="p1" PHYSICAL_IMG_NR="1" WIDTH="600" HEIGHT="800">
+      <PrintSpace HPOS="0" VPOS="0" WIDTH="600" HEIGHT="800">
+        <ComposedBlock ID="cb1" HPOS="10" VPOS="10" WIDTH="580" HEIGHT="300">
+          <TextBlock ID="tb1" HPOS="10" VPOS="10" WIDTH="580" HEIGHT="90">
+            <Shape><Polygon POINTS="10 10 590 10 590 100"/></Shape>
+            <TextLine ID="tl1" HPOS="10" VPOS="10" WIDTH="580" HEIGHT="40">
+              <String ID="s1" HPOS="10" VPOS="10" WIDTH="150" HEIGHT="40" CONTENT="Lorem" WC="0.9" CC="0 0 0 9 0"/>
+              <SP WIDTH="10" HPOS="160" VPOS="10"/>
+              <String ID="s2" HPOS="170" VPOS="10" WIDTH="400" HEIGHT="40" CONTENT="ipsum" WC="0.7"/>
+            </TextLine>
+            <TextLine ID="tl2" HPOS="10.4" VPOS="60" WIDTH="580" HEIGHT="40">
+              <String ID="s3" HPOS="10" VPOS="60" WIDTH="300" HEIGHT="40" CONTENT="dolo" WC="0.8"/>
+              <HYP CONTENT="-"/>
+            </TextLine>
+          </TextBlock>
+        </ComposedBlock>
+        <Illustration ID="il1" HPOS="10" VPOS="400" WIDTH="580" HEIGHT="300"/>
+      </PrintSpace>
+    </Page>
+  </Layout>
+</alto>
+`
+
+func TestParse(t *testing.T) {
+	a, err := Parse([]byte(altoV2))
+	if err != nil {
+		t.Fatalf("Error parsing: %v", err)
+	}
+	if len(a.Pages) != 1 || a.Description.FileName != "test.png" {
+		t.Fatalf("Unexpected document: %+v", a)
+	}
+	blocks := a.Pages[0].Blocks()
+	if len(blocks) != 3 {
+		t.Fatalf("Expected 3 blocks, got %d", len(blocks))
+	}
+	lines := a.Pages[0].Lines()
+	if len(lines) != 2 || lines[0].Text() != "Lorem ipsum" || lines[1].Text() != "dolo-" {
+		t.Fatalf("Unexpected lines: %+v", lines)
+	}
+	if c := lines[0].Conf(); c != 0.8 {
+		t.Errorf("Expected line confidence 0.8, got %f", c)
+	}
+
+	var out bytes.Buffer
+	err = Write(&out, a)
+	if err != nil {
+		t.Fatalf("Error writing: %v", err)
+	}
+	for _, s := range []string{
+		`<alto xmlns="` + Namespace2 + `">`,
+		`<ComposedBlock ID="cb1"`,
+		`<HYP CONTENT="-"></HYP>`,
+	} {
+		if !strings.Contains(out.String(), s) {
+			t.Errorf("Expected output to contain '%s':\n%s", s, out.String())
+		}
+	}
+	if strings.Contains(out.String(), "Shape") {
+		t.Errorf("Unexpected Shape in output:\n%s", out.String())
+	}
+}
+
+func TestHocr(t *testing.T) {
+	a, err := Parse([]byte(altoV2))
+	if err != nil {
+		t.Fatalf("Error parsing: %v", err)
+	}
+	h, err := ToHocr(a)
+	if err != nil {
+		t.Fatalf("Error converting to hOCR: %v", err)
+	}
+	var lines []string
+	for _, l := range h.Lines() {
+		lines = append(lines, hocr.LineText(*l))
+	}
+	if strings.Join(lines, "|") != "Lorem ipsum|dolo-" {
+		t.Errorf("Unexpected hOCR lines: %v", lines)
+	}
+	if len(h.Pages[0].Areas) != 2 || h.Pages[0].Areas[1].Class != "ocr_photo" {
+		t.Errorf("Unexpected hOCR areas: %+v", h.Pages[0].Areas)
+	}
+	glyphs, err := h.Lines()[0].Words[0].Glyphs()
+	if err != nil {
+		t.Fatalf("Error getting glyphs: %v", err)
+	}
+	if len(glyphs) != 5 || glyphs[0].Conf != 100 || glyphs[3].Conf != 0 {
+		t.Errorf("Unexpected glyphs: %+v", glyphs)
+	}
+
+	back, err := FromHocr(h)
+	if err != nil {
+		t.Fatalf("Error converting from hOCR: %v", err)
+	}
+	bl := back.Pages[0].Lines()
+	if len(bl) != 2 || bl[0].Text() != "Lorem ipsum" || bl[1].Text() != "dolo-" {
+		t.Fatalf("Unexpected lines from hOCR: %+v", bl)
+	}
+	items := bl[1].Items
+	if len(items) != 2 || items[1].XMLName.Local != "HYP" || items[0].Content != "dolo" {
+		t.Errorf("Hyphen not converted: %+v", items)
+	}
+	if s := bl[0].Strings()[0]; s.CC != "0 0 0 9 0" || s.WC != 0.9 {
+		t.Errorf("Unexpected confidences: %+v", s)
+	}
+	if sp := bl[0].Items[1]; sp.XMLName.Local != "SP" || sp.Hpos != 160 || sp.Width != 10 {
+		t.Errorf("Unexpected space: %+v", sp)
+	}
+}
+
+func TestToPixels(t *testing.T) {
+	// 300 dpi, so 254 mm10 (an inch) is 300 pixels
+	mm10 := strings.Replace(altoV2, "<MeasurementUnit>pixel", "<MeasurementUnit>mm10", 1)
+	a, err := Parse([]byte(mm10))
+	if err != nil {
+		t.Fatalf("Error parsing: %v", err)
+	}
+	_, err = ToHocr(a)
+	if err == nil {
+		t.Errorf("Expected error converting mm10 to hOCR")
+	}
+	err = a.ToPixels(0)
+	if err == nil {
+		t.Errorf("Expected error converting mm10 with no resolution")
+	}
+
+	err = a.ToPixels(300)
+	if err != nil {
+		t.Fatalf("Error converting to pixels: %v", err)
+	}
+	if a.Description.MeasurementUnit != "pixel" || math.Round(a.Pages[0].Width) != 709 {
+		t.Errorf("Unexpected page: %+v", a.Pages[0])
+	}
+	h, err := ToHocr(a)
+	if err != nil {
+		t.Fatalf("Error converting to hOCR: %v", err)
+	}
+	props, err := h.Lines()[1].Properties()
+	if err != nil {
+		t.Fatalf("Error parsing line properties: %v", err)
+	}
+	expected := image.Rect(12, 71, 697, 118)
+	if props.Bbox != expected {
+		t.Errorf("Expected line bbox %v, got %v", expected, props.Bbox)
+	}
+
+	// with an image, the resolution is found from its size
+	var buf bytes.Buffer
+	err = png.Encode(&buf, image.NewGray(image.Rect(0, 0, 709, 945)))
+	if err != nil {
+		t.Fatalf("Error encoding image: %v", err)
+	}
+	imgs := fstest.MapFS{"test.png": &fstest.MapFile{Data: buf.Bytes()}}
+	lines, err := ReadLineDetails(strings.NewReader(mm10), imgs)
+	if err != nil {
+		t.Fatalf("Error getting line details: %v", err)
+	}
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines, got %d", len(lines))
+	}
+	// 709 pixels for 600 mm10 is a little over 300 dpi
+	expected = image.Rect(12, 71, 698, 118)
+	if b := lines[1].Img.(line.ImgCrop).Rect; b != expected {
+		t.Errorf("Expected line image bounds %v, got %v", expected, b)
+	}
+	buf.Reset()
+	err = lines[1].Img.CopyLineTo(&buf)
+	if err != nil {
+		t.Fatalf("Error copying line image: %v", err)
+	}
+	limg, err := png.Decode(&buf)
+	if err != nil {
+		t.Fatalf("Error decoding line image: %v", err)
+	}
+	if s := limg.Bounds().Size(); s != expected.Size() {
+		t.Errorf("Expected line image size %v, got %v", expected.Size(), s)
+	}
+
+	a, _ = Parse([]byte(strings.Replace(altoV2, "<MeasurementUnit>pixel", "<MeasurementUnit>furlong", 1)))
+	err = a.ToPixels(300)
+	if err == nil {
+		t.Errorf("Expected error converting an unknown unit")
+	}
+}
+
+func TestIsAlto(t *testing.T) {
+	cases := []struct {
+		name     string
+		doc      string
+		expected bool
+	}{
+		{"alto", altoV2, true},
+		{"page", `<?xml version="1.0"?><!-- PAGE --><PcGts xmlns="http://schema.primaresearch.org/PAGE/gts/pagecontent/2019-07-15"><Page/></PcGts>`, false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			is, err := isAlto(strings.NewReader(c.doc))
+			if err != nil {
+				t.Fatalf("Error finding root element: %v", err)
+			}
+			if is != c.expected {
+				t.Errorf("Expected %v, got %v", c.expected, is)
+			}
+		})
+	}
+
+	if _, err := isAlto(strings.NewReader("not xml")); err == nil {
+		t.Errorf("Expected an error for a document with no root element")
+	}
+}
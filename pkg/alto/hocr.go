@@ -0,0 +1,290 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package alto
+
+import (
+	"encoding/xml"
+	"fmt"
+	"image"
+	"math"
+	"os"
+	"strconv"
+	"strings"
+
+	"rescribe.xyz/utils/pkg/hocr"
+)
+
+// ToHocr converts an ALTO document to hOCR. Each TextBlock becomes
+// an ocr_carea, including those nested in ComposedBlocks, which are
+// flattened, and each Illustration becomes an ocr_photo. Lines and
+// Strings become ocr_line and ocrx_word elements, with any HYP
+// added to the end of the last word of its line. Coordinates must
+// be in pixels, so documents in other units should be converted
+// with ToPixels first.
+func ToHocr(a Alto) (hocr.Hocr, error) {
+	var h hocr.Hocr
+	if unit := a.Description.MeasurementUnit; !isPixel(unit) {
+		return h, fmt.Errorf("coordinates are in %s, not pixels", unit)
+	}
+	h.SetMeta("ocr-capabilities", "ocr_page ocr_carea ocr_photo ocr_line ocrx_word ocrp_wconf")
+
+	for n, p := range a.Pages {
+		var props hocr.Properties
+		props.Image = a.Description.FileName
+		props.Bbox = image.Rect(0, 0, int(p.Width), int(p.Height))
+		props.Ppageno = n
+		if props.Image != "" {
+			props.Keys = []string{"image"}
+		}
+		props.Keys = append(props.Keys, "bbox", "ppageno")
+		pg := hocr.Page{Class: "ocr_page", Id: p.ID, Title: props.String()}
+
+		for _, b := range p.Blocks() {
+			switch b.XMLName.Local {
+			case "TextBlock":
+				var par hocr.Paragraph
+				for _, l := range b.TextLines {
+					par.Lines = append(par.Lines, lineToHocr(l))
+				}
+				ar := hocr.Area{Class: "ocr_carea", Id: b.ID, Title: bboxTitle(b.Rect())}
+				ar.Paragraphs = []hocr.Paragraph{par}
+				pg.Areas = append(pg.Areas, ar)
+			case "Illustration":
+				pg.Areas = append(pg.Areas, hocr.Area{Class: "ocr_photo", Id: b.ID, Title: bboxTitle(b.Rect())})
+			}
+		}
+		h.Pages = append(h.Pages, pg)
+	}
+
+	return h, nil
+}
+
+// bboxTitle returns a title containing just a bbox
+func bboxTitle(r image.Rectangle) string {
+	return hocr.Properties{Bbox: r}.String()
+}
+
+func lineToHocr(l TextLine) hocr.OcrLine {
+	ol := hocr.OcrLine{Class: "ocr_line", Id: l.ID, Title: bboxTitle(l.Rect())}
+	var props []hocr.Properties
+	for _, i := range l.Items {
+		switch i.XMLName.Local {
+		case "String":
+			var p hocr.Properties
+			p.Bbox = i.Rect()
+			p.Wconf = i.WC * 100
+			p.Confs = ccToConfs(i.CC)
+			if len(p.Confs) != len([]rune(i.Content)) {
+				p.Confs = nil
+			}
+			props = append(props, p)
+			ol.Words = append(ol.Words, hocr.OcrWord{Class: "ocrx_word", Id: i.ID, Text: i.Content})
+		case "HYP":
+			if n := len(ol.Words); n > 0 {
+				ol.Words[n-1].Text += i.Content
+				// the hyphen has no confidence of its own
+				props[n-1].Confs = nil
+			}
+		}
+	}
+	for n := range ol.Words {
+		ol.Words[n].Title = props[n].String()
+	}
+	return ol
+}
+
+// ccToConfs converts an ALTO CC attribute, which has a digit from 0
+// (sure) to 9 (unsure) for each character, to hOCR confidences,
+// from 0 to 100. Both space separated and unseparated digits are
+// accepted, as both are found in the wild.
+func ccToConfs(cc string) []float64 {
+	var confs []float64
+	for _, c := range strings.Join(strings.Fields(cc), "") {
+		if c < '0' || c > '9' {
+			return nil
+		}
+		confs = append(confs, math.Round(float64(9-(c-'0'))*100/9))
+	}
+	return confs
+}
+
+// confsToCC converts hOCR confidences to an ALTO CC attribute
+func confsToCC(confs []float64) string {
+	var cc []string
+	for _, c := range confs {
+		if c < 0 {
+			return ""
+		}
+		d := 9 - int(c*9/100+0.5)
+		if d < 0 {
+			d = 0
+		}
+		cc = append(cc, strconv.Itoa(d))
+	}
+	return strings.Join(cc, " ")
+}
+
+// FromHocr converts hOCR to an ALTO document. Each paragraph
+// becomes a TextBlock, and each ocr_photo or ocr_image area an
+// Illustration. The image name is taken from the first page. Ids
+// are generated for any elements which don't have them.
+func FromHocr(h hocr.Hocr) (Alto, error) {
+	var a Alto
+	a.Xmlns = Namespace4
+	a.Description.MeasurementUnit = "pixel"
+
+	ids := idMaker{}
+	for n, pg := range h.Pages {
+		props, err := pg.Properties()
+		if err != nil {
+			return a, fmt.Errorf("Error parsing properties of page %s: %v", pg.Id, err)
+		}
+		if n == 0 {
+			a.Description.FileName = props.Image
+		}
+		p := Page{ID: ids.id(pg.Id, "page"), PhysicalImgNr: n + 1}
+		p.Width = float64(props.Bbox.Dx())
+		p.Height = float64(props.Bbox.Dy())
+		space := Space{Box: boxFromRect(props.Bbox)}
+
+		for _, ar := range pg.Areas {
+			aprops, err := ar.Properties()
+			if err != nil {
+				return a, fmt.Errorf("Error parsing properties of area %s: %v", ar.Id, err)
+			}
+			if ar.Class == "ocr_photo" || ar.Class == "ocr_image" {
+				b := Block{XMLName: xml.Name{Local: "Illustration"}, ID: ids.id(ar.Id, "illustration"), Box: boxFromRect(aprops.Bbox)}
+				space.Blocks = append(space.Blocks, b)
+				continue
+			}
+			for _, par := range ar.Paragraphs {
+				pprops, err := par.Properties()
+				if err != nil {
+					return a, fmt.Errorf("Error parsing properties of paragraph %s: %v", par.Id, err)
+				}
+				b := Block{XMLName: xml.Name{Local: "TextBlock"}}
+				b.ID = ids.id(par.Id, "block")
+				if par.Class == "" && len(ar.Paragraphs) == 1 {
+					b.ID = ids.id(ar.Id, "block")
+				}
+				r := pprops.Bbox
+				if r.Empty() {
+					r = aprops.Bbox
+				}
+				for _, l := range par.Lines {
+					tl, err := lineFromHocr(l, ids)
+					if err != nil {
+						return a, err
+					}
+					if pprops.Bbox.Empty() && aprops.Bbox.Empty() {
+						r = r.Union(tl.Rect())
+					}
+					b.TextLines = append(b.TextLines, tl)
+				}
+				b.Box = boxFromRect(r)
+				space.Blocks = append(space.Blocks, b)
+			}
+		}
+		p.PrintSpace = &space
+		a.Pages = append(a.Pages, p)
+	}
+
+	return a, nil
+}
+
+// idMaker makes unique ids
+type idMaker map[string]bool
+
+// id returns id if it is set and unused, otherwise a new id
+// starting with prefix
+func (m idMaker) id(id string, prefix string) string {
+	if id != "" && !m[id] {
+		m[id] = true
+		return id
+	}
+	for n := len(m) + 1; ; n++ {
+		id = fmt.Sprintf("%s_%d", prefix, n)
+		if !m[id] {
+			m[id] = true
+			return id
+		}
+	}
+}
+
+// itemFromRect returns an item with the given name and box
+func itemFromRect(name string, r image.Rectangle) Item {
+	b := boxFromRect(r)
+	return Item{XMLName: xml.Name{Local: name}, Hpos: b.Hpos, Vpos: b.Vpos, Width: b.Width, Height: b.Height}
+}
+
+func lineFromHocr(l hocr.OcrLine, ids idMaker) (TextLine, error) {
+	var tl TextLine
+	props, err := l.Properties()
+	if err != nil {
+		return tl, fmt.Errorf("Error parsing properties of line %s: %v", l.Id, err)
+	}
+	tl.ID = ids.id(l.Id, "line")
+	tl.Box = boxFromRect(props.Bbox)
+
+	if len(l.Words) == 0 {
+		// a line without words, as ocropus produces
+		s := itemFromRect("String", props.Bbox)
+		s.ID = ids.id("", "string")
+		s.Content = hocr.LineText(l)
+		tl.Items = []Item{s}
+		return tl, nil
+	}
+
+	var prev image.Rectangle
+	for n, w := range l.Words {
+		wprops, err := w.Properties()
+		if err != nil {
+			return tl, fmt.Errorf("Error parsing properties of word %s: %v", w.Id, err)
+		}
+		glyphs, err := w.Glyphs()
+		if err != nil {
+			// glyph information is optional, so the word is kept
+			// without it
+			fmt.Fprintf(os.Stderr, "Warning: word %s: %v\n", w.Id, err)
+			glyphs = hocr.TextGlyphs(w)
+		}
+		var txt string
+		var confs []float64
+		for _, g := range glyphs {
+			txt += g.Text
+			confs = append(confs, g.Conf)
+		}
+
+		if n > 0 && !prev.Empty() && !wprops.Bbox.Empty() && wprops.Bbox.Min.X > prev.Max.X {
+			sp := itemFromRect("SP", image.Rect(prev.Max.X, prev.Min.Y, wprops.Bbox.Min.X, prev.Min.Y))
+			tl.Items = append(tl.Items, sp)
+		}
+		prev = wprops.Bbox
+
+		var hyp string
+		if n == len(l.Words)-1 && len([]rune(txt)) > 1 {
+			for _, h := range []string{"-", "¬", "⸗"} {
+				if strings.HasSuffix(txt, h) {
+					txt = strings.TrimSuffix(txt, h)
+					confs = confs[:len(confs)-1]
+					hyp = h
+					break
+				}
+			}
+		}
+
+		s := itemFromRect("String", wprops.Bbox)
+		s.ID = ids.id(w.Id, "string")
+		s.Content = txt
+		s.WC = wprops.Wconf / 100
+		s.CC = confsToCC(confs)
+		tl.Items = append(tl.Items, s)
+		if hyp != "" {
+			tl.Items = append(tl.Items, Item{XMLName: xml.Name{Local: "HYP"}, Content: hyp})
+		}
+	}
+
+	return tl, nil
+}
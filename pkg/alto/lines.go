@@ -0,0 +1,162 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package alto
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"rescribe.xyz/utils/pkg/line"
+)
+
+// lineDetails converts an ALTO document into a line.Details,
+// including image segments for each line, which are only cropped
+// from the page image when they are used. The page image named
+// imgpath is opened from imgs, and used for every page. If the
+// coordinates aren't in pixels, the resolution of the image is
+// found from its width and that of the first page.
+func lineDetails(a Alto, imgs fs.FS, imgpath string) (line.Details, error) {
+	lines := make(line.Details, 0)
+
+	imgpath = path.Base(filepath.ToSlash(imgpath))
+
+	found := true
+	cfg, _, err := line.DecodeConfig(imgs, imgpath)
+	if errors.Is(err, fs.ErrNotExist) {
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+		found = false
+	} else if err != nil {
+		return lines, err
+	}
+	// every line is from the same page image, so only it needs to
+	// be kept decoded
+	cache := line.NewPageCache(1)
+
+	if unit := a.Description.MeasurementUnit; found && !isPixel(unit) {
+		if len(a.Pages) == 0 || a.Pages[0].Width <= 0 {
+			return lines, fmt.Errorf("can't convert %s to pixels, as the page has no width", unit)
+		}
+		dpi := float64(cfg.Width) / a.Pages[0].Width * unitsPerInch[unit]
+		err = a.ToPixels(dpi)
+		if err != nil {
+			return lines, err
+		}
+	}
+
+	for i := range a.Pages {
+		for _, l := range a.Pages[i].Lines() {
+			var ln line.Detail
+			ln.Name = l.ID
+			ln.Avgconf = l.Conf()
+			if ln.Avgconf == 0 {
+				// no confidence is known
+				ln.Avgconf = -1
+			}
+			ln.Text = l.Text()
+			ln.OcrName = strings.TrimSuffix(imgpath, path.Ext(imgpath))
+			for _, s := range l.Strings() {
+				confs := ccToConfs(s.CC)
+				chars := []rune(s.Content)
+				for n, c := range chars {
+					g := line.Glyph{Text: string(c), Conf: -1}
+					if len(confs) == len(chars) {
+						g.Conf = confs[n] / 100
+					}
+					ln.Glyphs = append(ln.Glyphs, g)
+				}
+			}
+			if found {
+				ln.Img = line.ImgCrop{FS: imgs, Path: imgpath, Rect: l.Rect(), Cache: cache}
+			}
+			lines = append(lines, ln)
+		}
+	}
+
+	return lines, nil
+}
+
+// ReadLineDetails parses ALTO XML from r and returns a corresponding
+// line.Details, including image extracts for each line. The page
+// image is opened from imgs, using the image name in the ALTO
+// Description.
+func ReadLineDetails(r io.Reader, imgs fs.FS) (line.Details, error) {
+	a, err := Read(r)
+	if err != nil {
+		return make(line.Details, 0), err
+	}
+	return lineDetails(a, imgs, a.Description.FileName)
+}
+
+// ReadLineDetailsCustomImg is a variant of ReadLineDetails that
+// uses the image named imgname in imgs, rather than the image name
+// in the ALTO Description
+func ReadLineDetailsCustomImg(r io.Reader, imgs fs.FS, imgname string) (line.Details, error) {
+	a, err := Read(r)
+	if err != nil {
+		return make(line.Details, 0), err
+	}
+	return lineDetails(a, imgs, imgname)
+}
+
+// GetLineDetails parses an ALTO XML file and returns a corresponding
+// line.Details, including image extracts for each line, with the
+// page image opened from the same directory
+func GetLineDetails(fn string) (line.Details, error) {
+	f, err := os.Open(fn)
+	if err != nil {
+		return make(line.Details, 0), err
+	}
+	defer f.Close()
+
+	return ReadLineDetails(f, os.DirFS(filepath.Dir(fn)))
+}
+
+// GetLineDetailsCustomImg is a variant of GetLineDetails that uses
+// a provided image path, rather than the image name in the ALTO
+// Description
+func GetLineDetailsCustomImg(fn string, imgfn string) (line.Details, error) {
+	f, err := os.Open(fn)
+	if err != nil {
+		return make(line.Details, 0), err
+	}
+	defer f.Close()
+
+	return ReadLineDetailsCustomImg(f, os.DirFS(filepath.Dir(imgfn)), filepath.Base(imgfn))
+}
+
+// isAlto reports whether the root element of an XML document is
+// alto, rather than for example PcGts for PAGE XML
+func isAlto(r io.Reader) (bool, error) {
+	d := xml.NewDecoder(r)
+	for {
+		t, err := d.Token()
+		if err != nil {
+			return false, fmt.Errorf("Error finding root element: %v", err)
+		}
+		if se, ok := t.(xml.StartElement); ok {
+			return se.Name.Local == "alto", nil
+		}
+	}
+}
+
+// IsAltoFile reports whether an XML file is an ALTO document, so
+// that it can be told apart from other XML such as PAGE, which
+// use the same suffix
+func IsAltoFile(fn string) (bool, error) {
+	f, err := os.Open(fn)
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	return isAlto(f)
+}
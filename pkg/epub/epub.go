@@ -0,0 +1,505 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+// epub creates EPUB 3 books from hOCR pages, for reading copies of
+// OCRed books. Paragraphs are joined across lines and pages, and
+// the book can be split into chapters at headings, which are
+// listed in its table of contents.
+package epub
+
+import (
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"crypto/sha1"
+	"encoding/xml"
+	"fmt"
+	"image/png"
+	"io"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"rescribe.xyz/utils/pkg/hocr"
+	"rescribe.xyz/utils/pkg/line"
+	"rescribe.xyz/utils/pkg/normalize"
+)
+
+// Page is a page of a book, with the file system its image can be
+// found in
+type Page struct {
+	hocr.Page
+	Images fs.FS
+}
+
+// Metadata describes a book
+type Metadata struct {
+	Title    string
+	Author   string
+	Year     string
+	Language string // if empty, the language of the hOCR is used
+}
+
+// Heading is an entry in the table of contents, which starts at a
+// page of the book, counting from 1
+type Heading struct {
+	Title string
+	Page  int
+}
+
+// Options control how a book is created
+type Options struct {
+	Metadata
+	// TOC is the table of contents. A new chapter starts at each
+	// heading in it.
+	TOC []Heading
+	// Headings finds headings in the text to use as the table of
+	// contents, if TOC is empty
+	Headings bool
+	// Images includes the image of each page before its text
+	Images bool
+	// Profile is used to normalise the text, if it is set
+	Profile *normalize.Profile
+	// Modified is the modification time recorded in the book, or
+	// the current time if it is zero
+	Modified time.Time
+}
+
+// headingSize is how much larger than usual the text of a paragraph
+// must be for it to be found as a heading
+const headingSize = 1.4
+
+// headingWords is the most words a heading found by its size can
+// have
+const headingWords = 10
+
+// imageTypes are the media types of the image formats which can be
+// included in an EPUB as they are. Others are converted to PNG.
+var imageTypes = map[string]string{
+	".gif":  "image/gif",
+	".jpeg": "image/jpeg",
+	".jpg":  "image/jpeg",
+	".png":  "image/png",
+}
+
+type blockKind int
+
+const (
+	paragraphBlock blockKind = iota
+	headingBlock
+	pageBlock
+)
+
+// block is a part of the text of a book
+type block struct {
+	kind blockKind
+	text string
+	page int    // the page number, for a pageBlock
+	img  string // the name of the page image, for a pageBlock
+}
+
+// file is a file in the book
+type file struct {
+	name      string
+	mediaType string
+	data      []byte
+}
+
+// ReadTOC reads a table of contents, with each line giving the page
+// a heading starts on, followed by the heading, such as
+// "5 Chapter I". Blank lines are ignored.
+func ReadTOC(r io.Reader) ([]Heading, error) {
+	var toc []Heading
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		l := strings.TrimSpace(scanner.Text())
+		if l == "" {
+			continue
+		}
+		f := strings.SplitN(l, " ", 2)
+		page, err := strconv.Atoi(f[0])
+		if err != nil || len(f) < 2 {
+			return toc, fmt.Errorf("line %d: expected a page number and heading", n)
+		}
+		toc = append(toc, Heading{strings.TrimSpace(f[1]), page})
+	}
+	return toc, scanner.Err()
+}
+
+// lineSizes returns the x_size of each line in pages which has one
+func lineSizes(pages []Page) []float64 {
+	var sizes []float64
+	for _, p := range pages {
+		for _, l := range p.Lines() {
+			props, err := l.Properties()
+			if err == nil && props.Size > 0 {
+				sizes = append(sizes, props.Size)
+			}
+		}
+	}
+	sort.Float64s(sizes)
+	return sizes
+}
+
+// isHeading returns whether a paragraph looks like a heading,
+// because it is short and its text is larger than size. Being
+// marked as ocr_header isn't enough, as tesseract also uses that
+// for the running header at the top of each page.
+func isHeading(par hocr.Paragraph, text string, size float64) bool {
+	if len(par.Lines) == 0 || size <= 0 || strings.TrimFunc(text, unicode.IsDigit) == "" {
+		return false
+	}
+	for _, l := range par.Lines {
+		props, err := l.Properties()
+		if err != nil || props.Size < size {
+			return false
+		}
+	}
+	return len(strings.Fields(text)) <= headingWords
+}
+
+// continues returns whether a paragraph at the start of a page is
+// the continuation of the previous paragraph, because that ends
+// with a hyphenated word or this starts in lower case
+func continues(prev, text string) bool {
+	if _, ok := hocr.TrimHyphen(prev); ok {
+		return true
+	}
+	r, _ := utf8.DecodeRuneInString(text)
+	return unicode.IsLower(r)
+}
+
+// join joins a paragraph to one which it continues
+func join(prev, text string) string {
+	if trimmed, ok := hocr.TrimHyphen(prev); ok {
+		return trimmed + text
+	}
+	return prev + " " + text
+}
+
+// pageImage returns the image of a page as a file to include in the
+// book, converting it to PNG if necessary
+func pageImage(p Page, n int) (file, error) {
+	props, err := p.Properties()
+	if err != nil {
+		return file{}, err
+	}
+	if props.Image == "" {
+		return file{}, hocr.ErrNoImage
+	}
+	imgpath := path.Base(filepath.ToSlash(props.Image))
+	ext := strings.ToLower(path.Ext(imgpath))
+	if t, ok := imageTypes[ext]; ok {
+		b, err := fs.ReadFile(p.Images, imgpath)
+		if err != nil {
+			return file{}, err
+		}
+		return file{fmt.Sprintf("page%04d%s", n, ext), t, b}, nil
+	}
+	img, err := line.DecodeGray(p.Images, imgpath, props.ImageFrame())
+	if err != nil {
+		return file{}, err
+	}
+	var buf bytes.Buffer
+	err = png.Encode(&buf, img)
+	if err != nil {
+		return file{}, err
+	}
+	return file{fmt.Sprintf("page%04d.png", n), "image/png", buf.Bytes()}, nil
+}
+
+// blocks returns the text of the pages as blocks, along with any
+// page images to include
+func blocks(pages []Page, opts Options) ([]block, []file, error) {
+	var bs []block
+	var imgs []file
+	size := 0.0
+	if opts.Headings && len(opts.TOC) == 0 {
+		if sizes := lineSizes(pages); len(sizes) > 0 {
+			size = sizes[len(sizes)/2] * headingSize
+		}
+	}
+	// prev is the index of the last paragraph block, which the
+	// first paragraph on a page may continue
+	prev := -1
+	for i, p := range pages {
+		n := i + 1
+		for _, h := range opts.TOC {
+			if h.Page == n {
+				bs = append(bs, block{kind: headingBlock, text: h.Title})
+				prev = -1
+			}
+		}
+		pb := block{kind: pageBlock, page: n}
+		if opts.Images {
+			img, err := pageImage(p, n)
+			if err != nil {
+				return bs, imgs, fmt.Errorf("Error reading image for page %d: %w", n, err)
+			}
+			imgs = append(imgs, img)
+			pb.img = img.name
+		}
+		bs = append(bs, pb)
+
+		first := true
+		for _, par := range p.Paragraphs() {
+			text := hocr.ParagraphText(*par, true)
+			if opts.Profile != nil {
+				text = opts.Profile.String(text)
+			}
+			if text == "" {
+				continue
+			}
+			switch {
+			case opts.Headings && len(opts.TOC) == 0 && isHeading(*par, text, size):
+				bs = append(bs, block{kind: headingBlock, text: text})
+				prev = -1
+			case first && prev >= 0 && continues(bs[prev].text, text):
+				bs[prev].text = join(bs[prev].text, text)
+			default:
+				bs = append(bs, block{kind: paragraphBlock, text: text})
+				prev = len(bs) - 1
+			}
+			first = false
+		}
+	}
+	return bs, imgs, nil
+}
+
+// language returns the first language given in the pages
+func language(pages []Page) string {
+	for _, p := range pages {
+		if p.Lang != "" {
+			return p.Lang
+		}
+		for _, par := range p.Paragraphs() {
+			if par.Lang != "" {
+				return par.Lang
+			}
+		}
+	}
+	return ""
+}
+
+// escape returns s escaped for use in XML
+func escape(s string) string {
+	var b strings.Builder
+	xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
+
+const xhtmlHeader = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xmlns:epub="http://www.idpf.org/2007/ops" lang="%[1]s" xml:lang="%[1]s">
+<head>
+<title>%[2]s</title>
+</head>
+<body>
+`
+
+const xhtmlFooter = `</body>
+</html>
+`
+
+// navEntry is a link in the navigation document
+type navEntry struct {
+	text string
+	href string
+}
+
+// chapters splits blocks into chapters, starting a new one at each
+// heading, and returns them as XHTML files, along with the entries
+// for the table of contents and the list of pages
+func chapters(bs []block, lang, title string) ([]file, []navEntry, []navEntry) {
+	var files []file
+	var toc, pagelist []navEntry
+	var body strings.Builder
+	// text is whether the chapter has any text yet, rather than
+	// just page breaks, which can start the next chapter instead
+	text := false
+	name := func() string {
+		return fmt.Sprintf("chapter%03d.xhtml", len(files)+1)
+	}
+	flush := func() {
+		if body.Len() == 0 {
+			return
+		}
+		x := fmt.Sprintf(xhtmlHeader, escape(lang), escape(title)) + body.String() + xhtmlFooter
+		files = append(files, file{name(), "application/xhtml+xml", []byte(x)})
+		body.Reset()
+		text = false
+	}
+	for _, b := range bs {
+		switch b.kind {
+		case headingBlock:
+			if text {
+				flush()
+			}
+			text = true
+			id := fmt.Sprintf("heading%d", len(toc)+1)
+			toc = append(toc, navEntry{b.text, name() + "#" + id})
+			fmt.Fprintf(&body, "<h1 id=\"%s\">%s</h1>\n", id, escape(b.text))
+		case pageBlock:
+			id := fmt.Sprintf("page%d", b.page)
+			pagelist = append(pagelist, navEntry{strconv.Itoa(b.page), name() + "#" + id})
+			fmt.Fprintf(&body, "<div id=\"%s\" epub:type=\"pagebreak\" role=\"doc-pagebreak\" aria-label=\"%d\">", id, b.page)
+			if b.img != "" {
+				fmt.Fprintf(&body, "<img src=\"%s\" alt=\"Page %d\"/>", escape(b.img), b.page)
+			}
+			body.WriteString("</div>\n")
+		default:
+			text = true
+			fmt.Fprintf(&body, "<p>%s</p>\n", escape(b.text))
+		}
+	}
+	flush()
+	return files, toc, pagelist
+}
+
+// nav returns the navigation document
+func nav(toc, pagelist []navEntry, lang, title string) file {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(xhtmlHeader, escape(lang), escape(title)))
+	list := func(typ, heading string, entries []navEntry) {
+		fmt.Fprintf(&b, "<nav epub:type=\"%s\">\n<h1>%s</h1>\n<ol>\n", typ, heading)
+		for _, e := range entries {
+			fmt.Fprintf(&b, "<li><a href=\"%s\">%s</a></li>\n", escape(e.href), escape(e.text))
+		}
+		b.WriteString("</ol>\n</nav>\n")
+	}
+	list("toc", "Contents", toc)
+	if len(pagelist) > 0 {
+		list("page-list", "Pages", pagelist)
+	}
+	b.WriteString(xhtmlFooter)
+	return file{"nav.xhtml", "application/xhtml+xml", []byte(b.String())}
+}
+
+// identifier returns an identifier for the book, based on its
+// metadata and contents, so that the same book always gets the
+// same identifier
+func identifier(meta Metadata, bs []block) string {
+	h := sha1.New()
+	fmt.Fprintf(h, "%s\n%s\n%s\n", meta.Title, meta.Author, meta.Year)
+	for _, b := range bs {
+		fmt.Fprintf(h, "%d %s\n", b.kind, b.text)
+	}
+	u := h.Sum(nil)[:16]
+	u[6] = (u[6] & 0x0f) | 0x50 // version 5
+	u[8] = (u[8] & 0x3f) | 0x80 // variant
+	return fmt.Sprintf("urn:uuid:%x-%x-%x-%x-%x", u[0:4], u[4:6], u[6:8], u[8:10], u[10:])
+}
+
+// opf returns the package document
+func opf(meta Metadata, id string, modified time.Time, files []file, spine []file) file {
+	var b strings.Builder
+	b.WriteString(xml.Header)
+	b.WriteString(`<package xmlns="http://www.idpf.org/2007/opf" version="3.0" unique-identifier="bookid">` + "\n")
+	b.WriteString(`<metadata xmlns:dc="http://purl.org/dc/elements/1.1/">` + "\n")
+	fmt.Fprintf(&b, "<dc:identifier id=\"bookid\">%s</dc:identifier>\n", id)
+	fmt.Fprintf(&b, "<dc:title>%s</dc:title>\n", escape(meta.Title))
+	fmt.Fprintf(&b, "<dc:language>%s</dc:language>\n", escape(meta.Language))
+	if meta.Author != "" {
+		fmt.Fprintf(&b, "<dc:creator>%s</dc:creator>\n", escape(meta.Author))
+	}
+	if meta.Year != "" {
+		fmt.Fprintf(&b, "<dc:date>%s</dc:date>\n", escape(meta.Year))
+	}
+	fmt.Fprintf(&b, "<meta property=\"dcterms:modified\">%s</meta>\n", modified.UTC().Format("2006-01-02T15:04:05Z"))
+	b.WriteString("</metadata>\n<manifest>\n")
+	for i, f := range files {
+		props := ""
+		if f.name == "nav.xhtml" {
+			props = ` properties="nav"`
+		}
+		fmt.Fprintf(&b, "<item id=\"item%d\" href=\"%s\" media-type=\"%s\"%s/>\n", i+1, escape(f.name), f.mediaType, props)
+	}
+	b.WriteString("</manifest>\n<spine>\n")
+	for i, f := range files {
+		for _, s := range spine {
+			if s.name == f.name {
+				fmt.Fprintf(&b, "<itemref idref=\"item%d\"/>\n", i+1)
+			}
+		}
+	}
+	b.WriteString("</spine>\n</package>\n")
+	return file{"content.opf", "application/oebps-package+xml", []byte(b.String())}
+}
+
+const container = `<?xml version="1.0" encoding="UTF-8"?>
+<container version="1.0" xmlns="urn:oasis:names:tc:opendocument:xmlns:container">
+<rootfiles>
+<rootfile full-path="OEBPS/content.opf" media-type="application/oebps-package+xml"/>
+</rootfiles>
+</container>
+`
+
+// Write writes pages as an EPUB book
+func Write(w io.Writer, pages []Page, opts Options) error {
+	meta := opts.Metadata
+	if meta.Title == "" {
+		meta.Title = "Untitled"
+	}
+	if meta.Language == "" {
+		meta.Language = language(pages)
+	}
+	if meta.Language == "" {
+		meta.Language = "und"
+	}
+	modified := opts.Modified
+	if modified.IsZero() {
+		modified = time.Now()
+	}
+
+	bs, imgs, err := blocks(pages, opts)
+	if err != nil {
+		return err
+	}
+	text, toc, pagelist := chapters(bs, meta.Language, meta.Title)
+	if len(text) == 0 {
+		return fmt.Errorf("no text or pages in the book")
+	}
+	if len(toc) == 0 {
+		toc = []navEntry{{meta.Title, text[0].name}}
+	}
+
+	files := append([]file{nav(toc, pagelist, meta.Language, meta.Title)}, text...)
+	files = append(files, imgs...)
+	files = append([]file{opf(meta, identifier(meta, bs), modified, files, text)}, files...)
+
+	z := zip.NewWriter(w)
+	// the mimetype must come first, and be uncompressed, with no
+	// extra fields, so its modification time isn't set, as that
+	// would add an extended timestamp field
+	f, err := z.CreateHeader(&zip.FileHeader{Name: "mimetype", Method: zip.Store})
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(f, "application/epub+zip")
+	if err != nil {
+		return err
+	}
+	all := append([]file{{"META-INF/container.xml", "", []byte(container)}}, files...)
+	for i, fl := range all {
+		name := fl.name
+		if i > 0 {
+			name = "OEBPS/" + name
+		}
+		f, err := z.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: modified})
+		if err != nil {
+			return err
+		}
+		_, err = f.Write(fl.data)
+		if err != nil {
+			return err
+		}
+	}
+	return z.Close()
+}
@@ -0,0 +1,186 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package epub
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/binary"
+	"encoding/xml"
+	"image"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"rescribe.xyz/utils/pkg/hocr"
+)
+
+const testHocr = `<html><body>
+<div class='ocr_page' id='page_1' title='image "0001.png"; bbox 0 0 600 800'>
+<p class='ocr_par' lang='lat'>
+<span class='ocr_line' title='bbox 0 0 300 60; x_size 60'>LIBER PRIMVS</span>
+</p>
+<p class='ocr_par'>
+<span class='ocr_line' title='bbox 0 100 500 130; x_size 30'>Lorem ipsum dolor</span>
+<span class='ocr_line' title='bbox 0 130 500 160; x_size 30'>sit amet, con-</span>
+</p>
+</div>
+<div class='ocr_page' id='page_2' title='image "0002.png"; bbox 0 0 600 800'>
+<p class='ocr_par'>
+<span class='ocr_line' title='bbox 0 0 500 30; x_size 30'>ſectetur &amp; adipiscing.</span>
+</p>
+<p class='ocr_par'>
+<span class='ocr_line' title='bbox 0 100 500 130; x_size 30'>Sed do eiusmod</span>
+</p>
+</div>
+<div class='ocr_page' id='page_3' title='image "0003.png"; bbox 0 0 600 800'>
+<p class='ocr_par'>
+<span class='ocr_header' title='bbox 200 0 400 20; x_size 20'>LIBER I.</span>
+</p>
+<p class='ocr_par'>
+<span class='ocr_line' title='bbox 0 100 500 130; x_size 30'>Ut enim ad minim</span>
+</p>
+</div>
+</body></html>`
+
+// readEpub returns the files in an EPUB, and checks that the
+// mimetype is first and uncompressed
+func readEpub(t *testing.T, b []byte) map[string]string {
+	z, err := zip.NewReader(bytes.NewReader(b), int64(len(b)))
+	if err != nil {
+		t.Fatalf("Error reading zip: %v", err)
+	}
+	if z.File[0].Name != "mimetype" || z.File[0].Method != zip.Store {
+		t.Errorf("mimetype is not the first file, uncompressed")
+	}
+	// the local header of the mimetype must have no extra field,
+	// so that it is at a fixed offset for readers which check it
+	if extra := binary.LittleEndian.Uint16(b[28:30]); extra != 0 || string(b[30:38]) != "mimetype" {
+		t.Errorf("mimetype has an extra field of length %d", extra)
+	}
+	files := make(map[string]string)
+	for _, f := range z.File {
+		r, err := f.Open()
+		if err != nil {
+			t.Fatalf("Error opening %s: %v", f.Name, err)
+		}
+		c, err := io.ReadAll(r)
+		if err != nil {
+			t.Fatalf("Error reading %s: %v", f.Name, err)
+		}
+		r.Close()
+		files[f.Name] = string(c)
+		if strings.HasSuffix(f.Name, "ml") || strings.HasSuffix(f.Name, ".opf") {
+			d := xml.NewDecoder(bytes.NewReader(c))
+			for err == nil {
+				_, err = d.Token()
+			}
+			if err != io.EOF {
+				t.Errorf("%s is not well formed: %v", f.Name, err)
+			}
+		}
+	}
+	return files
+}
+
+func TestWrite(t *testing.T) {
+	h, err := hocr.Parse([]byte(testHocr))
+	if err != nil {
+		t.Fatalf("Error parsing hOCR: %v", err)
+	}
+	var img bytes.Buffer
+	err = png.Encode(&img, image.NewGray(image.Rect(0, 0, 60, 80)))
+	if err != nil {
+		t.Fatalf("Error encoding image: %v", err)
+	}
+	imgs := fstest.MapFS{
+		"0001.png": &fstest.MapFile{Data: img.Bytes()},
+		"0002.png": &fstest.MapFile{Data: img.Bytes()},
+		"0003.png": &fstest.MapFile{Data: img.Bytes()},
+	}
+	var pages []Page
+	for _, p := range h.Pages {
+		pages = append(pages, Page{p, imgs})
+	}
+	modified := time.Date(2021, 3, 4, 5, 6, 7, 0, time.UTC)
+
+	cases := []struct {
+		name     string
+		opts     Options
+		chapters []string
+		nav      []string
+		images   bool
+	}{
+		{"plain", Options{}, []string{"LIBER PRIMVS", "Lorem ipsum dolor sit amet, conſectetur &amp; adipiscing.", "Sed do eiusmod"},
+			[]string{`<a href="chapter001.xhtml">Untitled</a>`, `<a href="chapter001.xhtml#page2">2</a>`}, false},
+		{"headings", Options{Headings: true}, []string{"<p>Lorem ipsum", "<p>Sed do"},
+			[]string{`<a href="chapter001.xhtml#heading1">LIBER PRIMVS</a>`}, false},
+		{"toc", Options{TOC: []Heading{{"Caput II", 2}}}, []string{"LIBER PRIMVS", "<h1 id=\"heading1\">Caput II</h1>"},
+			[]string{`<a href="chapter002.xhtml#heading1">Caput II</a>`, `<a href="chapter002.xhtml#page2">2</a>`}, false},
+		{"images", Options{Images: true}, []string{`<img src="page0001.png" alt="Page 1"/>`}, nil, true},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			c.opts.Modified = modified
+			c.opts.Title = "Liber"
+			if c.name == "plain" {
+				c.opts.Title = ""
+			}
+			var buf bytes.Buffer
+			err := Write(&buf, pages, c.opts)
+			if err != nil {
+				t.Fatalf("Error writing EPUB: %v", err)
+			}
+			files := readEpub(t, buf.Bytes())
+
+			var text string
+			for name, f := range files {
+				if strings.HasPrefix(name, "OEBPS/chapter") {
+					text += f
+				}
+			}
+			for _, s := range c.chapters {
+				if !strings.Contains(text, s) {
+					t.Errorf("Expected %q in chapters:\n%s", s, text)
+				}
+			}
+			for _, s := range c.nav {
+				if !strings.Contains(files["OEBPS/nav.xhtml"], s) {
+					t.Errorf("Expected %q in nav:\n%s", s, files["OEBPS/nav.xhtml"])
+				}
+			}
+			// the running header isn't a heading, despite being
+			// marked as ocr_header
+			if strings.Contains(files["OEBPS/nav.xhtml"], "LIBER I.") {
+				t.Errorf("Running header found as a heading:\n%s", files["OEBPS/nav.xhtml"])
+			}
+			if _, ok := files["OEBPS/page0001.png"]; ok != c.images {
+				t.Errorf("Expected page image to be included: %v", c.images)
+			}
+			opf := files["OEBPS/content.opf"]
+			for _, s := range []string{"<dc:language>lat</dc:language>", "2021-03-04T05:06:07Z", `properties="nav"`} {
+				if !strings.Contains(opf, s) {
+					t.Errorf("Expected %q in content.opf:\n%s", s, opf)
+				}
+			}
+		})
+	}
+}
+
+func TestReadTOC(t *testing.T) {
+	toc, err := ReadTOC(strings.NewReader("1 Praefatio\n\n12  Liber I \n"))
+	if err != nil {
+		t.Fatalf("Error reading TOC: %v", err)
+	}
+	if len(toc) != 2 || toc[0] != (Heading{"Praefatio", 1}) || toc[1] != (Heading{"Liber I", 12}) {
+		t.Errorf("Unexpected TOC: %+v", toc)
+	}
+	if _, err = ReadTOC(strings.NewReader("Liber I\n")); err == nil {
+		t.Errorf("Expected an error for a line without a page number")
+	}
+}
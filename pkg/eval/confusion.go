@@ -0,0 +1,138 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package eval
+
+import (
+	"sort"
+	"strings"
+)
+
+// maxRun is the longest run of differing characters, on either
+// side, which is treated as a single confusion, such as rn for m.
+// Longer runs are usually just badly recognised text, and are
+// broken up into the individual edits.
+const maxRun = 3
+
+// Confusion is a difference between OCR and ground truth, which
+// may be more than one character, such as rn recognised for m. OCR
+// is empty for characters which are missing from the OCR, and GT is
+// empty for extra characters in the OCR.
+type Confusion struct {
+	OCR string
+	GT  string
+}
+
+// Op returns the type of the confusion: Substitute, Insert or
+// Delete
+func (c Confusion) Op() Op {
+	switch {
+	case c.GT == "":
+		return Insert
+	case c.OCR == "":
+		return Delete
+	}
+	return Substitute
+}
+
+// Confusions returns the confusions in an alignment. Each run of
+// adjacent edits which include an insertion or deletion is joined
+// into one confusion, such as rn for m, unless it is too long to be
+// useful, and other edits are kept separate.
+func Confusions(edits []Edit) []Confusion {
+	var confusions []Confusion
+	var run []Edit
+	flush := func() {
+		var ocr, gt []string
+		for _, e := range run {
+			ocr = append(ocr, e.OCR)
+			gt = append(gt, e.GT)
+		}
+		subs := true
+		for _, e := range run {
+			subs = subs && e.Op == Substitute
+		}
+		o, g := strings.Join(ocr, ""), strings.Join(gt, "")
+		if subs || len(Chars(o)) > maxRun || len(Chars(g)) > maxRun {
+			for _, e := range run {
+				confusions = append(confusions, Confusion{e.OCR, e.GT})
+			}
+		} else if len(run) > 0 {
+			confusions = append(confusions, Confusion{o, g})
+		}
+		run = nil
+	}
+	for _, e := range edits {
+		if e.Op == Match {
+			flush()
+			continue
+		}
+		run = append(run, e)
+	}
+	flush()
+	return confusions
+}
+
+// ConfusionCount is the number of times a confusion was found, with
+// the ids of some examples of where it was
+type ConfusionCount struct {
+	Confusion
+	Count    int
+	Examples []string
+}
+
+// ConfusionTable counts the confusions found in many comparisons
+// between OCR and ground truth
+type ConfusionTable struct {
+	// MaxExamples is the number of example ids kept for each
+	// confusion
+	MaxExamples int
+	counts      map[Confusion]*ConfusionCount
+}
+
+// NewConfusionTable returns an empty ConfusionTable, keeping up to
+// maxExamples examples for each confusion
+func NewConfusionTable(maxExamples int) *ConfusionTable {
+	return &ConfusionTable{MaxExamples: maxExamples, counts: make(map[Confusion]*ConfusionCount)}
+}
+
+// Add compares OCR text with ground truth, after normalising them,
+// adding any confusions found to the table, with id as the example
+// for each
+func (t *ConfusionTable) Add(ocr, gt string, id string, opts Options) {
+	edits := Align(Chars(Normalise(ocr, opts)), Chars(Normalise(gt, opts)))
+	for _, c := range Confusions(edits) {
+		cc, ok := t.counts[c]
+		if !ok {
+			cc = &ConfusionCount{Confusion: c}
+			t.counts[c] = cc
+		}
+		cc.Count++
+		if len(cc.Examples) < t.MaxExamples && (len(cc.Examples) == 0 || cc.Examples[len(cc.Examples)-1] != id) {
+			cc.Examples = append(cc.Examples, id)
+		}
+	}
+}
+
+// Top returns the n most frequent confusions, most frequent first,
+// or all of them if n is 0
+func (t *ConfusionTable) Top(n int) []ConfusionCount {
+	var counts []ConfusionCount
+	for _, c := range t.counts {
+		counts = append(counts, *c)
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Count != counts[j].Count {
+			return counts[i].Count > counts[j].Count
+		}
+		if counts[i].GT != counts[j].GT {
+			return counts[i].GT < counts[j].GT
+		}
+		return counts[i].OCR < counts[j].OCR
+	})
+	if n > 0 && len(counts) > n {
+		counts = counts[:n]
+	}
+	return counts
+}
@@ -0,0 +1,278 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+// eval measures the accuracy of OCR by comparing it with ground
+// truth, giving the character error rate (CER) and word error rate
+// (WER), based on the Levenshtein distance between them
+package eval
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/norm"
+	"rescribe.xyz/utils/pkg/normalize"
+)
+
+// Result counts the errors found by comparing OCR with ground
+// truth. Errors are the number of insertions, deletions and
+// substitutions needed to turn the OCR into the ground truth.
+type Result struct {
+	Chars      int // number of characters in the ground truth
+	CharErrors int
+	Words      int // number of words in the ground truth
+	WordErrors int
+}
+
+// CER returns the character error rate, as a proportion of the
+// characters in the ground truth
+func (r Result) CER() float64 {
+	return rate(r.CharErrors, r.Chars)
+}
+
+// WER returns the word error rate, as a proportion of the words in
+// the ground truth
+func (r Result) WER() float64 {
+	return rate(r.WordErrors, r.Words)
+}
+
+func rate(errors, total int) float64 {
+	if total == 0 {
+		if errors == 0 {
+			return 0
+		}
+		return 1
+	}
+	return float64(errors) / float64(total)
+}
+
+// Add adds the counts of another result to r, so that results for
+// each line can be combined into a result for a page or a book
+func (r *Result) Add(o Result) {
+	r.Chars += o.Chars
+	r.CharErrors += o.CharErrors
+	r.Words += o.Words
+	r.WordErrors += o.WordErrors
+}
+
+// Options control how text is normalised before it is compared
+type Options struct {
+	IgnoreCase        bool
+	IgnorePunctuation bool
+	// Profile is used to normalise the text, rather than NFC
+	Profile *normalize.Profile
+}
+
+// Normalise prepares text for comparison. It is converted to Unicode
+// normalisation form NFC, or normalised with opts.Profile if it is
+// set, with runs of whitespace replaced with a single space and
+// leading and trailing whitespace removed, and further changes can
+// be made according to opts.
+func Normalise(s string, opts Options) string {
+	if opts.Profile != nil {
+		s = opts.Profile.String(s)
+	} else {
+		s = norm.NFC.String(s)
+	}
+	if opts.IgnoreCase {
+		s = strings.ToLower(s)
+	}
+	if opts.IgnorePunctuation {
+		s = strings.Map(func(r rune) rune {
+			if unicode.IsPunct(r) {
+				return -1
+			}
+			return r
+		}, s)
+	}
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// Chars splits text into characters, keeping any combining marks
+// with the character they follow, so that for example a letter
+// with a combining macron counts as one character
+func Chars(s string) []string {
+	var chars []string
+	for _, r := range s {
+		if len(chars) > 0 && unicode.In(r, unicode.Mn, unicode.Me) {
+			chars[len(chars)-1] += string(r)
+			continue
+		}
+		chars = append(chars, string(r))
+	}
+	return chars
+}
+
+// Compare compares OCR text with ground truth, after normalising
+// both of them
+func Compare(ocr, gt string, opts Options) Result {
+	ocr = Normalise(ocr, opts)
+	gt = Normalise(gt, opts)
+	gtchars, gtwords := Chars(gt), strings.Fields(gt)
+	return Result{
+		Chars:      len(gtchars),
+		CharErrors: Distance(Chars(ocr), gtchars),
+		Words:      len(gtwords),
+		WordErrors: Distance(strings.Fields(ocr), gtwords),
+	}
+}
+
+// Distance returns the Levenshtein distance between two sequences,
+// which is the number of insertions, deletions and substitutions
+// needed to turn one into the other
+func Distance(a, b []string) int {
+	return lastRow(a, b)[len(b)]
+}
+
+// lastRow returns the last row of the Levenshtein distance table
+// between a and b, which is the distance between all of a and each
+// prefix of b. Only the previous row of the table is kept as it is
+// filled, so memory use grows linearly.
+func lastRow(a, b []string) []int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cur[j] = min3(prev[j]+1, cur[j-1]+1, prev[j-1]+cost(a[i-1], b[j-1]))
+		}
+		prev, cur = cur, prev
+	}
+	return prev
+}
+
+func cost(a, b string) int {
+	if a == b {
+		return 0
+	}
+	return 1
+}
+
+func min3(a, b, c int) int {
+	if b < a {
+		a = b
+	}
+	if c < a {
+		a = c
+	}
+	return a
+}
+
+// Op is a type of edit in an alignment
+type Op int
+
+const (
+	Match      Op = iota // the OCR and ground truth are the same
+	Substitute           // the OCR has something else in place of the ground truth
+	Insert               // the OCR has something extra
+	Delete               // the OCR is missing something in the ground truth
+)
+
+func (o Op) String() string {
+	switch o {
+	case Match:
+		return "match"
+	case Substitute:
+		return "substitute"
+	case Insert:
+		return "insert"
+	case Delete:
+		return "delete"
+	}
+	return "unknown"
+}
+
+// Edit is a step in an alignment. OCR is empty for a Delete, and
+// GT is empty for an Insert.
+type Edit struct {
+	Op  Op
+	OCR string
+	GT  string
+}
+
+// maxTable is the largest distance table which Align fills in
+// full. Longer sequences are split first, so that aligning the
+// whole text of a book doesn't need a table of every character of
+// the OCR against every character of the ground truth.
+const maxTable = 1 << 20
+
+// Align returns a minimal alignment between an OCR sequence and a
+// ground truth sequence, such as the results of Chars or
+// strings.Fields. Substitutions are preferred over insertions and
+// deletions where either would do.
+func Align(ocr, gt []string) []Edit {
+	if len(ocr) < 2 || (len(ocr)+1)*(len(gt)+1) <= maxTable {
+		return alignTable(ocr, gt)
+	}
+
+	// split the OCR in half, and find the point in the ground truth
+	// which a minimal alignment passes through, using the distances
+	// from the start to the middle and from the end back to the
+	// middle (Hirschberg's algorithm)
+	mid := len(ocr) / 2
+	fwd := lastRow(ocr[:mid], gt)
+	bwd := lastRow(reversed(ocr[mid:]), reversed(gt))
+	split := 0
+	for j := range fwd {
+		if fwd[j]+bwd[len(gt)-j] < fwd[split]+bwd[len(gt)-split] {
+			split = j
+		}
+	}
+	return append(Align(ocr[:mid], gt[:split]), Align(ocr[mid:], gt[split:])...)
+}
+
+// reversed returns a reversed copy of s
+func reversed(s []string) []string {
+	r := make([]string, len(s))
+	for i, v := range s {
+		r[len(s)-1-i] = v
+	}
+	return r
+}
+
+// alignTable aligns two sequences using a full distance table
+func alignTable(ocr, gt []string) []Edit {
+	// d[i][j] is the distance between ocr[:i] and gt[:j]
+	d := make([][]int, len(ocr)+1)
+	for i := range d {
+		d[i] = make([]int, len(gt)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(ocr); i++ {
+		for j := 1; j <= len(gt); j++ {
+			d[i][j] = min3(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost(ocr[i-1], gt[j-1]))
+		}
+	}
+
+	// trace back from the end to find the edits
+	var edits []Edit
+	i, j := len(ocr), len(gt)
+	for i > 0 || j > 0 {
+		switch {
+		case i > 0 && j > 0 && d[i][j] == d[i-1][j-1]+cost(ocr[i-1], gt[j-1]):
+			op := Match
+			if ocr[i-1] != gt[j-1] {
+				op = Substitute
+			}
+			edits = append(edits, Edit{op, ocr[i-1], gt[j-1]})
+			i, j = i-1, j-1
+		case i > 0 && d[i][j] == d[i-1][j]+1:
+			edits = append(edits, Edit{Insert, ocr[i-1], ""})
+			i--
+		default:
+			edits = append(edits, Edit{Delete, "", gt[j-1]})
+			j--
+		}
+	}
+	for l, r := 0, len(edits)-1; l < r; l, r = l+1, r-1 {
+		edits[l], edits[r] = edits[r], edits[l]
+	}
+	return edits
+}
@@ -0,0 +1,154 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package eval
+
+import (
+	"strings"
+	"testing"
+
+	"rescribe.xyz/utils/pkg/normalize"
+)
+
+func TestCompare(t *testing.T) {
+	cases := []struct {
+		name     string
+		ocr, gt  string
+		opts     Options
+		expected Result
+	}{
+		{"same", "Lorem ipsum", "Lorem ipsum", Options{}, Result{11, 0, 2, 0}},
+		{"whitespace", " Lorem \t ipsum\n", "Lorem ipsum", Options{}, Result{11, 0, 2, 0}},
+		{"substitution", "Lorem ipfum", "Lorem ipſum", Options{}, Result{11, 1, 2, 1}},
+		{"deletion", "Lorem psum", "Lorem ipsum", Options{}, Result{11, 1, 2, 1}},
+		{"insertion", "Lorem ipsum dolor", "Lorem ipsum", Options{}, Result{11, 6, 2, 1}},
+		{"empty ocr", "", "Lorem", Options{}, Result{5, 5, 1, 1}},
+		{"empty gt", "Lorem", "", Options{}, Result{0, 5, 0, 1}},
+		// e + combining macron, compared with the precomposed form
+		// and with a bare e
+		{"nfc", "dē", "dē", Options{}, Result{2, 0, 1, 0}},
+		{"combining", "dq̄", "de", Options{}, Result{2, 1, 1, 1}},
+		{"case", "LOREM ipsum", "Lorem Ipsum", Options{IgnoreCase: true}, Result{11, 0, 2, 0}},
+		{"punctuation", "Lorem, ipsum.", "Lorem ipsum", Options{IgnorePunctuation: true}, Result{11, 0, 2, 0}},
+		{"profile", "ſuﬁcit", "suficit", Options{Profile: normalize.Modernised}, Result{7, 0, 1, 0}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r := Compare(c.ocr, c.gt, c.opts)
+			if r != c.expected {
+				t.Errorf("Expected %+v, got %+v", c.expected, r)
+			}
+		})
+	}
+
+	var total Result
+	total.Add(Result{10, 1, 2, 1})
+	total.Add(Result{30, 2, 6, 1})
+	if total.CER() != 0.075 || total.WER() != 0.25 {
+		t.Errorf("Unexpected rates for %+v: %f %f", total, total.CER(), total.WER())
+	}
+}
+
+func TestAlign(t *testing.T) {
+	edits := Align(Chars("Lorcm ipssum"), Chars("Lorem ipsum"))
+	var s []string
+	for _, e := range edits {
+		switch e.Op {
+		case Match:
+			s = append(s, e.GT)
+		case Substitute:
+			s = append(s, "["+e.OCR+">"+e.GT+"]")
+		case Insert:
+			s = append(s, "[+"+e.OCR+"]")
+		case Delete:
+			s = append(s, "[-"+e.GT+"]")
+		}
+	}
+	if got := strings.Join(s, ""); got != "Lor[c>e]m ip[+s]sum" {
+		t.Errorf("Unexpected alignment: %s", got)
+	}
+	if d := Distance(Chars("Lorcm ipssum"), Chars("Lorem ipsum")); d != 2 {
+		t.Errorf("Expected distance 2, got %d", d)
+	}
+}
+
+func TestAlignLong(t *testing.T) {
+	// long enough that the alignment is split rather than done
+	// with a single table
+	var ocr, gt []string
+	for i := 0; i < 3000; i++ {
+		c := string(rune('a' + i*7%26))
+		gt = append(gt, c)
+		switch i % 97 {
+		case 0:
+			ocr = append(ocr, "x")
+		case 1:
+		case 2:
+			ocr = append(ocr, c, "y")
+		default:
+			ocr = append(ocr, c)
+		}
+	}
+
+	edits := Align(ocr, gt)
+	var errs int
+	var gotocr, gotgt []string
+	for _, e := range edits {
+		if e.Op != Match {
+			errs++
+		}
+		if e.Op != Delete {
+			gotocr = append(gotocr, e.OCR)
+		}
+		if e.Op != Insert {
+			gotgt = append(gotgt, e.GT)
+		}
+	}
+	if d := Distance(ocr, gt); errs != d {
+		t.Errorf("Alignment has %d edits, but the distance is %d", errs, d)
+	}
+	if strings.Join(gotocr, "") != strings.Join(ocr, "") || strings.Join(gotgt, "") != strings.Join(gt, "") {
+		t.Errorf("Alignment doesn't cover both sequences")
+	}
+}
+
+func TestConfusions(t *testing.T) {
+	table := NewConfusionTable(2)
+	table.Add("fic iftud", "ſic iſtud", "l1", Options{})
+	table.Add("modo cft", "modo eſt", "l2", Options{})
+	table.Add("rnodo", "modo", "l3", Options{})
+	table.Add("fed", "ſed", "l4", Options{})
+	table.Add("quodxyzw", "quod", "l5", Options{})
+
+	top := table.Top(1)
+	if len(top) != 1 || top[0].Confusion != (Confusion{"f", "ſ"}) || top[0].Count != 4 ||
+		strings.Join(top[0].Examples, ",") != "l1,l2" {
+		t.Errorf("Unexpected top confusion: %+v", top)
+	}
+
+	counts := make(map[Confusion]int)
+	for _, c := range table.Top(0) {
+		counts[c.Confusion] = c.Count
+	}
+	for _, c := range []Confusion{{"c", "e"}, {"rn", "m"}} {
+		if counts[c] != 1 {
+			t.Errorf("Expected 1 of %+v, got %d", c, counts[c])
+		}
+	}
+	if op := (Confusion{"rn", "m"}).Op(); op != Substitute {
+		t.Errorf("Expected substitution, got %v", op)
+	}
+
+	// the long insertion is split into single characters
+	inserts := 0
+	for c := range counts {
+		if c.Op() == Insert {
+			inserts++
+		}
+	}
+	if len(counts) != 7 || inserts != 4 {
+		t.Errorf("Unexpected confusions: %+v", counts)
+	}
+}
@@ -0,0 +1,148 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package hocr
+
+import (
+	"io"
+	"math"
+	"sort"
+)
+
+// LineConf is the confidence of the words in a line
+type LineConf struct {
+	Page  string // id of the page
+	Line  string // id of the line
+	Words int
+	Min   float64 // lowest confidence of any word
+}
+
+// ConfStats holds the word confidences of a document, from which
+// statistics such as the median can be calculated
+type ConfStats struct {
+	// Confs is the confidence of each word, in ascending order
+	Confs []float64
+	// Lines is the confidence of each line which has any words
+	Lines []LineConf
+}
+
+// ConfStats reads the confidence of each remaining word in the
+// document, based on its x_wconf value
+func (r *Reader) ConfStats() (ConfStats, error) {
+	var s ConfStats
+	for {
+		p, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return s, err
+		}
+		for _, l := range p.Lines() {
+			lc := LineConf{Page: p.Id, Line: l.Id}
+			for _, w := range l.Words {
+				c, err := wordConf(w.Title)
+				if err != nil {
+					err = r.skip(&PropertyError{p.Id, l.Id, w.Id, w.Title, err})
+					if err != nil {
+						return s, err
+					}
+					continue
+				}
+				if lc.Words == 0 || c < lc.Min {
+					lc.Min = c
+				}
+				lc.Words++
+				s.Confs = append(s.Confs, c)
+			}
+			if lc.Words > 0 {
+				s.Lines = append(s.Lines, lc)
+			}
+		}
+	}
+	sort.Float64s(s.Confs)
+	return s, nil
+}
+
+// Words returns the number of words
+func (s ConfStats) Words() int {
+	return len(s.Confs)
+}
+
+// Mean returns the mean confidence, or 0 if there are no words
+func (s ConfStats) Mean() float64 {
+	if len(s.Confs) == 0 {
+		return 0
+	}
+	var total float64
+	for _, c := range s.Confs {
+		total += c
+	}
+	return total / float64(len(s.Confs))
+}
+
+// Median returns the median confidence
+func (s ConfStats) Median() float64 {
+	return s.Percentile(50)
+}
+
+// StdDev returns the standard deviation of the confidences
+func (s ConfStats) StdDev() float64 {
+	if len(s.Confs) == 0 {
+		return 0
+	}
+	mean := s.Mean()
+	var total float64
+	for _, c := range s.Confs {
+		total += (c - mean) * (c - mean)
+	}
+	return math.Sqrt(total / float64(len(s.Confs)))
+}
+
+// Percentile returns the confidence below which p percent of the
+// words fall, interpolating between the nearest words
+func (s ConfStats) Percentile(p float64) float64 {
+	if len(s.Confs) == 0 {
+		return 0
+	}
+	rank := p / 100 * float64(len(s.Confs)-1)
+	if rank <= 0 {
+		return s.Confs[0]
+	}
+	if rank >= float64(len(s.Confs)-1) {
+		return s.Confs[len(s.Confs)-1]
+	}
+	lo := int(rank)
+	return s.Confs[lo] + (s.Confs[lo+1]-s.Confs[lo])*(rank-float64(lo))
+}
+
+// Below returns the fraction of words with a confidence below t
+func (s ConfStats) Below(t float64) float64 {
+	if len(s.Confs) == 0 {
+		return 0
+	}
+	n := sort.SearchFloat64s(s.Confs, t)
+	return float64(n) / float64(len(s.Confs))
+}
+
+// Histogram returns the number of words in each of n equal bins
+// of confidence from 0 to 100. Confidences of 100 are included in
+// the last bin.
+func (s ConfStats) Histogram(n int) []int {
+	if n < 1 {
+		return nil
+	}
+	bins := make([]int, n)
+	for _, c := range s.Confs {
+		i := int(c / 100 * float64(n))
+		if i < 0 {
+			i = 0
+		}
+		if i >= n {
+			i = n - 1
+		}
+		bins[i]++
+	}
+	return bins
+}
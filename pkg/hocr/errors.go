@@ -0,0 +1,50 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package hocr
+
+import (
+	"errors"
+	"strings"
+)
+
+// Errors for properties which are needed but missing
+var (
+	ErrNoWconf = errors.New("No x_wconf found")
+	ErrNoBbox  = errors.New("No bbox found")
+	ErrNoImage = errors.New("No image found")
+)
+
+// PropertyError is an error with the properties in the title of
+// a hOCR element, such as a missing x_wconf or bbox. It records
+// where in the document the problem is, and the title in question.
+type PropertyError struct {
+	Page  string // id of the page
+	Line  string // id of the line, if any
+	Word  string // id of the word, if any
+	Title string
+	Err   error
+}
+
+func (e *PropertyError) Error() string {
+	var where []string
+	if e.Page != "" {
+		where = append(where, "page "+e.Page)
+	}
+	if e.Line != "" {
+		where = append(where, "line "+e.Line)
+	}
+	if e.Word != "" {
+		where = append(where, "word "+e.Word)
+	}
+	s := e.Err.Error() + " in title '" + e.Title + "'"
+	if len(where) > 0 {
+		s = strings.Join(where, ", ") + ": " + s
+	}
+	return s
+}
+
+func (e *PropertyError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,101 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package hocr
+
+import (
+	"fmt"
+	"image"
+	"strings"
+)
+
+// Glyph is a single character, with its bounding box and
+// confidence, where they are known
+type Glyph struct {
+	Text string
+	Bbox image.Rectangle // empty if not known
+	Conf float64         // from 0 to 100, or -1 if not known
+}
+
+// Glyphs returns the glyphs of the word. If the word has character
+// elements, such as tesseract's ocrx_cinfo, each of them is a glyph,
+// with its box and confidence taken from its x_bboxes or bbox and
+// x_conf or x_confs properties. Otherwise each character of the
+// word's text is a glyph, with boxes and confidences taken from the
+// word's x_bboxes and x_confs properties, if present.
+func (w OcrWord) Glyphs() ([]Glyph, error) {
+	var glyphs []Glyph
+
+	if len(w.Chars) > 0 {
+		for _, c := range w.Chars {
+			p, err := c.Properties()
+			if err != nil {
+				return glyphs, err
+			}
+			g := Glyph{Text: c.Text, Conf: -1}
+			switch {
+			case len(p.Bboxes) > 0:
+				g.Bbox = p.Bboxes[0]
+			case p.Has("bbox"):
+				g.Bbox = p.Bbox
+			}
+			switch {
+			case p.Has("x_conf"):
+				g.Conf = p.Conf
+			case len(p.Confs) > 0:
+				g.Conf = p.Confs[0]
+			}
+			glyphs = append(glyphs, g)
+		}
+		return glyphs, nil
+	}
+
+	p, err := w.Properties()
+	if err != nil {
+		return glyphs, err
+	}
+	chars := []rune(strings.TrimSpace(w.Text))
+	if len(p.Confs) > 0 && len(p.Confs) != len(chars) {
+		return glyphs, fmt.Errorf("x_confs has %d values for %d characters", len(p.Confs), len(chars))
+	}
+	if len(p.Bboxes) > 0 && len(p.Bboxes) != len(chars) {
+		return glyphs, fmt.Errorf("x_bboxes has %d boxes for %d characters", len(p.Bboxes), len(chars))
+	}
+	for i, c := range chars {
+		g := Glyph{Text: string(c), Conf: -1}
+		if len(p.Confs) > 0 {
+			g.Conf = p.Confs[i]
+		}
+		if len(p.Bboxes) > 0 {
+			g.Bbox = p.Bboxes[i]
+		}
+		glyphs = append(glyphs, g)
+	}
+	return glyphs, nil
+}
+
+// TextGlyphs returns a glyph for each character of the text of a
+// word, with no box or confidence. This can be used in place of
+// Glyphs when a word's glyph information is malformed, as it is
+// optional.
+func TextGlyphs(w OcrWord) []Glyph {
+	var glyphs []Glyph
+	for _, c := range strings.TrimSpace(WordText(w)) {
+		glyphs = append(glyphs, Glyph{Text: string(c), Conf: -1})
+	}
+	return glyphs
+}
+
+// Glyphs returns the glyphs of each word in the line, in order
+func (l OcrLine) Glyphs() ([]Glyph, error) {
+	var glyphs []Glyph
+	for _, w := range l.Words {
+		g, err := w.Glyphs()
+		if err != nil {
+			return glyphs, err
+		}
+		glyphs = append(glyphs, g...)
+	}
+	return glyphs, nil
+}
@@ -7,73 +7,208 @@
 package hocr
 
 import (
-	"encoding/xml"
+	"bytes"
 	"errors"
-	"io/ioutil"
-	"regexp"
-	"strconv"
+	"io"
+	"os"
 	"strings"
 )
 
+// Hocr is a hOCR document, made up of one or more pages
 type Hocr struct {
-	Pages []Page `xml:"body>div"`
+	Title string
+	Meta  []Meta
+	Pages []Page
+	doc   docMarkup
 }
 
+// Meta is a named metadata item from the head of a hOCR document,
+// such as ocr-system or ocr-capabilities
+type Meta struct {
+	Name    string
+	Content string
+}
+
+// Page is an ocr_page, containing the areas of content on it
 type Page struct {
-	Lines []OcrLine `xml:"div>p>span"`
-	Title string    `xml:"title,attr"`
+	Class string
+	Id    string
+	Lang  string
+	Title string
+	Areas []Area
+	m     markup
+}
+
+// Area is a block of content on a page, such as an ocr_carea,
+// ocrx_block or ocr_float. Any paragraphs or lines which are
+// not inside an area in the hOCR are put into an implicit area
+// with an empty Class.
+type Area struct {
+	Class      string
+	Id         string
+	Lang       string
+	Title      string
+	Paragraphs []Paragraph
+	m          markup
+}
+
+// Paragraph is an ocr_par. Any lines which are not inside a
+// paragraph in the hOCR are put into an implicit paragraph with
+// an empty Class.
+type Paragraph struct {
+	Class string
+	Id    string
+	Lang  string
+	Title string
+	Lines []OcrLine
+	m     markup
 }
 
+// OcrLine is a line of text, such as an ocr_line or ocrx_line,
+// or an ocr_header, ocr_caption or ocr_textfloat which contains
+// words directly, as tesseract produces
 type OcrLine struct {
-	Class string    `xml:"class,attr"`
-	Id    string    `xml:"id,attr"`
-	Title string    `xml:"title,attr"`
-	Words []OcrWord `xml:"span"`
-	Text  string    `xml:",chardata"`
+	Class string
+	Id    string
+	Lang  string
+	Title string
+	Words []OcrWord
+	Text  string
+	m     markup
 }
 
 type OcrWord struct {
-	Class string    `xml:"class,attr"`
-	Id    string    `xml:"id,attr"`
-	Title string    `xml:"title,attr"`
-	Chars []OcrChar `xml:"span"`
-	Text  string    `xml:",chardata"`
+	Class string
+	Id    string
+	Lang  string
+	Title string
+	Chars []OcrChar
+	Text  string
+	m     markup
 }
 
 type OcrChar struct {
-	Class string    `xml:"class,attr"`
-	Id    string    `xml:"id,attr"`
-	Title string    `xml:"title,attr"`
-	Chars []OcrChar `xml:"span"`
-	Text  string    `xml:",chardata"`
+	Class string
+	Id    string
+	Lang  string
+	Title string
+	Chars []OcrChar
+	Text  string
+	m     markup
+}
+
+// Paragraphs returns all paragraphs in the document, in order
+func (h *Hocr) Paragraphs() []*Paragraph {
+	var pars []*Paragraph
+	for i := range h.Pages {
+		pars = append(pars, h.Pages[i].Paragraphs()...)
+	}
+	return pars
+}
+
+// Lines returns all lines in the document, in order
+func (h *Hocr) Lines() []*OcrLine {
+	var lines []*OcrLine
+	for i := range h.Pages {
+		lines = append(lines, h.Pages[i].Lines()...)
+	}
+	return lines
+}
+
+// Words returns all words in the document, in order
+func (h *Hocr) Words() []*OcrWord {
+	var words []*OcrWord
+	for i := range h.Pages {
+		words = append(words, h.Pages[i].Words()...)
+	}
+	return words
+}
+
+// Paragraphs returns all paragraphs on the page, in order
+func (p *Page) Paragraphs() []*Paragraph {
+	var pars []*Paragraph
+	for i := range p.Areas {
+		for j := range p.Areas[i].Paragraphs {
+			pars = append(pars, &p.Areas[i].Paragraphs[j])
+		}
+	}
+	return pars
+}
+
+// Lines returns all lines on the page, in order
+func (p *Page) Lines() []*OcrLine {
+	var lines []*OcrLine
+	for i := range p.Areas {
+		lines = append(lines, p.Areas[i].Lines()...)
+	}
+	return lines
+}
+
+// Words returns all words on the page, in order
+func (p *Page) Words() []*OcrWord {
+	var words []*OcrWord
+	for i := range p.Areas {
+		words = append(words, p.Areas[i].Words()...)
+	}
+	return words
+}
+
+// Lines returns all lines in the area, in order
+func (a *Area) Lines() []*OcrLine {
+	var lines []*OcrLine
+	for i := range a.Paragraphs {
+		for j := range a.Paragraphs[i].Lines {
+			lines = append(lines, &a.Paragraphs[i].Lines[j])
+		}
+	}
+	return lines
+}
+
+// Words returns all words in the area, in order
+func (a *Area) Words() []*OcrWord {
+	var words []*OcrWord
+	for i := range a.Paragraphs {
+		words = append(words, a.Paragraphs[i].Words()...)
+	}
+	return words
+}
+
+// Words returns all words in the paragraph, in order
+func (p *Paragraph) Words() []*OcrWord {
+	var words []*OcrWord
+	for i := range p.Lines {
+		for j := range p.Lines[i].Words {
+			words = append(words, &p.Lines[i].Words[j])
+		}
+	}
+	return words
 }
 
 // Returns the confidence for a word based on its x_wconf value
 func wordConf(s string) (float64, error) {
-	re, err := regexp.Compile(`x_wconf ([0-9.]+)`)
+	// other properties being malformed doesn't matter
+	p, err := ParseProperties(s)
+	if p.Has("x_wconf") {
+		return p.Wconf, nil
+	}
 	if err != nil {
 		return 0.0, err
 	}
-	conf := re.FindStringSubmatch(s)
-	return strconv.ParseFloat(conf[1], 64)
+	return 0.0, ErrNoWconf
 }
 
 // BoxCoords parses bbox coordinate strings
 func BoxCoords(s string) ([4]int, error) {
 	var coords [4]int
-	re, err := regexp.Compile(`bbox ([0-9]+) ([0-9]+) ([0-9]+) ([0-9]+)`)
-	if err != nil {
-		return coords, err
-	}
-	coordstr := re.FindStringSubmatch(s)
-	for i := range coords {
-		c, err := strconv.Atoi(coordstr[i+1])
+	p, err := ParseProperties(s)
+	if !p.Has("bbox") {
 		if err != nil {
 			return coords, err
 		}
-		coords[i] = c
+		return coords, ErrNoBbox
 	}
-	return coords, nil
+	b := p.Bbox
+	return [4]int{b.Min.X, b.Min.Y, b.Max.X, b.Max.Y}, nil
 }
 
 func noText(s string) bool {
@@ -84,96 +219,108 @@ func noText(s string) bool {
 // Parse parses a hOCR file
 func Parse(b []byte) (Hocr, error) {
 	var hocr Hocr
+	var pages []Page
 
-	err := xml.Unmarshal(b, &hocr)
-	if err != nil {
-		return hocr, err
+	r := NewReader(bytes.NewReader(b))
+	for {
+		p, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return hocr, err
+		}
+		pages = append(pages, p)
+	}
+	if r.root == nil {
+		return hocr, errors.New("No hOCR document found")
 	}
 
+	hocr = r.document()
+	hocr.Pages = pages
+
 	return hocr, nil
 }
 
-// GetText parses a hOCR file and extracts the text from it
-func GetText(hocrfn string) (string, error) {
-	var s string
+// MetaContent returns the content of the named metadata item, or
+// an empty string if it isn't present
+func (h *Hocr) MetaContent(name string) string {
+	for _, m := range h.Meta {
+		if m.Name == name {
+			return m.Content
+		}
+	}
+	return ""
+}
 
-	file, err := ioutil.ReadFile(hocrfn)
-	if err != nil {
-		return s, err
+// SetMeta sets the content of the named metadata item, adding it
+// if it isn't already present
+func (h *Hocr) SetMeta(name string, content string) {
+	for i, m := range h.Meta {
+		if m.Name == name {
+			h.Meta[i].Content = content
+			return
+		}
 	}
+	h.Meta = append(h.Meta, Meta{name, content})
+}
+
+// ReadText parses hOCR from r and extracts the text from it
+func ReadText(r io.Reader) (string, error) {
+	var s strings.Builder
+	err := NewReader(r).WriteText(&s)
+	return s.String(), err
+}
+
+// ReadAvgConf parses hOCR from r and calculates its average
+// confidence from confidences embedded in each word
+func ReadAvgConf(r io.Reader) (float64, error) {
+	return NewReader(r).AvgConf()
+}
+
+// ReadWordConfs parses hOCR from r and returns an array
+// containing the confidences of each word therein
+func ReadWordConfs(r io.Reader) ([]float64, error) {
+	var confs []float64
+	err := NewReader(r).WordConfs(func(c float64) error {
+		confs = append(confs, c)
+		return nil
+	})
+	return confs, err
+}
 
-	h, err := Parse(file)
+// GetText parses a hOCR file and extracts the text from it
+func GetText(hocrfn string) (string, error) {
+	f, err := os.Open(hocrfn)
 	if err != nil {
-		return s, err
+		return "", err
 	}
+	defer f.Close()
 
-	for _, p := range h.Pages {
-		for _, l := range p.Lines {
-			s += LineText(l) + "\n"
-		}
-	}
-	return s, nil
+	return ReadText(f)
 }
 
 // GetAvgConf calculates the average confidence of a hOCR file from
 // confidences embedded in each word
 func GetAvgConf(hocrfn string) (float64, error) {
-	file, err := ioutil.ReadFile(hocrfn)
-	if err != nil {
-		return 0, err
-	}
-
-	h, err := Parse(file)
+	f, err := os.Open(hocrfn)
 	if err != nil {
 		return 0, err
 	}
+	defer f.Close()
 
-	var total, num float64
-	for _, p := range h.Pages {
-		for _, l := range p.Lines {
-			for _, w := range l.Words {
-				c, err := wordConf(w.Title)
-				if err != nil {
-					return 0, err
-				}
-				total += c
-				num++
-			}
-		}
-	}
-	if num == 0 {
-		return 0, errors.New("No words found")
-	}
-	return total / num, nil
+	return ReadAvgConf(f)
 }
 
 // GetWordConfs is a utility function that parses a hocr
 // file and returns an array containing the confidences
 // of each word therein
 func GetWordConfs(hocrfn string) ([]float64, error) {
-	var confs []float64
-
-	file, err := ioutil.ReadFile(hocrfn)
+	f, err := os.Open(hocrfn)
 	if err != nil {
-		return confs, err
-	}
-
-	h, err := Parse(file)
-	if err != nil {
-		return confs, err
-	}
-
-	for _, p := range h.Pages {
-		for _, l := range p.Lines {
-			for _, w := range l.Words {
-				c, err := wordConf(w.Title)
-				if err != nil {
-					return confs, err
-				}
-				confs = append(confs, c)
-			}
-		}
+		return []float64{}, err
 	}
+	defer f.Close()
 
-	return confs, nil
+	return ReadWordConfs(f)
 }
@@ -0,0 +1,751 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package hocr
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"rescribe.xyz/utils/pkg/line"
+)
+
+const tessHocr = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html PUBLIC "-//W3C//DTD XHTML 1.0 Transitional//EN"
+    "http://www.w3.org/TR/xhtml1/DTD/xhtml1-transitional.dtd">
+<html xmlns="http://www.w3.org/1999/xhtml" xml:lang="en" lang="en">
+ <head>
+  <title></title>
+  <meta http-equiv="Content-Type" content="text/html;charset=utf-8"/>
+  <meta name='ocr-system' content='tesseract 4.1.1' />
+  <meta name='ocr-capabilities' content='ocr_page ocr_carea ocr_par ocr_line ocrx_word ocrp_wconf'/>
+ </head>
+ <body>
+  <div class='ocr_page' id='page_1' title='image "test.png"; bbox 0 0 600 800; ppageno 0'>
+   <div class='ocr_carea' id='block_1_1' title="bbox 10 10 590 100">
+    <p class='ocr_par' id='par_1_1' lang='lat' title="bbox 10 10 590 100">
+     <span class='ocr_header' id='line_1_1' title="bbox 10 10 590 40; baseline 0 -5; x_size 30; x_descenders 5; x_ascenders 8">
+      <span class='ocrx_word' id='word_1_1' title='bbox 10 10 200 40; x_wconf 91'>TITVLVS</span>
+     </span>
+     <span class='ocr_line' id='line_1_2' title="bbox 10 50 590 100; baseline 0 -5; x_size 30; x_descenders 5; x_ascenders 8">
+      <span class='ocrx_word' id='word_1_2' title='bbox 10 50 200 100; x_wconf 85'>Lorem</span>
+      <span class='ocrx_word' id='word_1_3' title='bbox 210 50 590 100; x_wconf 73'><em>ipſum</em></span>
+     </span>
+    </p>
+   </div>
+   <div class='ocr_photo' id='block_1_2' title="bbox 10 120 590 700"></div>
+  </div>
+ </body>
+</html>
+`
+
+const ocropusHocr = `<!DOCTYPE html PUBLIC "-//W3C//DTD HTML 4.01 Transitional//EN">
+<html>
+<head>
+<meta name="ocr-capabilities" content="ocr_line ocr_page" />
+<meta http-equiv="Content-Type" content="text/html; charset=utf-8" />
+</head>
+<body>
+<div class='ocr_page' title='file test.png'>
+<span class='ocr_line' title='bbox 12 20 400 50'>dolor sit&nbsp;amet</span><br />
+<span class='ocr_line' title='bbox 12 60 400 90'>consectetur</span><br />
+</div>
+</body>
+</html>
+`
+
+const inlineHocr = `<html><body>
+<div class='ocr_page' title='bbox 0 0 600 800'>
+<span class='ocr_line' title='bbox 12 20 400 50'>ab <em>cd</em> ef<!-- note --></span>
+<span class='ocr_line' title='bbox 12 60 400 90'>x<sup>2</sup> <strong>y</strong></span>
+</div>
+</body></html>
+`
+
+const abbyyHocr = `<html><body>
+<div class="ocr_page" id="page_1" title="bbox 0 0 600 800">
+ <div class="ocr_carea" id="block_1">
+  <span class="ocrx_line" id="line_1" title="bbox 0 0 10 10">
+   <span class="ocrx_word" id="word_1" title="x_wconf 90">una</span>
+  </span>
+  <div class="ocr_textfloat" id="float_1">
+   <p class="ocr_par" id="par_2">
+    <span class="ocr_line" id="line_2"><span class="ocrx_word" id="word_2" title="x_wconf 40">nota</span></span>
+   </p>
+  </div>
+  <p class="ocr_caption" id="caption_1"><span class="ocrx_word" id="word_3">Fig</span></p>
+ </div>
+</div>
+</body></html>
+`
+
+func TestParse(t *testing.T) {
+	cases := []struct {
+		name  string
+		hocr  string
+		areas []string
+		pars  int
+		lines []string
+		words int
+	}{
+		{"tesseract", tessHocr, []string{"ocr_carea", "ocr_photo"}, 1, []string{"TITVLVS", "Lorem ipſum"}, 3},
+		{"ocropus", ocropusHocr, []string{""}, 1, []string{"dolor sit\u00a0amet", "consectetur"}, 0},
+		{"abbyy", abbyyHocr, []string{"ocr_carea", "ocr_textfloat"}, 3, []string{"una", "Fig", "nota"}, 3},
+		{"inline", inlineHocr, []string{""}, 1, []string{"ab cd ef", "x2 y"}, 0},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			h, err := Parse([]byte(c.hocr))
+			if err != nil {
+				t.Fatalf("Error parsing: %v", err)
+			}
+			if len(h.Pages) != 1 {
+				t.Fatalf("Expected 1 page, got %d", len(h.Pages))
+			}
+			areas := h.Pages[0].Areas
+			if len(areas) != len(c.areas) {
+				t.Fatalf("Expected %d areas, got %d", len(c.areas), len(areas))
+			}
+			for i, a := range areas {
+				if a.Class != c.areas[i] {
+					t.Errorf("Area %d: expected class '%s', got '%s'", i, c.areas[i], a.Class)
+				}
+			}
+			if len(h.Paragraphs()) != c.pars {
+				t.Errorf("Expected %d paragraphs, got %d", c.pars, len(h.Paragraphs()))
+			}
+			lines := h.Lines()
+			if len(lines) != len(c.lines) {
+				t.Fatalf("Expected %d lines, got %d", len(c.lines), len(lines))
+			}
+			for i, l := range lines {
+				if txt := LineText(*l); txt != c.lines[i] {
+					t.Errorf("Line %d: expected '%s', got '%s'", i, c.lines[i], txt)
+				}
+			}
+			if len(h.Words()) != c.words {
+				t.Errorf("Expected %d words, got %d", c.words, len(h.Words()))
+			}
+		})
+	}
+}
+
+func TestWrite(t *testing.T) {
+	cases := []struct {
+		name     string
+		hocr     string
+		contains []string
+	}{
+		{"tesseract", tessHocr, []string{
+			`<meta name="ocr-system" content="tesseract 4.1.1" />`,
+			`<meta http-equiv="Content-Type" content="text/html;charset=utf-8" />`,
+			`<html xmlns="http://www.w3.org/1999/xhtml" xml:lang="en" lang="en">`,
+			`title="image &quot;test.png&quot;; bbox 0 0 600 800; ppageno 0"`,
+			`<em>ipſum</em>`,
+			`<div class="ocr_photo" id="block_1_2" title="bbox 10 120 590 700"></div>`,
+		}},
+		{"ocropus", ocropusHocr, []string{
+			`<span class="ocr_line" title="bbox 12 20 400 50">dolor sit` + "\u00a0" + `amet</span>`,
+			`<br />`,
+		}},
+		{"inline", inlineHocr, []string{
+			`<span class="ocr_line" title="bbox 12 20 400 50">ab <em>cd</em> ef<!-- note --></span>`,
+			`<span class="ocr_line" title="bbox 12 60 400 90">x<sup>2</sup> <strong>y</strong></span>`,
+		}},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			h, err := Parse([]byte(c.hocr))
+			if err != nil {
+				t.Fatalf("Error parsing: %v", err)
+			}
+			var first strings.Builder
+			err = Write(&first, h)
+			if err != nil {
+				t.Fatalf("Error writing: %v", err)
+			}
+			for _, s := range c.contains {
+				if !strings.Contains(first.String(), s) {
+					t.Errorf("Expected output to contain '%s'", s)
+				}
+			}
+
+			h, err = Parse([]byte(first.String()))
+			if err != nil {
+				t.Fatalf("Error parsing written hOCR: %v", err)
+			}
+			var second strings.Builder
+			err = Write(&second, h)
+			if err != nil {
+				t.Fatalf("Error writing: %v", err)
+			}
+			if first.String() != second.String() {
+				t.Errorf("Output differs after round trip.\nFirst:\n%s\nSecond:\n%s", first.String(), second.String())
+			}
+		})
+	}
+}
+
+const bookHocr = `<?xml version="1.0" encoding="UTF-8"?>
+<html xmlns="http://www.w3.org/1999/xhtml">
+ <head>
+  <title>A Book</title>
+  <meta name='ocr-system' content='tesseract 4.1.1' />
+ </head>
+ <body>
+  <div class='ocr_page' id='page_1' title='image "p1.png"; bbox 0 0 600 800; ppageno 0'>
+   <span class='ocr_line' id='line_1_1' title="bbox 10 10 590 40">
+    <span class='ocrx_word' id='word_1_1' title='bbox 10 10 200 40; x_wconf 90'>Prima</span>
+   </span>
+  </div>
+  <!-- page two follows -->
+  <div class='ocr_page' id='page_2' title='image "p2.png"; bbox 0 0 600 800; ppageno 1'>
+   <span class='ocr_line' id='line_2_1' title="bbox 10 10 590 40">
+    <span class='ocrx_word' id='word_2_1' title='bbox 10 10 200 40; x_wconf 70'>Secunda</span>
+    <span class='ocrx_word' id='word_2_2' title='bbox 210 10 400 40; x_wconf 80'>pagina</span>
+   </span>
+  </div>
+ </body>
+</html>
+`
+
+func TestReader(t *testing.T) {
+	r := NewReader(strings.NewReader(bookHocr))
+	var ids []string
+	for {
+		p, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("Error reading page: %v", err)
+		}
+		ids = append(ids, p.Id)
+		if len(ids) == 1 {
+			doc := r.Document()
+			if doc.Title != "A Book" || doc.MetaContent("ocr-system") != "tesseract 4.1.1" {
+				t.Errorf("Unexpected document metadata: %+v", doc)
+			}
+		}
+	}
+	if strings.Join(ids, " ") != "page_1 page_2" {
+		t.Errorf("Unexpected pages read: %v", ids)
+	}
+
+	avg, err := NewReader(strings.NewReader(bookHocr)).AvgConf()
+	if err != nil {
+		t.Fatalf("Error getting average confidence: %v", err)
+	}
+	if avg != 80 {
+		t.Errorf("Expected average confidence of 80, got %f", avg)
+	}
+
+	var txt strings.Builder
+	err = NewReader(strings.NewReader(bookHocr)).WriteText(&txt)
+	if err != nil {
+		t.Fatalf("Error getting text: %v", err)
+	}
+	if txt.String() != "Prima\nSecunda pagina\n" {
+		t.Errorf("Unexpected text: '%s'", txt.String())
+	}
+
+	h, err := Parse([]byte(bookHocr))
+	if err != nil {
+		t.Fatalf("Error parsing: %v", err)
+	}
+	var out strings.Builder
+	err = Write(&out, h)
+	if err != nil {
+		t.Fatalf("Error writing: %v", err)
+	}
+	if !strings.Contains(out.String(), "</div>\n  <!-- page two follows -->\n  <div class=\"ocr_page\" id=\"page_2\"") {
+		t.Errorf("Comment between pages not preserved:\n%s", out.String())
+	}
+}
+
+const badHocr = `<html><body>
+<div class='ocr_page' id='page_1' title='bbox 0 0 600 800'>
+ <span class='ocr_line' id='line_1' title='bbox 10 10 590 40'>
+  <span class='ocrx_word' id='word_1' title='bbox 10 10 200 40; x_wconf 60'>bona</span>
+  <span class='ocrx_word' id='word_2' title='bbox 210 10 400 40'>mala</span>
+ </span>
+ <span class='ocr_line' id='line_2' title='baseline 0 -5'>
+  <span class='ocrx_word' id='word_3' title='bbox 10 50 200 90; x_wconf 80'>sine</span>
+ </span>
+</div>
+</body></html>
+`
+
+func TestLenient(t *testing.T) {
+	_, err := NewReader(strings.NewReader(badHocr)).AvgConf()
+	var perr *PropertyError
+	if !errors.As(err, &perr) {
+		t.Fatalf("Expected a PropertyError, got %v", err)
+	}
+	if perr.Page != "page_1" || perr.Line != "line_1" || perr.Word != "word_2" || !errors.Is(err, ErrNoWconf) {
+		t.Errorf("Unexpected error details: %+v", perr)
+	}
+
+	var warnings []error
+	r := NewReader(strings.NewReader(badHocr))
+	r.Lenient = true
+	r.Warn = func(err error) { warnings = append(warnings, err) }
+	avg, err := r.AvgConf()
+	if err != nil {
+		t.Fatalf("Error getting average confidence: %v", err)
+	}
+	if avg != 70 {
+		t.Errorf("Expected average confidence of 70, got %f", avg)
+	}
+	if len(warnings) != 1 {
+		t.Errorf("Expected 1 warning, got %d: %v", len(warnings), warnings)
+	}
+
+	_, err = ReadLineDetailsCustomImg(strings.NewReader(badHocr), fstest.MapFS{}, "")
+	if !errors.Is(err, ErrNoWconf) {
+		t.Errorf("Expected missing x_wconf error, got %v", err)
+	}
+
+	warnings = nil
+	r = NewReader(strings.NewReader(badHocr))
+	r.Lenient = true
+	r.Warn = func(err error) { warnings = append(warnings, err) }
+	var lines []line.Detail
+	err = r.LineDetails(fstest.MapFS{}, func(l line.Detail) error {
+		lines = append(lines, l)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error getting line details: %v", err)
+	}
+	if len(lines) != 2 {
+		t.Errorf("Expected 2 lines, got %d", len(lines))
+	}
+	// missing image, missing x_wconf and missing bbox
+	if len(warnings) != 3 {
+		t.Errorf("Expected 3 warnings, got %d: %v", len(warnings), warnings)
+	}
+
+	// lines without any confidences have a defined average
+	lines, err = ReadLineDetailsCustomImg(strings.NewReader(ocropusHocr), fstest.MapFS{}, "")
+	if err != nil {
+		t.Fatalf("Error getting line details without words: %v", err)
+	}
+	for _, l := range lines {
+		if l.Avgconf != -1 {
+			t.Errorf("Expected unknown confidence for line %s, got %f", l.Text, l.Avgconf)
+		}
+	}
+
+	fn := filepath.Join(t.TempDir(), "bad.hocr")
+	err = os.WriteFile(fn, []byte(badHocr), 0644)
+	if err != nil {
+		t.Fatalf("Error writing %s: %v", fn, err)
+	}
+	warnings = nil
+	lines, err = OpenLineDetails(fn, "", func(r *Reader) {
+		r.Lenient = true
+		r.Warn = func(err error) { warnings = append(warnings, err) }
+	})
+	if err != nil {
+		t.Fatalf("Error opening line details: %v", err)
+	}
+	if len(lines) != 2 || len(warnings) != 3 || !strings.HasPrefix(warnings[0].Error(), fn+": ") {
+		t.Errorf("Unexpected line details from file: %d lines, warnings %v", len(lines), warnings)
+	}
+}
+
+const glyphHocr = `<html><body>
+<div class='ocr_page' id='page_1' title='bbox 0 0 600 800'>
+ <span class='ocr_line' id='line_1' title='bbox 10 10 590 40'>
+  <span class='ocrx_word' id='word_1' title='bbox 10 10 60 40; x_wconf 90'>
+   <span class='ocrx_cinfo' title='x_bboxes 10 10 30 40; x_conf 99.5'>u</span>
+   <span class='ocrx_cinfo' title='x_bboxes 30 10 60 40; x_conf 41.2'>t</span>
+  </span>
+  <span class='ocrx_word' id='word_2' title='bbox 70 10 100 40; x_wconf 80; x_confs 95 62'>et</span>
+  <span class='ocrx_word' id='word_3' title='bbox 110 10 140 40; x_wconf 80'>a</span>
+ </span>
+</div>
+</body></html>
+`
+
+func TestGlyphs(t *testing.T) {
+	h, err := Parse([]byte(glyphHocr))
+	if err != nil {
+		t.Fatalf("Error parsing: %v", err)
+	}
+	glyphs, err := h.Lines()[0].Glyphs()
+	if err != nil {
+		t.Fatalf("Error getting glyphs: %v", err)
+	}
+	expected := []Glyph{
+		{"u", image.Rect(10, 10, 30, 40), 99.5},
+		{"t", image.Rect(30, 10, 60, 40), 41.2},
+		{"e", image.Rectangle{}, 95},
+		{"t", image.Rectangle{}, 62},
+		{"a", image.Rectangle{}, -1},
+	}
+	if len(glyphs) != len(expected) {
+		t.Fatalf("Expected %d glyphs, got %d: %v", len(expected), len(glyphs), glyphs)
+	}
+	for i, g := range glyphs {
+		if g != expected[i] {
+			t.Errorf("Glyph %d: expected %+v, got %+v", i, expected[i], g)
+		}
+	}
+
+	// bad glyph information is only a warning, even when strict
+	bad := strings.Replace(glyphHocr, "x_confs 95 62", "x_confs 95", 1)
+	var warnings []error
+	r := NewReader(strings.NewReader(bad))
+	r.Warn = func(err error) { warnings = append(warnings, err) }
+	var lines line.Details
+	err = r.LineDetailsCustomImg(fstest.MapFS{}, "", func(l line.Detail) error {
+		lines = append(lines, l)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error getting line details with bad glyphs: %v", err)
+	}
+	if len(lines) != 1 || len(lines[0].Glyphs) != 5 || lines[0].Glyphs[2].Conf != -1 || len(warnings) != 1 {
+		t.Errorf("Unexpected line details with bad glyphs: %+v, warnings %v", lines, warnings)
+	}
+}
+
+func TestIndex(t *testing.T) {
+	h, err := Parse([]byte(tessHocr))
+	if err != nil {
+		t.Fatalf("Error parsing: %v", err)
+	}
+	x, err := NewIndex(&h.Pages[0])
+	if err != nil {
+		t.Fatalf("Error creating index: %v", err)
+	}
+
+	w, ok := x.WordAt(image.Pt(300, 70))
+	if !ok || w.Word.Id != "word_1_3" || w.Line.Id != "line_1_2" || w.Bbox != image.Rect(210, 50, 590, 100) {
+		t.Errorf("Unexpected word at point: %+v", w)
+	}
+	if _, ok = x.WordAt(image.Pt(205, 70)); ok {
+		t.Errorf("Unexpected word found between words")
+	}
+
+	lines := x.LinesIn(image.Rect(0, 30, 100, 60))
+	if len(lines) != 2 || lines[0].Line.Id != "line_1_1" || lines[1].Line.Id != "line_1_2" {
+		t.Errorf("Unexpected lines in rectangle: %+v", lines)
+	}
+	pars := x.ParagraphsIn(image.Rect(500, 90, 600, 200))
+	if len(pars) != 1 || pars[0].Paragraph.Id != "par_1_1" {
+		t.Errorf("Unexpected paragraphs in rectangle: %+v", pars)
+	}
+	if pars = x.ParagraphsIn(image.Rect(0, 300, 600, 800)); len(pars) != 0 {
+		t.Errorf("Unexpected paragraphs below text: %+v", pars)
+	}
+
+	for _, c := range []struct {
+		pt image.Point
+		id string
+	}{
+		{image.Pt(100, 20), "line_1_1"},
+		{image.Pt(300, 44), "line_1_1"},
+		{image.Pt(300, 47), "line_1_2"},
+		{image.Pt(900, 700), "line_1_2"},
+		{image.Pt(-200, -300), "line_1_1"},
+	} {
+		l, ok := x.NearestLine(c.pt)
+		if !ok || l.Line.Id != c.id {
+			t.Errorf("Expected nearest line to %v to be %s, got %+v", c.pt, c.id, l)
+		}
+	}
+
+	if txt := x.Text(image.Rect(0, 0, 300, 100)); txt != "TITVLVS\nLorem" {
+		t.Errorf("Unexpected text in region: %q", txt)
+	}
+	if txt := x.Text(image.Rect(0, 0, 600, 800)); txt != "TITVLVS\nLorem ipſum" {
+		t.Errorf("Unexpected text of page: %q", txt)
+	}
+
+	o, err := Parse([]byte(ocropusHocr))
+	if err != nil {
+		t.Fatalf("Error parsing: %v", err)
+	}
+	x, err = NewIndex(&o.Pages[0])
+	if err != nil {
+		t.Fatalf("Error creating index: %v", err)
+	}
+	if l, ok := x.NearestLine(image.Pt(200, 95)); !ok || LineText(*l.Line) != "consectetur" {
+		t.Errorf("Unexpected nearest line: %+v", l)
+	}
+	if txt := x.Text(image.Rect(0, 0, 600, 800)); txt != "dolor sit\u00a0amet\nconsectetur" {
+		t.Errorf("Unexpected text of page without words: %q", txt)
+	}
+	if txt := x.Text(image.Rect(0, 40, 600, 100)); txt != "consectetur" {
+		t.Errorf("Unexpected text of line without words: %q", txt)
+	}
+}
+
+func TestMergeSplit(t *testing.T) {
+	var docs []Hocr
+	for _, s := range []string{tessHocr, tessHocr, ocropusHocr} {
+		h, err := Parse([]byte(s))
+		if err != nil {
+			t.Fatalf("Error parsing: %v", err)
+		}
+		docs = append(docs, h)
+	}
+
+	m := Merge(docs)
+	if len(m.Pages) != 3 {
+		t.Fatalf("Expected 3 pages, got %d", len(m.Pages))
+	}
+	if m.Pages[1].Id != "page_2" || m.Pages[1].Title != `image "test.png"; bbox 0 0 600 800; ppageno 1; x_frame 0` {
+		t.Errorf("Unexpected second page: %s %s", m.Pages[1].Id, m.Pages[1].Title)
+	}
+	if m.Pages[0].Title != docs[0].Pages[0].Title {
+		t.Errorf("Unexpected first page title: %s", m.Pages[0].Title)
+	}
+	if m.Pages[2].Title != "file test.png; ppageno 2; x_frame 0" {
+		t.Errorf("Unexpected third page title: %s", m.Pages[2].Title)
+	}
+	if w := m.Pages[1].Words()[2]; w.Id != "word_2_3" || w.Title != "bbox 210 50 590 100; x_wconf 73" {
+		t.Errorf("Unexpected word on second page: %s %s", w.Id, w.Title)
+	}
+	if docs[1].Pages[0].Id != "page_1" || docs[1].Words()[2].Id != "word_1_3" {
+		t.Errorf("Merge changed the original documents")
+	}
+	if m.MetaContent("ocr-system") != "tesseract 4.1.1" {
+		t.Errorf("Metadata not preserved: %+v", m.Meta)
+	}
+
+	var b strings.Builder
+	err := Write(&b, m)
+	if err != nil {
+		t.Fatalf("Error writing: %v", err)
+	}
+	merged, err := Parse([]byte(b.String()))
+	if err != nil {
+		t.Fatalf("Error parsing merged document: %v", err)
+	}
+
+	split := Split(merged)
+	if len(split) != 3 {
+		t.Fatalf("Expected 3 documents, got %d", len(split))
+	}
+	titles := []string{docs[0].Pages[0].Title, docs[1].Pages[0].Title, "file test.png; ppageno 0"}
+	for i, d := range split {
+		if len(d.Pages) != 1 || d.Pages[0].Id != m.Pages[i].Id || d.Pages[0].Title != titles[i] {
+			t.Errorf("Unexpected page %d: %+v", i, d.Pages)
+		}
+		if d.MetaContent("ocr-system") != "tesseract 4.1.1" {
+			t.Errorf("Metadata not preserved in page %d: %+v", i, d.Meta)
+		}
+	}
+	if LineText(*split[2].Lines()[1]) != "consectetur" {
+		t.Errorf("Unexpected text in last page: %v", split[2].Lines())
+	}
+}
+
+const columnsHocr = `<html><body>
+<div class='ocr_page' id='page_1' title='bbox 0 0 1000 400'>
+<p class='ocr_par' id='par_1_1'>
+<span class='ocr_line' id='line_1_1' title='bbox 0 0 180 40'><span class='ocrx_word' title='bbox 0 0 100 40'>Lorem</span> <span class='ocrx_word' title='bbox 120 0 180 40'>ip-</span></span>
+<span class='ocr_line' id='line_1_2' title='bbox 0 40 180 80'><span class='ocrx_word' title='bbox 0 40 60 80'>ſum</span> <span class='ocrx_word' title='bbox 80 40 180 80'>dolor</span></span>
+</p>
+<p class='ocr_par' id='par_1_2'>
+<span class='ocr_line' id='line_1_3' title='bbox 0 120 160 160'><span class='ocrx_word' title='bbox 0 120 60 160'>sit</span> <span class='ocrx_word' title='bbox 80 120 160 160'>amet</span></span>
+</p>
+<p class='ocr_par' id='par_1_3'>
+<span class='ocr_line' id='line_1_4' title='bbox 500 0 620 40'><span class='ocrx_word' title='bbox 500 0 620 40'>conſe¬</span></span>
+<span class='ocr_line' id='line_1_5' title='bbox 500 40 620 80'><span class='ocrx_word' title='bbox 500 40 620 80'>ctetur</span></span>
+</p>
+</div>
+</body></html>`
+
+func TestPageText(t *testing.T) {
+	h, err := Parse([]byte(columnsHocr))
+	if err != nil {
+		t.Fatalf("Error parsing: %v", err)
+	}
+	cases := []struct {
+		name     string
+		opts     TextOptions
+		expected string
+	}{
+		{"lines", TextOptions{}, "Lorem ip-\nſum dolor\nsit amet\nconſe¬\nctetur\n"},
+		{"paragraphs", TextOptions{Mode: TextParagraphs}, "Lorem ip-\nſum dolor\n\nsit amet\n\nconſe¬\nctetur\n\n"},
+		{"dehyphenated", TextOptions{Mode: TextParagraphs, Dehyphenate: true}, "Lorem ipſum\ndolor\n\nsit amet\n\nconſectetur\n\n"},
+		{"layout", TextOptions{Mode: TextLayout},
+			"Lorem ip-                conſe¬\n" +
+				"ſum dolor                ctetur\n" +
+				"\n" +
+				"sit amet\n"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := PageText(h.Pages[0], c.opts)
+			if actual != c.expected {
+				t.Errorf("Expected %q, got %q", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestJSON(t *testing.T) {
+	h, err := Parse([]byte(tessHocr))
+	if err != nil {
+		t.Fatalf("Error parsing: %v", err)
+	}
+	b, err := json.Marshal(h)
+	if err != nil {
+		t.Fatalf("Error marshalling: %v", err)
+	}
+	for _, s := range []string{
+		`"version":1`,
+		`{"name":"ocr-system","content":"tesseract 4.1.1"}`,
+		`"class":"ocr_par","id":"par_1_1","lang":"lat"`,
+		`"bbox":[10,50,590,100],"baseline":{"slope":0,"offset":-5}`,
+		`"bbox":[210,50,590,100],"conf":73,"text":"ipſum"`,
+	} {
+		if !strings.Contains(string(b), s) {
+			t.Errorf("Expected JSON to contain '%s':\n%s", s, b)
+		}
+	}
+	if strings.Contains(string(b), `"text":"\n`) {
+		t.Errorf("Expected no whitespace text for lines with words:\n%s", b)
+	}
+
+	// words with chars have only whitespace between them too
+	cw := OcrWord{Class: "ocrx_word", Text: "\n ", Chars: []OcrChar{
+		{Class: "ocrx_cinfo", Title: "x_bboxes 0 0 10 20; x_conf 90", Text: "a"},
+		{Class: "ocrx_cinfo", Title: "x_bboxes 10 0 15 20; x_conf 80", Text: " "},
+		{Class: "ocrx_cinfo", Text: "\n ", Chars: []OcrChar{{Class: "ocrx_cinfo", Text: "b"}}},
+	}}
+	cb, err := json.Marshal(cw)
+	if err != nil {
+		t.Fatalf("Error marshalling word with chars: %v", err)
+	}
+	if strings.Contains(string(cb), `"text":"\n`) || !strings.Contains(string(cb), `"text":" "`) {
+		t.Errorf("Unexpected text in word with chars:\n%s", cb)
+	}
+
+	var j Hocr
+	err = json.Unmarshal(b, &j)
+	if err != nil {
+		t.Fatalf("Error unmarshalling: %v", err)
+	}
+	if len(j.Pages) != 1 || j.Pages[0].Title != h.Pages[0].Title {
+		t.Fatalf("Pages differ after round trip: %+v", j.Pages)
+	}
+	words := j.Words()
+	for i, w := range h.Words() {
+		if words[i].Class != w.Class || words[i].Id != w.Id || words[i].Title != w.Title || words[i].Text != w.Text {
+			t.Errorf("Word %d differs after round trip: %+v", i, words[i])
+		}
+	}
+	if j.Pages[0].Areas[1].Class != "ocr_photo" || j.Lines()[0].Class != "ocr_header" {
+		t.Errorf("Unexpected classes after round trip: %+v", j.Pages[0].Areas)
+	}
+
+	edited := strings.Replace(string(b), `"bbox":[210,50,590,100],"conf":73`, `"bbox":[210,50,580,100],"conf":75`, 1)
+	err = json.Unmarshal([]byte(edited), &j)
+	if err != nil {
+		t.Fatalf("Error unmarshalling edited JSON: %v", err)
+	}
+	var out strings.Builder
+	err = Write(&out, j)
+	if err != nil {
+		t.Fatalf("Error writing: %v", err)
+	}
+	for _, s := range []string{
+		`<meta name="ocr-system" content="tesseract 4.1.1" />`,
+		`<span class="ocrx_word" id="word_1_3" title="bbox 210 50 580 100; x_wconf 75">ipſum</span>`,
+		`title="image &quot;test.png&quot;; bbox 0 0 600 800; ppageno 0"`,
+	} {
+		if !strings.Contains(out.String(), s) {
+			t.Errorf("Expected output to contain '%s':\n%s", s, out.String())
+		}
+	}
+
+	if err = json.Unmarshal([]byte(`{"version":2,"pages":[]}`), &j); err == nil {
+		t.Errorf("Expected an error for an unsupported version")
+	}
+}
+
+func TestConfStats(t *testing.T) {
+	s, err := NewReader(strings.NewReader(bookHocr)).ConfStats()
+	if err != nil {
+		t.Fatalf("Error getting confidence statistics: %v", err)
+	}
+	if s.Words() != 3 || s.Mean() != 80 || s.Median() != 80 || s.Percentile(25) != 75 || s.Percentile(100) != 90 {
+		t.Errorf("Unexpected statistics: %d words, mean %f, median %f, 25%% %f, 100%% %f",
+			s.Words(), s.Mean(), s.Median(), s.Percentile(25), s.Percentile(100))
+	}
+	if math.Abs(s.StdDev()-8.165) > 0.001 {
+		t.Errorf("Unexpected standard deviation: %f", s.StdDev())
+	}
+	if s.Below(80) != 1.0/3 || s.Below(95) != 1 {
+		t.Errorf("Unexpected fractions below threshold: %f %f", s.Below(80), s.Below(95))
+	}
+	if h := s.Histogram(10); fmt.Sprint(h) != "[0 0 0 0 0 0 0 1 1 1]" {
+		t.Errorf("Unexpected histogram: %v", h)
+	}
+	if len(s.Lines) != 2 || s.Lines[1] != (LineConf{"page_2", "line_2_1", 2, 70}) {
+		t.Errorf("Unexpected line confidences: %+v", s.Lines)
+	}
+
+	r := NewReader(strings.NewReader(badHocr))
+	r.Lenient = true
+	r.Warn = func(error) {}
+	s, err = r.ConfStats()
+	if err != nil {
+		t.Fatalf("Error getting lenient confidence statistics: %v", err)
+	}
+	if s.Words() != 2 || len(s.Lines) != 2 || s.Lines[0].Words != 1 {
+		t.Errorf("Unexpected lenient statistics: %+v", s)
+	}
+
+	var empty ConfStats
+	if empty.Mean() != 0 || empty.Median() != 0 || empty.StdDev() != 0 || empty.Below(50) != 0 {
+		t.Errorf("Expected zero statistics with no words")
+	}
+}
+
+func TestFiles(t *testing.T) {
+	dir := t.TempDir()
+	for _, fn := range []string{"10.hocr", "2.hocr", "page_002.hocr", "page_1.hocr", "notes.txt", "sub/1.hocr"} {
+		fn = filepath.Join(dir, fn)
+		err := os.MkdirAll(filepath.Dir(fn), 0755)
+		if err != nil {
+			t.Fatalf("Error creating directory for %s: %v", fn, err)
+		}
+		err = os.WriteFile(fn, []byte(tessHocr), 0644)
+		if err != nil {
+			t.Fatalf("Error writing %s: %v", fn, err)
+		}
+	}
+	files, err := Files(dir)
+	if err != nil {
+		t.Fatalf("Error listing files: %v", err)
+	}
+	want := []string{"2.hocr", "10.hocr", "page_1.hocr", "page_002.hocr"}
+	if len(files) != len(want) {
+		t.Fatalf("Expected files %v, got %v", want, files)
+	}
+	for i, fn := range want {
+		if files[i] != filepath.Join(dir, fn) {
+			t.Errorf("Expected file %d to be %s, got %s", i, fn, files[i])
+		}
+	}
+}
@@ -0,0 +1,340 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package hocr
+
+import (
+	"image"
+	"sort"
+	"strings"
+)
+
+// cellSize is the size in pixels of each square cell of the grid
+// used by Index. It is around the height of a line of text in a
+// typical scan, so most words are in only one or two cells.
+const cellSize = 64
+
+// WordBox is a word found by an Index, with its bounding box
+type WordBox struct {
+	Word *OcrWord
+	Line *OcrLine
+	Bbox image.Rectangle
+}
+
+// LineBox is a line found by an Index, with its bounding box
+type LineBox struct {
+	Line *OcrLine
+	Bbox image.Rectangle
+}
+
+// ParagraphBox is a paragraph found by an Index, with its bounding
+// box, which is the union of the boxes of its lines if it has no
+// bbox of its own
+type ParagraphBox struct {
+	Paragraph *Paragraph
+	Bbox      image.Rectangle
+}
+
+// Index is a spatial index of the words, lines and paragraphs of a
+// page, which can be queried by point and by rectangle. It refers
+// to the page it was created from, so the page shouldn't have
+// elements added or removed while the index is in use. Results are
+// always returned in document order. Elements without a bbox are
+// not indexed.
+type Index struct {
+	words []WordBox
+	lines []LineBox
+	pars  []ParagraphBox
+	// each grid maps a cell to the indexes of the elements which
+	// overlap it
+	wordGrid map[image.Point][]int
+	lineGrid map[image.Point][]int
+	parGrid  map[image.Point][]int
+	// order is the position of each line in the document
+	order map[*OcrLine]int
+}
+
+// NewIndex creates a spatial index for a page
+func NewIndex(p *Page) (*Index, error) {
+	x := &Index{
+		wordGrid: make(map[image.Point][]int),
+		lineGrid: make(map[image.Point][]int),
+		parGrid:  make(map[image.Point][]int),
+		order:    make(map[*OcrLine]int),
+	}
+	for _, par := range p.Paragraphs() {
+		props, err := par.Properties()
+		if err != nil {
+			return x, &PropertyError{Page: p.Id, Title: par.Title, Err: err}
+		}
+		parbox := props.Bbox
+		ownbox := !parbox.Empty()
+		for i := range par.Lines {
+			l := &par.Lines[i]
+			x.order[l] = len(x.order)
+			props, err := l.Properties()
+			if err != nil {
+				return x, &PropertyError{Page: p.Id, Line: l.Id, Title: l.Title, Err: err}
+			}
+			if !props.Bbox.Empty() {
+				addToGrid(x.lineGrid, props.Bbox, len(x.lines))
+				x.lines = append(x.lines, LineBox{l, props.Bbox})
+				if !ownbox {
+					parbox = parbox.Union(props.Bbox)
+				}
+			}
+			for j := range l.Words {
+				w := &l.Words[j]
+				wprops, err := w.Properties()
+				if err != nil {
+					return x, &PropertyError{p.Id, l.Id, w.Id, w.Title, err}
+				}
+				if wprops.Bbox.Empty() {
+					continue
+				}
+				addToGrid(x.wordGrid, wprops.Bbox, len(x.words))
+				x.words = append(x.words, WordBox{w, l, wprops.Bbox})
+			}
+		}
+		if !parbox.Empty() {
+			addToGrid(x.parGrid, parbox, len(x.pars))
+			x.pars = append(x.pars, ParagraphBox{par, parbox})
+		}
+	}
+	return x, nil
+}
+
+// cells returns the range of grid cells covered by a rectangle
+func cells(r image.Rectangle) image.Rectangle {
+	return image.Rect(floorDiv(r.Min.X, cellSize), floorDiv(r.Min.Y, cellSize),
+		floorDiv(r.Max.X-1, cellSize)+1, floorDiv(r.Max.Y-1, cellSize)+1)
+}
+
+// floorDiv divides, rounding towards negative infinity, so that
+// negative coordinates are put in the correct cell
+func floorDiv(a, b int) int {
+	if a < 0 {
+		return -((-a + b - 1) / b)
+	}
+	return a / b
+}
+
+func addToGrid(grid map[image.Point][]int, r image.Rectangle, i int) {
+	c := cells(r)
+	for y := c.Min.Y; y < c.Max.Y; y++ {
+		for x := c.Min.X; x < c.Max.X; x++ {
+			pt := image.Pt(x, y)
+			grid[pt] = append(grid[pt], i)
+		}
+	}
+}
+
+// query returns the indexes of the elements in a grid which may
+// overlap r, in order
+func query(grid map[image.Point][]int, r image.Rectangle) []int {
+	found := make(map[int]bool)
+	c := cells(r)
+	for y := c.Min.Y; y < c.Max.Y; y++ {
+		for x := c.Min.X; x < c.Max.X; x++ {
+			for _, i := range grid[image.Pt(x, y)] {
+				found[i] = true
+			}
+		}
+	}
+	var indexes []int
+	for i := range found {
+		indexes = append(indexes, i)
+	}
+	sort.Ints(indexes)
+	return indexes
+}
+
+// pointRect returns a rectangle covering just one pixel
+func pointRect(pt image.Point) image.Rectangle {
+	return image.Rectangle{pt, pt.Add(image.Pt(1, 1))}
+}
+
+// WordsIn returns the words whose boxes overlap r
+func (x *Index) WordsIn(r image.Rectangle) []WordBox {
+	var words []WordBox
+	for _, i := range query(x.wordGrid, r) {
+		if x.words[i].Bbox.Overlaps(r) {
+			words = append(words, x.words[i])
+		}
+	}
+	return words
+}
+
+// LinesIn returns the lines whose boxes overlap r
+func (x *Index) LinesIn(r image.Rectangle) []LineBox {
+	var lines []LineBox
+	for _, i := range query(x.lineGrid, r) {
+		if x.lines[i].Bbox.Overlaps(r) {
+			lines = append(lines, x.lines[i])
+		}
+	}
+	return lines
+}
+
+// ParagraphsIn returns the paragraphs whose boxes overlap r
+func (x *Index) ParagraphsIn(r image.Rectangle) []ParagraphBox {
+	var pars []ParagraphBox
+	for _, i := range query(x.parGrid, r) {
+		if x.pars[i].Bbox.Overlaps(r) {
+			pars = append(pars, x.pars[i])
+		}
+	}
+	return pars
+}
+
+// WordAt returns the word at a point, if there is one. If several
+// word boxes contain the point, the smallest is returned.
+func (x *Index) WordAt(pt image.Point) (WordBox, bool) {
+	words := x.WordsIn(pointRect(pt))
+	if len(words) == 0 {
+		return WordBox{}, false
+	}
+	best := words[0]
+	for _, w := range words[1:] {
+		if area(w.Bbox) < area(best.Bbox) {
+			best = w
+		}
+	}
+	return best, true
+}
+
+// LineAt returns the line at a point, if there is one. If several
+// line boxes contain the point, the smallest is returned.
+func (x *Index) LineAt(pt image.Point) (LineBox, bool) {
+	lines := x.LinesIn(pointRect(pt))
+	if len(lines) == 0 {
+		return LineBox{}, false
+	}
+	best := lines[0]
+	for _, l := range lines[1:] {
+		if area(l.Bbox) < area(best.Bbox) {
+			best = l
+		}
+	}
+	return best, true
+}
+
+// NearestLine returns the line whose box is nearest to a point,
+// which is the line at the point if there is one. It returns false
+// only if the page has no lines.
+func (x *Index) NearestLine(pt image.Point) (LineBox, bool) {
+	if l, ok := x.LineAt(pt); ok {
+		return l, true
+	}
+	if len(x.lines) == 0 {
+		return LineBox{}, false
+	}
+	// Search outwards from the point a ring of cells at a time. Any
+	// line not yet seen is in a cell at least n-1 cells away, so
+	// once that is further than the best line found, stop.
+	c := cells(pointRect(pt)).Min
+	best, bestd := -1, 0
+	maxring := x.maxRing(c)
+	for n := 0; n <= maxring; n++ {
+		if min := (n - 1) * cellSize; best >= 0 && min > 0 && min*min > bestd {
+			break
+		}
+		for _, i := range x.ring(c, n) {
+			d := distSq(pt, x.lines[i].Bbox)
+			if best < 0 || d < bestd || (d == bestd && i < best) {
+				best, bestd = i, d
+			}
+		}
+	}
+	return x.lines[best], true
+}
+
+// maxRing returns the ring of cells around c which includes every
+// line in the index
+func (x *Index) maxRing(c image.Point) int {
+	max := 0
+	for pt := range x.lineGrid {
+		d := pt.Sub(c)
+		for _, v := range []int{d.X, -d.X, d.Y, -d.Y} {
+			if v > max {
+				max = v
+			}
+		}
+	}
+	return max
+}
+
+// ring returns the indexes of the lines in the cells exactly n
+// cells away from c
+func (x *Index) ring(c image.Point, n int) []int {
+	var indexes []int
+	for y := c.Y - n; y <= c.Y+n; y++ {
+		for xx := c.X - n; xx <= c.X+n; xx++ {
+			if y != c.Y-n && y != c.Y+n && xx != c.X-n && xx != c.X+n {
+				continue
+			}
+			indexes = append(indexes, x.lineGrid[image.Pt(xx, y)]...)
+		}
+	}
+	return indexes
+}
+
+// distSq returns the square of the distance from a point to the
+// nearest pixel of a rectangle
+func distSq(pt image.Point, r image.Rectangle) int {
+	dx, dy := 0, 0
+	if pt.X < r.Min.X {
+		dx = r.Min.X - pt.X
+	} else if pt.X >= r.Max.X {
+		dx = pt.X - r.Max.X + 1
+	}
+	if pt.Y < r.Min.Y {
+		dy = r.Min.Y - pt.Y
+	} else if pt.Y >= r.Max.Y {
+		dy = pt.Y - r.Max.Y + 1
+	}
+	return dx*dx + dy*dy
+}
+
+func area(r image.Rectangle) int {
+	return r.Dx() * r.Dy()
+}
+
+// centre returns the centre point of a rectangle
+func centre(r image.Rectangle) image.Point {
+	return r.Min.Add(r.Max).Div(2)
+}
+
+// Text returns the text of the words whose centres are inside r,
+// and of any lines without words whose centres are inside it, with
+// a space between words, and a newline between lines
+func (x *Index) Text(r image.Rectangle) string {
+	var found []*OcrLine
+	words := make(map[*OcrLine][]string)
+	for _, w := range x.WordsIn(r) {
+		if !centre(w.Bbox).In(r) {
+			continue
+		}
+		if _, ok := words[w.Line]; !ok {
+			found = append(found, w.Line)
+		}
+		words[w.Line] = append(words[w.Line], WordText(*w.Word))
+	}
+	for _, l := range x.LinesIn(r) {
+		if len(l.Line.Words) == 0 && !noText(l.Line.Text) && centre(l.Bbox).In(r) {
+			found = append(found, l.Line)
+		}
+	}
+	sort.Slice(found, func(i, j int) bool { return x.order[found[i]] < x.order[found[j]] })
+
+	lines := make([]string, len(found))
+	for i, l := range found {
+		if len(l.Words) == 0 {
+			lines[i] = LineText(*l)
+		} else {
+			lines[i] = strings.Join(words[l], " ")
+		}
+	}
+	return strings.Join(lines, "\n")
+}
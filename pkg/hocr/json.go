@@ -0,0 +1,370 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package hocr
+
+import (
+	"encoding/json"
+	"fmt"
+	"image"
+)
+
+// JSONVersion is the version of the JSON schema used by MarshalJSON
+const JSONVersion = 1
+
+// The JSON form of a hOCR document is:
+//
+//	{
+//	  "version": 1,
+//	  "title": "...",
+//	  "meta": [{"name": "ocr-system", "content": "tesseract 4.1.1"}],
+//	  "pages": [page]
+//	}
+//
+// Each page contains "areas", each area "paragraphs", each paragraph
+// "lines", each line "words", and each word "chars", which can also
+// contain "chars". Every element has these fields, which are
+// omitted if they are empty:
+//
+//	class     the hOCR class, such as ocr_line
+//	id        the id
+//	lang      the language
+//	title     the title attribute, with all of the hOCR properties
+//	bbox      the bbox property, as [x0, y0, x1, y1]
+//	baseline  the baseline property of a line, as {"slope", "offset"}
+//	conf      the x_wconf property of a word, or x_conf of a char
+//
+// Lines, words and chars also have "text", which for lines and
+// words is only set if they have text of their own, rather than
+// the text of their words or chars.
+//
+// When JSON is read, any of bbox, baseline and conf which differ
+// from the properties in the title are set in the title, so they
+// can be edited without changing the title.
+
+type jsonBaseline struct {
+	Slope  float64 `json:"slope"`
+	Offset float64 `json:"offset"`
+}
+
+// jsonElement holds the fields shared by all elements
+type jsonElement struct {
+	Class    string        `json:"class,omitempty"`
+	Id       string        `json:"id,omitempty"`
+	Lang     string        `json:"lang,omitempty"`
+	Title    string        `json:"title,omitempty"`
+	Bbox     *[4]int       `json:"bbox,omitempty"`
+	Baseline *jsonBaseline `json:"baseline,omitempty"`
+	Conf     *float64      `json:"conf,omitempty"`
+}
+
+// confKey returns the property used for the confidence of elements
+// of a class
+func confKey(class string) string {
+	switch class {
+	case "ocrx_word":
+		return "x_wconf"
+	case "ocrx_cinfo":
+		return "x_conf"
+	}
+	return ""
+}
+
+// newJSONElement returns the JSON fields for an element, with any
+// properties which can be parsed from its title
+func newJSONElement(class, id, lang, title string, isLine bool) jsonElement {
+	e := jsonElement{Class: class, Id: id, Lang: lang, Title: title}
+	props, err := ParseProperties(title)
+	if err != nil {
+		return e
+	}
+	if props.Has("bbox") {
+		b := props.Bbox
+		e.Bbox = &[4]int{b.Min.X, b.Min.Y, b.Max.X, b.Max.Y}
+	}
+	if isLine && props.Has("baseline") {
+		e.Baseline = &jsonBaseline{props.Baseline.Slope, props.Baseline.Offset}
+	}
+	switch confKey(class) {
+	case "x_wconf":
+		if props.Has("x_wconf") {
+			e.Conf = &props.Wconf
+		}
+	case "x_conf":
+		if props.Has("x_conf") {
+			e.Conf = &props.Conf
+		}
+	}
+	return e
+}
+
+// title returns the title of an element read from JSON, with any
+// properties which were changed in the JSON set in it
+func (e jsonElement) title() (string, error) {
+	props, err := ParseProperties(e.Title)
+	if err != nil {
+		if e.Bbox == nil && e.Baseline == nil && e.Conf == nil {
+			return e.Title, nil
+		}
+		return e.Title, err
+	}
+	title := e.Title
+	set := func(key string) {
+		title = setTitleProperty(title, key, props.format(key))
+	}
+	if e.Bbox != nil {
+		b := image.Rect(e.Bbox[0], e.Bbox[1], e.Bbox[2], e.Bbox[3])
+		if !props.Has("bbox") || props.Bbox != b {
+			props.Bbox = b
+			set("bbox")
+		}
+	}
+	if e.Baseline != nil {
+		b := Baseline{e.Baseline.Slope, e.Baseline.Offset}
+		if !props.Has("baseline") || props.Baseline != b {
+			props.Baseline = b
+			set("baseline")
+		}
+	}
+	if e.Conf != nil {
+		switch confKey(e.Class) {
+		case "x_wconf":
+			if !props.Has("x_wconf") || props.Wconf != *e.Conf {
+				props.Wconf = *e.Conf
+				set("x_wconf")
+			}
+		case "x_conf":
+			if !props.Has("x_conf") || props.Conf != *e.Conf {
+				props.Conf = *e.Conf
+				set("x_conf")
+			}
+		}
+	}
+	return title, nil
+}
+
+type jsonMeta struct {
+	Name    string `json:"name"`
+	Content string `json:"content"`
+}
+
+type jsonHocr struct {
+	Version int        `json:"version"`
+	Title   string     `json:"title,omitempty"`
+	Meta    []jsonMeta `json:"meta,omitempty"`
+	Pages   []Page     `json:"pages"`
+}
+
+// MarshalJSON encodes a hOCR document as JSON
+func (h Hocr) MarshalJSON() ([]byte, error) {
+	j := jsonHocr{Version: JSONVersion, Title: h.Title, Pages: h.Pages}
+	for _, m := range h.Meta {
+		j.Meta = append(j.Meta, jsonMeta{m.Name, m.Content})
+	}
+	if j.Pages == nil {
+		j.Pages = []Page{}
+	}
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON decodes a hOCR document from JSON
+func (h *Hocr) UnmarshalJSON(b []byte) error {
+	var j jsonHocr
+	err := json.Unmarshal(b, &j)
+	if err != nil {
+		return err
+	}
+	if j.Version > JSONVersion {
+		return fmt.Errorf("unsupported hOCR JSON version %d", j.Version)
+	}
+	*h = Hocr{Title: j.Title, Pages: j.Pages}
+	for _, m := range j.Meta {
+		h.Meta = append(h.Meta, Meta{m.Name, m.Content})
+	}
+	return nil
+}
+
+type jsonPage struct {
+	jsonElement
+	Areas []Area `json:"areas"`
+}
+
+// MarshalJSON encodes a page as JSON
+func (p Page) MarshalJSON() ([]byte, error) {
+	j := jsonPage{newJSONElement(p.Class, p.Id, p.Lang, p.Title, false), p.Areas}
+	if j.Areas == nil {
+		j.Areas = []Area{}
+	}
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON decodes a page from JSON
+func (p *Page) UnmarshalJSON(b []byte) error {
+	var j jsonPage
+	err := json.Unmarshal(b, &j)
+	if err != nil {
+		return err
+	}
+	title, err := j.title()
+	if err != nil {
+		return fmt.Errorf("Error in page %s: %v", j.Id, err)
+	}
+	*p = Page{Class: j.Class, Id: j.Id, Lang: j.Lang, Title: title, Areas: j.Areas}
+	return nil
+}
+
+type jsonArea struct {
+	jsonElement
+	Paragraphs []Paragraph `json:"paragraphs"`
+}
+
+// MarshalJSON encodes an area as JSON
+func (a Area) MarshalJSON() ([]byte, error) {
+	j := jsonArea{newJSONElement(a.Class, a.Id, a.Lang, a.Title, false), a.Paragraphs}
+	if j.Paragraphs == nil {
+		j.Paragraphs = []Paragraph{}
+	}
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON decodes an area from JSON
+func (a *Area) UnmarshalJSON(b []byte) error {
+	var j jsonArea
+	err := json.Unmarshal(b, &j)
+	if err != nil {
+		return err
+	}
+	title, err := j.title()
+	if err != nil {
+		return fmt.Errorf("Error in area %s: %v", j.Id, err)
+	}
+	*a = Area{Class: j.Class, Id: j.Id, Lang: j.Lang, Title: title, Paragraphs: j.Paragraphs}
+	return nil
+}
+
+type jsonParagraph struct {
+	jsonElement
+	Lines []OcrLine `json:"lines"`
+}
+
+// MarshalJSON encodes a paragraph as JSON
+func (p Paragraph) MarshalJSON() ([]byte, error) {
+	j := jsonParagraph{newJSONElement(p.Class, p.Id, p.Lang, p.Title, false), p.Lines}
+	if j.Lines == nil {
+		j.Lines = []OcrLine{}
+	}
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON decodes a paragraph from JSON
+func (p *Paragraph) UnmarshalJSON(b []byte) error {
+	var j jsonParagraph
+	err := json.Unmarshal(b, &j)
+	if err != nil {
+		return err
+	}
+	title, err := j.title()
+	if err != nil {
+		return fmt.Errorf("Error in paragraph %s: %v", j.Id, err)
+	}
+	*p = Paragraph{Class: j.Class, Id: j.Id, Lang: j.Lang, Title: title, Lines: j.Lines}
+	return nil
+}
+
+type jsonLine struct {
+	jsonElement
+	Text  string    `json:"text,omitempty"`
+	Words []OcrWord `json:"words"`
+}
+
+// MarshalJSON encodes a line as JSON
+func (l OcrLine) MarshalJSON() ([]byte, error) {
+	j := jsonLine{jsonElement: newJSONElement(l.Class, l.Id, l.Lang, l.Title, true), Words: l.Words}
+	if !noText(l.Text) {
+		// lines with words only have whitespace between them
+		j.Text = l.Text
+	}
+	if j.Words == nil {
+		j.Words = []OcrWord{}
+	}
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON decodes a line from JSON
+func (l *OcrLine) UnmarshalJSON(b []byte) error {
+	var j jsonLine
+	err := json.Unmarshal(b, &j)
+	if err != nil {
+		return err
+	}
+	title, err := j.title()
+	if err != nil {
+		return fmt.Errorf("Error in line %s: %v", j.Id, err)
+	}
+	*l = OcrLine{Class: j.Class, Id: j.Id, Lang: j.Lang, Title: title, Text: j.Text, Words: j.Words}
+	return nil
+}
+
+type jsonWord struct {
+	jsonElement
+	Text  string    `json:"text,omitempty"`
+	Chars []OcrChar `json:"chars,omitempty"`
+}
+
+// MarshalJSON encodes a word as JSON
+func (w OcrWord) MarshalJSON() ([]byte, error) {
+	j := jsonWord{jsonElement: newJSONElement(w.Class, w.Id, w.Lang, w.Title, false), Chars: w.Chars}
+	if !noText(w.Text) {
+		// words with chars only have whitespace between them
+		j.Text = w.Text
+	}
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON decodes a word from JSON
+func (w *OcrWord) UnmarshalJSON(b []byte) error {
+	var j jsonWord
+	err := json.Unmarshal(b, &j)
+	if err != nil {
+		return err
+	}
+	title, err := j.title()
+	if err != nil {
+		return fmt.Errorf("Error in word %s: %v", j.Id, err)
+	}
+	*w = OcrWord{Class: j.Class, Id: j.Id, Lang: j.Lang, Title: title, Text: j.Text, Chars: j.Chars}
+	return nil
+}
+
+type jsonChar struct {
+	jsonElement
+	Text  string    `json:"text,omitempty"`
+	Chars []OcrChar `json:"chars,omitempty"`
+}
+
+// MarshalJSON encodes a char as JSON
+func (c OcrChar) MarshalJSON() ([]byte, error) {
+	j := jsonChar{jsonElement: newJSONElement(c.Class, c.Id, c.Lang, c.Title, false), Chars: c.Chars}
+	if len(c.Chars) == 0 || !noText(c.Text) {
+		// chars with children only have whitespace between them,
+		// but a char without children can be a space
+		j.Text = c.Text
+	}
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON decodes a char from JSON
+func (c *OcrChar) UnmarshalJSON(b []byte) error {
+	var j jsonChar
+	err := json.Unmarshal(b, &j)
+	if err != nil {
+		return err
+	}
+	title, err := j.title()
+	if err != nil {
+		return fmt.Errorf("Error in char %s: %v", j.Id, err)
+	}
+	*c = OcrChar{Class: j.Class, Id: j.Id, Lang: j.Lang, Title: title, Text: j.Text, Chars: j.Chars}
+	return nil
+}
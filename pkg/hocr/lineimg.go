@@ -0,0 +1,139 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package hocr
+
+import (
+	"image"
+	"image/color"
+	"io/fs"
+	"math"
+
+	"rescribe.xyz/utils/pkg/line"
+)
+
+// white is used for any part of a line image which is outside of
+// the page, or which is masked out
+const white = 0xff
+
+// lineImg returns a line image which is cropped from the page
+// image only when it is needed, according to the Deskew, Padding
+// and MaskNeighbours settings of the Reader. others are the boxes
+// of the other lines on the page, which are used for masking.
+func (r *Reader) lineImg(imgs fs.FS, imgpath string, frame int, props Properties, others []image.Rectangle) line.ImgCrop {
+	if r.Cache == nil {
+		r.Cache = line.NewPageCache(DefaultCacheSize)
+	}
+	img := line.ImgCrop{FS: imgs, Path: imgpath, Frame: frame, Rect: props.Bbox.Inset(-r.Padding), Cache: r.Cache}
+	if r.Deskew || len(others) > 0 {
+		deskew, padding := r.Deskew, r.Padding
+		img.Crop = func(page *image.Gray) image.Image {
+			return lineImage(page, props, others, deskew, padding)
+		}
+	}
+	return img
+}
+
+// lineImage returns the image for a line from the page image,
+// straightened if deskew is set, with padding pixels around it,
+// and with any parts inside the others boxes whited out
+func lineImage(page *image.Gray, props Properties, others []image.Rectangle, deskew bool, padding int) image.Image {
+	box := props.Bbox
+	var mask []image.Rectangle
+	for _, o := range others {
+		if o.Overlaps(box.Inset(-padding - box.Dy())) {
+			mask = append(mask, o)
+		}
+	}
+
+	if !deskew {
+		crop := box.Inset(-padding).Intersect(page.Bounds())
+		if len(mask) == 0 {
+			return page.SubImage(crop)
+		}
+		img := image.NewGray(crop)
+		for y := crop.Min.Y; y < crop.Max.Y; y++ {
+			for x := crop.Min.X; x < crop.Max.X; x++ {
+				img.SetGray(x, y, color.Gray{maskedPixel(page, box, mask, x, y)})
+			}
+		}
+		return img
+	}
+
+	// angle is the clockwise rotation of the line on the page, from
+	// the textangle, which is anticlockwise and in degrees, and the
+	// slope of the baseline
+	angle := math.Atan(props.Baseline.Slope) - props.Textangle*math.Pi/180
+	sin, cos := math.Sincos(angle)
+	w, h := lineSize(box, sin, cos)
+	w += 2 * float64(padding)
+	h += 2 * float64(padding)
+
+	// each pixel of the straightened image is taken from the page,
+	// rotating around the centre of the line box
+	cx := float64(box.Min.X+box.Max.X) / 2
+	cy := float64(box.Min.Y+box.Max.Y) / 2
+	img := image.NewGray(image.Rect(0, 0, int(math.Round(w)), int(math.Round(h))))
+	b := img.Bounds()
+	for y := 0; y < b.Max.Y; y++ {
+		for x := 0; x < b.Max.X; x++ {
+			dx := float64(x) + 0.5 - w/2
+			dy := float64(y) + 0.5 - h/2
+			sx := cx + dx*cos - dy*sin
+			sy := cy + dx*sin + dy*cos
+			img.SetGray(x, y, color.Gray{sample(page, box, mask, sx, sy)})
+		}
+	}
+	return img
+}
+
+// lineSize returns the width and height of a line which, rotated
+// by an angle, fills box. If the size can't be determined, which
+// is the case for angles near 45 degrees, the size of box is used.
+func lineSize(box image.Rectangle, sin, cos float64) (float64, float64) {
+	bw, bh := float64(box.Dx()), float64(box.Dy())
+	sin, cos = math.Abs(sin), math.Abs(cos)
+	det := cos*cos - sin*sin
+	if math.Abs(det) < 0.5 {
+		return bw, bh
+	}
+	w := (bw*cos - bh*sin) / det
+	h := (bh*cos - bw*sin) / det
+	if w < 1 || h < 1 {
+		return bw, bh
+	}
+	return w, h
+}
+
+// sample returns the value of the page image at a point, using
+// bilinear interpolation between the nearest pixels
+func sample(page *image.Gray, box image.Rectangle, mask []image.Rectangle, x, y float64) uint8 {
+	x, y = x-0.5, y-0.5
+	x0, y0 := math.Floor(x), math.Floor(y)
+	fx, fy := x-x0, y-y0
+	px, py := int(x0), int(y0)
+	v := float64(maskedPixel(page, box, mask, px, py))*(1-fx)*(1-fy) +
+		float64(maskedPixel(page, box, mask, px+1, py))*fx*(1-fy) +
+		float64(maskedPixel(page, box, mask, px, py+1))*(1-fx)*fy +
+		float64(maskedPixel(page, box, mask, px+1, py+1))*fx*fy
+	return uint8(math.Round(v))
+}
+
+// maskedPixel returns the value of a pixel of the page image, or
+// white if it is outside of the page or inside any of the mask
+// boxes but not inside the line box
+func maskedPixel(page *image.Gray, box image.Rectangle, mask []image.Rectangle, x, y int) uint8 {
+	pt := image.Pt(x, y)
+	if !pt.In(page.Bounds()) {
+		return white
+	}
+	if !pt.In(box) {
+		for _, m := range mask {
+			if pt.In(m) {
+				return white
+			}
+		}
+	}
+	return page.GrayAt(x, y).Y
+}
@@ -8,15 +8,16 @@ package hocr
 //       be sorted easily
 
 import (
+	"errors"
 	"fmt"
 	"image"
-	"image/draw"
 	_ "image/jpeg"
 	_ "image/png"
-	"io/ioutil"
+	"io"
+	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
-	"regexp"
 	"strings"
 
 	"rescribe.xyz/utils/pkg/line"
@@ -24,15 +25,19 @@ import (
 
 // Returns the image path for a page from a ocr_page title
 func imagePathFromTitle(s string) (string, error) {
-	re, err := regexp.Compile(`image ["']([^"']+)["']`)
+	p, err := ParseProperties(s)
+	if p.Image != "" {
+		return p.Image, nil
+	}
 	if err != nil {
 		return "", err
 	}
-	m := re.FindStringSubmatch(s)
-	return m[1], nil
+	return "", ErrNoImage
 }
 
-// LineText extracts the text from an OcrLine
+// LineText extracts the text from an OcrLine. If the line has no
+// text of its own, the text of each word is used, or for words
+// with no text, the text of their ocrx_cinfo characters.
 func LineText(l OcrLine) string {
 	linetext := ""
 
@@ -40,150 +45,244 @@ func LineText(l OcrLine) string {
 	if noText(linetext) {
 		linetext = ""
 		for _, w := range l.Words {
-			if w.Class != "ocrx_word" {
-				continue
-			}
-			linetext += w.Text + " "
-		}
-	}
-	if noText(linetext) {
-		linetext = ""
-		for _, w := range l.Words {
-			if w.Class != "ocrx_word" {
-				continue
-			}
-			for _, c := range w.Chars {
-				if c.Class != "ocrx_cinfo" {
-					continue
+			wordtext := w.Text
+			if noText(wordtext) {
+				wordtext = ""
+				for _, c := range w.Chars {
+					if c.Class != "ocrx_cinfo" {
+						continue
+					}
+					wordtext += c.Text
 				}
-				linetext += c.Text
 			}
-			linetext += " "
+			linetext += wordtext + " "
 		}
 	}
 	linetext = strings.TrimRight(linetext, " ")
 	return linetext
 }
 
-// parseLineDetails parses a Hocr struct into a line.Details
-// struct, including extracted image segments for each line.
-// The image location is taken from imgPath, which can either
-// be imagePathFromTitle (see above) which loads the image
-// path embedded in the title attribute of a hocr page, or
-// a custom handler.
-func parseLineDetails(h Hocr, dir string, imgPath func(string) (string, error)) (line.Details, error) {
+// WordText returns the text of a word, from its characters if it
+// has no text of its own
+func WordText(w OcrWord) string {
+	return LineText(OcrLine{Words: []OcrWord{w}})
+}
+
+// pageLineDetails parses a Page into a line.Details
+// struct, including image segments for each line, which
+// are only cropped from the page image when they are used.
+// The page image is opened from imgs, with its name taken
+// from imgPath, which can either be imagePathFromTitle
+// (see above) which loads the image path embedded in the
+// title attribute of a hocr page, or a custom handler.
+func (r *Reader) pageLineDetails(p Page, imgs fs.FS, imgPath func(string) (string, error)) (line.Details, error) {
 	lines := make(line.Details, 0)
 
-	for _, p := range h.Pages {
-		imgpath, err := imgPath(p.Title)
+	imgpath, err := imgPath(p.Title)
+	if err != nil {
+		err = r.skip(&PropertyError{Page: p.Id, Title: p.Title, Err: err})
 		if err != nil {
 			return lines, err
 		}
-		imgpath = filepath.Join(dir, filepath.Base(imgpath))
+	}
 
-		var img image.Image
-		var gray *image.Gray
-		pngf, err := os.Open(imgpath)
-		if err != nil {
-			fmt.Fprintf(os.Stderr, "Warning: error opening image %s: %v\n", imgpath, err)
-		}
-		defer pngf.Close()
-		img, _, err = image.Decode(pngf)
-		if err == nil {
-			b := img.Bounds()
-			gray = image.NewGray(image.Rect(0, 0, b.Dx(), b.Dy()))
-			draw.Draw(gray, b, img, b.Min, draw.Src)
+	var ocrname string
+	if imgpath != "" {
+		imgpath = path.Base(filepath.ToSlash(imgpath))
+		ocrname = strings.TrimSuffix(imgpath, path.Ext(imgpath))
+		_, _, err = line.DecodeConfig(imgs, imgpath)
+		if errors.Is(err, fs.ErrNotExist) {
+			r.warn(err)
+			imgpath = ""
+		} else if err != nil {
+			// an image which can't be decoded, such as one in an
+			// unsupported format, is skipped if the reader is
+			// lenient, leaving the lines without images
+			err = r.skip(err)
+			if err != nil {
+				return lines, err
+			}
+			imgpath = ""
 		}
+	}
+	// multi-page TIFF images use ppageno to select the page
+	pprops, _ := p.Properties()
+	frame := pprops.ImageFrame()
 
-		for _, l := range p.Lines {
-			totalconf := float64(0)
-			num := 0
-			for _, w := range l.Words {
-				c, err := wordConf(w.Title)
+	for _, l := range p.Lines() {
+		totalconf := float64(0)
+		num := 0
+		for _, w := range l.Words {
+			c, err := wordConf(w.Title)
+			if err != nil {
+				err = r.skip(&PropertyError{p.Id, l.Id, w.Id, w.Title, err})
 				if err != nil {
 					return lines, err
 				}
-				num++
-				totalconf += c
+				continue
 			}
+			num++
+			totalconf += c
+		}
 
-			coords, err := BoxCoords(l.Title)
+		var ln line.Detail
+		for _, w := range l.Words {
+			glyphs, err := w.Glyphs()
 			if err != nil {
-				return lines, err
+				// glyph information is optional, so the word is
+				// kept without it, even if the reader is strict
+				r.warn(&PropertyError{p.Id, l.Id, w.Id, w.Title, err})
+				glyphs = TextGlyphs(w)
+			}
+			for _, g := range glyphs {
+				conf := g.Conf
+				if conf >= 0 {
+					conf = conf / 100
+				}
+				ln.Glyphs = append(ln.Glyphs, line.Glyph{Text: g.Text, Conf: conf, Bbox: g.Bbox})
 			}
+		}
 
-			var ln line.Detail
-			ln.Name = l.Id
+		ln.Name = l.Id
+		ln.Avgconf = -1
+		if num > 0 {
 			ln.Avgconf = (totalconf / float64(num)) / 100
-			ln.Text = LineText(l)
-			imgpath, err := imgPath(p.Title)
+		}
+		ln.Text = LineText(*l)
+		ln.OcrName = ocrname
+
+		props, err := l.Properties()
+		if err == nil && !props.Has("bbox") {
+			err = ErrNoBbox
+		}
+		if err != nil {
+			// without a bbox the line can still be used, just
+			// without an image
+			err = r.skip(&PropertyError{Page: p.Id, Line: l.Id, Title: l.Title, Err: err})
 			if err != nil {
 				return lines, err
 			}
-			ln.OcrName = strings.TrimSuffix(filepath.Base(imgpath), ".png")
-			if gray != nil {
-				var imgd line.ImgDirect
-				imgd.Img = gray.SubImage(image.Rect(coords[0], coords[1], coords[2], coords[3]))
-				ln.Img = imgd
+		} else if imgpath != "" {
+			var others []image.Rectangle
+			if r.MaskNeighbours {
+				others = otherBoxes(p, l)
 			}
-			lines = append(lines, ln)
+			ln.Img = r.lineImg(imgs, imgpath, frame, props, others)
 		}
-		pngf.Close()
+		lines = append(lines, ln)
 	}
 	return lines, nil
 }
 
-// GetLineDetails parses a hocr file and returns a corresponding
-// line.Details, including image extracts for each line
-func GetLineDetails(hocrfn string) (line.Details, error) {
-	var newlines line.Details
+// otherBoxes returns the bounding boxes of all lines on a page
+// other than l
+func otherBoxes(p Page, l *OcrLine) []image.Rectangle {
+	var boxes []image.Rectangle
+	for _, o := range p.Lines() {
+		if o == l {
+			continue
+		}
+		props, err := o.Properties()
+		if err != nil || props.Bbox.Empty() {
+			continue
+		}
+		boxes = append(boxes, props.Bbox)
+	}
+	return boxes
+}
+
+// ReadLineDetails parses hOCR from r and returns a corresponding
+// line.Details, including image extracts for each line. Page images
+// are opened from imgs, using the image names embedded in the hOCR.
+// imgs can be any fs.FS, such as a directory from os.DirFS, a zip
+// archive from zip.NewReader or an in-memory fstest.MapFS.
+func ReadLineDetails(r io.Reader, imgs fs.FS) (line.Details, error) {
+	return readLineDetails(r, imgs, imagePathFromTitle)
+}
+
+// ReadLineDetailsCustomImg is a variant of ReadLineDetails that
+// uses the image named imgname in imgs for line image extracts,
+// rather than the image name embedded in the hOCR
+func ReadLineDetailsCustomImg(r io.Reader, imgs fs.FS, imgname string) (line.Details, error) {
+	return readLineDetails(r, imgs, func(s string) (string, error) { return imgname, nil })
+}
+
+func readLineDetails(r io.Reader, imgs fs.FS, imgPath func(string) (string, error)) (line.Details, error) {
+	lines := make(line.Details, 0)
+	err := NewReader(r).lineDetails(imgs, imgPath, func(l line.Detail) error {
+		lines = append(lines, l)
+		return nil
+	})
+	return lines, err
+}
 
-	file, err := ioutil.ReadFile(hocrfn)
+// openLineDetails opens a hocr file and returns a corresponding
+// line.Details for all of its lines, with page images opened
+// from the same directory as the hocr file
+func openLineDetails(hocrfn string, imgPath func(string) (string, error)) (line.Details, error) {
+	f, err := os.Open(hocrfn)
 	if err != nil {
-		return newlines, err
+		return make(line.Details, 0), err
 	}
+	defer f.Close()
 
-	h, err := Parse(file)
+	return readLineDetails(f, os.DirFS(filepath.Dir(hocrfn)), imgPath)
+}
+
+// OpenLineDetails opens a hocr file and returns a line.Details for
+// all of its lines, with page images opened from the same directory
+// as the file. If imgname is set it is used as the image for every
+// page, rather than the image names embedded in the hocr. The
+// Reader used can be configured by setup, if it is not nil, and any
+// warnings it reports are prefixed with the file name.
+func OpenLineDetails(hocrfn string, imgname string, setup func(*Reader)) (line.Details, error) {
+	lines := make(line.Details, 0)
+	f, err := os.Open(hocrfn)
 	if err != nil {
-		return newlines, err
+		return lines, err
 	}
+	defer f.Close()
 
-	return parseLineDetails(h, filepath.Dir(hocrfn), imagePathFromTitle)
+	r := NewReader(f)
+	if setup != nil {
+		setup(r)
+	}
+	warn := r.Warn
+	r.Warn = func(err error) {
+		err = fmt.Errorf("%s: %w", hocrfn, err)
+		if warn != nil {
+			warn(err)
+			return
+		}
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+
+	imgPath := imagePathFromTitle
+	if imgname != "" {
+		imgPath = func(s string) (string, error) { return imgname, nil }
+	}
+	err = r.lineDetails(os.DirFS(filepath.Dir(hocrfn)), imgPath, func(l line.Detail) error {
+		lines = append(lines, l)
+		return nil
+	})
+	return lines, err
+}
+
+// GetLineDetails parses a hocr file and returns a corresponding
+// line.Details, including image extracts for each line
+func GetLineDetails(hocrfn string) (line.Details, error) {
+	return openLineDetails(hocrfn, imagePathFromTitle)
 }
 
 // GetLineDetailsCustomImg is a variant of GetLineDetails that
 // uses a provided image path for line image extracts, rather
 // than the image name embedded in the .hocr
 func GetLineDetailsCustomImg(hocrfn string, imgfn string) (line.Details, error) {
-	var newlines line.Details
-
-	file, err := ioutil.ReadFile(hocrfn)
-	if err != nil {
-		return newlines, err
-	}
-
-	h, err := Parse(file)
-	if err != nil {
-		return newlines, err
-	}
-
-	return parseLineDetails(h, filepath.Dir(hocrfn), func(s string) (string, error) { return imgfn, nil })
+	return openLineDetails(hocrfn, func(s string) (string, error) { return imgfn, nil })
 }
 
 // GetLineBasics parses a hocr file and returns a corresponding
 // line.Details, without any image extracts
 func GetLineBasics(hocrfn string) (line.Details, error) {
-	var newlines line.Details
-
-	file, err := ioutil.ReadFile(hocrfn)
-	if err != nil {
-		return newlines, err
-	}
-
-	h, err := Parse(file)
-	if err != nil {
-		return newlines, err
-	}
-
-	return parseLineDetails(h, filepath.Dir(hocrfn), imagePathFromTitle)
+	return openLineDetails(hocrfn, imagePathFromTitle)
 }
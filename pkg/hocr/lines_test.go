@@ -0,0 +1,321 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package hocr
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"rescribe.xyz/utils/pkg/line"
+)
+
+// testImage returns a PNG encoded gray image of the given size
+func testImage(t *testing.T, w, h int) []byte {
+	img := image.NewGray(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			img.SetGray(x, y, color.Gray{uint8((x + y) % 256)})
+		}
+	}
+	var b bytes.Buffer
+	err := png.Encode(&b, img)
+	if err != nil {
+		t.Fatalf("Error encoding test image: %v", err)
+	}
+	return b.Bytes()
+}
+
+// lineSummary is a comparable summary of a line.Detail
+type lineSummary struct {
+	name    string
+	ocrname string
+	text    string
+	conf    float64
+	size    image.Point
+}
+
+// summarise converts line details to summaries, decoding each line
+// image to find its size
+func summarise(lines line.Details, err error) ([]lineSummary, error) {
+	var s []lineSummary
+	if err != nil {
+		return s, err
+	}
+	for _, l := range lines {
+		var size image.Point
+		if l.Img != nil {
+			var b bytes.Buffer
+			err = l.Img.CopyLineTo(&b)
+			if err != nil {
+				return s, err
+			}
+			img, err := png.Decode(&b)
+			if err != nil {
+				return s, err
+			}
+			size = img.Bounds().Size()
+		}
+		s = append(s, lineSummary{l.Name, l.OcrName, l.Text, l.Avgconf, size})
+	}
+	return s, nil
+}
+
+func TestReadLineDetails(t *testing.T) {
+	imgs := fstest.MapFS{
+		"test.png":  {Data: testImage(t, 600, 800)},
+		"other.png": {Data: testImage(t, 600, 800)},
+	}
+
+	cases := []struct {
+		name    string
+		imgname string
+		ocrname string
+	}{
+		{"embedded", "", "test"},
+		{"custom", "other.png", "other"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var lines []lineSummary
+			var err error
+			r := strings.NewReader(tessHocr)
+			if c.imgname == "" {
+				lines, err = summarise(ReadLineDetails(r, imgs))
+			} else {
+				lines, err = summarise(ReadLineDetailsCustomImg(r, imgs, c.imgname))
+			}
+			if err != nil {
+				t.Fatalf("Error reading line details: %v", err)
+			}
+			expected := []lineSummary{
+				{"line_1_1", c.ocrname, "TITVLVS", 0.91, image.Pt(580, 30)},
+				{"line_1_2", c.ocrname, "Lorem ipſum", 0.79, image.Pt(580, 50)},
+			}
+			if len(lines) != len(expected) {
+				t.Fatalf("Expected %d lines, got %d", len(expected), len(lines))
+			}
+			for i, l := range lines {
+				if l != expected[i] {
+					t.Errorf("Line %d: expected %+v, got %+v", i, expected[i], l)
+				}
+			}
+		})
+	}
+}
+
+const skewHocr = `<html><body>
+<div class='ocr_page' id='page_1' title='image "skew.png"; bbox 0 0 400 200'>
+ <span class='ocr_line' id='line_1_1' title='bbox 50 60 350 110; baseline 0.1 -30'>skewed</span>
+ <span class='ocr_line' id='line_1_2' title='bbox 50 112 350 150'>next</span>
+</div>
+</body></html>
+`
+
+// skewImage returns a PNG encoded image with a dark band sloping
+// down by 1 pixel in 10, matching the first line of skewHocr, and
+// a dark block for the second line
+func skewImage(t *testing.T) []byte {
+	img := image.NewGray(image.Rect(0, 0, 400, 200))
+	for y := 0; y < 200; y++ {
+		for x := 0; x < 400; x++ {
+			img.SetGray(x, y, color.Gray{white})
+			top := 60 + (x-50)/10
+			if x >= 50 && x < 350 && y >= top && y < top+20 {
+				img.SetGray(x, y, color.Gray{0})
+			}
+			if x >= 50 && x < 350 && y >= 112 && y < 150 {
+				img.SetGray(x, y, color.Gray{0})
+			}
+		}
+	}
+	var b bytes.Buffer
+	err := png.Encode(&b, img)
+	if err != nil {
+		t.Fatalf("Error encoding test image: %v", err)
+	}
+	return b.Bytes()
+}
+
+// darkness returns the proportion of pixels in a line image which
+// are darker than mid gray
+func darkness(t *testing.T, l line.Detail) (image.Point, float64) {
+	var b bytes.Buffer
+	err := l.Img.CopyLineTo(&b)
+	if err != nil {
+		t.Fatalf("Error copying line image: %v", err)
+	}
+	img, err := png.Decode(&b)
+	if err != nil {
+		t.Fatalf("Error decoding line image: %v", err)
+	}
+	bounds := img.Bounds()
+	dark := 0
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			if color.GrayModel.Convert(img.At(x, y)).(color.Gray).Y < 0x80 {
+				dark++
+			}
+		}
+	}
+	return bounds.Size(), float64(dark) / float64(bounds.Dx()*bounds.Dy())
+}
+
+func TestLineImages(t *testing.T) {
+	imgs := fstest.MapFS{"skew.png": {Data: skewImage(t)}}
+
+	cases := []struct {
+		name   string
+		deskew bool
+		pad    int
+		mask   bool
+		size   image.Point
+		dark   float64 // minimum proportion of dark pixels
+		light  float64 // maximum proportion of dark pixels
+	}{
+		{"plain", false, 0, false, image.Pt(300, 50), 0.35, 0.45},
+		{"padded", false, 5, false, image.Pt(310, 60), 0.35, 0.4},
+		{"masked", false, 5, true, image.Pt(310, 60), 0.3, 0.35},
+		{"deskewed", true, 0, false, image.Pt(299, 20), 0.9, 1},
+		{"deskewedpadded", true, 4, false, image.Pt(307, 28), 0.65, 0.8},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			r := NewReader(strings.NewReader(skewHocr))
+			r.Deskew = c.deskew
+			r.Padding = c.pad
+			r.MaskNeighbours = c.mask
+			var lines line.Details
+			err := r.LineDetails(imgs, func(l line.Detail) error {
+				lines = append(lines, l)
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("Error reading line details: %v", err)
+			}
+			size, dark := darkness(t, lines[0])
+			if size != c.size {
+				t.Errorf("Expected size %v, got %v", c.size, size)
+			}
+			if dark < c.dark || dark > c.light {
+				t.Errorf("Expected %.2f-%.2f dark, got %.2f", c.dark, c.light, dark)
+			}
+		})
+	}
+}
+
+func TestUnsupportedImage(t *testing.T) {
+	imgs := fstest.MapFS{"test.png": {Data: []byte("\xff\x0a\xfa\x7a\x00\x00\x00\x00")}}
+	_, err := ReadLineDetails(strings.NewReader(tessHocr), imgs)
+	var ferr *line.FormatError
+	if !errors.As(err, &ferr) || ferr.Format != "JPEG XL" {
+		t.Errorf("Expected a JPEG XL format error, got %v", err)
+	}
+
+	// a lenient reader warns about the image and keeps the lines
+	var warnings []error
+	r := NewReader(strings.NewReader(tessHocr))
+	r.Lenient = true
+	r.Warn = func(err error) { warnings = append(warnings, err) }
+	n := 0
+	err = r.LineDetails(imgs, func(l line.Detail) error {
+		if l.Img != nil {
+			t.Errorf("Expected no image for line %s", l.Name)
+		}
+		n++
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error reading line details leniently: %v", err)
+	}
+	if n == 0 || len(warnings) != 1 || !errors.As(warnings[0], &ferr) {
+		t.Errorf("Expected lines and a format warning, got %d lines and warnings %v", n, warnings)
+	}
+}
+
+// multiTIFF returns an uncompressed gray TIFF image with a page of
+// each of the given sizes
+func multiTIFF(sizes []image.Point) []byte {
+	le := binary.LittleEndian
+	b := []byte("II*\x00\x00\x00\x00\x00")
+	next := 4 // offset of the pointer to the next IFD
+	for _, s := range sizes {
+		data := len(b)
+		b = append(b, make([]byte, s.X*s.Y)...)
+		le.PutUint32(b[next:], uint32(len(b)))
+		entries := [][2]uint32{
+			{256, uint32(s.X)}, {257, uint32(s.Y)}, {258, 8}, {259, 1},
+			{262, 1}, {273, uint32(data)}, {277, 1}, {278, uint32(s.Y)},
+			{279, uint32(s.X * s.Y)},
+		}
+		ifd := make([]byte, 2+len(entries)*12+4)
+		le.PutUint16(ifd, uint16(len(entries)))
+		for i, e := range entries {
+			entry := ifd[2+i*12:]
+			le.PutUint16(entry, uint16(e[0]))
+			le.PutUint16(entry[2:], 4) // LONG
+			le.PutUint32(entry[4:], 1)
+			le.PutUint32(entry[8:], e[1])
+		}
+		next = len(b) + len(ifd) - 4
+		b = append(b, ifd...)
+	}
+	return b
+}
+
+// tiffPageHocr is a page from a multi-page TIFF, with a line
+// covering the whole page
+const tiffPageHocr = `<html><body>
+<div class='ocr_page' id='page_1' title='image "book.tif"; bbox 0 0 %[1]d %[2]d; ppageno %[3]d'>
+<span class='ocr_line' id='line_1_1' title='bbox 0 0 %[1]d %[2]d'><span class='ocrx_word' id='word_1_1' title='bbox 0 0 %[1]d %[2]d; x_wconf 90'>frame%[3]d</span></span>
+</div>
+</body></html>`
+
+func TestMergeTIFFLines(t *testing.T) {
+	imgs := fstest.MapFS{"book.tif": {Data: multiTIFF([]image.Point{{10, 5}, {20, 8}})}}
+
+	// the pages are in a different order from the frames, so
+	// merging changes their ppageno
+	var docs []Hocr
+	for _, s := range []string{fmt.Sprintf(tiffPageHocr, 20, 8, 1), fmt.Sprintf(tiffPageHocr, 10, 5, 0)} {
+		h, err := Parse([]byte(s))
+		if err != nil {
+			t.Fatalf("Error parsing: %v", err)
+		}
+		docs = append(docs, h)
+	}
+	var b bytes.Buffer
+	err := Write(&b, Merge(docs))
+	if err != nil {
+		t.Fatalf("Error writing merged document: %v", err)
+	}
+
+	lines, err := summarise(ReadLineDetails(bytes.NewReader(b.Bytes()), imgs))
+	if err != nil {
+		t.Fatalf("Error reading merged line details: %v", err)
+	}
+	if len(lines) != 2 || lines[0].size != image.Pt(20, 8) || lines[1].size != image.Pt(10, 5) {
+		t.Errorf("Unexpected lines from merged document: %+v", lines)
+	}
+
+	merged, err := Parse(b.Bytes())
+	if err != nil {
+		t.Fatalf("Error parsing merged document: %v", err)
+	}
+	for i, d := range Split(merged) {
+		if d.Pages[0].Title != docs[i].Pages[0].Title {
+			t.Errorf("Expected page %d title %s, got %s", i, docs[i].Pages[0].Title, d.Pages[0].Title)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package hocr
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// tessPageID and tessID match the ids tesseract gives to pages and
+// to the elements on them, which include the page number
+var tessPageID = regexp.MustCompile(`^(.*)_([0-9]+)$`)
+var tessID = regexp.MustCompile(`^(.*)_([0-9]+)_([0-9]+)$`)
+
+// Merge joins several hOCR documents into one, such as a hOCR file
+// for each page of a book into one for the whole book. The head of
+// the first document is used, with the ocr-capabilities of all of
+// them. Ids of the form tesseract uses, such as page_1 or
+// word_1_2, are renumbered by their page in the merged document,
+// any other ids which clash are made unique, and the ppageno of
+// each page is set to its position in the document, counting from
+// 0. As ppageno also selects the frame of a multi-page TIFF image,
+// any different original ppageno is kept as x_frame, which Split
+// restores. Otherwise the pages are unchanged.
+func Merge(docs []Hocr) Hocr {
+	if len(docs) == 0 {
+		return Hocr{}
+	}
+	var h Hocr
+	h.Title = docs[0].Title
+	h.Meta = append([]Meta{}, docs[0].Meta...)
+	h.doc = docs[0].doc
+
+	var caps []string
+	seen := make(map[string]bool)
+	for _, d := range docs {
+		for _, c := range strings.Fields(d.MetaContent("ocr-capabilities")) {
+			if !seen[c] {
+				seen[c] = true
+				caps = append(caps, c)
+			}
+		}
+		h.Pages = append(h.Pages, d.Pages...)
+	}
+	if len(caps) > 0 {
+		h.SetMeta("ocr-capabilities", strings.Join(caps, " "))
+	}
+	if h.MetaContent("ocr-number-of-pages") != "" {
+		h.SetMeta("ocr-number-of-pages", strconv.Itoa(len(h.Pages)))
+	}
+
+	// markup after the pages of the first document should follow
+	// all of the pages
+	n := len(docs[0].Pages)
+	h.doc.body.extra = nil
+	for _, x := range docs[0].doc.body.extra {
+		if x.pos >= n {
+			x.pos = len(h.Pages)
+		}
+		h.doc.body.extra = append(h.doc.body.extra, x)
+	}
+
+	ids := make(idSet)
+	pages := h.Pages
+	h.Pages = make([]Page, len(pages))
+	for i, p := range pages {
+		h.Pages[i] = renumberPage(p, i+1, ids)
+	}
+	return h
+}
+
+// idSet records which ids have been used
+type idSet map[string]bool
+
+// unique returns id, or if it has already been used, id with a
+// numeric suffix which hasn't been
+func (s idSet) unique(id string) string {
+	if id == "" {
+		return id
+	}
+	u := id
+	for n := 2; s[u]; n++ {
+		u = fmt.Sprintf("%s_%d", id, n)
+	}
+	s[u] = true
+	return u
+}
+
+// renumber returns a unique id for an element on page n, replacing
+// the page number in tesseract style ids
+func (s idSet) renumber(id string, n int) string {
+	if m := tessID.FindStringSubmatch(id); m != nil {
+		id = fmt.Sprintf("%s_%d_%s", m[1], n, m[3])
+	}
+	return s.unique(id)
+}
+
+// renumberPage returns a copy of p with its ids renumbered as page
+// n, and its ppageno set to n-1, keeping the original as x_frame
+func renumberPage(p Page, n int, ids idSet) Page {
+	if m := tessPageID.FindStringSubmatch(p.Id); m != nil {
+		p.Id = fmt.Sprintf("%s_%d", m[1], n)
+	}
+	p.Id = ids.unique(p.Id)
+	props, err := p.Properties()
+	p.Title = setTitleProperty(p.Title, "ppageno", strconv.Itoa(n-1))
+	_, renumbered := props.Other["x_frame"]
+	if err == nil && !renumbered && props.Ppageno != n-1 {
+		p.Title = setTitleProperty(p.Title, "x_frame", strconv.Itoa(props.Ppageno))
+	}
+
+	p.Areas = append([]Area{}, p.Areas...)
+	for i := range p.Areas {
+		a := &p.Areas[i]
+		a.Id = ids.renumber(a.Id, n)
+		a.Paragraphs = append([]Paragraph{}, a.Paragraphs...)
+		for j := range a.Paragraphs {
+			par := &a.Paragraphs[j]
+			par.Id = ids.renumber(par.Id, n)
+			par.Lines = append([]OcrLine{}, par.Lines...)
+			for k := range par.Lines {
+				l := &par.Lines[k]
+				l.Id = ids.renumber(l.Id, n)
+				l.Words = append([]OcrWord{}, l.Words...)
+				for m := range l.Words {
+					w := &l.Words[m]
+					w.Id = ids.renumber(w.Id, n)
+					w.Chars = renumberChars(w.Chars, n, ids)
+				}
+			}
+		}
+	}
+	return p
+}
+
+func renumberChars(chars []OcrChar, n int, ids idSet) []OcrChar {
+	if chars == nil {
+		return nil
+	}
+	chars = append([]OcrChar{}, chars...)
+	for i := range chars {
+		chars[i].Id = ids.renumber(chars[i].Id, n)
+		chars[i].Chars = renumberChars(chars[i].Chars, n, ids)
+	}
+	return chars
+}
+
+// setTitleProperty sets a property in a title attribute to value,
+// leaving the rest of the title as it is. If the property isn't
+// already there it is added to the end.
+func setTitleProperty(title string, key string, value string) string {
+	props := splitProperties(title)
+	for i, p := range props {
+		f := strings.Fields(p)
+		if len(f) > 0 && f[0] == key {
+			space := p[:len(p)-len(strings.TrimLeft(p, " "))]
+			props[i] = space + key + " " + value
+			return strings.Join(props, ";")
+		}
+	}
+	if strings.TrimSpace(title) == "" {
+		return key + " " + value
+	}
+	return strings.TrimRight(title, "; ") + "; " + key + " " + value
+}
+
+// removeTitleProperty removes a property from a title attribute,
+// leaving the rest of the title as it is
+func removeTitleProperty(title string, key string) string {
+	var kept []string
+	for _, p := range splitProperties(title) {
+		f := strings.Fields(p)
+		if len(f) > 0 && f[0] == key {
+			continue
+		}
+		kept = append(kept, p)
+	}
+	return strings.TrimLeft(strings.Join(kept, ";"), " ")
+}
+
+// restoreFrame returns p with the ppageno it had before Merge, if
+// Merge changed it
+func restoreFrame(p Page) Page {
+	props, _ := p.Properties()
+	if f, ok := props.Other["x_frame"]; ok {
+		p.Title = setTitleProperty(p.Title, "ppageno", f)
+		p.Title = removeTitleProperty(p.Title, "x_frame")
+	}
+	return p
+}
+
+// Split splits a hOCR document into a document for each page, such
+// as a hOCR file for a whole book into one for each page. Each has
+// the same head as the original document, and the pages are
+// unchanged, apart from restoring any ppageno changed by Merge.
+func Split(h Hocr) []Hocr {
+	var docs []Hocr
+	for _, p := range h.Pages {
+		var d Hocr
+		d.Title = h.Title
+		d.Meta = append([]Meta{}, h.Meta...)
+		if d.MetaContent("ocr-number-of-pages") != "" {
+			d.SetMeta("ocr-number-of-pages", "1")
+		}
+		d.doc = h.doc
+		// keep markup before the first page and after the last
+		d.doc.body.extra = nil
+		for _, x := range h.doc.body.extra {
+			switch {
+			case x.pos == 0:
+				d.doc.body.extra = append(d.doc.body.extra, x)
+			case x.pos >= len(h.Pages):
+				x.pos = 1
+				d.doc.body.extra = append(d.doc.body.extra, x)
+			}
+		}
+		d.Pages = []Page{restoreFrame(p)}
+		docs = append(docs, d)
+	}
+	return docs
+}
@@ -0,0 +1,625 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package hocr
+
+// This file contains the parsing of hOCR markup into the document
+// model. Rather than relying on fixed paths through the document,
+// as different OCR engines nest things quite differently, each
+// element is first read into a generic element tree, and then
+// classified by its hOCR class into pages, areas, paragraphs,
+// lines, words and characters.
+
+import (
+	"encoding/xml"
+	"io"
+	"strings"
+)
+
+// element is a generic XML element, used as an intermediate form
+// before building the typed document model.
+type element struct {
+	name     xml.Name
+	attrs    []xml.Attr
+	children []interface{} // *element, xml.CharData, xml.Comment, xml.ProcInst or xml.Directive
+	// k is the kind of the element, and below is a bit set of the
+	// kinds of its descendants, which are set by classify
+	k     kind
+	below uint
+}
+
+type kind int
+
+const (
+	kindOther kind = iota
+	kindPage
+	kindArea
+	kindPar
+	kindLine
+	kindWord
+	kindChar
+)
+
+// lineClasses are classes which always denote a line
+var lineClasses = []string{"ocr_line", "ocrx_line"}
+
+// floatLineClasses are classes which are used by some engines
+// (notably tesseract) for lines, and by others for areas
+// containing lines
+var floatLineClasses = []string{"ocr_header", "ocr_footer", "ocr_caption", "ocr_textfloat", "ocr_pageno"}
+
+var wordClasses = []string{"ocrx_word", "ocr_word"}
+
+var charClasses = []string{"ocrx_cinfo", "ocr_glyph", "ocr_cinfo"}
+
+// newDecoder returns an xml.Decoder set up to be forgiving of
+// the HTML-isms commonly found in hOCR files.
+func newDecoder(r io.Reader) *xml.Decoder {
+	d := xml.NewDecoder(r)
+	d.Strict = false
+	d.AutoClose = xml.HTMLAutoClose
+	d.Entity = xml.HTMLEntity
+	return d
+}
+
+// readElement reads the contents of the element started by start
+// from d, until its matching end element.
+func readElement(d *xml.Decoder, start xml.StartElement) (*element, error) {
+	e := &element{name: start.Name, attrs: append([]xml.Attr(nil), start.Attr...)}
+	for {
+		t, err := d.Token()
+		if err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return e, err
+		}
+		switch t := t.(type) {
+		case xml.StartElement:
+			c, err := readElement(d, t)
+			if err != nil {
+				return e, err
+			}
+			e.children = append(e.children, c)
+		case xml.EndElement:
+			e.classify()
+			return e, nil
+		case xml.CharData:
+			e.children = append(e.children, t.Copy())
+		case xml.Comment:
+			e.children = append(e.children, t.Copy())
+		case xml.ProcInst:
+			e.children = append(e.children, t.Copy())
+		case xml.Directive:
+			e.children = append(e.children, t.Copy())
+		}
+	}
+}
+
+// attr returns the value of the named attribute, ignoring any
+// namespace, or an empty string if it isn't present
+func (e *element) attr(name string) string {
+	for _, a := range e.attrs {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// hasClass reports whether the element has any of the given classes
+func (e *element) hasClass(classes ...string) bool {
+	for _, c := range strings.Fields(e.attr("class")) {
+		for _, want := range classes {
+			if c == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isHocr reports whether the element has any hOCR class
+func (e *element) isHocr() bool {
+	for _, c := range strings.Fields(e.attr("class")) {
+		if strings.HasPrefix(c, "ocr_") || strings.HasPrefix(c, "ocrx_") {
+			return true
+		}
+	}
+	return false
+}
+
+// classify sets the kind of the element and of its descendants,
+// from those of its children, which must already be classified, so
+// that a whole tree is classified in one pass up from its leaves
+func (e *element) classify() {
+	e.below = 0
+	for _, c := range e.children {
+		if c, ok := c.(*element); ok {
+			e.below |= c.below | 1<<uint(c.k)
+		}
+	}
+	switch {
+	case e.hasClass("ocr_page"):
+		e.k = kindPage
+	case e.hasClass("ocr_par"):
+		e.k = kindPar
+	case e.hasClass(lineClasses...):
+		e.k = kindLine
+	case e.hasClass(wordClasses...):
+		e.k = kindWord
+	case e.hasClass(charClasses...):
+		e.k = kindChar
+	case e.hasClass(floatLineClasses...):
+		if e.contains(kindLine, kindPar) {
+			e.k = kindArea
+		} else {
+			e.k = kindLine
+		}
+	case e.isHocr():
+		e.k = kindArea
+	default:
+		e.k = kindOther
+	}
+}
+
+// classifyTree classifies an element and all of its descendants,
+// for a tree which was built up other than by readElement
+func (e *element) classifyTree() {
+	for _, c := range e.children {
+		if c, ok := c.(*element); ok {
+			c.classifyTree()
+		}
+	}
+	e.classify()
+}
+
+// kind returns the role of the element in the hOCR structure
+func (e *element) kind() kind {
+	return e.k
+}
+
+// contains reports whether any descendant of the element is of
+// one of the given kinds
+func (e *element) contains(kinds ...kind) bool {
+	for _, k := range kinds {
+		if e.below&(1<<uint(k)) != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// text returns the character data of an element and all of its
+// descendants, except for those of the kinds given in skip
+func (e *element) text(skip ...kind) string {
+	var s strings.Builder
+	for _, c := range e.children {
+		switch c := c.(type) {
+		case xml.CharData:
+			s.Write(c)
+		case *element:
+			k := c.kind()
+			skipped := false
+			for _, sk := range skip {
+				if k == sk {
+					skipped = true
+				}
+			}
+			if !skipped {
+				s.WriteString(c.text(skip...))
+			}
+		}
+	}
+	return s.String()
+}
+
+// markup holds the parts of an element which are not represented
+// in the document model, so that it can be written out again
+// faithfully.
+type markup struct {
+	name  xml.Name
+	attrs []xml.Attr
+	extra []extra
+	// inner and text hold the original content of a line with no
+	// words, or a word or character with no child characters,
+	// which is written back out in preference to the plain text
+	// if the text is unchanged
+	inner []interface{}
+	text  string
+}
+
+// extra is a piece of markup which has no place in the document
+// model, such as an unknown element or a comment, together with
+// its position among the model children of its parent.
+type extra struct {
+	pos  int
+	node interface{}
+}
+
+func newMarkup(e *element) markup {
+	return markup{name: e.name, attrs: e.attrs}
+}
+
+// isExtra reports whether a child node should be kept as extra
+// markup, as it contains nothing of the hOCR structure.
+func isExtra(c interface{}) bool {
+	switch c := c.(type) {
+	case *element:
+		return c.kind() == kindOther && !c.contains(kindPage, kindArea, kindPar, kindLine, kindWord, kindChar)
+	case xml.Comment:
+		return true
+	}
+	return false
+}
+
+// addExtra adds c to the stray element if there is one, so that
+// it will end up in an implicit container, or otherwise as extra
+// markup at position pos in m.
+func addExtra(c interface{}, stray *element, m *markup, pos int) {
+	if stray != nil {
+		stray.children = append(stray.children, c)
+		return
+	}
+	m.extra = append(m.extra, extra{pos, c})
+}
+
+// docMarkup holds the parts of a document outside of its pages
+// which are not represented in the document model
+type docMarkup struct {
+	prolog []interface{} // comments before the root element
+	html   markup        // extra holds any children other than head and body
+	head   markup        // extra holds any children other than title and named meta elements
+	body   markup        // extra holds any children other than pages, positioned among them
+}
+
+// buildDoc builds a document from the root html element. As this
+// is used by Reader, the page elements will usually just be stubs
+// marking the position of each page, with no content.
+func buildDoc(root *element) Hocr {
+	root.classifyTree()
+	var h Hocr
+	h.doc.html = newMarkup(root)
+	body := root
+	for _, c := range root.children {
+		c, ok := c.(*element)
+		if !ok {
+			continue
+		}
+		switch c.name.Local {
+		case "head":
+			h.doc.head = newMarkup(c)
+			for _, hc := range c.children {
+				switch hc := hc.(type) {
+				case *element:
+					switch {
+					case hc.name.Local == "title":
+						h.Title = hc.text()
+					case hc.name.Local == "meta" && hc.attr("name") != "":
+						h.Meta = append(h.Meta, Meta{hc.attr("name"), hc.attr("content")})
+					default:
+						h.doc.head.extra = append(h.doc.head.extra, extra{0, hc})
+					}
+				case xml.Comment:
+					h.doc.head.extra = append(h.doc.head.extra, extra{0, hc})
+				}
+			}
+		case "body":
+			body = c
+		}
+	}
+	if body != root {
+		h.doc.body = newMarkup(body)
+		for _, c := range root.children {
+			if e, ok := c.(*element); ok && e.name.Local == "head" {
+				continue
+			}
+			if isExtra(c) {
+				h.doc.html.extra = append(h.doc.html.extra, extra{0, c})
+			}
+		}
+	}
+	h.Pages = collectPages(body, &h.doc.body, nil)
+	return h
+}
+
+// collectPages gathers the pages below an element, appending them
+// to pages
+func collectPages(e *element, m *markup, pages []Page) []Page {
+	for _, c := range e.children {
+		if isExtra(c) {
+			m.extra = append(m.extra, extra{len(pages), c})
+			continue
+		}
+		c, ok := c.(*element)
+		if !ok {
+			continue
+		}
+		if c.kind() == kindPage {
+			pages = append(pages, buildPage(c))
+			continue
+		}
+		if c.name.Local != "head" {
+			pages = collectPages(c, m, pages)
+		}
+	}
+	return pages
+}
+
+func buildPage(e *element) Page {
+	p := Page{
+		Class: e.attr("class"),
+		Id:    e.attr("id"),
+		Lang:  e.attr("lang"),
+		Title: e.attr("title"),
+		m:     newMarkup(e),
+	}
+	p.Areas = collectAreas(e, &p.m, nil)
+	return p
+}
+
+// collectAreas gathers the areas below a container element,
+// appending them to areas. Any paragraphs or lines which are not
+// inside an area are gathered into an implicit area with no class,
+// and elements with no hOCR class are looked through, so that no
+// text is lost however the document is nested.
+func collectAreas(e *element, m *markup, areas []Area) []Area {
+	var stray *element
+	flush := func() {
+		if stray != nil {
+			var a Area
+			a.Paragraphs = collectParagraphs(stray, &a.m, nil)
+			areas = append(areas, a)
+			stray = nil
+		}
+	}
+	for _, c := range e.children {
+		if isExtra(c) {
+			addExtra(c, stray, m, len(areas))
+			continue
+		}
+		c, ok := c.(*element)
+		if !ok {
+			continue
+		}
+		switch c.kind() {
+		case kindArea:
+			flush()
+			areas = append(areas, buildArea(c))
+			areas = collectNestedAreas(c, areas)
+		case kindPar, kindLine, kindWord:
+			if stray == nil {
+				stray = &element{}
+			}
+			stray.children = append(stray.children, c)
+		case kindOther:
+			flush()
+			areas = collectAreas(c, m, areas)
+		}
+	}
+	flush()
+	return areas
+}
+
+// collectNestedAreas appends any areas nested within an area to
+// areas, flattening them to be siblings of it.
+func collectNestedAreas(e *element, areas []Area) []Area {
+	for _, c := range e.children {
+		c, ok := c.(*element)
+		if !ok {
+			continue
+		}
+		switch c.kind() {
+		case kindArea:
+			areas = append(areas, buildArea(c))
+			areas = collectNestedAreas(c, areas)
+		case kindOther:
+			areas = collectNestedAreas(c, areas)
+		}
+	}
+	return areas
+}
+
+func buildArea(e *element) Area {
+	a := Area{
+		Class: e.attr("class"),
+		Id:    e.attr("id"),
+		Lang:  e.attr("lang"),
+		Title: e.attr("title"),
+		m:     newMarkup(e),
+	}
+	a.Paragraphs = collectParagraphs(e, &a.m, nil)
+	return a
+}
+
+// collectParagraphs gathers the paragraphs below a container
+// element, stopping at any nested areas, and appends them to pars.
+// Lines which are not in a paragraph are gathered into an implicit
+// paragraph with no class.
+func collectParagraphs(e *element, m *markup, pars []Paragraph) []Paragraph {
+	var stray *element
+	flush := func() {
+		if stray != nil {
+			var p Paragraph
+			p.Lines = collectLines(stray, &p.m, nil)
+			pars = append(pars, p)
+			stray = nil
+		}
+	}
+	for _, c := range e.children {
+		if isExtra(c) {
+			addExtra(c, stray, m, len(pars))
+			continue
+		}
+		c, ok := c.(*element)
+		if !ok {
+			continue
+		}
+		switch c.kind() {
+		case kindArea:
+			flush()
+		case kindPar:
+			flush()
+			pars = append(pars, buildParagraph(c))
+		case kindLine, kindWord:
+			if stray == nil {
+				stray = &element{}
+			}
+			stray.children = append(stray.children, c)
+		case kindOther:
+			flush()
+			pars = collectParagraphs(c, m, pars)
+		}
+	}
+	flush()
+	return pars
+}
+
+func buildParagraph(e *element) Paragraph {
+	p := Paragraph{
+		Class: e.attr("class"),
+		Id:    e.attr("id"),
+		Lang:  e.attr("lang"),
+		Title: e.attr("title"),
+		m:     newMarkup(e),
+	}
+	p.Lines = collectLines(e, &p.m, nil)
+	return p
+}
+
+// collectLines gathers the lines below a container element and
+// appends them to lines. Any words which are not in a line are
+// gathered into an implicit line with no class.
+func collectLines(e *element, m *markup, lines []OcrLine) []OcrLine {
+	var stray *element
+	flush := func() {
+		if stray != nil {
+			var l OcrLine
+			l.Words = collectWords(stray, &l.m, nil)
+			lines = append(lines, l)
+			stray = nil
+		}
+	}
+	for _, c := range e.children {
+		if isExtra(c) {
+			addExtra(c, stray, m, len(lines))
+			continue
+		}
+		c, ok := c.(*element)
+		if !ok {
+			continue
+		}
+		switch c.kind() {
+		case kindLine:
+			flush()
+			lines = append(lines, buildLine(c))
+		case kindWord:
+			if stray == nil {
+				stray = &element{}
+			}
+			stray.children = append(stray.children, c)
+		case kindOther, kindArea, kindPar:
+			flush()
+			lines = collectLines(c, m, lines)
+		}
+	}
+	flush()
+	return lines
+}
+
+func buildLine(e *element) OcrLine {
+	l := OcrLine{
+		Class: e.attr("class"),
+		Id:    e.attr("id"),
+		Lang:  e.attr("lang"),
+		Title: e.attr("title"),
+		Text:  e.text(kindWord, kindChar),
+		m:     newMarkup(e),
+	}
+	l.Words = collectWords(e, &l.m, nil)
+	if len(l.Words) == 0 {
+		// the content of a line without words is kept as it is, like
+		// that of a word, so that any inline markup stays in place
+		l.m.extra = nil
+		l.m.inner = e.children
+		l.m.text = l.Text
+	}
+	return l
+}
+
+// collectWords gathers the words below a line element and appends
+// them to words
+func collectWords(e *element, m *markup, words []OcrWord) []OcrWord {
+	for _, c := range e.children {
+		if isExtra(c) {
+			m.extra = append(m.extra, extra{len(words), c})
+			continue
+		}
+		c, ok := c.(*element)
+		if !ok {
+			continue
+		}
+		switch c.kind() {
+		case kindWord:
+			words = append(words, buildWord(c))
+		case kindOther:
+			words = collectWords(c, m, words)
+		}
+	}
+	return words
+}
+
+func buildWord(e *element) OcrWord {
+	w := OcrWord{
+		Class: e.attr("class"),
+		Id:    e.attr("id"),
+		Lang:  e.attr("lang"),
+		Title: e.attr("title"),
+		Text:  e.text(kindChar),
+		m:     newMarkup(e),
+	}
+	w.Chars = collectChars(e, &w.m, nil)
+	if len(w.Chars) == 0 {
+		w.m.extra = nil
+		w.m.inner = e.children
+		w.m.text = w.Text
+	}
+	return w
+}
+
+// collectChars gathers the characters directly below an element
+// and appends them to chars
+func collectChars(e *element, m *markup, chars []OcrChar) []OcrChar {
+	for _, c := range e.children {
+		if isExtra(c) {
+			m.extra = append(m.extra, extra{len(chars), c})
+			continue
+		}
+		c, ok := c.(*element)
+		if !ok {
+			continue
+		}
+		switch c.kind() {
+		case kindChar:
+			ch := OcrChar{
+				Class: c.attr("class"),
+				Id:    c.attr("id"),
+				Lang:  c.attr("lang"),
+				Title: c.attr("title"),
+				Text:  c.text(kindChar),
+				m:     newMarkup(c),
+			}
+			ch.Chars = collectChars(c, &ch.m, nil)
+			if len(ch.Chars) == 0 {
+				ch.m.extra = nil
+				ch.m.inner = c.children
+				ch.m.text = ch.Text
+			}
+			chars = append(chars, ch)
+		case kindOther:
+			chars = collectChars(c, m, chars)
+		}
+	}
+	return chars
+}
@@ -0,0 +1,476 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package hocr
+
+import (
+	"fmt"
+	"image"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Properties holds the properties of a hOCR element, which are
+// embedded in its title attribute, such as
+// title="bbox 10 20 300 60; baseline 0.01 -8; x_wconf 93"
+type Properties struct {
+	Bbox       image.Rectangle   // bbox
+	Baseline   Baseline          // baseline
+	Wconf      float64           // x_wconf
+	Conf       float64           // x_conf
+	Confs      []float64         // x_confs
+	Bboxes     []image.Rectangle // x_bboxes
+	Size       float64           // x_size
+	Ascenders  float64           // x_ascenders
+	Descenders float64           // x_descenders
+	Font       string            // x_font
+	Fsize      float64           // x_fsize
+	Textangle  float64           // textangle
+	Ppageno    int               // ppageno
+	ScanRes    [2]int            // scan_res
+	Image      string            // image
+	Other      map[string]string // any other properties, unparsed
+	// Keys lists the properties which are present, in order. Any
+	// property which has a non-zero value is also considered to be
+	// present by Has and String, so it is only necessary to add to
+	// Keys to include a property with a zero value.
+	Keys []string
+}
+
+// Baseline is the baseline of a line, given as the slope of the
+// line and its vertical offset from the bottom left corner of the
+// line's bounding box
+type Baseline struct {
+	Slope  float64
+	Offset float64
+}
+
+// propertyOrder is the order in which known properties are written
+// if they aren't listed in Keys
+var propertyOrder = []string{"image", "bbox", "baseline", "textangle", "ppageno", "scan_res",
+	"x_size", "x_descenders", "x_ascenders", "x_font", "x_fsize", "x_wconf", "x_conf", "x_confs", "x_bboxes"}
+
+// ParseProperties parses the properties from a hOCR title attribute.
+// Each property is parsed on its own, so if any are malformed the
+// others are still returned, along with an error for the first bad
+// one.
+func ParseProperties(title string) (Properties, error) {
+	var p Properties
+	var err error
+	for _, prop := range splitProperties(title) {
+		args := propertyFields(prop)
+		if len(args) == 0 {
+			continue
+		}
+		key := args[0]
+		args = args[1:]
+		// the property is set on a copy, so that a bad one leaves
+		// no partial value behind
+		q := p
+		perr := q.set(key, args)
+		if perr != nil {
+			if err == nil {
+				err = fmt.Errorf("Error parsing property '%s': %v", prop, perr)
+			}
+			continue
+		}
+		p = q
+		p.addKey(key)
+	}
+	return p, err
+}
+
+// set parses the arguments for a property and sets it
+func (p *Properties) set(key string, args []string) error {
+	var err error
+	switch key {
+	case "bbox":
+		var r []image.Rectangle
+		r, err = parseRects(args)
+		if err == nil && len(r) != 1 {
+			err = fmt.Errorf("expected 4 values, got %d", len(args))
+		}
+		if err == nil {
+			p.Bbox = r[0]
+		}
+	case "baseline":
+		var f []float64
+		f, err = parseFloats(args)
+		if err == nil && len(f) != 2 {
+			err = fmt.Errorf("expected 2 values, got %d", len(args))
+		}
+		if err == nil {
+			p.Baseline = Baseline{f[0], f[1]}
+		}
+	case "x_wconf":
+		p.Wconf, err = parseFloat(args)
+	case "x_conf":
+		p.Conf, err = parseFloat(args)
+	case "x_confs":
+		p.Confs, err = parseFloats(args)
+	case "x_bboxes":
+		p.Bboxes, err = parseRects(args)
+	case "x_size":
+		p.Size, err = parseFloat(args)
+	case "x_ascenders":
+		p.Ascenders, err = parseFloat(args)
+	case "x_descenders":
+		p.Descenders, err = parseFloat(args)
+	case "x_font":
+		p.Font = strings.Join(args, " ")
+	case "x_fsize":
+		p.Fsize, err = parseFloat(args)
+	case "textangle":
+		p.Textangle, err = parseFloat(args)
+	case "ppageno":
+		var i []int
+		i, err = parseInts(args)
+		if err == nil && len(i) != 1 {
+			err = fmt.Errorf("expected 1 value, got %d", len(args))
+		}
+		if err == nil {
+			p.Ppageno = i[0]
+		}
+	case "scan_res":
+		var i []int
+		i, err = parseInts(args)
+		if err == nil && len(i) != 2 {
+			err = fmt.Errorf("expected 2 values, got %d", len(args))
+		}
+		if err == nil {
+			p.ScanRes = [2]int{i[0], i[1]}
+		}
+	case "image":
+		p.Image = strings.Join(args, " ")
+	default:
+		if p.Other == nil {
+			p.Other = make(map[string]string)
+		}
+		var quoted []string
+		for _, a := range args {
+			quoted = append(quoted, quoteArg(a))
+		}
+		p.Other[key] = strings.Join(quoted, " ")
+	}
+	return err
+}
+
+// addKey adds a key to the list of keys, if it isn't already there
+func (p *Properties) addKey(key string) {
+	for _, k := range p.Keys {
+		if k == key {
+			return
+		}
+	}
+	p.Keys = append(p.Keys, key)
+}
+
+// Has reports whether the named property is present
+func (p Properties) Has(key string) bool {
+	for _, k := range p.Keys {
+		if k == key {
+			return true
+		}
+	}
+	switch key {
+	case "bbox":
+		return p.Bbox != image.Rectangle{}
+	case "baseline":
+		return p.Baseline != Baseline{}
+	case "x_wconf":
+		return p.Wconf != 0
+	case "x_conf":
+		return p.Conf != 0
+	case "x_confs":
+		return len(p.Confs) > 0
+	case "x_bboxes":
+		return len(p.Bboxes) > 0
+	case "x_size":
+		return p.Size != 0
+	case "x_ascenders":
+		return p.Ascenders != 0
+	case "x_descenders":
+		return p.Descenders != 0
+	case "x_font":
+		return p.Font != ""
+	case "x_fsize":
+		return p.Fsize != 0
+	case "textangle":
+		return p.Textangle != 0
+	case "ppageno":
+		return p.Ppageno != 0
+	case "scan_res":
+		return p.ScanRes != [2]int{}
+	case "image":
+		return p.Image != ""
+	}
+	_, ok := p.Other[key]
+	return ok
+}
+
+// ImageFrame returns the frame of the page image to use, for images
+// with several frames such as multi-page TIFFs. This is usually the
+// ppageno, but if Merge has renumbered the page the original is
+// kept as x_frame.
+func (p Properties) ImageFrame() int {
+	if f, ok := p.Other["x_frame"]; ok {
+		n, err := strconv.Atoi(f)
+		if err == nil {
+			return n
+		}
+	}
+	return p.Ppageno
+}
+
+// String formats the properties as a hOCR title attribute
+func (p Properties) String() string {
+	var props []string
+	done := make(map[string]bool)
+	add := func(key string) {
+		if done[key] || !p.Has(key) {
+			return
+		}
+		done[key] = true
+		props = append(props, key+" "+p.format(key))
+	}
+	for _, k := range p.Keys {
+		add(k)
+	}
+	for _, k := range propertyOrder {
+		add(k)
+	}
+	var other []string
+	for k := range p.Other {
+		other = append(other, k)
+	}
+	sort.Strings(other)
+	for _, k := range other {
+		add(k)
+	}
+	return strings.Join(props, "; ")
+}
+
+// format formats the arguments of a property
+func (p Properties) format(key string) string {
+	switch key {
+	case "bbox":
+		return formatRects([]image.Rectangle{p.Bbox})
+	case "baseline":
+		return formatFloats([]float64{p.Baseline.Slope, p.Baseline.Offset})
+	case "x_wconf":
+		return formatFloats([]float64{p.Wconf})
+	case "x_conf":
+		return formatFloats([]float64{p.Conf})
+	case "x_confs":
+		return formatFloats(p.Confs)
+	case "x_bboxes":
+		return formatRects(p.Bboxes)
+	case "x_size":
+		return formatFloats([]float64{p.Size})
+	case "x_ascenders":
+		return formatFloats([]float64{p.Ascenders})
+	case "x_descenders":
+		return formatFloats([]float64{p.Descenders})
+	case "x_font":
+		return quoteString(p.Font)
+	case "x_fsize":
+		return formatFloats([]float64{p.Fsize})
+	case "textangle":
+		return formatFloats([]float64{p.Textangle})
+	case "ppageno":
+		return strconv.Itoa(p.Ppageno)
+	case "scan_res":
+		return strconv.Itoa(p.ScanRes[0]) + " " + strconv.Itoa(p.ScanRes[1])
+	case "image":
+		return quoteString(p.Image)
+	}
+	return p.Other[key]
+}
+
+// splitProperties splits a title attribute into its properties,
+// which are separated by semicolons outside of any quotes
+func splitProperties(title string) []string {
+	var props []string
+	var cur strings.Builder
+	var quote rune
+	rs := []rune(title)
+	for i := 0; i < len(rs); i++ {
+		r := rs[i]
+		switch {
+		case quote != 0 && isEscape(rs, i, quote):
+			cur.WriteRune(r)
+			i++
+			r = rs[i]
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote == 0 && (r == '"' || r == '\''):
+			quote = r
+		case quote == 0 && r == ';':
+			props = append(props, cur.String())
+			cur.Reset()
+			continue
+		}
+		cur.WriteRune(r)
+	}
+	props = append(props, cur.String())
+	return props
+}
+
+// isEscape reports whether the rune at i is a backslash escaping a
+// quote character. A backslash is only an escape directly before
+// the quote, so that paths such as "C:\Users\me\0001.png" are
+// read as they are.
+func isEscape(rs []rune, i int, quote rune) bool {
+	return rs[i] == '\\' && i+1 < len(rs) && rs[i+1] == quote
+}
+
+// propertyFields splits a property into its fields, which are
+// separated by whitespace, with any quoted fields unquoted
+func propertyFields(prop string) []string {
+	var fields []string
+	var cur strings.Builder
+	var quote rune
+	infield := false
+	rs := []rune(prop)
+	for i := 0; i < len(rs); i++ {
+		r := rs[i]
+		switch {
+		case quote != 0 && isEscape(rs, i, quote):
+			i++
+			cur.WriteRune(rs[i])
+		case quote != 0 && r == quote:
+			quote = 0
+		case quote == 0 && (r == '"' || r == '\''):
+			quote = r
+			infield = true
+		case quote == 0 && (r == ' ' || r == '\t' || r == '\n' || r == '\r'):
+			if infield {
+				fields = append(fields, cur.String())
+				cur.Reset()
+				infield = false
+			}
+		default:
+			cur.WriteRune(r)
+			infield = true
+		}
+	}
+	if infield {
+		fields = append(fields, cur.String())
+	}
+	return fields
+}
+
+// quoteArg quotes a string argument if it needs it
+func quoteArg(s string) string {
+	if s != "" && !strings.ContainsAny(s, " \t\n\r;\"'\\") {
+		return s
+	}
+	return quoteString(s)
+}
+
+// quoteString quotes a string argument
+func quoteString(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
+
+func parseFloat(args []string) (float64, error) {
+	if len(args) != 1 {
+		return 0, fmt.Errorf("expected 1 value, got %d", len(args))
+	}
+	return strconv.ParseFloat(args[0], 64)
+}
+
+func parseFloats(args []string) ([]float64, error) {
+	var f []float64
+	for _, a := range args {
+		v, err := strconv.ParseFloat(a, 64)
+		if err != nil {
+			return f, err
+		}
+		f = append(f, v)
+	}
+	return f, nil
+}
+
+// parseInts parses integer arguments, accepting and truncating any
+// which are written as decimals, as some engines do for coordinates
+func parseInts(args []string) ([]int, error) {
+	var i []int
+	for _, a := range args {
+		v, err := strconv.Atoi(a)
+		if err != nil {
+			f, ferr := strconv.ParseFloat(a, 64)
+			if ferr != nil {
+				return i, err
+			}
+			v = int(f)
+		}
+		i = append(i, v)
+	}
+	return i, nil
+}
+
+func parseRects(args []string) ([]image.Rectangle, error) {
+	i, err := parseInts(args)
+	if err != nil {
+		return nil, err
+	}
+	if len(i)%4 != 0 {
+		return nil, fmt.Errorf("expected a multiple of 4 values, got %d", len(i))
+	}
+	var r []image.Rectangle
+	for n := 0; n < len(i); n += 4 {
+		// the rectangle is not canonicalised, so that invalid boxes
+		// can be detected
+		r = append(r, image.Rectangle{image.Pt(i[n], i[n+1]), image.Pt(i[n+2], i[n+3])})
+	}
+	return r, nil
+}
+
+func formatFloats(f []float64) string {
+	var s []string
+	for _, v := range f {
+		s = append(s, strconv.FormatFloat(v, 'f', -1, 64))
+	}
+	return strings.Join(s, " ")
+}
+
+func formatRects(rects []image.Rectangle) string {
+	var s []string
+	for _, r := range rects {
+		s = append(s, fmt.Sprintf("%d %d %d %d", r.Min.X, r.Min.Y, r.Max.X, r.Max.Y))
+	}
+	return strings.Join(s, " ")
+}
+
+// Properties parses the properties of the page
+func (p Page) Properties() (Properties, error) {
+	return ParseProperties(p.Title)
+}
+
+// Properties parses the properties of the area
+func (a Area) Properties() (Properties, error) {
+	return ParseProperties(a.Title)
+}
+
+// Properties parses the properties of the paragraph
+func (p Paragraph) Properties() (Properties, error) {
+	return ParseProperties(p.Title)
+}
+
+// Properties parses the properties of the line
+func (l OcrLine) Properties() (Properties, error) {
+	return ParseProperties(l.Title)
+}
+
+// Properties parses the properties of the word
+func (w OcrWord) Properties() (Properties, error) {
+	return ParseProperties(w.Title)
+}
+
+// Properties parses the properties of the character
+func (c OcrChar) Properties() (Properties, error) {
+	return ParseProperties(c.Title)
+}
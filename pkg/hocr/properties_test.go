@@ -0,0 +1,105 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package hocr
+
+import (
+	"errors"
+	"image"
+	"testing"
+)
+
+func TestProperties(t *testing.T) {
+	cases := []struct {
+		title string
+		str   string
+		check func(p Properties) bool
+	}{
+		{
+			`image "/home/test/a;b.png"; bbox 0 0 2480 3508; ppageno 0`,
+			`image "/home/test/a;b.png"; bbox 0 0 2480 3508; ppageno 0`,
+			func(p Properties) bool {
+				return p.Image == "/home/test/a;b.png" && p.Bbox == image.Rect(0, 0, 2480, 3508) && p.Has("ppageno")
+			},
+		},
+		{
+			`image "C:\Users\me\book\0001.png"; bbox 0 0 2480 3508`,
+			`image "C:\Users\me\book\0001.png"; bbox 0 0 2480 3508`,
+			func(p Properties) bool {
+				return p.Image == `C:\Users\me\book\0001.png`
+			},
+		},
+		{
+			`x_font "say \"hi\""; x_source 'C:\scans\a.tif'`,
+			`x_font "say \"hi\""; x_source "C:\scans\a.tif"`,
+			func(p Properties) bool {
+				return p.Font == `say "hi"` && p.Other["x_source"] == `"C:\scans\a.tif"`
+			},
+		},
+		{
+			`bbox 10 20 300 60; baseline 0.012 -8; x_size 41; x_descenders 9; x_ascenders 11`,
+			`bbox 10 20 300 60; baseline 0.012 -8; x_size 41; x_descenders 9; x_ascenders 11`,
+			func(p Properties) bool {
+				return p.Baseline == Baseline{0.012, -8} && p.Size == 41 && p.Descenders == 9 && p.Ascenders == 11
+			},
+		},
+		{
+			`x_bboxes 1 2 3 4 5 6 7 8; x_confs 99.1 42; x_wconf 0; x_font "Times New Roman"; x_fsize 12`,
+			`x_bboxes 1 2 3 4 5 6 7 8; x_confs 99.1 42; x_wconf 0; x_font "Times New Roman"; x_fsize 12`,
+			func(p Properties) bool {
+				return len(p.Bboxes) == 2 && p.Bboxes[1] == image.Rect(5, 6, 7, 8) &&
+					len(p.Confs) == 2 && p.Confs[0] == 99.1 && p.Has("x_wconf") &&
+					p.Font == "Times New Roman" && p.Fsize == 12
+			},
+		},
+		{
+			`textangle 90; scan_res 300 300; x_source "scan.tif"; nlp 0.9`,
+			`textangle 90; scan_res 300 300; x_source scan.tif; nlp 0.9`,
+			func(p Properties) bool {
+				return p.Textangle == 90 && p.ScanRes == [2]int{300, 300} &&
+					p.Other["x_source"] == "scan.tif" && p.Has("nlp") && !p.Has("bbox")
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.title, func(t *testing.T) {
+			p, err := ParseProperties(c.title)
+			if err != nil {
+				t.Fatalf("Error parsing properties: %v", err)
+			}
+			if !c.check(p) {
+				t.Errorf("Unexpected properties: %+v", p)
+			}
+			if p.String() != c.str {
+				t.Errorf("Expected '%s', got '%s'", c.str, p.String())
+			}
+		})
+	}
+
+	var p Properties
+	p.Bbox = image.Rect(1, 2, 3, 4)
+	p.Wconf = 80
+	p.Image = "x.png"
+	if s := p.String(); s != `image "x.png"; bbox 1 2 3 4; x_wconf 80` {
+		t.Errorf("Unexpected formatting of new properties: '%s'", s)
+	}
+
+	_, err := ParseProperties("bbox 1 2 3")
+	if err == nil {
+		t.Errorf("Expected error parsing incomplete bbox")
+	}
+
+	// the other properties are still read if one is malformed
+	p, err = ParseProperties("bbox 1 2 3; x_wconf 90")
+	if err == nil || p.Has("bbox") || p.Wconf != 90 {
+		t.Errorf("Expected error for bbox and x_wconf 90, got %+v, %v", p, err)
+	}
+	if c, err := wordConf("bbox 1 2 3; x_wconf 90"); err != nil || c != 90 {
+		t.Errorf("Expected word confidence 90, got %f, %v", c, err)
+	}
+	if _, err := BoxCoords("bbox 1 2 3; x_wconf 90"); err == nil || errors.Is(err, ErrNoBbox) {
+		t.Errorf("Expected error for bad bbox, got %v", err)
+	}
+}
@@ -0,0 +1,275 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package hocr
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+
+	"rescribe.xyz/utils/pkg/line"
+)
+
+// Reader reads a hOCR document a page at a time, so that even very
+// large documents, such as a whole book, can be processed without
+// holding the whole document in memory.
+type Reader struct {
+	d      *xml.Decoder
+	prolog []interface{}
+	// root is the document read so far, apart from the content of
+	// its pages, which are represented by empty stub elements
+	root  *element
+	stack []*element
+
+	// Lenient causes missing or malformed properties, such as a
+	// word with no x_wconf or a line with no bbox, and page images
+	// which can't be decoded, to be skipped and reported with
+	// Warn, rather than stopping with an error.
+	Lenient bool
+	// Warn is called with any problems which have been skipped.
+	// If it is nil, warnings are printed to standard error.
+	Warn func(error)
+
+	// Deskew causes line images to be rotated to straighten them,
+	// according to the baseline and textangle of each line, rather
+	// than being a plain crop of the line's bbox.
+	Deskew bool
+	// Padding is the number of pixels of the page image to include
+	// around each line image.
+	Padding int
+	// MaskNeighbours causes any part of a line image which is
+	// inside the bbox of another line, but not its own, to be
+	// whited out.
+	MaskNeighbours bool
+	// Cache holds the decoded page images used by line images. If
+	// it is nil, a cache of DefaultCacheSize pages is created, so
+	// that memory use stays bounded however many pages are read.
+	Cache *line.PageCache
+}
+
+// DefaultCacheSize is the number of decoded page images kept for
+// line images by a Reader with no Cache set
+const DefaultCacheSize = 4
+
+// NewReader returns a Reader which reads a hOCR document from r
+func NewReader(r io.Reader) *Reader {
+	return &Reader{d: newDecoder(r)}
+}
+
+// Next reads the next page of the document. It returns io.EOF
+// once there are no more pages.
+func (r *Reader) Next() (Page, error) {
+	for {
+		t, err := r.d.Token()
+		if err != nil {
+			return Page{}, err
+		}
+		switch t := t.(type) {
+		case xml.StartElement:
+			if r.root == nil {
+				r.root = &element{name: t.Name, attrs: t.Copy().Attr}
+				r.stack = append(r.stack, r.root)
+				continue
+			}
+			if len(r.stack) == 0 {
+				// ignore anything after the root element
+				err = r.d.Skip()
+				if err != nil {
+					return Page{}, err
+				}
+				continue
+			}
+			parent := r.stack[len(r.stack)-1]
+			e := &element{name: t.Name, attrs: t.Copy().Attr}
+			if e.hasClass("ocr_page") {
+				full, err := readElement(r.d, t)
+				if err != nil {
+					return Page{}, err
+				}
+				parent.children = append(parent.children, e)
+				return buildPage(full), nil
+			}
+			if t.Name.Local == "head" {
+				e, err = readElement(r.d, t)
+				if err != nil {
+					return Page{}, err
+				}
+				parent.children = append(parent.children, e)
+				continue
+			}
+			parent.children = append(parent.children, e)
+			r.stack = append(r.stack, e)
+		case xml.EndElement:
+			if len(r.stack) > 0 {
+				r.stack = r.stack[:len(r.stack)-1]
+			}
+		case xml.CharData:
+			// whitespace between pages is not kept, as it can add up
+			// for very large documents and is not needed
+			if len(r.stack) > 2 || (len(r.stack) > 0 && len(bytes.TrimSpace(t)) > 0) {
+				top := r.stack[len(r.stack)-1]
+				top.children = append(top.children, t.Copy())
+			}
+		case xml.Comment:
+			if r.root == nil {
+				r.prolog = append(r.prolog, t.Copy())
+			} else if len(r.stack) > 0 {
+				top := r.stack[len(r.stack)-1]
+				top.children = append(top.children, t.Copy())
+			}
+		}
+	}
+}
+
+// skip returns err, unless the reader is lenient, in which case
+// err is reported as a warning and nil is returned, so that the
+// item with the problem can be skipped
+func (r *Reader) skip(err error) error {
+	if !r.Lenient {
+		return err
+	}
+	r.warn(err)
+	return nil
+}
+
+// warn reports a problem with the document
+func (r *Reader) warn(err error) {
+	if r.Warn != nil {
+		r.Warn(err)
+		return
+	}
+	fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+}
+
+// document returns the document read so far, with an empty page
+// in place of each page which has been read
+func (r *Reader) document() Hocr {
+	if r.root == nil {
+		return Hocr{}
+	}
+	h := buildDoc(r.root)
+	h.doc.prolog = r.prolog
+	return h
+}
+
+// Document returns the parts of the document which have been read
+// so far, other than its pages, such as its title and metadata.
+// This will be complete once the first page has been read by Next.
+func (r *Reader) Document() Hocr {
+	h := r.document()
+	h.Pages = nil
+	return h
+}
+
+// WriteText writes the text of each remaining line in the document
+// to w, followed by a newline
+func (r *Reader) WriteText(w io.Writer) error {
+	for {
+		p, err := r.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, l := range p.Lines() {
+			_, err = io.WriteString(w, LineText(*l)+"\n")
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// WordConfs calls fn with the confidence of each remaining word in
+// the document, based on its x_wconf value. If fn returns an error
+// then reading stops and the error is returned.
+func (r *Reader) WordConfs(fn func(float64) error) error {
+	for {
+		p, err := r.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		for _, l := range p.Lines() {
+			for _, w := range l.Words {
+				c, err := wordConf(w.Title)
+				if err != nil {
+					err = r.skip(&PropertyError{p.Id, l.Id, w.Id, w.Title, err})
+					if err != nil {
+						return err
+					}
+					continue
+				}
+				err = fn(c)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// AvgConf calculates the average confidence of the remaining words
+// in the document, based on their x_wconf values
+func (r *Reader) AvgConf() (float64, error) {
+	var total, num float64
+	err := r.WordConfs(func(c float64) error {
+		total += c
+		num++
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	if num == 0 {
+		return 0, errors.New("No words found")
+	}
+	return total / num, nil
+}
+
+// LineDetails calls fn with a line.Detail for each remaining line
+// in the document, including an extracted image of the line. The
+// page images are opened from imgs, using the image names embedded
+// in the hOCR. If fn returns an error then reading stops and the
+// error is returned.
+func (r *Reader) LineDetails(imgs fs.FS, fn func(line.Detail) error) error {
+	return r.lineDetails(imgs, imagePathFromTitle, fn)
+}
+
+// LineDetailsCustomImg is a variant of LineDetails that uses the
+// image named imgname in imgs for every page, rather than the image
+// names embedded in the hOCR.
+func (r *Reader) LineDetailsCustomImg(imgs fs.FS, imgname string, fn func(line.Detail) error) error {
+	return r.lineDetails(imgs, func(s string) (string, error) { return imgname, nil }, fn)
+}
+
+func (r *Reader) lineDetails(imgs fs.FS, imgPath func(string) (string, error), fn func(line.Detail) error) error {
+	for {
+		p, err := r.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		lines, err := r.pageLineDetails(p, imgs, imgPath)
+		if err != nil {
+			return err
+		}
+		for _, l := range lines {
+			err = fn(l)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
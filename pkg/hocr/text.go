@@ -0,0 +1,289 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package hocr
+
+import (
+	"image"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TextMode is a way of laying out the text of a page
+type TextMode int
+
+const (
+	TextLines      TextMode = iota // each line on its own
+	TextParagraphs                 // with a blank line between paragraphs
+	TextLayout                     // placed on a grid of characters by position
+)
+
+// TextOptions control how the text of a page is written by PageText
+type TextOptions struct {
+	Mode TextMode
+	// Dehyphenate joins words which are hyphenated across the end
+	// of a line. It is ignored in TextLayout mode.
+	Dehyphenate bool
+}
+
+// hyphens are the characters which can end a line in the middle of
+// a word, including ¬ and ⸗, which are common in historical print
+var hyphens = "-¬⸗\u00ad\u2010"
+
+// PageText returns the text of a page according to opts, with each
+// line followed by a newline
+func PageText(p Page, opts TextOptions) string {
+	if opts.Mode == TextLayout {
+		return layoutText(p)
+	}
+
+	var pars [][]string
+	for _, par := range p.Paragraphs() {
+		var lines []string
+		for _, l := range par.Lines {
+			lines = append(lines, LineText(l))
+		}
+		pars = append(pars, lines)
+	}
+	if opts.Dehyphenate {
+		var lines []*string
+		for i := range pars {
+			for j := range pars[i] {
+				lines = append(lines, &pars[i][j])
+			}
+		}
+		dehyphenate(lines)
+	}
+
+	var s strings.Builder
+	for _, lines := range pars {
+		n := 0
+		for _, l := range lines {
+			if l == "" && opts.Dehyphenate {
+				continue
+			}
+			s.WriteString(l + "\n")
+			n++
+		}
+		if opts.Mode == TextParagraphs && n > 0 {
+			s.WriteString("\n")
+		}
+	}
+	return s.String()
+}
+
+// TrimHyphen returns a line of text without its final hyphen, if
+// it ends with a word hyphenated onto the next line, and whether it
+// did
+func TrimHyphen(l string) (string, bool) {
+	last, size := utf8.DecodeLastRuneInString(l)
+	if !strings.ContainsRune(hyphens, last) {
+		return l, false
+	}
+	before, _ := utf8.DecodeLastRuneInString(l[:len(l)-size])
+	if !unicode.IsLetter(before) {
+		return l, false
+	}
+	return l[:len(l)-size], true
+}
+
+// ParagraphText returns the text of a paragraph as a single line.
+// Words hyphenated across lines are joined, without the hyphen if
+// dehyphenate is set.
+func ParagraphText(par Paragraph, dehyphenate bool) string {
+	var text string
+	for _, l := range par.Lines {
+		t := strings.TrimSpace(LineText(l))
+		if t == "" {
+			continue
+		}
+		trimmed, hyphenated := TrimHyphen(text)
+		switch {
+		case text == "":
+			text = t
+		case hyphenated && dehyphenate:
+			text = trimmed + t
+		case hyphenated:
+			text += t
+		default:
+			text += " " + t
+		}
+	}
+	return text
+}
+
+// dehyphenate joins each word hyphenated at the end of a line with
+// the first word of the following line, leaving any lines which
+// become empty as empty strings
+func dehyphenate(lines []*string) {
+	for i := 0; i+1 < len(lines); i++ {
+		l, ok := TrimHyphen(*lines[i])
+		next := strings.TrimLeft(*lines[i+1], " ")
+		if !ok || next == "" {
+			continue
+		}
+		word, rest := next, ""
+		if n := strings.IndexRune(next, ' '); n >= 0 {
+			word, rest = next[:n], strings.TrimLeft(next[n:], " ")
+		}
+		*lines[i] = l + word
+		*lines[i+1] = rest
+	}
+}
+
+// layoutText places the words of a page on a grid of characters,
+// according to their bboxes, so that the text keeps the layout of
+// the page, such as columns. The size of each character on the
+// grid is the typical size of the characters and the spacing of the
+// lines on the page. Any lines without a bbox are put at the end.
+func layoutText(p Page) string {
+	type boxedLine struct {
+		line *OcrLine
+		bbox image.Rectangle
+	}
+	var lines []boxedLine
+	var nobox []string
+	var widths, heights, gaps []float64
+	for _, par := range p.Paragraphs() {
+		prev := -1
+		for i := range par.Lines {
+			l := &par.Lines[i]
+			props, err := l.Properties()
+			if err != nil || props.Bbox.Empty() {
+				if t := LineText(*l); t != "" {
+					nobox = append(nobox, t)
+				}
+				continue
+			}
+			lines = append(lines, boxedLine{l, props.Bbox})
+			heights = append(heights, float64(props.Bbox.Dy()))
+			y := (props.Bbox.Min.Y + props.Bbox.Max.Y) / 2
+			if prev >= 0 && y > prev {
+				gaps = append(gaps, float64(y-prev))
+			}
+			prev = y
+			for _, w := range l.Words {
+				wprops, err := w.Properties()
+				n := utf8.RuneCountInString(WordText(w))
+				if err != nil || wprops.Bbox.Empty() || n == 0 {
+					continue
+				}
+				widths = append(widths, float64(wprops.Bbox.Dx())/float64(n))
+			}
+		}
+	}
+	if len(lines) == 0 {
+		return PageText(p, TextOptions{})
+	}
+
+	// lines often overlap, so the spacing between them in a
+	// paragraph is a better measure of their height
+	lineh := median(gaps)
+	if lineh == 0 {
+		lineh = median(heights)
+	}
+	charw := median(widths)
+	if charw == 0 {
+		charw = lineh / 2
+	}
+	origin := lines[0].bbox.Min
+	for _, l := range lines {
+		if l.bbox.Min.X < origin.X {
+			origin.X = l.bbox.Min.X
+		}
+		if l.bbox.Min.Y < origin.Y {
+			origin.Y = l.bbox.Min.Y
+		}
+	}
+	col := func(x int) int {
+		return int(math.Round(float64(x-origin.X) / charw))
+	}
+
+	var rows [][]rune
+	for _, l := range lines {
+		// lay out the words of the line, starting at the column
+		// of the line
+		start := col(l.bbox.Min.X)
+		var text []rune
+		if noText(l.line.Text) {
+			for _, w := range l.line.Words {
+				t := WordText(w)
+				if t == "" {
+					continue
+				}
+				c := len(text)
+				if c > 0 {
+					c++
+				}
+				if props, err := w.Properties(); err == nil && !props.Bbox.Empty() && col(props.Bbox.Min.X)-start > c {
+					c = col(props.Bbox.Min.X) - start
+				}
+				text = append(text, []rune(strings.Repeat(" ", c-len(text))+t)...)
+			}
+		} else {
+			text = []rune(LineText(*l.line))
+		}
+		if len(text) == 0 {
+			continue
+		}
+
+		// use the first row from the line's position down which has
+		// space for it
+		centre := (l.bbox.Min.Y + l.bbox.Max.Y) / 2
+		r := int(math.Floor(float64(centre-origin.Y) / lineh))
+		for ; !rowFree(rows, r, start, start+len(text)); r++ {
+		}
+		for len(rows) <= r {
+			rows = append(rows, nil)
+		}
+		for len(rows[r]) < start+len(text) {
+			rows[r] = append(rows[r], ' ')
+		}
+		copy(rows[r][start:], text)
+	}
+
+	var s strings.Builder
+	blank := true
+	for _, row := range rows {
+		t := strings.TrimRight(string(row), " ")
+		if t == "" && blank {
+			continue
+		}
+		blank = t == ""
+		s.WriteString(t + "\n")
+	}
+	for _, t := range nobox {
+		s.WriteString(t + "\n")
+	}
+	if t := strings.TrimRight(s.String(), "\n"); t != "" {
+		return t + "\n"
+	}
+	return ""
+}
+
+// rowFree returns whether there is space in a row of the grid for
+// text between columns start and end, leaving a gap on either side
+func rowFree(rows [][]rune, r, start, end int) bool {
+	if r >= len(rows) {
+		return true
+	}
+	for i := start - 1; i <= end && i < len(rows[r]); i++ {
+		if i >= 0 && rows[r][i] != ' ' {
+			return false
+		}
+	}
+	return true
+}
+
+func median(f []float64) float64 {
+	if len(f) == 0 {
+		return 0
+	}
+	s := append([]float64{}, f...)
+	sort.Float64s(s)
+	return s[len(s)/2]
+}
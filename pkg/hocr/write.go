@@ -0,0 +1,407 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package hocr
+
+import (
+	"bufio"
+	"encoding/xml"
+	"io"
+	"strings"
+)
+
+const xhtmlNS = "http://www.w3.org/1999/xhtml"
+const xmlNS = "http://www.w3.org/XML/1998/namespace"
+
+const docHeader = `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html PUBLIC "-//W3C//DTD XHTML 1.0 Transitional//EN"
+    "http://www.w3.org/TR/xhtml1/DTD/xhtml1-transitional.dtd">
+`
+
+var textEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+var attrEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;", `"`, "&quot;",
+	"\n", "&#xA;", "\r", "&#xD;", "\t", "&#x9;")
+
+// Encoder writes hOCR documents to an output stream. Any markup
+// which was parsed from the original document but isn't part of
+// the document model, such as the document head, unknown elements
+// and attributes, is written back out alongside the model.
+type Encoder struct {
+	w        *bufio.Writer
+	err      error
+	prefixes map[string]string
+}
+
+// NewEncoder returns a new Encoder that writes to w
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: bufio.NewWriter(w)}
+}
+
+// Write writes h to w as an XHTML hOCR document
+func Write(w io.Writer, h Hocr) error {
+	return NewEncoder(w).Encode(h)
+}
+
+// Encode writes h to the stream as an XHTML hOCR document
+func (e *Encoder) Encode(h Hocr) error {
+	e.prefixes = make(map[string]string)
+	for _, a := range h.doc.html.attrs {
+		if a.Name.Space == "xmlns" {
+			e.prefixes[a.Value] = a.Name.Local
+		}
+	}
+
+	e.print(docHeader)
+	for _, c := range h.doc.prolog {
+		e.node(c)
+		e.print("\n")
+	}
+
+	attrs := h.doc.html.attrs
+	hasNS := false
+	for _, a := range attrs {
+		if a.Name.Space == "" && a.Name.Local == "xmlns" {
+			hasNS = true
+		}
+	}
+	if !hasNS {
+		attrs = append([]xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: xhtmlNS}}, attrs...)
+	}
+	e.startTag(defaultName(h.doc.html.name, "html"), attrs, false)
+
+	e.head(h)
+
+	e.indent(1)
+	e.startTag(defaultName(h.doc.body.name, "body"), h.doc.body.attrs, false)
+	for i, p := range h.Pages {
+		e.extras(h.doc.body, i, 2)
+		e.page(p, 2)
+	}
+	e.trailing(h.doc.body, len(h.Pages), 2)
+	e.indent(1)
+	e.endTag(defaultName(h.doc.body.name, "body"))
+
+	e.trailing(h.doc.html, 0, 1)
+	e.print("\n")
+	e.endTag(defaultName(h.doc.html.name, "html"))
+	e.print("\n")
+
+	if e.err != nil {
+		return e.err
+	}
+	return e.w.Flush()
+}
+
+// head writes the head of the document
+func (e *Encoder) head(h Hocr) {
+	name := defaultName(h.doc.head.name, "head")
+	e.indent(1)
+	e.startTag(name, h.doc.head.attrs, false)
+	e.indent(2)
+	e.print("<title>")
+	e.text(h.Title)
+	e.print("</title>")
+	meta := h.Meta
+	if h.MetaContent("ocr-capabilities") == "" {
+		meta = append(meta, Meta{"ocr-capabilities", capabilities(h)})
+	}
+	for _, m := range meta {
+		e.indent(2)
+		e.print(`<meta name="` + attrEscaper.Replace(m.Name) + `" content="` + attrEscaper.Replace(m.Content) + `" />`)
+	}
+	if h.doc.head.name.Local == "" {
+		// this goes after the named metadata, where it will be
+		// written again as extra markup if the output is read back
+		e.indent(2)
+		e.print(`<meta http-equiv="Content-Type" content="text/html;charset=utf-8" />`)
+	}
+	e.trailing(h.doc.head, 0, 2)
+	e.indent(1)
+	e.endTag(name)
+}
+
+// capabilities returns the hOCR capabilities used by a document
+func capabilities(h Hocr) string {
+	var caps []string
+	seen := make(map[string]bool)
+	add := func(class string, title string) {
+		for _, c := range strings.Fields(class) {
+			if !seen[c] {
+				seen[c] = true
+				caps = append(caps, c)
+			}
+		}
+		if !seen["ocrp_wconf"] && strings.Contains(title, "x_wconf") {
+			seen["ocrp_wconf"] = true
+			caps = append(caps, "ocrp_wconf")
+		}
+	}
+	for _, p := range h.Pages {
+		add(p.Class, p.Title)
+		for _, a := range p.Areas {
+			add(a.Class, a.Title)
+			for _, par := range a.Paragraphs {
+				add(par.Class, par.Title)
+				for _, l := range par.Lines {
+					add(l.Class, l.Title)
+					for _, w := range l.Words {
+						add(w.Class, w.Title)
+						for _, c := range w.Chars {
+							add(c.Class, c.Title)
+						}
+					}
+				}
+			}
+		}
+	}
+	return strings.Join(caps, " ")
+}
+
+func (e *Encoder) page(p Page, depth int) {
+	implicit, d := e.open(p.m, "div", p.Class, p.Id, p.Lang, p.Title, depth)
+	for i, a := range p.Areas {
+		e.extras(p.m, i, d)
+		e.area(a, d)
+	}
+	e.trailing(p.m, len(p.Areas), d)
+	e.close(p.m, "div", implicit, depth, len(p.Areas))
+}
+
+func (e *Encoder) area(a Area, depth int) {
+	implicit, d := e.open(a.m, "div", a.Class, a.Id, a.Lang, a.Title, depth)
+	for i, p := range a.Paragraphs {
+		e.extras(a.m, i, d)
+		e.paragraph(p, d)
+	}
+	e.trailing(a.m, len(a.Paragraphs), d)
+	e.close(a.m, "div", implicit, depth, len(a.Paragraphs))
+}
+
+func (e *Encoder) paragraph(p Paragraph, depth int) {
+	implicit, d := e.open(p.m, "p", p.Class, p.Id, p.Lang, p.Title, depth)
+	for i, l := range p.Lines {
+		e.extras(p.m, i, d)
+		e.line(l, d)
+	}
+	e.trailing(p.m, len(p.Lines), d)
+	e.close(p.m, "p", implicit, depth, len(p.Lines))
+}
+
+func (e *Encoder) line(l OcrLine, depth int) {
+	implicit, d := e.open(l.m, "span", l.Class, l.Id, l.Lang, l.Title, depth)
+	if len(l.Words) == 0 {
+		e.content(l.m, nil, l.Text, d)
+		if !implicit {
+			e.endTag(defaultName(l.m.name, "span"))
+		}
+		return
+	}
+	for i, w := range l.Words {
+		e.extras(l.m, i, d)
+		e.word(w, d)
+	}
+	e.trailing(l.m, len(l.Words), d)
+	e.close(l.m, "span", implicit, depth, len(l.Words))
+}
+
+func (e *Encoder) word(w OcrWord, depth int) {
+	e.indent(depth)
+	e.startTag(defaultName(w.m.name, "span"), modelAttrs(w.m.attrs, w.Class, w.Id, w.Lang, w.Title), false)
+	e.content(w.m, w.Chars, w.Text, depth)
+	e.endTag(defaultName(w.m.name, "span"))
+}
+
+func (e *Encoder) char(c OcrChar, depth int) {
+	e.indent(depth)
+	e.startTag(defaultName(c.m.name, "span"), modelAttrs(c.m.attrs, c.Class, c.Id, c.Lang, c.Title), false)
+	e.content(c.m, c.Chars, c.Text, depth)
+	e.endTag(defaultName(c.m.name, "span"))
+}
+
+// content writes the content of a line, word or character, which
+// is either a set of characters or some text. The original markup
+// is used if the text is unchanged, so that any formatting such as
+// <em> or <strong> is retained.
+func (e *Encoder) content(m markup, chars []OcrChar, text string, depth int) {
+	if len(chars) > 0 {
+		for i, c := range chars {
+			e.extras(m, i, depth+1)
+			e.char(c, depth+1)
+		}
+		e.trailing(m, len(chars), depth+1)
+		e.indent(depth)
+		return
+	}
+	if m.inner != nil && text == m.text {
+		for _, n := range m.inner {
+			e.node(n)
+		}
+		return
+	}
+	e.text(text)
+}
+
+// open writes the start of a model element, unless it is an
+// implicit container, returning whether it was implicit and the
+// depth at which its children should be written.
+func (e *Encoder) open(m markup, name string, class, id, lang, title string, depth int) (bool, int) {
+	if m.name.Local == "" && class == "" && id == "" && lang == "" && title == "" {
+		return true, depth
+	}
+	e.indent(depth)
+	e.startTag(defaultName(m.name, name), modelAttrs(m.attrs, class, id, lang, title), false)
+	return false, depth + 1
+}
+
+// close writes the end of a model element opened with open. An
+// element with no children is closed on the same line.
+func (e *Encoder) close(m markup, name string, implicit bool, depth int, children int) {
+	if implicit {
+		return
+	}
+	if children > 0 || len(m.extra) > 0 {
+		e.indent(depth)
+	}
+	e.endTag(defaultName(m.name, name))
+}
+
+// extras writes any extra markup at position pos
+func (e *Encoder) extras(m markup, pos int, depth int) {
+	for _, x := range m.extra {
+		if x.pos == pos {
+			e.indent(depth)
+			e.node(x.node)
+		}
+	}
+}
+
+// trailing writes any extra markup at or after position pos
+func (e *Encoder) trailing(m markup, pos int, depth int) {
+	for _, x := range m.extra {
+		if x.pos >= pos {
+			e.indent(depth)
+			e.node(x.node)
+		}
+	}
+}
+
+// modelAttrs returns the attributes for an element, with the class,
+// id, lang and title values taken from the model, and any other
+// attributes from the original markup.
+func modelAttrs(orig []xml.Attr, class, id, lang, title string) []xml.Attr {
+	names := []string{"class", "id", "lang", "title"}
+	vals := map[string]string{"class": class, "id": id, "lang": lang, "title": title}
+	done := make(map[string]bool)
+	var attrs []xml.Attr
+	for _, a := range orig {
+		v, ok := vals[a.Name.Local]
+		if !ok || (a.Name.Space != "" && a.Name.Space != xmlNS) {
+			attrs = append(attrs, a)
+			continue
+		}
+		done[a.Name.Local] = true
+		if v == "" {
+			continue
+		}
+		a.Value = v
+		attrs = append(attrs, a)
+	}
+	for _, n := range names {
+		if !done[n] && vals[n] != "" {
+			attrs = append(attrs, xml.Attr{Name: xml.Name{Local: n}, Value: vals[n]})
+		}
+	}
+	return attrs
+}
+
+func defaultName(n xml.Name, def string) xml.Name {
+	if n.Local == "" {
+		return xml.Name{Local: def}
+	}
+	return n
+}
+
+// node writes a generic piece of markup
+func (e *Encoder) node(n interface{}) {
+	switch n := n.(type) {
+	case *element:
+		empty := len(n.children) == 0
+		void := false
+		for _, v := range xml.HTMLAutoClose {
+			if n.name.Local == v {
+				void = true
+			}
+		}
+		e.startTag(n.name, n.attrs, empty && void)
+		if empty && void {
+			return
+		}
+		for _, c := range n.children {
+			e.node(c)
+		}
+		e.endTag(n.name)
+	case xml.CharData:
+		e.text(string(n))
+	case xml.Comment:
+		e.print("<!--" + string(n) + "-->")
+	case xml.ProcInst:
+		e.print("<?" + n.Target + " " + string(n.Inst) + "?>")
+	case xml.Directive:
+		e.print("<!" + string(n) + ">")
+	}
+}
+
+// qname returns the qualified name to write for n
+func (e *Encoder) qname(n xml.Name) string {
+	switch {
+	case n.Space == "":
+		return n.Local
+	case n.Space == "xmlns":
+		return "xmlns:" + n.Local
+	case n.Space == xmlNS:
+		return "xml:" + n.Local
+	}
+	if p, ok := e.prefixes[n.Space]; ok {
+		return p + ":" + n.Local
+	}
+	if strings.ContainsAny(n.Space, ":/") {
+		// a namespace with no prefix is the default namespace
+		return n.Local
+	}
+	return n.Space + ":" + n.Local
+}
+
+func (e *Encoder) startTag(n xml.Name, attrs []xml.Attr, empty bool) {
+	var s strings.Builder
+	s.WriteString("<" + e.qname(n))
+	for _, a := range attrs {
+		s.WriteString(" " + e.qname(a.Name) + `="` + attrEscaper.Replace(a.Value) + `"`)
+	}
+	if empty {
+		s.WriteString(" />")
+	} else {
+		s.WriteString(">")
+	}
+	e.print(s.String())
+}
+
+func (e *Encoder) endTag(n xml.Name) {
+	e.print("</" + e.qname(n) + ">")
+}
+
+func (e *Encoder) indent(depth int) {
+	e.print("\n" + strings.Repeat(" ", depth))
+}
+
+func (e *Encoder) text(s string) {
+	e.print(textEscaper.Replace(s))
+}
+
+func (e *Encoder) print(s string) {
+	if e.err != nil {
+		return
+	}
+	_, e.err = e.w.WriteString(s)
+}
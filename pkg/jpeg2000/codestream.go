@@ -0,0 +1,582 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package jpeg2000
+
+import (
+	"encoding/binary"
+)
+
+// Markers of the codestream, as defined in Annex A of T.800
+const (
+	markerSOC = 0xff4f
+	markerCAP = 0xff50
+	markerSIZ = 0xff51
+	markerCOD = 0xff52
+	markerCOC = 0xff53
+	markerQCD = 0xff5c
+	markerQCC = 0xff5d
+	markerRGN = 0xff5e
+	markerPOC = 0xff5f
+	markerPPM = 0xff60
+	markerPPT = 0xff61
+	markerSOT = 0xff90
+	markerSOP = 0xff91
+	markerEPH = 0xff92
+	markerSOD = 0xff93
+	markerEOC = 0xffd9
+)
+
+// Progression orders
+const (
+	orderLRCP = iota
+	orderRLCP
+	orderRPCL
+	orderPCRL
+	orderCPRL
+)
+
+// Code-block styles
+const (
+	styleBypass  = 0x01
+	styleReset   = 0x02
+	styleTermAll = 0x04
+	styleCausal  = 0x08
+	styleSegMark = 0x20
+	styleHT      = 0x40
+)
+
+// maxSamples is the largest number of samples in an image which
+// will be decoded, to avoid running out of memory on bad input
+const maxSamples = 1 << 28
+
+// component is the precision and subsampling of an image component
+type component struct {
+	prec   int
+	signed bool
+	dx, dy int
+}
+
+// siz is the size of the image and its tiles, from the SIZ marker
+type siz struct {
+	rsiz     int
+	x1, y1   int // the size of the reference grid
+	x0, y0   int // the offset of the image on the reference grid
+	tw, th   int // the size of the tiles
+	tx0, ty0 int // the offset of the first tile
+	comps    []component
+}
+
+func (s *siz) tilesX() int {
+	return ceilDiv(s.x1-s.tx0, s.tw)
+}
+
+func (s *siz) tilesY() int {
+	return ceilDiv(s.y1-s.ty0, s.th)
+}
+
+// compStyle is the coding style of a tile-component, from a COD or
+// COC marker
+type compStyle struct {
+	levels     int // decomposition levels
+	cbw, cbh   int // code-block width and height exponents
+	cbStyle    byte
+	reversible bool
+	ppx, ppy   []int // precinct size exponents of each resolution
+}
+
+// codingStyle is the coding style of a tile, from a COD marker
+type codingStyle struct {
+	sop, eph bool
+	order    int
+	layers   int
+	mct      bool
+	comp     compStyle
+}
+
+// quantization is the quantization of a tile-component, from a QCD
+// or QCC marker
+type quantization struct {
+	style int // 0 for none, 1 for scalar derived, 2 for scalar expounded
+	guard int
+	exps  []int
+	mants []int
+}
+
+// progression is a progression order change, from a POC marker,
+// covering resolutions rs to re and components cs to ce, not
+// including the ends, and layers up to le
+type progression struct {
+	rs, cs     int
+	le, re, ce int
+	order      int
+}
+
+// tileHeader is the coding style, quantization, region of interest
+// and progression order changes set in a main or tile-part header
+type tileHeader struct {
+	cod  *codingStyle
+	cocs []*compStyle
+	qcd  *quantization
+	qccs []*quantization
+	rgn  []int // shift of each component, or -1 if not set
+	poc  []progression
+}
+
+func newTileHeader(ncomp int) tileHeader {
+	h := tileHeader{
+		cocs: make([]*compStyle, ncomp),
+		qccs: make([]*quantization, ncomp),
+		rgn:  make([]int, ncomp),
+	}
+	for i := range h.rgn {
+		h.rgn[i] = -1
+	}
+	return h
+}
+
+// tileInfo collects the headers and data of the tile-parts of a tile
+type tileInfo struct {
+	tileHeader
+	parts   int
+	data    []byte
+	packed  bool   // whether packet headers are in PPM or PPT markers
+	headers []byte // packet headers from PPM or PPT markers
+}
+
+// codestream is the parsed headers of a codestream, with the data of
+// each tile
+type codestream struct {
+	siz
+	main  tileHeader
+	ppm   []byte
+	tiles []*tileInfo
+}
+
+func ceilDiv(a, b int) int {
+	return (a + b - 1) / b
+}
+
+func u16(b []byte) int {
+	return int(binary.BigEndian.Uint16(b))
+}
+
+func u32(b []byte) int {
+	return int(binary.BigEndian.Uint32(b))
+}
+
+// markerSegment returns the marker at pos in b and its segment, not
+// including the length, and the position after it
+func markerSegment(b []byte, pos int) (int, []byte, int, error) {
+	if pos+2 > len(b) {
+		return 0, nil, pos, FormatError("missing marker")
+	}
+	m := u16(b[pos:])
+	if m>>8 != 0xff {
+		return 0, nil, pos, FormatError("missing marker")
+	}
+	switch {
+	case m == markerSOC, m == markerSOD, m == markerEOC, m == markerEPH, m >= 0xff30 && m <= 0xff3f:
+		return m, nil, pos + 2, nil
+	}
+	if pos+4 > len(b) {
+		return m, nil, pos, FormatError("truncated marker segment")
+	}
+	n := u16(b[pos+2:])
+	if n < 2 || pos+2+n > len(b) {
+		return m, nil, pos, FormatError("truncated marker segment")
+	}
+	return m, b[pos+4 : pos+2+n], pos + 2 + n, nil
+}
+
+// readSIZ parses a SIZ marker segment
+func readSIZ(b []byte) (siz, error) {
+	var s siz
+	if len(b) < 36 {
+		return s, FormatError("SIZ marker too short")
+	}
+	s.rsiz = u16(b)
+	s.x1, s.y1 = u32(b[2:]), u32(b[6:])
+	s.x0, s.y0 = u32(b[10:]), u32(b[14:])
+	s.tw, s.th = u32(b[18:]), u32(b[22:])
+	s.tx0, s.ty0 = u32(b[26:]), u32(b[30:])
+	n := u16(b[34:])
+	if n == 0 || len(b) < 36+3*n {
+		return s, FormatError("bad number of components")
+	}
+	if s.x1 <= s.x0 || s.y1 <= s.y0 || s.tw == 0 || s.th == 0 ||
+		s.tx0 > s.x0 || s.ty0 > s.y0 || s.tx0+s.tw <= s.x0 || s.ty0+s.th <= s.y0 {
+		return s, FormatError("bad image or tile size")
+	}
+	if s.tilesX() > 65535 || s.tilesY() > 65535 || s.tilesX()*s.tilesY() > 65535 {
+		return s, FormatError("too many tiles")
+	}
+	if s.rsiz&0x4000 != 0 {
+		return s, UnsupportedError("high throughput codestream")
+	}
+	for i := 0; i < n; i++ {
+		c := b[36+3*i:]
+		comp := component{prec: int(c[0]&0x7f) + 1, signed: c[0]&0x80 != 0, dx: int(c[1]), dy: int(c[2])}
+		if comp.dx == 0 || comp.dy == 0 {
+			return s, FormatError("bad component subsampling")
+		}
+		if comp.prec > 16 {
+			return s, UnsupportedError("components of more than 16 bits")
+		}
+		if ceilDiv(s.x1, comp.dx) == ceilDiv(s.x0, comp.dx) || ceilDiv(s.y1, comp.dy) == ceilDiv(s.y0, comp.dy) {
+			return s, FormatError("component with no samples")
+		}
+		s.comps = append(s.comps, comp)
+	}
+	if s.x1-s.x0 > maxSamples || s.y1-s.y0 > maxSamples || (s.x1-s.x0)*(s.y1-s.y0)*n > maxSamples {
+		return s, UnsupportedError("image too large")
+	}
+	return s, nil
+}
+
+// readCompStyle parses the coding style of a component, as in the
+// SPcod and SPcoc parameters of COD and COC markers
+func readCompStyle(b []byte, precincts bool) (compStyle, error) {
+	var s compStyle
+	if len(b) < 5 {
+		return s, FormatError("coding style too short")
+	}
+	s.levels = int(b[0])
+	s.cbw, s.cbh = int(b[1])+2, int(b[2])+2
+	s.cbStyle = b[3]
+	if s.levels > 32 || s.cbw > 10 || s.cbh > 10 || s.cbw+s.cbh > 12 {
+		return s, FormatError("bad coding style")
+	}
+	if s.cbStyle&styleHT != 0 {
+		return s, UnsupportedError("high throughput code-blocks")
+	}
+	switch b[4] {
+	case 0:
+	case 1:
+		s.reversible = true
+	default:
+		return s, UnsupportedError("custom wavelet transform")
+	}
+	s.ppx = make([]int, s.levels+1)
+	s.ppy = make([]int, s.levels+1)
+	for r := range s.ppx {
+		s.ppx[r], s.ppy[r] = 15, 15
+		if !precincts {
+			continue
+		}
+		if len(b) < 6+r {
+			return s, FormatError("missing precinct size")
+		}
+		s.ppx[r], s.ppy[r] = int(b[5+r]&0xf), int(b[5+r]>>4)
+		if r > 0 && (s.ppx[r] == 0 || s.ppy[r] == 0) {
+			return s, FormatError("bad precinct size")
+		}
+	}
+	return s, nil
+}
+
+// readCOD parses a COD marker segment
+func readCOD(b []byte) (*codingStyle, error) {
+	if len(b) < 5 {
+		return nil, FormatError("COD marker too short")
+	}
+	c := &codingStyle{
+		sop:    b[0]&2 != 0,
+		eph:    b[0]&4 != 0,
+		order:  int(b[1]),
+		layers: u16(b[2:]),
+		mct:    b[4] != 0,
+	}
+	if c.order > orderCPRL || c.layers == 0 {
+		return nil, FormatError("bad coding style")
+	}
+	var err error
+	c.comp, err = readCompStyle(b[5:], b[0]&1 != 0)
+	return c, err
+}
+
+// readComp reads a component index, which takes two bytes if there
+// are more than 256 components
+func readComp(b []byte, ncomp int) (int, []byte, error) {
+	var c int
+	switch {
+	case ncomp <= 256 && len(b) >= 1:
+		c, b = int(b[0]), b[1:]
+	case ncomp > 256 && len(b) >= 2:
+		c, b = u16(b), b[2:]
+	default:
+		return 0, b, FormatError("marker too short")
+	}
+	if c >= ncomp {
+		return 0, b, FormatError("bad component index")
+	}
+	return c, b, nil
+}
+
+// readQuantization parses the quantization parameters of a QCD or
+// QCC marker
+func readQuantization(b []byte) (*quantization, error) {
+	if len(b) < 1 {
+		return nil, FormatError("quantization marker too short")
+	}
+	q := &quantization{style: int(b[0] & 0x1f), guard: int(b[0] >> 5)}
+	b = b[1:]
+	switch q.style {
+	case 0:
+		for _, v := range b {
+			q.exps = append(q.exps, int(v>>3))
+			q.mants = append(q.mants, 0)
+		}
+	case 1, 2:
+		for ; len(b) >= 2; b = b[2:] {
+			v := u16(b)
+			q.exps = append(q.exps, v>>11)
+			q.mants = append(q.mants, v&0x7ff)
+		}
+	default:
+		return nil, FormatError("bad quantization style")
+	}
+	if len(q.exps) == 0 {
+		return nil, FormatError("missing quantization step sizes")
+	}
+	return q, nil
+}
+
+// step returns the exponent and mantissa of the quantization step
+// size of band i, counting from the LL band, which is at level nb
+// of a component with levels decomposition levels
+func (q *quantization) step(i, levels, nb int) (int, int, error) {
+	if q.style == 1 {
+		e := q.exps[0] - levels + nb
+		if e < 0 {
+			return 0, 0, FormatError("bad quantization step size")
+		}
+		return e, q.mants[0], nil
+	}
+	if i >= len(q.exps) {
+		return 0, 0, FormatError("missing quantization step size")
+	}
+	return q.exps[i], q.mants[i], nil
+}
+
+// readPOC parses a POC marker segment
+func readPOC(b []byte, ncomp int) ([]progression, error) {
+	n := 7
+	if ncomp > 256 {
+		n = 9
+	}
+	if len(b) < n || len(b)%n != 0 {
+		return nil, FormatError("bad POC marker")
+	}
+	var poc []progression
+	for ; len(b) >= n; b = b[n:] {
+		var p progression
+		p.rs = int(b[0])
+		if n == 7 {
+			p.cs, p.le, p.re, p.ce, p.order = int(b[1]), u16(b[2:]), int(b[4]), int(b[5]), int(b[6])
+			if p.ce == 0 {
+				p.ce = 256
+			}
+		} else {
+			p.cs, p.le, p.re, p.ce, p.order = u16(b[1:]), u16(b[3:]), int(b[5]), u16(b[6:]), int(b[8])
+			if p.ce == 0 {
+				p.ce = 16384
+			}
+		}
+		if p.order > orderCPRL {
+			return nil, FormatError("bad progression order")
+		}
+		poc = append(poc, p)
+	}
+	return poc, nil
+}
+
+// readHeader parses a marker segment which can be in a main or
+// tile-part header, reporting whether the marker was recognised
+func (h *tileHeader) readHeader(m int, b []byte, ncomp int) (bool, error) {
+	var err error
+	switch m {
+	case markerCOD:
+		h.cod, err = readCOD(b)
+	case markerCOC:
+		var c int
+		c, b, err = readComp(b, ncomp)
+		if err != nil {
+			return true, err
+		}
+		if len(b) < 1 {
+			return true, FormatError("COC marker too short")
+		}
+		var s compStyle
+		s, err = readCompStyle(b[1:], b[0]&1 != 0)
+		h.cocs[c] = &s
+	case markerQCD:
+		h.qcd, err = readQuantization(b)
+	case markerQCC:
+		var c int
+		c, b, err = readComp(b, ncomp)
+		if err != nil {
+			return true, err
+		}
+		h.qccs[c], err = readQuantization(b)
+	case markerRGN:
+		var c int
+		c, b, err = readComp(b, ncomp)
+		if err != nil {
+			return true, err
+		}
+		if len(b) < 2 || b[0] != 0 {
+			return true, FormatError("bad RGN marker")
+		}
+		h.rgn[c] = int(b[1])
+	case markerPOC:
+		var poc []progression
+		poc, err = readPOC(b, ncomp)
+		h.poc = append(h.poc, poc...)
+	default:
+		return false, nil
+	}
+	return true, err
+}
+
+// readCodestream parses a codestream, reading just the SIZ marker if
+// sizeOnly is set. A codestream which is cut short is read as far
+// as it goes.
+func readCodestream(b []byte, sizeOnly bool) (*codestream, error) {
+	if len(b) < 2 || u16(b) != markerSOC {
+		return nil, FormatError("missing SOC marker")
+	}
+	m, seg, pos, err := markerSegment(b, 2)
+	if err != nil {
+		return nil, err
+	}
+	if m != markerSIZ {
+		return nil, FormatError("missing SIZ marker")
+	}
+	cs := &codestream{}
+	cs.siz, err = readSIZ(seg)
+	if err != nil || sizeOnly {
+		return cs, err
+	}
+	ncomp := len(cs.comps)
+	cs.main = newTileHeader(ncomp)
+	cs.tiles = make([]*tileInfo, cs.tilesX()*cs.tilesY())
+
+	for {
+		m, seg, next, err := markerSegment(b, pos)
+		if err != nil {
+			return nil, err
+		}
+		if m == markerSOT {
+			break
+		}
+		pos = next
+		ok, err := cs.main.readHeader(m, seg, ncomp)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case ok:
+		case m == markerPPM:
+			if len(seg) > 0 {
+				cs.ppm = append(cs.ppm, seg[1:]...)
+			}
+		case m == markerCAP:
+			return nil, UnsupportedError("high throughput codestream")
+		}
+	}
+	if cs.main.cod == nil || cs.main.qcd == nil {
+		return nil, FormatError("missing COD or QCD marker")
+	}
+
+	ppm := cs.ppm
+	for pos < len(b) {
+		sot := pos
+		m, seg, next, err := markerSegment(b, pos)
+		if m == markerEOC {
+			break
+		}
+		if err != nil || m != markerSOT || len(seg) < 8 {
+			if cs.hasTiles() {
+				// ignore anything after the data which was read
+				break
+			}
+			return nil, FormatError("missing SOT marker")
+		}
+		t, length, part := u16(seg), u32(seg[2:]), int(seg[6])
+		if t >= len(cs.tiles) {
+			return nil, FormatError("bad tile index")
+		}
+		end := len(b)
+		if length != 0 && sot+length < end {
+			end = sot + length
+		} else if length == 0 && u16(b[end-2:]) == markerEOC {
+			end -= 2
+		}
+		info := cs.tiles[t]
+		if info == nil {
+			info = &tileInfo{tileHeader: newTileHeader(ncomp)}
+			cs.tiles[t] = info
+		}
+
+		for pos = next; ; {
+			m, seg, next, err := markerSegment(b, pos)
+			if err != nil {
+				return nil, err
+			}
+			pos = next
+			if m == markerSOD {
+				break
+			}
+			if m == markerPPT {
+				if len(seg) > 0 {
+					info.headers = append(info.headers, seg[1:]...)
+					info.packed = true
+				}
+				continue
+			}
+			// coding parameters can only be set in the first part
+			if part == 0 {
+				_, err = info.readHeader(m, seg, ncomp)
+				if err != nil {
+					return nil, err
+				}
+			}
+		}
+
+		if len(cs.ppm) > 0 {
+			if len(ppm) < 4 {
+				return nil, FormatError("PPM marker too short")
+			}
+			n := u32(ppm)
+			if n > len(ppm)-4 {
+				return nil, FormatError("PPM marker too short")
+			}
+			info.headers = append(info.headers, ppm[4:4+n]...)
+			info.packed = true
+			ppm = ppm[4+n:]
+		}
+		if pos > end {
+			return nil, FormatError("bad tile-part length")
+		}
+		if info.data == nil {
+			info.data = b[pos:end:end]
+		} else {
+			info.data = append(info.data, b[pos:end]...)
+		}
+		info.parts++
+		pos = end
+	}
+	return cs, nil
+}
+
+// hasTiles reports whether any tile-parts have been read
+func (cs *codestream) hasTiles() bool {
+	for _, t := range cs.tiles {
+		if t != nil {
+			return true
+		}
+	}
+	return false
+}
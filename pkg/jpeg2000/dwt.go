@@ -0,0 +1,108 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package jpeg2000
+
+// Lifting parameters of the irreversible 9-7 wavelet transform
+const (
+	alpha = -1.586134342059924
+	beta  = -0.052980118572961
+	gamma = 0.882911075530934
+	delta = 0.443506852043971
+	kappa = 1.230174104914001
+)
+
+// idwt applies the inverse wavelet transform to the data of a
+// tile-component, each resolution of which holds its LL band in its
+// top left, followed by its HL, LH and HH bands
+func (tc *tileComp) idwt() {
+	w := tc.x1 - tc.x0
+	var buf, line []float32
+	for r := 1; r < len(tc.res); r++ {
+		res := &tc.res[r]
+		rw, rh := res.x1-res.x0, res.y1-res.y0
+		if rw == 0 || rh == 0 {
+			continue
+		}
+		if n := max(rw, rh); len(buf) < n {
+			buf = make([]float32, n)
+			line = make([]float32, n)
+		}
+		prev := &tc.res[r-1]
+		for y := 0; y < rh; y++ {
+			row := tc.data[y*w : y*w+rw]
+			copy(line, row)
+			synthesize(row, line[:rw], prev.x1-prev.x0, res.x0&1, tc.style.reversible)
+		}
+		for x := 0; x < rw; x++ {
+			for y := 0; y < rh; y++ {
+				line[y] = tc.data[y*w+x]
+			}
+			synthesize(buf[:rh], line[:rh], prev.y1-prev.y0, res.y0&1, tc.style.reversible)
+			for y := 0; y < rh; y++ {
+				tc.data[y*w+x] = buf[y]
+			}
+		}
+	}
+}
+
+// synthesize reconstructs a signal in out from its low pass
+// coefficients, which are the first sn of in, and its high pass
+// coefficients, which follow. The signal starts at an odd index if
+// odd is 1.
+func synthesize(out, in []float32, sn, odd int, reversible bool) {
+	n := len(out)
+	if n == 1 {
+		out[0] = in[0]
+		if odd == 1 {
+			if reversible {
+				out[0] = float32(int32(in[0]) / 2)
+			} else {
+				out[0] /= 2
+			}
+		}
+		return
+	}
+	for i := range out {
+		if (i+odd)&1 == 0 {
+			out[i] = in[i/2]
+		} else {
+			out[i] = in[sn+i/2]
+		}
+	}
+	if reversible {
+		for i := odd; i < n; i += 2 {
+			out[i] -= float32((int32(at(out, i-1)) + int32(at(out, i+1)) + 2) >> 2)
+		}
+		for i := 1 - odd; i < n; i += 2 {
+			out[i] += float32((int32(at(out, i-1)) + int32(at(out, i+1))) >> 1)
+		}
+		return
+	}
+	for i := odd; i < n; i += 2 {
+		out[i] *= kappa
+	}
+	for i := 1 - odd; i < n; i += 2 {
+		out[i] /= kappa
+	}
+	for _, step := range []struct {
+		start int
+		k     float32
+	}{{odd, delta}, {1 - odd, gamma}, {odd, beta}, {1 - odd, alpha}} {
+		for i := step.start; i < n; i += 2 {
+			out[i] -= step.k * (at(out, i-1) + at(out, i+1))
+		}
+	}
+}
+
+// at returns the sample at i of a signal which is extended
+// symmetrically past its ends
+func at(s []float32, i int) float32 {
+	if i < 0 {
+		i = -i
+	} else if i >= len(s) {
+		i = 2*(len(s)-1) - i
+	}
+	return s[i]
+}
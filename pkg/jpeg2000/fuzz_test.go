@@ -0,0 +1,41 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+//go:build go1.18
+// +build go1.18
+
+package jpeg2000
+
+import (
+	"bytes"
+	"encoding/base64"
+	"testing"
+)
+
+func FuzzDecode(f *testing.F) {
+	for _, s := range testImages {
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			f.Fatalf("Error decoding test image: %v", err)
+		}
+		f.Add(b)
+	}
+	f.Fuzz(func(t *testing.T, b []byte) {
+		cfg, err := DecodeConfig(bytes.NewReader(b))
+		if err != nil {
+			return
+		}
+		// large images are valid, but too slow to fuzz
+		if cfg.Width*cfg.Height > 1<<20 {
+			return
+		}
+		img, err := Decode(bytes.NewReader(b))
+		if err != nil {
+			return
+		}
+		if s := img.Bounds().Size(); s.X != cfg.Width || s.Y != cfg.Height {
+			t.Errorf("Decoded size %v differs from config %dx%d", s, cfg.Width, cfg.Height)
+		}
+	})
+}
@@ -0,0 +1,493 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+// jpeg2000 decodes JPEG 2000 images, as defined in ITU-T T.800,
+// either as JP2 files or as bare codestreams.
+//
+// Images with one or two components are decoded as gray, and those
+// with more as RGB, ignoring any further components such as alpha.
+// Palettes and sYCC colour are converted, but ICC profiles are
+// ignored. High throughput (HTJ2K) images, and components of more
+// than 16 bits, aren't supported.
+package jpeg2000
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"io"
+	"io/ioutil"
+	"math"
+)
+
+const (
+	jp2Magic = "\x00\x00\x00\x0cjP  \r\n\x87\n"
+	j2kMagic = "\xff\x4f\xff\x51"
+)
+
+func init() {
+	image.RegisterFormat("jpeg2000", jp2Magic, Decode, DecodeConfig)
+	image.RegisterFormat("jpeg2000", j2kMagic, Decode, DecodeConfig)
+}
+
+// FormatError reports that the input isn't a valid JPEG 2000 image
+type FormatError string
+
+func (e FormatError) Error() string {
+	return "invalid JPEG 2000 image: " + string(e)
+}
+
+// UnsupportedError reports that the input uses a feature of JPEG
+// 2000 which isn't supported
+type UnsupportedError string
+
+func (e UnsupportedError) Error() string {
+	return "unsupported JPEG 2000 feature: " + string(e)
+}
+
+// Colour spaces of the colr box
+const (
+	csGray = 17
+	csSYCC = 18
+)
+
+// jp2 is the information from the header box of a JP2 file
+type jp2 struct {
+	colour  int
+	palette [][]int // palette entries of each column
+	bits    []int   // precision of each column of the palette
+	cmap    []cmapEntry
+}
+
+// cmapEntry maps a component, or a column of the palette applied to
+// it, to a channel
+type cmapEntry struct {
+	comp   int
+	column int // or -1 to use the component directly
+}
+
+// plane is the samples of a component or channel, offset to be
+// unsigned
+type plane struct {
+	x0, y0 int // the first sample, in component coordinates
+	w, h   int
+	dx, dy int
+	prec   int
+	pix    []uint16
+}
+
+// readBox reads the header of a box, returning its type and the
+// length of its contents, which is -1 if it runs to the end
+func readBox(r io.Reader) (string, int64, error) {
+	var h [16]byte
+	_, err := io.ReadFull(r, h[:8])
+	if err != nil {
+		return "", 0, err
+	}
+	n := int64(u32(h[:]))
+	switch n {
+	case 0:
+		return string(h[4:8]), -1, nil
+	case 1:
+		_, err = io.ReadFull(r, h[8:])
+		if err != nil {
+			return "", 0, err
+		}
+		n = int64(u32(h[8:]))<<32 | int64(u32(h[12:])) - 16
+	default:
+		n -= 8
+	}
+	if n < 0 {
+		return "", 0, FormatError("bad box length")
+	}
+	return string(h[4:8]), n, nil
+}
+
+// readJP2Header parses the contents of a JP2 header box
+func readJP2Header(b []byte) (*jp2, error) {
+	j := &jp2{}
+	r := bytes.NewReader(b)
+	for r.Len() > 0 {
+		t, n, err := readBox(r)
+		if err != nil {
+			return nil, FormatError("bad JP2 header")
+		}
+		if n < 0 || n > int64(r.Len()) {
+			n = int64(r.Len())
+		}
+		c := make([]byte, n)
+		r.Read(c)
+		switch t {
+		case "colr":
+			if len(c) >= 7 && c[0] == 1 && j.colour == 0 {
+				j.colour = u32(c[3:])
+			}
+		case "pclr":
+			if len(c) < 3 {
+				return nil, FormatError("bad palette")
+			}
+			ne, npc := u16(c), int(c[2])
+			if ne == 0 || npc == 0 || len(c) < 3+npc {
+				return nil, FormatError("bad palette")
+			}
+			j.bits = make([]int, npc)
+			j.palette = make([][]int, npc)
+			size := 0
+			for i := range j.bits {
+				j.bits[i] = int(c[3+i]&0x7f) + 1
+				if j.bits[i] > 16 {
+					return nil, UnsupportedError("palette of more than 16 bits")
+				}
+				size += (j.bits[i] + 7) / 8
+				j.palette[i] = make([]int, ne)
+			}
+			c = c[3+npc:]
+			if len(c) < ne*size {
+				return nil, FormatError("palette too short")
+			}
+			for e := 0; e < ne; e++ {
+				for i, bits := range j.bits {
+					v := int(c[0])
+					if bits > 8 {
+						v = u16(c)
+					}
+					c = c[(bits+7)/8:]
+					j.palette[i][e] = v
+				}
+			}
+		case "cmap":
+			for ; len(c) >= 4; c = c[4:] {
+				e := cmapEntry{comp: u16(c), column: -1}
+				if c[2] == 1 {
+					e.column = int(c[3])
+				}
+				j.cmap = append(j.cmap, e)
+			}
+		}
+	}
+	return j, nil
+}
+
+// readJP2 reads the boxes of a JP2 file up to its codestream box,
+// returning the information from its header and a reader for the
+// codestream
+func readJP2(r io.Reader) (*jp2, io.Reader, error) {
+	var j *jp2
+	for {
+		t, n, err := readBox(r)
+		if err != nil {
+			return nil, nil, FormatError("missing codestream")
+		}
+		switch t {
+		case "jp2h":
+			if n < 0 || n > 1<<24 {
+				return nil, nil, FormatError("bad JP2 header")
+			}
+			b := make([]byte, n)
+			_, err = io.ReadFull(r, b)
+			if err != nil {
+				return nil, nil, FormatError("bad JP2 header")
+			}
+			j, err = readJP2Header(b)
+			if err != nil {
+				return nil, nil, err
+			}
+		case "jp2c":
+			if j == nil {
+				return nil, nil, FormatError("missing JP2 header")
+			}
+			if n >= 0 {
+				r = io.LimitReader(r, n)
+			}
+			return j, r, nil
+		default:
+			if n < 0 {
+				return nil, nil, FormatError("missing codestream")
+			}
+			_, err = io.CopyN(ioutil.Discard, r, n)
+			if err != nil {
+				return nil, nil, FormatError("missing codestream")
+			}
+		}
+	}
+}
+
+// open reads any JP2 boxes before the codestream, returning a reader
+// of the codestream
+func open(r io.Reader) (*jp2, io.Reader, error) {
+	var magic [12]byte
+	_, err := io.ReadFull(r, magic[:4])
+	if err != nil {
+		return nil, nil, FormatError("missing signature")
+	}
+	if string(magic[:4]) == j2kMagic {
+		return nil, io.MultiReader(bytes.NewReader(magic[:4]), r), nil
+	}
+	_, err = io.ReadFull(r, magic[4:])
+	if err != nil || string(magic[:]) != jp2Magic {
+		return nil, nil, FormatError("missing signature")
+	}
+	return readJP2(r)
+}
+
+// channels returns the precision of each channel of the image, and
+// whether they should be shown in colour
+func channels(j *jp2, s *siz) ([]int, bool) {
+	var precs []int
+	if j != nil {
+		for _, e := range j.cmap {
+			if e.column >= 0 && e.column < len(j.bits) {
+				precs = append(precs, j.bits[e.column])
+			} else if e.column < 0 && e.comp < len(s.comps) {
+				precs = append(precs, s.comps[e.comp].prec)
+			}
+		}
+	}
+	if len(precs) == 0 {
+		for _, c := range s.comps {
+			precs = append(precs, c.prec)
+		}
+	}
+	colour := len(precs) >= 3
+	if j != nil && j.colour == csGray {
+		colour = false
+	}
+	if colour {
+		precs = precs[:3]
+	} else {
+		precs = precs[:1]
+	}
+	return precs, colour
+}
+
+// DecodeConfig returns the colour model and size of a JPEG 2000 image
+func DecodeConfig(r io.Reader) (image.Config, error) {
+	var cfg image.Config
+	j, r, err := open(r)
+	if err != nil {
+		return cfg, err
+	}
+	var h [6]byte
+	_, err = io.ReadFull(r, h[:])
+	if err != nil || u16(h[4:]) < 2 {
+		return cfg, FormatError("missing SIZ marker")
+	}
+	b := make([]byte, 4+u16(h[4:]))
+	copy(b, h[:])
+	_, err = io.ReadFull(r, b[6:])
+	if err != nil {
+		return cfg, FormatError("missing SIZ marker")
+	}
+	cs, err := readCodestream(b, true)
+	if err != nil {
+		return cfg, err
+	}
+	cfg.Width, cfg.Height = cs.x1-cs.x0, cs.y1-cs.y0
+	cfg.ColorModel = model(channels(j, &cs.siz))
+	return cfg, nil
+}
+
+// model returns the colour model used for channels of precs bits
+func model(precs []int, colour bool) color.Model {
+	deep := false
+	for _, p := range precs {
+		deep = deep || p > 8
+	}
+	switch {
+	case colour && deep:
+		return color.RGBA64Model
+	case colour:
+		return color.RGBAModel
+	case deep:
+		return color.Gray16Model
+	}
+	return color.GrayModel
+}
+
+// decode decodes the components of an image
+func decode(r io.Reader) (*jp2, *codestream, []plane, error) {
+	j, r, err := open(r)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	cs, err := readCodestream(b, false)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	planes := make([]plane, len(cs.comps))
+	for i, c := range cs.comps {
+		p := &planes[i]
+		p.x0, p.y0 = ceilDiv(cs.x0, c.dx), ceilDiv(cs.y0, c.dy)
+		p.w, p.h = ceilDiv(cs.x1, c.dx)-p.x0, ceilDiv(cs.y1, c.dy)-p.y0
+		p.dx, p.dy, p.prec = c.dx, c.dy, c.prec
+		p.pix = make([]uint16, p.w*p.h)
+	}
+	for i := range planes {
+		// tiles which are missing are left as zero coefficients,
+		// which are shifted to the middle of the range
+		p := &planes[i]
+		mid := uint16(1) << uint(p.prec-1)
+		for j := range p.pix {
+			p.pix[j] = mid
+		}
+	}
+	for t, info := range cs.tiles {
+		if info == nil {
+			continue
+		}
+		tl, err := newTile(cs, t, info)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		err = tl.decode(planes)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+	}
+
+	if j != nil && len(j.cmap) > 0 {
+		planes, err = applyPalette(j, planes)
+	}
+	return j, cs, planes, err
+}
+
+// Decode decodes a JPEG 2000 image
+func Decode(r io.Reader) (image.Image, error) {
+	j, cs, planes, err := decode(r)
+	if err != nil {
+		return nil, err
+	}
+	precs, colour := channels(j, &cs.siz)
+	ycc := j != nil && j.colour == csSYCC
+	if j == nil && colour && (planes[1].dx > 1 || planes[1].dy > 1) {
+		// a bare codestream with subsampled chroma is most likely
+		// to be YCbCr
+		ycc = true
+	}
+	return toImage(cs, planes[:len(precs)], colour, ycc, model(precs, colour)), nil
+}
+
+// applyPalette returns the channels of an image, as mapped from its
+// components by the cmap box, through any palette
+func applyPalette(j *jp2, planes []plane) ([]plane, error) {
+	var out []plane
+	for _, e := range j.cmap {
+		if e.comp >= len(planes) {
+			return nil, FormatError("bad component mapping")
+		}
+		p := planes[e.comp]
+		if e.column < 0 {
+			out = append(out, p)
+			continue
+		}
+		if e.column >= len(j.palette) {
+			return nil, FormatError("bad palette column")
+		}
+		col := j.palette[e.column]
+		pix := make([]uint16, len(p.pix))
+		for i, v := range p.pix {
+			if int(v) >= len(col) {
+				v = uint16(len(col) - 1)
+			}
+			pix[i] = uint16(col[v])
+		}
+		p.pix, p.prec = pix, j.bits[e.column]
+		out = append(out, p)
+	}
+	if len(out) == 0 {
+		return nil, FormatError("bad component mapping")
+	}
+	return out, nil
+}
+
+// toImage converts the channels of an image to an image.Image
+func toImage(cs *codestream, planes []plane, colour, ycc bool, m color.Model) image.Image {
+	w, h := cs.x1-cs.x0, cs.y1-cs.y0
+	rect := image.Rect(0, 0, w, h)
+
+	// scaled returns the samples of a channel at each pixel of the
+	// image, scaled to maxv
+	scaled := func(p plane, maxv int) []uint16 {
+		out := make([]uint16, w*h)
+		pmax := 1<<uint(p.prec) - 1
+		var scale []uint16
+		if pmax != maxv {
+			scale = make([]uint16, pmax+1)
+			for v := range scale {
+				scale[v] = uint16((v*maxv + pmax/2) / pmax)
+			}
+		}
+		for y := 0; y < h; y++ {
+			py := min(max((y+cs.y0)/p.dy-p.y0, 0), p.h-1)
+			row := p.pix[py*p.w:]
+			for x := 0; x < w; x++ {
+				px := x
+				if p.dx != 1 {
+					px = min(max((x+cs.x0)/p.dx-p.x0, 0), p.w-1)
+				}
+				v := row[px]
+				if scale != nil {
+					v = scale[v]
+				}
+				out[y*w+x] = v
+			}
+		}
+		return out
+	}
+
+	maxv := 0xff
+	if m == color.RGBA64Model || m == color.Gray16Model {
+		maxv = 0xffff
+	}
+	if !colour {
+		pix := scaled(planes[0], maxv)
+		if maxv == 0xff {
+			img := image.NewGray(rect)
+			for i, v := range pix {
+				img.Pix[i] = uint8(v)
+			}
+			return img
+		}
+		img := image.NewGray16(rect)
+		for i, v := range pix {
+			img.Pix[2*i], img.Pix[2*i+1] = uint8(v>>8), uint8(v)
+		}
+		return img
+	}
+
+	r, g, b := scaled(planes[0], maxv), scaled(planes[1], maxv), scaled(planes[2], maxv)
+	if ycc {
+		fmax, off := float64(maxv), float64(maxv+1)/2
+		for i := range r {
+			y, cb, cr := float64(r[i]), float64(g[i])-off, float64(b[i])-off
+			r[i] = clampRound(y+1.402*cr, fmax)
+			g[i] = clampRound(y-0.344136*cb-0.714136*cr, fmax)
+			b[i] = clampRound(y+1.772*cb, fmax)
+		}
+	}
+	if maxv == 0xff {
+		img := image.NewRGBA(rect)
+		for i := range r {
+			copy(img.Pix[4*i:], []uint8{uint8(r[i]), uint8(g[i]), uint8(b[i]), 0xff})
+		}
+		return img
+	}
+	img := image.NewRGBA64(rect)
+	for i := range r {
+		copy(img.Pix[8*i:], []uint8{
+			uint8(r[i] >> 8), uint8(r[i]), uint8(g[i] >> 8), uint8(g[i]),
+			uint8(b[i] >> 8), uint8(b[i]), 0xff, 0xff,
+		})
+	}
+	return img
+}
+
+func clampRound(v, maxv float64) uint16 {
+	return uint16(math.Max(0, math.Min(maxv, math.Round(v))))
+}
@@ -0,0 +1,293 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package jpeg2000
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"image"
+	"image/color"
+	"testing"
+)
+
+// testImages were encoded with OpenJPEG, each from components with
+// samples set by pattern
+var testImages = map[string]string{
+	// three decomposition levels, offset from the origin
+	"gray": `/0//UQApAAAAAAAYAAAAEQAAAAMAAAACAAAAGAAAABEAAAAAAAAAAAABBwEB/1IA
+DAAAAAEAAgQEAAH/XAAKQEBISFBISFD/ZAAlAAFDcmVhdGVkIGJ5IE9wZW5KUEVH
+IHZlcnNpb24gMi41LjD/kAAKAAAAAAFlAAH/k8+0XApbTZcq0PPDmRp4Ojqe7vqZ
+j8DhfF2Px9orPwGY/AYAGTxsl1QdZIq4lgLgGJAHPngFTy+/H1nxsnuKfbS9KlCJ
+gzZHi4P0By8dtCJ8vwDfDIvB8E7iM4s6wdwPaz3/Z15c+DikvN+atfmlR+CMUk7f
+SKPnjj9gEqmXDioMsidp/QyH+G8SHrEw0pxikFypZ+7wB2sjLyvN/36qYFe0d0EN
+XYA6BTWOfFWk9sX5+tT0lWA2nhl2VMeFHA5KeGc8ENIwipc4n8qvvPp48s6z/wWY
+LUQb6LaB5+12vxvnUdJT75VqZrLV/uvGf2YSqDixE1HyoEWAo87dFjClAl6x2kcV
+N8h8a/1XTfFRdsAOwzhHnhdvzUkTwAREj9WfRANxjbnR0DBlxx141bWAeM75FpdI
+qXNdzYeuDYI9VcDEvKw7GKA3VvgYfC427NkASNSIKdbzdn//2Q==`,
+	// the same, with its packet headers in a PPT marker
+	"ppt": `/0//UQApAAAAAAAYAAAAEQAAAAMAAAACAAAAGAAAABEAAAAAAAAAAAABBwEB/1IA
+DAAAAAEAAgQEAAH/XAAKQEBISFBISFD/ZAAlAAFDcmVhdGVkIGJ5IE9wZW5KUEVH
+IHZlcnNpb24gMi41LjD/kAAKAAAAAAFqAAH/YQAXAM+0XMfaKz8BmPwGAN+atfml
+R+CM/5MKW02XKtDzw5kaeDo6nu76mY/A4Xxdjxk8bJdUHWSKuJYC4BiQBz54BU8v
+vx9Z8bJ7in20vSpQiYM2R4uD9AcvHbQifL8A3wyLwfBO4jOLOsHcD2s9/2deXPg4
+pLxSTt9Io+eOP2ASqZcOKgyyJ2n9DIf4bxIesTDSnGKQXKln7vAHayMvK83/fqpg
+V7R3QQ1dgDoFNY58VaT2xfn61PSVYDaeGXZUx4UcDkp4ZzwQ0jCKlzifyq+8+njy
+zrP/BZgtRBvotoHn7Xa/G+dR0lPvlWpmstX+68Z/ZhKoOLETUfKgRYCjzt0WMKUC
+XrHaRxU3yHxr/VdN8VF2wA7DOEeeF2/NSRPABESP1Z9EA3GNudHQMGXHHXjVtYB4
+zvkWl0ipc13Nh64Ngj1VwMS8rDsYoDdW+Bh8Ljbs2QBI1Igp1vN2f//Z`,
+	// the same, with its packet headers in a PPM marker
+	"ppm": `/0//UQApAAAAAAAYAAAAEQAAAAMAAAACAAAAGAAAABEAAAAAAAAAAAABBwEB/1IA
+DAAAAAEAAgQEAAH/XAAKQEBISFBISFD/ZAAlAAFDcmVhdGVkIGJ5IE9wZW5KUEVH
+IHZlcnNpb24gMi41LjD/YAAbAAAAABTPtFzH2is/AZj8BgDfmrX5pUfgjP+QAAoA
+AAAAAVEAAf+TCltNlyrQ88OZGng6Op7u+pmPwOF8XY8ZPGyXVB1kiriWAuAYkAc+
+eAVPL78fWfGye4p9tL0qUImDNkeLg/QHLx20Iny/AN8Mi8HwTuIzizrB3A9rPf9n
+Xlz4OKS8Uk7fSKPnjj9gEqmXDioMsidp/QyH+G8SHrEw0pxikFypZ+7wB2sjLyvN
+/36qYFe0d0ENXYA6BTWOfFWk9sX5+tT0lWA2nhl2VMeFHA5KeGc8ENIwipc4n8qv
+vPp48s6z/wWYLUQb6LaB5+12vxvnUdJT75VqZrLV/uvGf2YSqDixE1HyoEWAo87d
+FjClAl6x2kcVN8h8a/1XTfFRdsAOwzhHnhdvzUkTwAREj9WfRANxjbnR0DBlxx14
+1bWAeM75FpdIqXNdzYeuDYI9VcDEvKw7GKA3VvgYfC427NkASNSIKdbzdn//2Q==`,
+	// colour transform, offset tiles and precincts, PCRL order, SOP and EPH markers
+	"rgb": `/0//UQAvAAAAAAAYAAAAEQAAAAMAAAACAAAADAAAAAwAAAABAAAAAQADBwEBBwEB
+BwEB/1IADwcDAAEBAgQEAAEiM0T/XAAKQEBISFBISFD/ZAAlAAFDcmVhdGVkIGJ5
+IE9wZW5KUEVHIHZlcnNpb24gMi41LjD/kAAKAAAAAAGgAAH/k/+RAAQAAM+0KP+S
+C31ogQ5X9glMz/+RAAQAAcPqBo+0Kg+oHP+SFDRWg66pEyqMC+JH55WuPwK4G/HS
+JYf/kQAEAALD6hqPtGIfaLj/kh69ybaL3xth2LItBr+/x/xjUx9Bl6srxUqrLsxw
+ND2qsvbdbXKnjp4kva1wSBptx5InIuDor1/0IKaxU4CnxBAO/pMV9jfoq/v/kQAE
+AAPfgGD/kgxZlc2xx8JjMORDkP+RAAQABM/AIvzDI/AI/5IMNxZRybzmnxWbPugh
+oZQEXl6PfROwvqQmqNTv/5EABAAFz8BGfgKx+AaA/5IgyBIxzPfbIxNHqcIR3TxZ
+XxaUmE5DRTDpzUhPfWirucRKTxr/fyMvh15hAGnK9l+D/3//kQAEAAbfgGD/khnq
+1VWv/U3VgOh8f/+RAAQAB8/AIn4BUPtBgP+SEtFDR3TjXj8ZKSGWyyHEtd5/C2jd
+lUUn/5EABAAIz8Ay/MPn5hL/kiQjYsQD91CFC2kfj0FeC4G8wtjtxez8i6IVhTt7
+Mc6GR5SjT/+QAAoAAQAAAigAAf+T/5EABAAAw+oEg+oE/5ILBgq/Ckz2Vf+RAAQA
+AcfaGw+oFh9oYP+SA/rR7CmmBhf3vhHdvwnhQr8rC5BgHSKnwryqSv9//5EABAAC
+x9oJH4Ag/5IMJxPpABZwLf+RAAQAA8/AIj7QafmEAP+SAclDhRXXdF8GzGOu/38P
+6+56zL6AP/+RAAQABMfaCT8wUP+SC9k+AwvKaYaM/5EABAAFz8AqPtBJ+YMA/5IA
+VCPRtt3OpeDPBieLvw861Hhup/+RAAQABsfUDv+SAIORoBnAf/+RAAQAB8PqBofU
+DQ+0HP+SA6/Hm6NmD2UX7JsPEcE0sJo3W/+RAAQACMPqFY+0Ug+oRP+SFjbjCazV
+IGVZ7CRihYC5Ejz5jv9/J9t2vLKOCFrA6DNSLa6uq/R16Y8KNAyYsVGVZ+pvduxY
+msb85/+RAAQACd+AQP+SBs1l8sdMQZ//kQAEAArPwBp+ARH4A4D/kgb3RTJmYxA5
+3sdjBcR/BJSGRcZen/+RAAQAC8faCz8AqPwBQP+SFgC3H08MnbuufozMJbDPFql1
+YH//kQAEAAzfgED/kguJ34tub3If/5EABAANx9oRPwCI/AIA/5IIRjvRpqbufwqi
+K1ys6ECrEYP3ARbXal//kQAEAA7PwEb8xKfmHP+SGKbhfAMIQjpuivMuxNcj7H8Z
+3g9B1bBdq4QDxakDWWvd/38Wkc89TmrQqODJcWdKn/+QAAoAAgAAATIAAf+T/5EA
+BAAAx9oJA+cG/5IOcdV3CP9//5EABAABw+oEj7QuD6gg/5IMVH73BTHu4l4Xca89
+Yo8OV7qKkWeGO/+RAAQAAsPqA4PqAv+SBznTBJ//kQAEAAPD6gKfgCx+AGD/kguD
+CAbCMX8Nrs//kQAEAATH2gkfgBj/kgu/Cr8FjV//kQAEAAXH2gt+YXH4AwD/kgvy
+BWrZD4gb/vzaGGhSln8NbhB+OLP/kQAEAAbH1Ab/kgguKf+RAAQAB8B8IQD/kglv
+/5EABAAIw+oF/5IK74M2qf+RAAQACcfUBv+SCsyJ/5EABAAKwfOC/5IEn/+RAAQA
+C4D/kv+RAAQADMfUCP+SAdwQf/+RAAQADcPqBP+SAtz/f/+RAAQADt+YIP+SC3Hr
+l/+QAAoAAwAAAYEAAf+T/5EABAAAw+0C/5IFn/+RAAQAAcD5AUD5AUD5wYD/kgpC
+ChsQhkf/kQAEAAKA/5L/kQAEAAOA/5L/kQAEAASA/5L/kQAEAAWA/5L/kQAEAAbD
+6gOD6gL/kguczwHJ/5EABAAHwPkCQ+oIgfOG/5IJaLN/Fy9BJjCU9k8QSrzl8qv/
+kQAEAAjD6gKPwAz/kgt4Cwtl/5EABAAJwfOCj7QSH2gg/5IOfxg+oRMU5v9//5EA
+BAAKgP+S/5EABAALgP+S/5EABAAMwHwggP+SAf+RAAQADcHzg/+SC/XP/5EABAAO
+gP+S/5EABAAPgP+S/5EABAAQgP+S/5EABAARgP+S/5EABAASx9QG/5ILSW//kQAE
+ABPAfCEA/5IMX/+RAAQAFMHzhf+SC5/UE9//kQAEABXH1AT/kgF9/5EABAAWx9oG
+/5IMOH3/kQAEABfH2gT/kg1z/5EABAAYx9QG/5IIf3//kQAEABmA/5L/kQAEABqA
+/5L/2Q==`,
+	// all code-block styles, in two layers
+	"styles": `/0//UQApAAAAAAAVAAAADwAAAAAAAAAAAAAAFQAAAA8AAAAAAAAAAAABBwEB/1IA
+DAAAAAIAAgEBPwH/XAAKQEBISFBISFD/ZAAlAAFDcmVhdGVkIGJ5IE9wZW5KUEVH
+IHZlcnNpb24gMi41LjD/kAAKAAAAAAIuAAH/k94ibBInOgAOvD+xl7Byb1+kgCR6
+8me1IH4b2+T9YlMlkslkMnS2aX1ZALW2zL3K6rbyizICttVa1Gy2FYgatsfaZJRa
+RSJZLP3mUkWiWSyGQzPzIlIlkokolEslkB63eJ4AamOlrBWFTWUszchA6gqGSrba
+ePWqtio7Y6q2xJm9rHSktfk+YcXdMSivJrlFAfeituXQ2eW2y9ADtgxAz7YaioMA
+GHlpmgChoZ8yrEIr8AoNG+ANtsKdvLY1BSGqtqVOqdW25/BISjKrWrTMXj/AiUiU
+UiSiUQj95EkvLaF4rFY/zIlIlEpFIlEolHH8DRcJSmSkLpdP8CRJJRJJJJRKIEiu
+KfmFUogdNOCaI30dyKG+eQDFThCJHNddsZtOAKALQIEBch7u+xgIz0eKmeHo8ap9
+CMhIFT/JJi7pKsEBNWHJEM2oO/FAGpOUABGaYN1WlF5dJwqtfWmetkoYDbbVCBy2
+RwS2XyAVlZfYKBQE82y/OzrdAKwOOMhNJy4ECVUlFeoFQDkqtgAxqvKbB7SOqrYG
+Bh+pCvbuCrYCiRBtllMeSrYxLyIABBdXaF+YZrxixfYcpio1iCrS2bYCBIi2AgWG
+tsFIqbZ+XEtSsTQ5X59+A/kXWedtvmmNHcCBHkbvWIprZQBgqcIGQtEFyHCF0HsA
+AYpBgACEckAAACrH5AjPOUAFS4abFUKbLUJXA2RwASG2wEK2AgAqtgIAKrb/2Q==`,
+	// signed 12 bit samples, with a region of interest
+	"signed": `/0//UQApAAAAAAAVAAAADQAAAAAAAAAAAAAAFQAAAA0AAAAAAAAAAAABiwEB/1IA
+DAAAAAEAAQQEAAH/XAAHQGBoaHD/XgAFAAAE/2QAJQABQ3JlYXRlZCBieSBPcGVu
+SlBFRyB2ZXJzaW9uIDIuNS4w/5AACgAAAAABBgAB/5Pf4XQSLbt2gtiR7R8lsUt2
+Yn6sjIDSJrsKe7vs/wFsy+HV4yxq7+ny3JWvQwJ1QHptG+vdhZX4ESzB35FHYHN7
+WrgiI4wDf1GRoSh1SX44Q6w2ncY+OwEm5F62kb+/6n/APqNIB9RlAH1E4B3Iox2n
+eFoRam61u17Xco40uVDBWIOB+m1Q9SnbMWFqMr3zzjzC7d63eXlHkX3e4hOw618o
+EqBYifU9dL9nHyEx2gs/y1TzPhxR1+WKfK0Z9kjL2zcrW7G/2RJ64ag7KUYlpIOW
+N2FD+vYVgTA081M0rP3nsUgaz2ObyCgln7br2+RNrRE/UsJS7hEbf//Z`,
+	// the 9-7 wavelet transform
+	"irreversible": `/0//UQApAAAAAAAVAAAADwAAAAAAAAAAAAAAFQAAAA8AAAAAAAAAAAABBwEB/1IA
+DAAAAAEAAgQEAAD/XAARQl9SUAVQBVBHV9NX01di/2QAJQABQ3JlYXRlZCBieSBP
+cGVuSlBFRyB2ZXJzaW9uIDIuNS4w/5AACgAAAAABUAAB/5PH5j4SWrH7D2v5TBhL
+frc6be7q00R3VOegFnbKasrDEqmPx+ArH4DkfgKgHre+2cwe4vSXK1bcCR2OPR5l
+M7t/GkDOXhLZmylA89/YmN82gKixaSr5mjE1krcHzRp/2tc1OvnqG2vKUC4R12Nk
+Fveid8fgkT80yPwNAENVh+R9AQgraEA2OK9h+aDpT7TFMah+b44O3MDUfd8HxOko
+xWk1NyntL77E7yGQNnyXIkloBtuWBSarTMzZYQlmrWzHHo40j5KxvlvmFZZGDJht
+9H4Tm1BwSxnet7TAQGhTjuaP0nBfqqv3vjHR25qsUQnUfS0gqhc7X0lpaoY/jHDe
+eFisCfuLIyaEzKYg00THvD9Cf5j2wrX2HIkVLmMaLsJgQvJ57/cUOSvG3Ejyg4UT
+/KYml0X9ElY88cvxMZ7jL8yaqvR3/9k=`,
+	// subsampled chroma, RPCL order
+	"subsampled": `/0//UQAvAAAAAAAUAAAADQAAAAEAAAAAAAAAFAAAAA0AAAAAAAAAAAADBwEBBwIC
+BwIC/1IADAACAAEAAQQEAAH/XAAHQEBISFD/ZAAlAAFDcmVhdGVkIGJ5IE9wZW5K
+UEVHIHZlcnNpb24gMi41LjD/kAAKAAAAAAF2AAH/k9+CEDiZzCSTApeYSugLd0Nc
+XJQtoftRh/mNdsDiQiwQGgSpcGfvBKln6bYPgYs/i3OzBcxbnMcJVNzh+QfeNY0P
+sVz54s+0RBHoOVSsxaxbuI0P7qZtMhdTz7RACN1x8FT10AnaCiqUTLojYs/A9n4G
+cfgVgENtHSr7cVbMsU7WNZXTWSR0tMp+naruxKHGq+l24EIQZcsCjReUYwHkl8UE
+p/Owz465Xu11vUWIZmhgYn84juuaN7moC8bxUUokL+Sp+Xot5BlOcOG//a3mgneY
+Mu5Z7NY80BZZU5DoITWl7EEzYoc9+PbaNWqYTXbHwswYkTH9CRlT33XC6uoVBDKZ
+zzXjnlaNx9YeMcbG4lf4w+oPg+cVA+cSHc830FqyyQYHkXZuTAu2CtULgs12my96
+fxsJ/OUu5yGdx8faIR9oVB9QUB/DFx7pVTMxSOv9SL1IIJ8Zb2CovN5xCSf8Gc1R
+pTAPeDwAx//Z`,
+	// a JP2 file
+	"jp2": `AAAADGpQICANCocKAAAAFGZ0eXBqcDIgAAAAAGpwMiAAAAAtanAyaAAAABZpaGRy
+AAAACwAAAA0AAwcHAAAAAAAPY29scgEAAAAAABAAAAGvanAyY/9P/1EALwAAAAAA
+DQAAAAsAAAAAAAAAAAAAAA0AAAALAAAAAAAAAAAAAwcBAQcBAQcBAf9SAAwAAAAB
+AQEEBAAB/1wAB0BASEhQ/2QAJQABQ3JlYXRlZCBieSBPcGVuSlBFRyB2ZXJzaW9u
+IDIuNS4w/5AACgAAAAABNAAB/5PPtKASXzqqybbIdrhMAXsT2yVGbSIXPNPVXD9q
+T3NnJX3leagXBl+leN2B/xlIPKTSHb8lZLZ8TrL2ipJ2l3rw4F8GjKRAHQLvQmRp
+nhVTtVb4ksT3CQf/GQB48d5OdObl7D52EVsznpKDFJk/espvMm68vEgwJzhIP8fa
+NQ+odh9ooF9jgeQYI/SQ9nD0o1OADhlIB/KMU2y0AEYfKTzIrYSF2QBwWQjzICke
+nAauKASdO7/4/OuE9h8qArqT/aiE6yL7WVUGtaqlavt3l8/AQn4CUfgFgCi4SdbP
+xjFFJm9C08imcb8jiM1DC0aGFXKsErNqiauYK00pNNzD8JCXXSD/f8/AJn4BUfgD
+AFi6Loizl+slD0uDmE7LvpkFSlUpNQU5ek//2Q==`,
+	// 16 bit samples
+	"deep": `/0//UQApAAAAAAANAAAACwAAAAAAAAAAAAAADQAAAAsAAAAAAAAAAAABDwEB/1IA
+DAAAAAEAAQQEAAH/XAAHQICIiJD/ZAAlAAFDcmVhdGVkIGJ5IE9wZW5KUEVHIHZl
+cnNpb24gMi41LjD/kAAKAAAAAACgAAH/k9/4kcgSICJJ501INbTPXz0tZoSNg4YP
+ZqERG98WSKyhTOHhJBchWFB/m2X4J4gL55FmGiTSSHuJiTccHK/AA+oZgAfUOQAD
+5ygdyKGdIR2/VXYV8KxWutihPnNrz6j23YPHKA5a+Ltw54Zfpgkr+z1F6tsHQx1f
+QiwiSYvhFx3Afs+1+LolvODRsMuxWsVFl2He/9k=`,
+}
+
+// pattern returns the sample at x, y of component c of the test
+// images, offset to be unsigned
+func pattern(c, x, y, prec int) int {
+	v := x*7 + y*13 + c*50 + x*y%17 + (x/5+y/3)&1*40
+	return v & (1<<uint(prec) - 1)
+}
+
+func testImage(t *testing.T, name string) []byte {
+	b, err := base64.StdEncoding.DecodeString(testImages[name])
+	if err != nil {
+		t.Fatalf("Error decoding test image %s: %v", name, err)
+	}
+	return b
+}
+
+func TestDecodePlanes(t *testing.T) {
+	for _, c := range []struct {
+		name      string
+		comps     int
+		tolerance int
+	}{
+		{"gray", 1, 0},
+		{"ppt", 1, 0},
+		{"ppm", 1, 0},
+		{"rgb", 3, 0},
+		{"styles", 1, 0},
+		{"signed", 1, 0},
+		{"irreversible", 1, 1},
+		{"subsampled", 3, 0},
+		{"jp2", 3, 0},
+		{"deep", 1, 0},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			_, _, planes, err := decode(bytes.NewReader(testImage(t, c.name)))
+			if err != nil {
+				t.Fatalf("Error decoding: %v", err)
+			}
+			if len(planes) != c.comps {
+				t.Fatalf("Expected %d components, got %d", c.comps, len(planes))
+			}
+			for i, p := range planes {
+				for y := 0; y < p.h; y++ {
+					for x := 0; x < p.w; x++ {
+						want := pattern(i, p.x0+x, p.y0+y, p.prec)
+						got := int(p.pix[y*p.w+x])
+						if got < want-c.tolerance || got > want+c.tolerance {
+							t.Fatalf("Expected component %d at %d,%d to be %d, got %d", i, x, y, want, got)
+						}
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestDecode(t *testing.T) {
+	for _, c := range []struct {
+		name  string
+		size  image.Point
+		model color.Model
+	}{
+		{"gray", image.Pt(21, 15), color.GrayModel},
+		{"rgb", image.Pt(21, 15), color.RGBAModel},
+		{"subsampled", image.Pt(19, 13), color.RGBAModel},
+		{"jp2", image.Pt(13, 11), color.RGBAModel},
+		{"deep", image.Pt(13, 11), color.Gray16Model},
+	} {
+		b := testImage(t, c.name)
+		cfg, format, err := image.DecodeConfig(bytes.NewReader(b))
+		if err != nil {
+			t.Errorf("Error decoding config of %s: %v", c.name, err)
+			continue
+		}
+		if format != "jpeg2000" || cfg.Width != c.size.X || cfg.Height != c.size.Y || cfg.ColorModel != c.model {
+			t.Errorf("Unexpected config of %s: %s %+v", c.name, format, cfg)
+		}
+		img, _, err := image.Decode(bytes.NewReader(b))
+		if err != nil {
+			t.Errorf("Error decoding %s: %v", c.name, err)
+			continue
+		}
+		if img.Bounds().Size() != c.size || img.ColorModel() != c.model {
+			t.Errorf("Unexpected image for %s: %v %T", c.name, img.Bounds(), img)
+		}
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(testImage(t, "jp2")))
+	if err != nil {
+		t.Fatalf("Error decoding jp2: %v", err)
+	}
+	want := color.RGBA{uint8(pattern(0, 3, 2, 8)), uint8(pattern(1, 3, 2, 8)), uint8(pattern(2, 3, 2, 8)), 0xff}
+	if got := img.At(3, 2); got != want {
+		t.Errorf("Expected jp2 pixel at 3,2 to be %v, got %v", want, got)
+	}
+	img, _, err = image.Decode(bytes.NewReader(testImage(t, "deep")))
+	if err != nil {
+		t.Fatalf("Error decoding deep: %v", err)
+	}
+	if got, want := img.At(5, 7), (color.Gray16{uint16(pattern(0, 5, 7, 16))}); got != want {
+		t.Errorf("Expected deep pixel at 5,7 to be %v, got %v", want, got)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	b := testImage(t, "gray")
+
+	// an image whose data is cut short is decoded as far as it goes
+	img, err := Decode(bytes.NewReader(b[:len(b)-100]))
+	if err != nil {
+		t.Errorf("Error decoding truncated image: %v", err)
+	} else if img.Bounds().Size() != image.Pt(21, 15) {
+		t.Errorf("Unexpected size of truncated image: %v", img.Bounds())
+	}
+
+	// but not if it is cut in its header
+	_, err = Decode(bytes.NewReader(b[:40]))
+	var ferr FormatError
+	if !errors.As(err, &ferr) {
+		t.Errorf("Expected a FormatError for a truncated header, got %v", err)
+	}
+	for n := range b {
+		Decode(bytes.NewReader(b[:n]))
+		DecodeConfig(bytes.NewReader(b[:n]))
+	}
+
+	// high throughput images are flagged in the capabilities of the
+	// SIZ marker
+	ht := append([]byte(nil), b...)
+	ht[6] |= 0x40
+	_, err = Decode(bytes.NewReader(ht))
+	var uerr UnsupportedError
+	if !errors.As(err, &uerr) {
+		t.Errorf("Expected an UnsupportedError for a high throughput image, got %v", err)
+	}
+}
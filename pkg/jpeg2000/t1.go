@@ -0,0 +1,531 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package jpeg2000
+
+// This file decodes code-blocks, as in Annexes C and D of T.800.
+
+// mqState is an entry of the probability estimation table of the
+// MQ decoder
+type mqState struct {
+	qe         uint32
+	nmps, nlps uint8
+	swap       bool
+}
+
+var mqStates = [47]mqState{
+	{0x5601, 1, 1, true}, {0x3401, 2, 6, false}, {0x1801, 3, 9, false},
+	{0x0ac1, 4, 12, false}, {0x0521, 5, 29, false}, {0x0221, 38, 33, false},
+	{0x5601, 7, 6, true}, {0x5401, 8, 14, false}, {0x4801, 9, 14, false},
+	{0x3801, 10, 14, false}, {0x3001, 11, 17, false}, {0x2401, 12, 18, false},
+	{0x1c01, 13, 20, false}, {0x1601, 29, 21, false}, {0x5601, 15, 14, true},
+	{0x5401, 16, 14, false}, {0x5101, 17, 15, false}, {0x4801, 18, 16, false},
+	{0x3801, 19, 17, false}, {0x3401, 20, 18, false}, {0x3001, 21, 19, false},
+	{0x2801, 22, 19, false}, {0x2401, 23, 20, false}, {0x2201, 24, 21, false},
+	{0x1c01, 25, 22, false}, {0x1801, 26, 23, false}, {0x1601, 27, 24, false},
+	{0x1401, 28, 25, false}, {0x1201, 29, 26, false}, {0x1101, 30, 27, false},
+	{0x0ac1, 31, 28, false}, {0x09c1, 32, 29, false}, {0x08a1, 33, 30, false},
+	{0x0521, 34, 31, false}, {0x0441, 35, 32, false}, {0x02a1, 36, 33, false},
+	{0x0221, 37, 34, false}, {0x0141, 38, 35, false}, {0x0111, 39, 36, false},
+	{0x0085, 40, 37, false}, {0x0049, 41, 38, false}, {0x0025, 42, 39, false},
+	{0x0015, 43, 40, false}, {0x0009, 44, 41, false}, {0x0005, 45, 42, false},
+	{0x0001, 45, 43, false}, {0x5601, 46, 46, false},
+}
+
+// Contexts of the MQ decoder; 0 to 8 are for significance, 9 to 13
+// for signs and 14 to 16 for refinement
+const (
+	ctxRL  = 17
+	ctxUNI = 18
+	numCtx = 19
+)
+
+// mqDecoder is an MQ arithmetic decoder
+type mqDecoder struct {
+	data  []byte
+	pos   int
+	c, a  uint32
+	ct    int
+	index [numCtx]uint8
+	mps   [numCtx]uint8
+}
+
+// reset sets all contexts to their initial states
+func (m *mqDecoder) reset() {
+	for i := range m.index {
+		m.index[i], m.mps[i] = 0, 0
+	}
+	m.index[0] = 4
+	m.index[ctxRL] = 3
+	m.index[ctxUNI] = 46
+}
+
+// byteAt returns the byte of the data at i, or 0xff past its end, as
+// if it were followed by a marker
+func (m *mqDecoder) byteAt(i int) uint32 {
+	if i < len(m.data) {
+		return uint32(m.data[i])
+	}
+	return 0xff
+}
+
+// init starts decoding a segment, keeping the current contexts
+func (m *mqDecoder) init(data []byte) {
+	m.data = data
+	m.pos = 0
+	m.c = m.byteAt(0) << 16
+	m.byteIn()
+	m.c <<= 7
+	m.ct -= 7
+	m.a = 0x8000
+}
+
+func (m *mqDecoder) byteIn() {
+	if m.byteAt(m.pos) == 0xff {
+		if b := m.byteAt(m.pos + 1); b > 0x8f {
+			m.c += 0xff00
+			m.ct = 8
+		} else {
+			m.pos++
+			m.c += b << 9
+			m.ct = 7
+		}
+	} else {
+		m.pos++
+		m.c += m.byteAt(m.pos) << 8
+		m.ct = 8
+	}
+}
+
+// decode decodes a decision in context cx
+func (m *mqDecoder) decode(cx int) int {
+	s := &mqStates[m.index[cx]]
+	d := int(m.mps[cx])
+	m.a -= s.qe
+	if m.c>>16 < s.qe {
+		if m.a < s.qe {
+			m.index[cx] = s.nmps
+		} else {
+			d = 1 - d
+			if s.swap {
+				m.mps[cx] ^= 1
+			}
+			m.index[cx] = s.nlps
+		}
+		m.a = s.qe
+	} else {
+		m.c -= s.qe << 16
+		if m.a&0x8000 != 0 {
+			return d
+		}
+		if m.a < s.qe {
+			d = 1 - d
+			if s.swap {
+				m.mps[cx] ^= 1
+			}
+			m.index[cx] = s.nlps
+		} else {
+			m.index[cx] = s.nmps
+		}
+	}
+	for {
+		if m.ct == 0 {
+			m.byteIn()
+		}
+		m.a <<= 1
+		m.c <<= 1
+		m.ct--
+		if m.a&0x8000 != 0 {
+			break
+		}
+	}
+	return d
+}
+
+// rawDecoder reads the bits of segments which bypass the MQ decoder
+type rawDecoder struct {
+	data []byte
+	pos  int
+	c    byte
+	ct   int
+}
+
+func (r *rawDecoder) init(data []byte) {
+	r.data, r.pos, r.c, r.ct = data, 0, 0, 0
+}
+
+func (r *rawDecoder) decode() int {
+	if r.ct == 0 {
+		next := byte(0xff)
+		if r.pos < len(r.data) {
+			next = r.data[r.pos]
+		}
+		switch {
+		case r.c == 0xff && next > 0x8f:
+			r.ct = 8
+		case r.c == 0xff:
+			r.c, r.ct = next, 7
+			r.pos++
+		default:
+			r.c, r.ct = next, 8
+			r.pos++
+		}
+	}
+	r.ct--
+	return int(r.c>>uint(r.ct)) & 1
+}
+
+// Flags of each coefficient, recording which of its neighbours are
+// significant, and their signs
+const (
+	sigN = 1 << iota
+	sigS
+	sigW
+	sigE
+	sigNW
+	sigNE
+	sigSW
+	sigSE
+	sgnN
+	sgnS
+	sgnW
+	sgnE
+	flagSig
+	flagVisit
+	flagRefine
+
+	sigNeighbours = sigN | sigS | sigW | sigE | sigNW | sigNE | sigSW | sigSE
+	// causalMask hides the neighbours below a coefficient, for the
+	// last row of a stripe in vertically causal mode
+	causalMask = ^uint16(sigS | sigSW | sigSE | sgnS)
+)
+
+// zcContexts are the significance contexts of each set of
+// significant neighbours, for LL and LH, HL, and HH bands
+var zcContexts [3][256]uint8
+
+// scContexts are the sign contexts of each set of significant
+// neighbours and their signs, with the bit to xor the decision with
+// in bit 7
+var scContexts [256]uint8
+
+func init() {
+	for f := 0; f < 256; f++ {
+		bit := func(b int) int {
+			if f&b != 0 {
+				return 1
+			}
+			return 0
+		}
+		h := bit(sigW) + bit(sigE)
+		v := bit(sigN) + bit(sigS)
+		d := bit(sigNW) + bit(sigNE) + bit(sigSW) + bit(sigSE)
+		zcContexts[0][f] = zc(h, v, d)
+		zcContexts[1][f] = zc(v, h, d)
+
+		var ctx uint8
+		switch hv := h + v; {
+		case d >= 3:
+			ctx = 8
+		case d == 2 && hv >= 1:
+			ctx = 7
+		case d == 2:
+			ctx = 6
+		case d == 1 && hv >= 2:
+			ctx = 5
+		case d == 1 && hv == 1:
+			ctx = 4
+		case d == 1:
+			ctx = 3
+		case hv >= 2:
+			ctx = 2
+		default:
+			ctx = uint8(hv)
+		}
+		zcContexts[2][f] = ctx
+
+		// for signs, the index has the significance of the four
+		// direct neighbours in its low bits, and their signs above
+		sign := func(sig, neg int) int {
+			switch {
+			case f&sig == 0:
+				return 0
+			case f&(neg>>4) != 0:
+				return -1
+			}
+			return 1
+		}
+		hc := sign(sigW, sgnW) + sign(sigE, sgnE)
+		vc := sign(sigN, sgnN) + sign(sigS, sgnS)
+		hc, vc = clamp1(hc), clamp1(vc)
+		var xor uint8
+		if hc < 0 || hc == 0 && vc < 0 {
+			hc, vc, xor = -hc, -vc, 0x80
+		}
+		if hc == 0 {
+			ctx = 9 + uint8(vc)
+		} else {
+			ctx = uint8(12 + vc)
+		}
+		scContexts[f] = ctx | xor
+	}
+}
+
+// zc returns the significance context of an LL or LH band
+func zc(h, v, d int) uint8 {
+	switch {
+	case h == 2:
+		return 8
+	case h == 1 && v >= 1:
+		return 7
+	case h == 1 && d >= 1:
+		return 6
+	case h == 1:
+		return 5
+	case v == 2:
+		return 4
+	case v == 1:
+		return 3
+	case d >= 2:
+		return 2
+	}
+	return uint8(d)
+}
+
+func clamp1(v int) int {
+	switch {
+	case v > 1:
+		return 1
+	case v < -1:
+		return -1
+	}
+	return v
+}
+
+// t1 decodes code-blocks, reusing its buffers between them
+type t1 struct {
+	mq     mqDecoder
+	raw    rawDecoder
+	w, h   int
+	data   []int32 // coefficients, in units of half a quantization step
+	flags  []uint16
+	zc     *[256]uint8
+	causal bool
+}
+
+// decodeBlock decodes the coefficients of a code-block in a band of
+// orientation orient, whose most significant bit-plane is top
+func (t *t1) decodeBlock(cb *codeBlock, orient int, style byte, top int) {
+	t.w, t.h = cb.x1-cb.x0, cb.y1-cb.y0
+	n := t.w * t.h
+	if cap(t.data) < n {
+		t.data = make([]int32, n)
+	}
+	t.data = t.data[:n]
+	for i := range t.data {
+		t.data[i] = 0
+	}
+	nf := (t.w + 2) * (t.h + 2)
+	if cap(t.flags) < nf {
+		t.flags = make([]uint16, nf)
+	}
+	t.flags = t.flags[:nf]
+	for i := range t.flags {
+		t.flags[i] = 0
+	}
+	t.zc = &zcContexts[[4]int{0, 1, 0, 2}[orient]]
+	t.causal = style&styleCausal != 0
+	t.mq.reset()
+
+	passes := 3*top + 1
+	if cb.passes < passes {
+		passes = cb.passes
+	}
+	plane, kind := top, 2
+	for i, seg := range cb.segs {
+		raw := style&styleBypass != 0 && i > 0 && kind != 2 && plane < top-3
+		if raw {
+			t.raw.init(seg.data)
+		} else {
+			t.mq.init(seg.data)
+		}
+		for k := 0; k < seg.passes && passes > 0; k++ {
+			switch kind {
+			case 0:
+				t.significance(plane, raw)
+			case 1:
+				t.refinement(plane, raw)
+			case 2:
+				t.cleanup(plane, style&styleSegMark != 0)
+			}
+			if style&styleReset != 0 {
+				t.mq.reset()
+			}
+			kind++
+			if kind == 3 {
+				kind = 0
+				plane--
+			}
+			passes--
+		}
+	}
+}
+
+// neighbours returns the flags of coefficient i, in row y, hiding
+// those below the stripe in vertically causal mode
+func (t *t1) neighbours(i, y int) uint16 {
+	f := t.flags[i]
+	if t.causal && y&3 == 3 {
+		f &= causalMask
+	}
+	return f
+}
+
+// setSignificant makes coefficient i significant, updating the flags
+// of its neighbours
+func (t *t1) setSignificant(i int, neg bool) {
+	s := t.w + 2
+	f := t.flags
+	f[i] |= flagSig
+	f[i-s] |= sigS
+	f[i+s] |= sigN
+	f[i-1] |= sigE
+	f[i+1] |= sigW
+	f[i-s-1] |= sigSE
+	f[i-s+1] |= sigSW
+	f[i+s-1] |= sigNE
+	f[i+s+1] |= sigNW
+	if neg {
+		f[i-s] |= sgnS
+		f[i+s] |= sgnN
+		f[i-1] |= sgnE
+		f[i+1] |= sgnW
+	}
+}
+
+// sign decodes the sign of a coefficient with flags f, reporting
+// whether it is negative
+func (t *t1) sign(f uint16, raw bool) bool {
+	if raw {
+		return t.raw.decode() == 1
+	}
+	c := scContexts[f&0xf|f>>4&0xf0]
+	return t.mq.decode(int(c&0x7f))^int(c>>7) == 1
+}
+
+// significant decodes the sign of coefficient i at x, y, which has
+// become significant at plane p
+func (t *t1) significant(i, x, y, p int, f uint16, raw bool) {
+	neg := t.sign(f, raw)
+	v := int32(3) << uint(p)
+	if neg {
+		v = -v
+	}
+	t.data[y*t.w+x] = v
+	t.setSignificant(i, neg)
+}
+
+// significance runs a significance propagation pass on plane p
+func (t *t1) significance(p int, raw bool) {
+	s := t.w + 2
+	for y0 := 0; y0 < t.h; y0 += 4 {
+		for x := 0; x < t.w; x++ {
+			for y := y0; y < y0+4 && y < t.h; y++ {
+				i := (y+1)*s + x + 1
+				f := t.neighbours(i, y)
+				if f&flagSig != 0 || f&sigNeighbours == 0 {
+					continue
+				}
+				var bit int
+				if raw {
+					bit = t.raw.decode()
+				} else {
+					bit = t.mq.decode(int(t.zc[f&0xff]))
+				}
+				if bit == 1 {
+					t.significant(i, x, y, p, f, raw)
+				}
+				t.flags[i] |= flagVisit
+			}
+		}
+	}
+}
+
+// refinement runs a magnitude refinement pass on plane p
+func (t *t1) refinement(p int, raw bool) {
+	s := t.w + 2
+	delta := int32(1) << uint(p)
+	for y0 := 0; y0 < t.h; y0 += 4 {
+		for x := 0; x < t.w; x++ {
+			for y := y0; y < y0+4 && y < t.h; y++ {
+				i := (y+1)*s + x + 1
+				f := t.neighbours(i, y)
+				if f&(flagSig|flagVisit) != flagSig {
+					continue
+				}
+				var bit int
+				switch {
+				case raw:
+					bit = t.raw.decode()
+				case f&flagRefine != 0:
+					bit = t.mq.decode(16)
+				case f&sigNeighbours != 0:
+					bit = t.mq.decode(15)
+				default:
+					bit = t.mq.decode(14)
+				}
+				d := delta
+				if bit == 0 {
+					d = -d
+				}
+				if v := &t.data[y*t.w+x]; *v < 0 {
+					*v -= d
+				} else {
+					*v += d
+				}
+				t.flags[i] |= flagRefine
+			}
+		}
+	}
+}
+
+// cleanup runs a cleanup pass on plane p
+func (t *t1) cleanup(p int, segmark bool) {
+	s := t.w + 2
+	for y0 := 0; y0 < t.h; y0 += 4 {
+		for x := 0; x < t.w; x++ {
+			y := y0
+			if y0+4 <= t.h {
+				// a run of four coefficients with nothing
+				// significant around them is coded together
+				i := (y0+1)*s + x + 1
+				f := t.flags[i] | t.flags[i+s] | t.flags[i+2*s] | t.neighbours(i+3*s, y0+3)
+				if f&(flagSig|flagVisit|sigNeighbours) == 0 {
+					if t.mq.decode(ctxRL) == 0 {
+						continue
+					}
+					y += t.mq.decode(ctxUNI)<<1 | t.mq.decode(ctxUNI)
+					i = (y+1)*s + x + 1
+					t.significant(i, x, y, p, t.neighbours(i, y), false)
+					y++
+				}
+			}
+			for ; y < y0+4 && y < t.h; y++ {
+				i := (y+1)*s + x + 1
+				f := t.neighbours(i, y)
+				if f&(flagSig|flagVisit) == 0 && t.mq.decode(int(t.zc[f&0xff])) == 1 {
+					t.significant(i, x, y, p, f, false)
+				}
+			}
+			for y := y0; y < y0+4 && y < t.h; y++ {
+				t.flags[(y+1)*s+x+1] &^= flagVisit
+			}
+		}
+	}
+	if segmark {
+		for i := 0; i < 4; i++ {
+			t.mq.decode(ctxUNI)
+		}
+	}
+}
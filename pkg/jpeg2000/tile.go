@@ -0,0 +1,808 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package jpeg2000
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+// errTruncated is returned when the data of a tile runs out before
+// all of its packets are read
+var errTruncated = errors.New("truncated tile")
+
+// segment is a codeword segment of a code-block
+type segment struct {
+	data      []byte
+	passes    int
+	maxPasses int
+}
+
+// codeBlock is a code-block, with its coordinates in its band
+type codeBlock struct {
+	x0, y0, x1, y1 int
+	included       bool
+	lblock         int
+	zero           int // missing most significant bit-planes
+	passes         int
+	segs           []segment
+}
+
+// precinctBand is the part of a band in a precinct
+type precinctBand struct {
+	blocks    []codeBlock
+	incl, zbp *tagTree
+}
+
+type precinct struct {
+	bands  []precinctBand
+	layers int // layers read so far
+}
+
+// band is a subband of a resolution of a tile-component
+type band struct {
+	orient         int // 0 for LL, 1 for HL, 2 for LH, 3 for HH
+	x0, y0, x1, y1 int
+	ox, oy         int // offset of the band in the tile-component's data
+	mb             int // number of magnitude bits
+	step           float32
+}
+
+type resolution struct {
+	x0, y0, x1, y1 int
+	ppx, ppy       int
+	px0, py0       int // index of the first precinct
+	pw, ph         int // number of precincts
+	bands          []band
+	precincts      []precinct
+}
+
+type tileComp struct {
+	x0, y0, x1, y1 int
+	style          *compStyle
+	roi            int
+	res            []resolution
+	data           []float32
+}
+
+// tile decodes a tile of an image
+type tile struct {
+	cs             *codestream
+	x0, y0, x1, y1 int
+	cod            *codingStyle
+	poc            []progression
+	comps          []tileComp
+
+	data    []byte
+	pos     int
+	packed  bool
+	headers []byte
+	hpos    int
+	pending []pendingSegment
+}
+
+// pendingSegment is the part of a segment of a code-block whose
+// length was read from a packet header
+type pendingSegment struct {
+	cb     *codeBlock
+	seg    int
+	passes int
+	length int
+}
+
+// newTile sets up the structure of tile t, using the headers of info
+func newTile(cs *codestream, t int, info *tileInfo) (*tile, error) {
+	p, q := t%cs.tilesX(), t/cs.tilesX()
+	tl := &tile{
+		cs:      cs,
+		x0:      max(cs.tx0+p*cs.tw, cs.x0),
+		y0:      max(cs.ty0+q*cs.th, cs.y0),
+		x1:      min(cs.tx0+(p+1)*cs.tw, cs.x1),
+		y1:      min(cs.ty0+(q+1)*cs.th, cs.y1),
+		cod:     info.cod,
+		poc:     info.poc,
+		data:    info.data,
+		packed:  info.packed,
+		headers: info.headers,
+	}
+	if tl.cod == nil {
+		tl.cod = cs.main.cod
+	}
+	if len(tl.poc) == 0 {
+		tl.poc = cs.main.poc
+	}
+
+	for c, comp := range cs.comps {
+		style := info.cocs[c]
+		switch {
+		case style != nil:
+		case info.cod != nil:
+			style = &info.cod.comp
+		case cs.main.cocs[c] != nil:
+			style = cs.main.cocs[c]
+		default:
+			style = &cs.main.cod.comp
+		}
+		quant := info.qccs[c]
+		switch {
+		case quant != nil:
+		case info.qcd != nil:
+			quant = info.qcd
+		case cs.main.qccs[c] != nil:
+			quant = cs.main.qccs[c]
+		default:
+			quant = cs.main.qcd
+		}
+		roi := info.rgn[c]
+		if roi < 0 {
+			roi = cs.main.rgn[c]
+		}
+		if roi < 0 {
+			roi = 0
+		}
+		tc := tileComp{
+			x0:    ceilDiv(tl.x0, comp.dx),
+			y0:    ceilDiv(tl.y0, comp.dy),
+			x1:    ceilDiv(tl.x1, comp.dx),
+			y1:    ceilDiv(tl.y1, comp.dy),
+			style: style,
+			roi:   roi,
+		}
+		err := tc.setup(comp, quant)
+		if err != nil {
+			return nil, err
+		}
+		tl.comps = append(tl.comps, tc)
+	}
+	return tl, nil
+}
+
+// setup sets up the resolutions, bands, precincts and code-blocks
+// of a tile-component
+func (tc *tileComp) setup(comp component, q *quantization) error {
+	s := tc.style
+	nl := s.levels
+	tc.data = make([]float32, (tc.x1-tc.x0)*(tc.y1-tc.y0))
+	band0 := 0
+	for r := 0; r <= nl; r++ {
+		d := uint(nl - r)
+		res := resolution{
+			x0:  ceilShift(tc.x0, d),
+			y0:  ceilShift(tc.y0, d),
+			x1:  ceilShift(tc.x1, d),
+			y1:  ceilShift(tc.y1, d),
+			ppx: s.ppx[r],
+			ppy: s.ppy[r],
+		}
+		if res.x1 > res.x0 && res.y1 > res.y0 {
+			res.px0, res.py0 = res.x0>>uint(res.ppx), res.y0>>uint(res.ppy)
+			res.pw = ceilShift(res.x1, uint(res.ppx)) - res.px0
+			res.ph = ceilShift(res.y1, uint(res.ppy)) - res.py0
+		}
+
+		orients := []int{1, 2, 3}
+		if r == 0 {
+			orients = []int{0}
+		}
+		var prev resolution
+		if r > 0 {
+			prev = tc.res[r-1]
+		}
+		for _, o := range orients {
+			b := band{orient: o}
+			nb := nl - r + 1
+			if r == 0 {
+				nb = nl
+			}
+			// the band's coordinates are those of its coefficients
+			// in the decomposition at level nb
+			ox, oy := o&1, o>>1
+			b.x0 = ceilShift(tc.x0-ox<<uint(nb-1), uint(nb))
+			b.x1 = ceilShift(tc.x1-ox<<uint(nb-1), uint(nb))
+			b.y0 = ceilShift(tc.y0-oy<<uint(nb-1), uint(nb))
+			b.y1 = ceilShift(tc.y1-oy<<uint(nb-1), uint(nb))
+			if r == 0 {
+				b.x0, b.x1, b.y0, b.y1 = res.x0, res.x1, res.y0, res.y1
+			}
+			b.ox, b.oy = ox*(prev.x1-prev.x0), oy*(prev.y1-prev.y0)
+
+			exp, mant, err := q.step(band0, nl, nb)
+			if err != nil {
+				return err
+			}
+			band0++
+			b.mb = q.guard + exp - 1
+			gain := [4]int{0, 1, 1, 2}[o]
+			if q.style == 0 {
+				b.step = 1
+			} else {
+				b.step = float32(math.Ldexp(1+float64(mant)/2048, comp.prec+gain-exp))
+			}
+			res.bands = append(res.bands, b)
+		}
+
+		// code-blocks are limited to the size of precincts, which
+		// are half the size in the bands of higher resolutions
+		cbw, cbh := min(s.cbw, res.ppx), min(s.cbh, res.ppy)
+		bppx, bppy := res.ppx, res.ppy
+		if r > 0 {
+			cbw, cbh = min(s.cbw, res.ppx-1), min(s.cbh, res.ppy-1)
+			bppx, bppy = res.ppx-1, res.ppy-1
+		}
+		res.precincts = make([]precinct, res.pw*res.ph)
+		for p := range res.precincts {
+			prc := &res.precincts[p]
+			i, j := p%res.pw, p/res.pw
+			for _, b := range res.bands {
+				px0 := max((res.px0+i)<<uint(bppx), b.x0)
+				px1 := min((res.px0+i+1)<<uint(bppx), b.x1)
+				py0 := max((res.py0+j)<<uint(bppy), b.y0)
+				py1 := min((res.py0+j+1)<<uint(bppy), b.y1)
+				var pb precinctBand
+				if px1 > px0 && py1 > py0 {
+					cx0, cy0 := px0>>uint(cbw), py0>>uint(cbh)
+					cw := ceilShift(px1, uint(cbw)) - cx0
+					ch := ceilShift(py1, uint(cbh)) - cy0
+					pb.blocks = make([]codeBlock, cw*ch)
+					for k := range pb.blocks {
+						x, y := cx0+k%cw, cy0+k/cw
+						pb.blocks[k] = codeBlock{
+							x0: max(x<<uint(cbw), px0),
+							y0: max(y<<uint(cbh), py0),
+							x1: min((x+1)<<uint(cbw), px1),
+							y1: min((y+1)<<uint(cbh), py1),
+						}
+					}
+					pb.incl = newTagTree(cw, ch)
+					pb.zbp = newTagTree(cw, ch)
+				}
+				prc.bands = append(prc.bands, pb)
+			}
+		}
+		tc.res = append(tc.res, res)
+	}
+	return nil
+}
+
+// ceilShift returns a divided by 2**d, rounded up
+func ceilShift(a int, d uint) int {
+	return (a + 1<<d - 1) >> d
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// readPackets reads the packets of the tile, in the order of its
+// progressions
+func (t *tile) readPackets() error {
+	poc := t.poc
+	if len(poc) == 0 {
+		poc = []progression{{le: t.cod.layers, re: 33, ce: len(t.comps), order: t.cod.order}}
+	}
+	for _, p := range poc {
+		err := t.progress(p)
+		if err == errTruncated {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// position is a precinct of a resolution of a component, and the
+// point on the reference grid which it starts at, as used to order
+// the position driven progressions
+type position struct {
+	c, r, p int
+	x, y    int
+}
+
+// progress reads the packets of a progression
+func (t *tile) progress(p progression) error {
+	le := min(p.le, t.cod.layers)
+	ce := min(p.ce, len(t.comps))
+	read := func(l, r, c, prc int) error {
+		// packets already read in an earlier progression are skipped
+		if l < t.comps[c].res[r].precincts[prc].layers {
+			return nil
+		}
+		return t.readPacket(l, c, r, prc)
+	}
+
+	switch p.order {
+	case orderLRCP:
+		for l := 0; l < le; l++ {
+			for r := p.rs; r < p.re; r++ {
+				for c := p.cs; c < ce; c++ {
+					if r >= len(t.comps[c].res) {
+						continue
+					}
+					for prc := range t.comps[c].res[r].precincts {
+						if err := read(l, r, c, prc); err != nil {
+							return err
+						}
+					}
+				}
+			}
+		}
+		return nil
+	case orderRLCP:
+		for r := p.rs; r < p.re; r++ {
+			for l := 0; l < le; l++ {
+				for c := p.cs; c < ce; c++ {
+					if r >= len(t.comps[c].res) {
+						continue
+					}
+					for prc := range t.comps[c].res[r].precincts {
+						if err := read(l, r, c, prc); err != nil {
+							return err
+						}
+					}
+				}
+			}
+		}
+		return nil
+	}
+
+	var pos []position
+	for c := p.cs; c < ce; c++ {
+		tc := &t.comps[c]
+		comp := t.cs.comps[c]
+		for r := p.rs; r < p.re && r < len(tc.res); r++ {
+			res := &tc.res[r]
+			d := uint(len(tc.res) - 1 - r)
+			for prc := range res.precincts {
+				i, j := prc%res.pw, prc/res.pw
+				pos = append(pos, position{
+					c: c, r: r, p: prc,
+					x: max((res.px0+i)<<uint(res.ppx)<<d*comp.dx, t.x0),
+					y: max((res.py0+j)<<uint(res.ppy)<<d*comp.dy, t.y0),
+				})
+			}
+		}
+	}
+	var less func(a, b position) bool
+	switch p.order {
+	case orderRPCL:
+		less = func(a, b position) bool {
+			if a.r != b.r {
+				return a.r < b.r
+			}
+			if a.y != b.y {
+				return a.y < b.y
+			}
+			if a.x != b.x {
+				return a.x < b.x
+			}
+			return a.c < b.c
+		}
+	case orderPCRL:
+		less = func(a, b position) bool {
+			if a.y != b.y {
+				return a.y < b.y
+			}
+			if a.x != b.x {
+				return a.x < b.x
+			}
+			if a.c != b.c {
+				return a.c < b.c
+			}
+			return a.r < b.r
+		}
+	case orderCPRL:
+		less = func(a, b position) bool {
+			if a.c != b.c {
+				return a.c < b.c
+			}
+			if a.y != b.y {
+				return a.y < b.y
+			}
+			if a.x != b.x {
+				return a.x < b.x
+			}
+			return a.r < b.r
+		}
+	}
+	sort.SliceStable(pos, func(i, j int) bool { return less(pos[i], pos[j]) })
+	for _, ps := range pos {
+		for l := 0; l < le; l++ {
+			if err := read(l, ps.r, ps.c, ps.p); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// readPacket reads the packet of layer l of a precinct
+func (t *tile) readPacket(l, c, r, p int) error {
+	tc := &t.comps[c]
+	res := &tc.res[r]
+	prc := &res.precincts[p]
+	prc.layers = l + 1
+	if t.packed && t.hpos >= len(t.headers) || !t.packed && t.pos >= len(t.data) {
+		return errTruncated
+	}
+	if t.cod.sop && t.pos+6 <= len(t.data) && u16(t.data[t.pos:]) == markerSOP {
+		t.pos += 6
+	}
+	br := &bitReader{data: t.data, pos: t.pos}
+	if t.packed {
+		br = &bitReader{data: t.headers, pos: t.hpos}
+	}
+
+	t.pending = t.pending[:0]
+	if br.read(1) == 1 {
+		for bi := range prc.bands {
+			pb := &prc.bands[bi]
+			b := &res.bands[bi]
+			for k := range pb.blocks {
+				err := t.readBlockHeader(br, pb, k, b, tc, l)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	br.align()
+	if br.pos > len(br.data) {
+		return errTruncated
+	}
+	if t.cod.eph && br.pos+2 <= len(br.data) && u16(br.data[br.pos:]) == markerEPH {
+		br.pos += 2
+	}
+	if t.packed {
+		t.hpos = br.pos
+	} else {
+		t.pos = br.pos
+	}
+
+	for _, ps := range t.pending {
+		n := ps.length
+		if t.pos+n > len(t.data) {
+			n = len(t.data) - t.pos
+		}
+		seg := &ps.cb.segs[ps.seg]
+		seg.data = append(seg.data, t.data[t.pos:t.pos+n]...)
+		seg.passes += ps.passes
+		ps.cb.passes += ps.passes
+		t.pos += n
+		if n < ps.length {
+			return errTruncated
+		}
+	}
+	return nil
+}
+
+// readBlockHeader reads the part of a packet header for code-block
+// k of a precinct band, in layer l
+func (t *tile) readBlockHeader(br *bitReader, pb *precinctBand, k int, b *band, tc *tileComp, l int) error {
+	cb := &pb.blocks[k]
+	if !cb.included {
+		if !pb.incl.decode(br, k, l+1) {
+			return nil
+		}
+		cb.zero = 0
+		for !pb.zbp.decode(br, k, cb.zero+1) {
+			cb.zero++
+			if cb.zero > 74 {
+				return FormatError("bad number of zero bit-planes")
+			}
+		}
+		cb.included = true
+		cb.lblock = 3
+	} else if br.read(1) == 0 {
+		return nil
+	}
+
+	passes := numPasses(br)
+	for br.read(1) == 1 {
+		cb.lblock++
+	}
+
+	seg := len(cb.segs) - 1
+	if seg < 0 || cb.segs[seg].passes == cb.segs[seg].maxPasses {
+		seg++
+		cb.segs = append(cb.segs, segment{maxPasses: maxPasses(tc.style.cbStyle, cb.segs)})
+	}
+	for done := cb.segs[seg].passes; passes > 0; done = 0 {
+		n := min(cb.segs[seg].maxPasses-done, passes)
+		bits := cb.lblock + floorLog2(n)
+		if bits > 32 {
+			return FormatError("bad code-block length")
+		}
+		t.pending = append(t.pending, pendingSegment{cb: cb, seg: seg, passes: n, length: br.read(bits)})
+		passes -= n
+		if passes > 0 {
+			seg++
+			cb.segs = append(cb.segs, segment{maxPasses: maxPasses(tc.style.cbStyle, cb.segs)})
+		}
+	}
+	return nil
+}
+
+// maxPasses returns the greatest number of coding passes which can
+// be in the next segment of a code-block
+func maxPasses(style byte, segs []segment) int {
+	switch {
+	case style&styleTermAll != 0:
+		return 1
+	case style&styleBypass != 0 && len(segs) == 0:
+		return 10
+	case style&styleBypass != 0:
+		if n := segs[len(segs)-1].maxPasses; n == 1 || n == 10 {
+			return 2
+		}
+		return 1
+	}
+	return 109
+}
+
+// numPasses reads the number of new coding passes in a packet
+func numPasses(br *bitReader) int {
+	switch {
+	case br.read(1) == 0:
+		return 1
+	case br.read(1) == 0:
+		return 2
+	}
+	if n := br.read(2); n != 3 {
+		return 3 + n
+	}
+	if n := br.read(5); n != 31 {
+		return 6 + n
+	}
+	return 37 + br.read(7)
+}
+
+func floorLog2(n int) int {
+	l := 0
+	for n > 1 {
+		n >>= 1
+		l++
+	}
+	return l
+}
+
+// bitReader reads the bits of packet headers, skipping those which
+// are stuffed after 0xff bytes
+type bitReader struct {
+	data []byte
+	pos  int
+	buf  uint32
+	ct   uint
+}
+
+func (br *bitReader) byteIn() {
+	br.buf = br.buf << 8 & 0xffff
+	br.ct = 8
+	if br.buf == 0xff00 {
+		br.ct = 7
+	}
+	if br.pos < len(br.data) {
+		br.buf |= uint32(br.data[br.pos])
+	}
+	br.pos++
+}
+
+// read reads n bits
+func (br *bitReader) read(n int) int {
+	v := 0
+	for ; n > 0; n-- {
+		if br.ct == 0 {
+			br.byteIn()
+		}
+		br.ct--
+		v = v<<1 | int(br.buf>>br.ct&1)
+	}
+	return v
+}
+
+// align skips to the end of the header
+func (br *bitReader) align() {
+	if br.buf&0xff == 0xff {
+		br.byteIn()
+	}
+	br.ct = 0
+}
+
+// tagTree is a tag tree, as in section B.10.2 of T.800
+type tagTree struct {
+	nodes   []tagNode
+	parents []int
+}
+
+type tagNode struct {
+	value, low int
+}
+
+func newTagTree(w, h int) *tagTree {
+	t := &tagTree{}
+	var levels [][2]int
+	for {
+		levels = append(levels, [2]int{w, h})
+		if w*h <= 1 {
+			break
+		}
+		w, h = (w+1)/2, (h+1)/2
+	}
+	start := 0
+	for i, l := range levels {
+		next := start + l[0]*l[1]
+		for y := 0; y < l[1]; y++ {
+			for x := 0; x < l[0]; x++ {
+				parent := -1
+				if i+1 < len(levels) {
+					parent = next + y/2*levels[i+1][0] + x/2
+				}
+				t.parents = append(t.parents, parent)
+				t.nodes = append(t.nodes, tagNode{value: math.MaxInt32})
+			}
+		}
+		start = next
+	}
+	return t
+}
+
+// decode reads whether the value of leaf is less than threshold
+func (t *tagTree) decode(br *bitReader, leaf, threshold int) bool {
+	var path [32]int
+	n := 0
+	for i := leaf; i >= 0; i = t.parents[i] {
+		path[n] = i
+		n++
+	}
+	low := 0
+	for n--; n >= 0; n-- {
+		node := &t.nodes[path[n]]
+		if low > node.low {
+			node.low = low
+		} else {
+			low = node.low
+		}
+		for low < threshold && low < node.value {
+			if br.read(1) == 1 {
+				node.value = low
+			} else {
+				low++
+			}
+		}
+		node.low = low
+	}
+	return t.nodes[leaf].value < threshold
+}
+
+// decode decodes the tile into the planes of each component
+func (t *tile) decode(planes []plane) error {
+	err := t.readPackets()
+	if err != nil {
+		return err
+	}
+	var dec t1
+	for c := range t.comps {
+		tc := &t.comps[c]
+		for r := range tc.res {
+			res := &tc.res[r]
+			for p := range res.precincts {
+				for bi, pb := range res.precincts[p].bands {
+					b := &res.bands[bi]
+					for k := range pb.blocks {
+						cb := &pb.blocks[k]
+						top := b.mb + tc.roi - cb.zero - 1
+						if cb.passes == 0 || top < 0 {
+							continue
+						}
+						if top > 29 {
+							return UnsupportedError("too many bit-planes")
+						}
+						dec.decodeBlock(cb, b.orient, tc.style.cbStyle, top)
+						tc.dequantize(&dec, cb, b)
+					}
+				}
+			}
+		}
+		tc.idwt()
+	}
+	return t.store(planes)
+}
+
+// dequantize copies the coefficients of a decoded code-block to the
+// tile-component's data
+func (tc *tileComp) dequantize(dec *t1, cb *codeBlock, b *band) {
+	w := tc.x1 - tc.x0
+	thresh := int32(1) << uint(tc.roi+1)
+	for y := cb.y0; y < cb.y1; y++ {
+		row := dec.data[(y-cb.y0)*dec.w:]
+		out := tc.data[(b.oy+y-b.y0)*w+b.ox+cb.x0-b.x0:]
+		for x := 0; x < dec.w; x++ {
+			v := row[x]
+			if tc.roi > 0 {
+				// coefficients of the region of interest were
+				// scaled up above the rest
+				if m := abs32(v); m >= thresh {
+					m >>= uint(tc.roi)
+					if v < 0 {
+						v = -m
+					} else {
+						v = m
+					}
+				}
+			}
+			if tc.style.reversible {
+				out[x] = float32(v / 2)
+			} else {
+				out[x] = float32(v) * b.step / 2
+			}
+		}
+	}
+}
+
+func abs32(v int32) int32 {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
+
+// store applies any component transform to the tile, and stores its
+// samples in the planes of each component
+func (t *tile) store(planes []plane) error {
+	if t.cod.mct && len(t.comps) >= 3 {
+		c0, c1, c2 := &t.comps[0], &t.comps[1], &t.comps[2]
+		if len(c0.data) != len(c1.data) || len(c0.data) != len(c2.data) {
+			return FormatError("component transform of components of different sizes")
+		}
+		if c0.style.reversible {
+			for i, y := range c0.data {
+				u, v := c1.data[i], c2.data[i]
+				g := y - float32(math.Floor(float64(u+v)/4))
+				c0.data[i], c1.data[i], c2.data[i] = v+g, g, u+g
+			}
+		} else {
+			for i, y := range c0.data {
+				u, v := c1.data[i], c2.data[i]
+				c0.data[i] = y + 1.402*v
+				c1.data[i] = y - 0.34413*u - 0.71414*v
+				c2.data[i] = y + 1.772*u
+			}
+		}
+	}
+	for c := range t.comps {
+		tc := &t.comps[c]
+		pl := &planes[c]
+		off := float32(int(1) << uint(pl.prec-1))
+		maxv := float32(int(1)<<uint(pl.prec) - 1)
+		w := tc.x1 - tc.x0
+		for y := tc.y0; y < tc.y1; y++ {
+			row := tc.data[(y-tc.y0)*w:]
+			out := pl.pix[(y-pl.y0)*pl.w+tc.x0-pl.x0:]
+			for x := 0; x < w; x++ {
+				v := float32(math.RoundToEven(float64(row[x]))) + off
+				switch {
+				case v < 0:
+					v = 0
+				case v > maxv:
+					v = maxv
+				}
+				out[x] = uint16(v)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package line
+
+import (
+	"image"
+	"image/png"
+	"io"
+	"io/fs"
+	"sync"
+)
+
+// This is an implementation of the CopyableImg interface that
+// stores only the location of a line in a page image, which is
+// decoded when the line image is needed, so that many lines can
+// be held without keeping their page images in memory
+type ImgCrop struct {
+	FS   fs.FS
+	Path string
+	// Frame is the page of a multi-page TIFF image to use
+	Frame int
+	// Rect is the area of the page image containing the line
+	Rect image.Rectangle
+	// Crop, if set, is used to extract the line image from the
+	// page, rather than taking the Rect area directly
+	Crop func(page *image.Gray) image.Image
+	// Cache, if set, is used to share decoded page images between
+	// lines
+	Cache *PageCache
+}
+
+func (i ImgCrop) CopyLineTo(w io.Writer) error {
+	var page *image.Gray
+	var err error
+	if i.Cache != nil {
+		page, err = i.Cache.Get(i.FS, i.Path, i.Frame)
+	} else {
+		page, err = DecodeGray(i.FS, i.Path, i.Frame)
+	}
+	if err != nil {
+		return err
+	}
+	var img image.Image
+	if i.Crop != nil {
+		img = i.Crop(page)
+	} else {
+		img = page.SubImage(i.Rect)
+	}
+	return png.Encode(w, img)
+}
+
+// PageCache holds the most recently used decoded page images, up to
+// a fixed number, so that lines from the same page don't each need
+// to decode it. Pages are identified by their path alone, so a
+// cache should only be used for images from one fs.FS. It is safe
+// for concurrent use.
+type PageCache struct {
+	size  int
+	mu    sync.Mutex
+	pages []cachedPage // most recently used last
+}
+
+type cachedPage struct {
+	path  string
+	frame int
+	img   *image.Gray
+}
+
+// NewPageCache returns a PageCache holding up to size pages
+func NewPageCache(size int) *PageCache {
+	return &PageCache{size: size}
+}
+
+// Get returns the decoded image at path in fsys, as DecodeGray, from
+// the cache if it is there
+func (c *PageCache) Get(fsys fs.FS, path string, frame int) (*image.Gray, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for i, p := range c.pages {
+		if p.path == path && p.frame == frame {
+			copy(c.pages[i:], c.pages[i+1:])
+			c.pages[len(c.pages)-1] = p
+			return p.img, nil
+		}
+	}
+
+	img, err := DecodeGray(fsys, path, frame)
+	if err != nil {
+		return nil, err
+	}
+	if c.size <= 0 {
+		return img, nil
+	}
+	for len(c.pages) >= c.size {
+		copy(c.pages, c.pages[1:])
+		c.pages[len(c.pages)-1] = cachedPage{}
+		c.pages = c.pages[:len(c.pages)-1]
+	}
+	c.pages = append(c.pages, cachedPage{path, frame, img})
+	return img, nil
+}
@@ -0,0 +1,160 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package line
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"io/fs"
+
+	_ "golang.org/x/image/tiff"
+	_ "golang.org/x/image/webp"
+	_ "rescribe.xyz/utils/pkg/jpeg2000"
+)
+
+// FormatError is returned when a page image is in a format which
+// can't be decoded
+type FormatError struct {
+	Path string
+	// Format is the name of the format, if it was recognised
+	Format string
+}
+
+func (e *FormatError) Error() string {
+	if e.Format == "" {
+		return fmt.Sprintf("Error decoding image %s: unrecognised image format", e.Path)
+	}
+	return fmt.Sprintf("Error decoding image %s: %s images are not supported", e.Path, e.Format)
+}
+
+// unsupported are the signatures of image formats which are known
+// but can't be decoded
+var unsupported = []struct {
+	name string
+	sig  string
+}{
+	{"JPEG XL", "\xff\x0a"},
+	{"JPEG XL", "\x00\x00\x00\x0cJXL \r\n\x87\n"},
+}
+
+// formatError returns a FormatError for an image which couldn't be
+// decoded, naming its format if it is recognised
+func formatError(path string, head []byte) error {
+	for _, u := range unsupported {
+		if bytes.HasPrefix(head, []byte(u.sig)) {
+			return &FormatError{path, u.name}
+		}
+	}
+	return &FormatError{Path: path}
+}
+
+// DecodeConfig returns the dimensions and format of an image,
+// without decoding the whole image, or a FormatError if it is in
+// a format which can't be decoded
+func DecodeConfig(fsys fs.FS, path string) (image.Config, string, error) {
+	f, err := fsys.Open(path)
+	if err != nil {
+		return image.Config{}, "", fmt.Errorf("Error opening image %s: %w", path, err)
+	}
+	defer f.Close()
+	head := make([]byte, 16)
+	n, err := io.ReadFull(f, head)
+	if err != nil && err != io.ErrUnexpectedEOF {
+		return image.Config{}, "", fmt.Errorf("Error reading image %s: %w", path, err)
+	}
+	head = head[:n]
+	cfg, format, err := image.DecodeConfig(io.MultiReader(bytes.NewReader(head), f))
+	if err == image.ErrFormat {
+		return cfg, format, formatError(path, head)
+	}
+	if err != nil {
+		return cfg, format, fmt.Errorf("Error decoding image %s: %w", path, err)
+	}
+	return cfg, format, nil
+}
+
+// DecodeGray opens and decodes an image, converting it to gray,
+// with its origin at 0,0. For multi-page TIFF images, frame selects
+// the page to use, counting from 0; it is ignored for images with
+// only one page.
+func DecodeGray(fsys fs.FS, path string, frame int) (*image.Gray, error) {
+	b, err := fs.ReadFile(fsys, path)
+	if err != nil {
+		return nil, fmt.Errorf("Error opening image %s: %w", path, err)
+	}
+	if isTIFF(b) {
+		b, err = tiffFrame(b, frame)
+		if err != nil {
+			return nil, fmt.Errorf("Error decoding image %s: %w", path, err)
+		}
+	}
+	img, _, err := image.Decode(bytes.NewReader(b))
+	if err == image.ErrFormat {
+		return nil, formatError(path, b)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Error decoding image %s: %w", path, err)
+	}
+	bounds := img.Bounds()
+	gray := image.NewGray(image.Rect(0, 0, bounds.Dx(), bounds.Dy()))
+	draw.Draw(gray, gray.Bounds(), img, bounds.Min, draw.Src)
+	return gray, nil
+}
+
+func isTIFF(b []byte) bool {
+	return bytes.HasPrefix(b, []byte("II*\x00")) || bytes.HasPrefix(b, []byte("MM\x00*"))
+}
+
+// tiffFrame returns a TIFF image whose first page is page frame of
+// the TIFF image b. As TIFF decoders only read the first page, this
+// is done by pointing the header at the image file directory (IFD)
+// of the frame, leaving the rest of the file as it is.
+func tiffFrame(b []byte, frame int) ([]byte, error) {
+	if len(b) < 8 {
+		return b, errors.New("TIFF header too short")
+	}
+	var order binary.ByteOrder = binary.LittleEndian
+	if b[0] == 'M' {
+		order = binary.BigEndian
+	}
+
+	var ifds []uint32
+	seen := make(map[uint32]bool)
+	for off := order.Uint32(b[4:8]); off != 0; {
+		if seen[off] {
+			return b, errors.New("TIFF IFDs form a loop")
+		}
+		seen[off] = true
+		if int64(off)+2 > int64(len(b)) {
+			return b, errors.New("TIFF IFD offset out of range")
+		}
+		ifds = append(ifds, off)
+		n := int64(order.Uint16(b[off : off+2]))
+		next := int64(off) + 2 + n*12
+		if next+4 > int64(len(b)) {
+			return b, errors.New("TIFF IFD out of range")
+		}
+		off = order.Uint32(b[next : next+4])
+	}
+
+	if len(ifds) <= 1 || frame == 0 {
+		return b, nil
+	}
+	if frame < 0 || frame >= len(ifds) {
+		return b, fmt.Errorf("page %d requested from a TIFF with %d pages", frame, len(ifds))
+	}
+	framed := make([]byte, len(b))
+	copy(framed, b)
+	order.PutUint32(framed[4:8], ifds[frame])
+	return framed, nil
+}
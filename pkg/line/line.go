@@ -10,26 +10,70 @@ import (
 	"image/png"
 	"io"
 	"os"
+	"sort"
 )
 
 type Detail struct {
-	Name    string
+	Name string
+	// Avgconf is the average confidence of the line, from 0 to 1,
+	// or -1 if it isn't known, as for a hOCR line with no words
+	// with an x_wconf
 	Avgconf float64
 	Img     CopyableImg
 	Text    string
 	OcrName string
+	Glyphs  []Glyph
+}
+
+// Glyph is a single character of a line, with its confidence on
+// the same scale as Avgconf, or -1 if it isn't known
+type Glyph struct {
+	Text string
+	Conf float64
+	Bbox image.Rectangle
 }
 
 type CopyableImg interface {
 	CopyLineTo(io.Writer) error
 }
 
+// SaveImg saves a line image to a new file named fn
+func SaveImg(fn string, img CopyableImg) error {
+	f, err := os.Create(fn)
+	if err != nil {
+		return err
+	}
+
+	err = img.CopyLineTo(f)
+	if err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
 type Details []Detail
 
 func (l Details) Len() int           { return len(l) }
 func (l Details) Less(i, j int) bool { return l[i].Avgconf < l[j].Avgconf }
 func (l Details) Swap(i, j int)      { l[i], l[j] = l[j], l[i] }
 
+// LowestGlyphs returns the n glyphs of the line with the lowest
+// confidence, worst first, ignoring any with unknown confidence
+func (d Detail) LowestGlyphs(n int) []Glyph {
+	var glyphs []Glyph
+	for _, g := range d.Glyphs {
+		if g.Conf >= 0 {
+			glyphs = append(glyphs, g)
+		}
+	}
+	sort.SliceStable(glyphs, func(i, j int) bool { return glyphs[i].Conf < glyphs[j].Conf })
+	if len(glyphs) > n {
+		glyphs = glyphs[:n]
+	}
+	return glyphs
+}
+
 // This is an implementation of the CopyableImg interface that
 // stores the image directly as an image.Image
 type ImgDirect struct {
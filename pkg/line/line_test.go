@@ -0,0 +1,181 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package line
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"image/png"
+	"io/fs"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+// countFS is an fs.FS which counts how many times each file is
+// opened
+type countFS struct {
+	files fstest.MapFS
+	opens map[string]int
+}
+
+func (c countFS) Open(name string) (fs.File, error) {
+	c.opens[name]++
+	return c.files.Open(name)
+}
+
+func TestImgCrop(t *testing.T) {
+	var b bytes.Buffer
+	err := png.Encode(&b, image.NewGray(image.Rect(0, 0, 100, 50)))
+	if err != nil {
+		t.Fatalf("Error encoding test image: %v", err)
+	}
+	fsys := countFS{fstest.MapFS{}, map[string]int{}}
+	for i := 1; i <= 3; i++ {
+		fsys.files[fmt.Sprintf("%d.png", i)] = &fstest.MapFile{Data: b.Bytes()}
+	}
+
+	cache := NewPageCache(2)
+	for _, c := range []struct {
+		path string
+		rect image.Rectangle
+		size image.Point
+	}{
+		{"1.png", image.Rect(0, 0, 50, 10), image.Pt(50, 10)},
+		{"1.png", image.Rect(0, 10, 100, 20), image.Pt(100, 10)},
+		{"2.png", image.Rect(0, 0, 10, 10), image.Pt(10, 10)},
+		{"1.png", image.Rect(90, 40, 200, 200), image.Pt(10, 10)},
+		{"3.png", image.Rect(0, 0, 10, 10), image.Pt(10, 10)},
+		{"2.png", image.Rect(0, 0, 10, 10), image.Pt(10, 10)},
+	} {
+		var out bytes.Buffer
+		err = ImgCrop{FS: fsys, Path: c.path, Rect: c.rect, Cache: cache}.CopyLineTo(&out)
+		if err != nil {
+			t.Fatalf("Error copying line: %v", err)
+		}
+		img, err := png.Decode(&out)
+		if err != nil {
+			t.Fatalf("Error decoding line: %v", err)
+		}
+		if s := img.Bounds().Size(); s != c.size {
+			t.Errorf("Expected %s %v to be %v, got %v", c.path, c.rect, c.size, s)
+		}
+	}
+
+	// 2.png is evicted when 3.png is added, as 1.png was used more
+	// recently
+	expected := map[string]int{"1.png": 1, "2.png": 2, "3.png": 1}
+	for k, v := range expected {
+		if fsys.opens[k] != v {
+			t.Errorf("Expected %s to be opened %d times, got %d", k, v, fsys.opens[k])
+		}
+	}
+
+	err = ImgCrop{FS: fsys, Path: "missing.png"}.CopyLineTo(&bytes.Buffer{})
+	if err == nil {
+		t.Errorf("Expected an error for a missing image")
+	}
+}
+
+// grayJP2 is a 12x7 gray JPEG 2000 image
+const grayJP2 = `AAAADGpQICANCocKAAAAFGZ0eXBqcDIgAAAAAGpwMiAAAAAtanAyaAAAABZp
+aGRyAAAABwAAAAwAAQcHAAAAAAAPY29scgEAAAAAABEAAADQanAyY/9P/1EA
+KQAAAAAADAAAAAcAAAAAAAAAAAAAAAwAAAAHAAAAAAAAAAAAAQcBAf9SAAwA
+AAABAAAEBAAB/1wABEBA/2QAJQABQ3JlYXRlZCBieSBPcGVuSlBFRyB2ZXJz
+aW9uIDIuNS4w/5AACgAAAAAAXgAB/5PfgmgSLdeDMDL4PrGHG4ZgEOokhsCX
+yK98LqqEivDXajzAXm4ah67ncqBpYPiVgGexJf3I5NuAVt5DobPwCXj0WT5n
+vtAbudZW/srT3XAK3//Z`
+
+// multiTIFF returns an uncompressed gray TIFF image with a page of
+// each of the given sizes
+func multiTIFF(sizes []image.Point) []byte {
+	le := binary.LittleEndian
+	b := []byte("II*\x00\x00\x00\x00\x00")
+	next := 4 // offset of the pointer to the next IFD
+	for _, s := range sizes {
+		data := len(b)
+		b = append(b, make([]byte, s.X*s.Y)...)
+		le.PutUint32(b[next:], uint32(len(b)))
+		entries := [][2]uint32{
+			{256, uint32(s.X)}, {257, uint32(s.Y)}, {258, 8}, {259, 1},
+			{262, 1}, {273, uint32(data)}, {277, 1}, {278, uint32(s.Y)},
+			{279, uint32(s.X * s.Y)},
+		}
+		ifd := make([]byte, 2+len(entries)*12+4)
+		le.PutUint16(ifd, uint16(len(entries)))
+		for i, e := range entries {
+			entry := ifd[2+i*12:]
+			le.PutUint16(entry, uint16(e[0]))
+			le.PutUint16(entry[2:], 4) // LONG
+			le.PutUint32(entry[4:], 1)
+			le.PutUint32(entry[8:], e[1])
+		}
+		next = len(b) + len(ifd) - 4
+		b = append(b, ifd...)
+	}
+	return b
+}
+
+func TestDecodeGray(t *testing.T) {
+	jp2, err := base64.StdEncoding.DecodeString(
+		strings.ReplaceAll(grayJP2, "\n", ""))
+	if err != nil {
+		t.Fatalf("Error decoding test image: %v", err)
+	}
+	fsys := fstest.MapFS{
+		"multi.tif":  {Data: multiTIFF([]image.Point{{10, 5}, {20, 8}})},
+		"single.tif": {Data: multiTIFF([]image.Point{{30, 3}})},
+		"page.jp2":   {Data: jp2},
+		"page.jxl":   {Data: []byte("\xff\x0a\xfa\x7a\x00\x00\x00\x00")},
+		"page.foo":   {Data: []byte("not an image at all")},
+	}
+
+	for _, c := range []struct {
+		path  string
+		frame int
+		size  image.Point
+	}{
+		{"multi.tif", 0, image.Pt(10, 5)},
+		{"multi.tif", 1, image.Pt(20, 8)},
+		{"single.tif", 3, image.Pt(30, 3)},
+		{"page.jp2", 0, image.Pt(12, 7)},
+	} {
+		img, err := DecodeGray(fsys, c.path, c.frame)
+		if err != nil {
+			t.Errorf("Error decoding %s frame %d: %v", c.path, c.frame, err)
+			continue
+		}
+		if s := img.Bounds().Size(); s != c.size {
+			t.Errorf("Expected %s frame %d to be %v, got %v", c.path, c.frame, c.size, s)
+		}
+	}
+
+	_, err = DecodeGray(fsys, "multi.tif", 2)
+	if err == nil {
+		t.Errorf("Expected an error for a missing TIFF page")
+	}
+
+	for _, c := range []struct {
+		path   string
+		format string
+	}{
+		{"page.jxl", "JPEG XL"},
+		{"page.foo", ""},
+	} {
+		_, _, err = DecodeConfig(fsys, c.path)
+		var ferr *FormatError
+		if !errors.As(err, &ferr) || ferr.Format != c.format {
+			t.Errorf("Expected FormatError for %s with format '%s', got %v", c.path, c.format, err)
+		}
+		_, err = DecodeGray(fsys, c.path, 0)
+		if !errors.As(err, &ferr) || ferr.Format != c.format {
+			t.Errorf("Expected FormatError for %s with format '%s', got %v", c.path, c.format, err)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+// markdown converts hOCR to Markdown, keeping the paragraphs of
+// the text. The lines of each paragraph are joined, so that it can
+// be reflowed.
+package markdown
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"rescribe.xyz/utils/pkg/hocr"
+	"rescribe.xyz/utils/pkg/normalize"
+)
+
+// Options control how hOCR is converted to Markdown
+type Options struct {
+	// Dehyphenate removes the hyphen from words which are
+	// hyphenated across lines, rather than keeping it
+	Dehyphenate bool
+	// PageMarkers adds a comment at the start of each page, with
+	// its number and the name of its image
+	PageMarkers bool
+	// Profile is used to normalise the text, if it is set
+	Profile *normalize.Profile
+}
+
+// special are the characters which are escaped anywhere in the text
+var special = regexp.MustCompile("[\\\\`*_\\[\\]<>#|]")
+
+// blockStart matches text at the start of a paragraph which would
+// otherwise start a list or be a thematic break
+var blockStart = regexp.MustCompile(`^(-+|\+|[0-9]+[.)])( |$)`)
+
+// escape escapes text so that it is not treated as Markdown syntax
+func escape(s string) string {
+	s = special.ReplaceAllString(s, `\$0`)
+	if m := blockStart.FindStringSubmatchIndex(s); m != nil {
+		s = s[:m[3]-1] + `\` + s[m[3]-1:]
+	}
+	return s
+}
+
+// Write writes a hOCR document as Markdown
+func Write(w io.Writer, h hocr.Hocr, opts Options) error {
+	var s strings.Builder
+	for i, p := range h.Pages {
+		if opts.PageMarkers {
+			props, err := p.Properties()
+			if err != nil {
+				return fmt.Errorf("Error converting page %d: %v", i+1, err)
+			}
+			marker := fmt.Sprintf("page %d", i+1)
+			if props.Image != "" {
+				marker += ", " + filepath.Base(props.Image)
+			}
+			fmt.Fprintf(&s, "<!-- %s -->\n\n", strings.ReplaceAll(marker, "--", "- -"))
+		}
+		for _, par := range p.Paragraphs() {
+			text := hocr.ParagraphText(*par, opts.Dehyphenate)
+			if opts.Profile != nil {
+				text = opts.Profile.String(text)
+			}
+			if text == "" {
+				continue
+			}
+			s.WriteString(escape(text) + "\n\n")
+		}
+	}
+	text := strings.TrimRight(s.String(), "\n")
+	if text == "" {
+		return nil
+	}
+	_, err := io.WriteString(w, text+"\n")
+	return err
+}
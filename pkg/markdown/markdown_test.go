@@ -0,0 +1,61 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package markdown
+
+import (
+	"bytes"
+	"testing"
+
+	"rescribe.xyz/utils/pkg/hocr"
+)
+
+const testHocr = `<html><body>
+<div class='ocr_page' id='page_1' title='image "/tmp/book/0001.png"; bbox 0 0 600 800'>
+<p class='ocr_par' id='par_1_1'>
+<span class='ocr_line' id='line_1_1'><span class='ocrx_word'>Lorem</span> <span class='ocrx_word'>ip-</span></span>
+<span class='ocr_line' id='line_1_2'><span class='ocrx_word'>ſum</span> <span class='ocrx_word'>*dolor*</span></span>
+</p>
+<p class='ocr_par' id='par_1_2'>
+<span class='ocr_line' id='line_1_3'>1. sit</span>
+<span class='ocr_line' id='line_1_4'>amet</span>
+</p>
+</div>
+<div class='ocr_page' id='page_2' title='bbox 0 0 600 800'>
+<p class='ocr_par' id='par_2_1'>
+<span class='ocr_line' id='line_2_1'>- consectetur</span>
+</p>
+</div>
+</body></html>`
+
+func TestWrite(t *testing.T) {
+	h, err := hocr.Parse([]byte(testHocr))
+	if err != nil {
+		t.Fatalf("Error parsing hOCR: %v", err)
+	}
+
+	cases := []struct {
+		name     string
+		opts     Options
+		expected string
+	}{
+		{"plain", Options{}, "Lorem ip-ſum \\*dolor\\*\n\n1\\. sit amet\n\n\\- consectetur\n"},
+		{"dehyphenated", Options{Dehyphenate: true}, "Lorem ipſum \\*dolor\\*\n\n1\\. sit amet\n\n\\- consectetur\n"},
+		{"pages", Options{PageMarkers: true},
+			"<!-- page 1, 0001.png -->\n\nLorem ip-ſum \\*dolor\\*\n\n1\\. sit amet\n\n" +
+				"<!-- page 2 -->\n\n\\- consectetur\n"},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			err := Write(&buf, h, c.opts)
+			if err != nil {
+				t.Fatalf("Error writing Markdown: %v", err)
+			}
+			if buf.String() != c.expected {
+				t.Errorf("Expected %q, got %q", c.expected, buf.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,194 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+// normalize converts text to a consistent form according to a
+// profile, so that the same glyph is encoded the same way whatever
+// the source of the text. As well as the standard Unicode forms,
+// there are profiles suited to historical print, and profiles can
+// be read from mapping files.
+package normalize
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+// Profile is a way of normalising text. The text is first converted
+// to a Unicode normalisation form, then any mappings are applied,
+// and then it is converted to the normalisation form again.
+type Profile struct {
+	Name    string
+	form    norm.Form
+	replace *strings.Replacer
+}
+
+// New returns a Profile which converts text to form, replacing each
+// key of mappings with its value. Keys are themselves converted to
+// form, so they match however they were written. Where keys
+// overlap, the longest one which matches is used.
+func New(name string, form norm.Form, mappings map[string]string) *Profile {
+	p := &Profile{Name: name, form: form}
+	if len(mappings) == 0 {
+		return p
+	}
+	// strings.Replacer tries its replacements in the order given,
+	// so they are sorted to make the result the same every time
+	keys := make([]string, 0, len(mappings))
+	for k := range mappings {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := form.String(keys[i]), form.String(keys[j])
+		if len(a) != len(b) {
+			return len(a) > len(b)
+		}
+		if a != b {
+			return a < b
+		}
+		return keys[i] < keys[j]
+	})
+	var oldnew []string
+	for _, k := range keys {
+		oldnew = append(oldnew, form.String(k), mappings[k])
+	}
+	p.replace = strings.NewReplacer(oldnew...)
+	return p
+}
+
+// String returns s normalised according to the profile
+func (p *Profile) String(s string) string {
+	s = p.form.String(s)
+	if p.replace == nil {
+		return s
+	}
+	return p.form.String(p.replace.Replace(s))
+}
+
+// modernised are the mappings used for a modernised transcription
+var modernised = map[string]string{
+	// abbreviation bars written as an overline are a macron
+	"\u0305": "\u0304",
+
+	"ſ": "s",
+	"ẛ": "ṡ",
+	"ꝛ": "r",
+	"ﬀ": "ff",
+	"ﬁ": "fi",
+	"ﬂ": "fl",
+	"ﬃ": "ffi",
+	"ﬄ": "ffl",
+	"ﬅ": "st",
+	"ﬆ": "st",
+}
+
+// The built in profiles. NFC and NFD only convert text to those
+// Unicode normalisation forms. Diplomatic keeps characters such as
+// long s, ligatures and abbreviation marks as they are, including
+// the difference between an overline and a macron, so it only
+// converts text to NFC. Modernised replaces long s and r rotunda
+// with s and r, splits ligatures such as ﬁ into their letters, and
+// writes abbreviation bars as macrons, while keeping letters such
+// as æ and abbreviation marks.
+var (
+	NFC        = New("nfc", norm.NFC, nil)
+	NFD        = New("nfd", norm.NFD, nil)
+	Diplomatic = New("diplomatic", norm.NFC, nil)
+	Modernised = New("modernised", norm.NFC, modernised)
+)
+
+var profiles = map[string]*Profile{
+	NFC.Name:        NFC,
+	NFD.Name:        NFD,
+	Diplomatic.Name: Diplomatic,
+	Modernised.Name: Modernised,
+}
+
+// Names returns the names of the built in profiles
+func Names() []string {
+	var names []string
+	for n := range profiles {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Get returns the built in profile with a name, or if there isn't
+// one, the profile read from the mapping file at that path
+func Get(name string) (*Profile, error) {
+	if p, ok := profiles[name]; ok {
+		return p, nil
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, fmt.Errorf("no normalization profile %s, and could not open it as a mapping file: %w", name, err)
+	}
+	defer f.Close()
+	return Read(f, name)
+}
+
+// Read reads a mapping file into a Profile which uses NFC. Each line
+// of the file is a string to replace, followed by whitespace and
+// its replacement, for example "ſ s". If there is no replacement
+// the string is removed. Either can use Go escapes such as \u0304
+// or \", and \s for a space. Blank lines and lines starting with #
+// are ignored. Each string can only be replaced once in a file.
+func Read(r io.Reader, name string) (*Profile, error) {
+	mappings := make(map[string]string)
+	lines := make(map[string]int)
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		f := strings.Fields(scanner.Text())
+		if len(f) == 0 || strings.HasPrefix(f[0], "#") {
+			continue
+		}
+		if len(f) > 2 {
+			return nil, fmt.Errorf("%s:%d: too many fields", name, n)
+		}
+		var s []string
+		for _, field := range f {
+			u, err := unescape(field)
+			if err != nil {
+				return nil, fmt.Errorf("%s:%d: %v", name, n, err)
+			}
+			s = append(s, u)
+		}
+		if s[0] == "" {
+			return nil, fmt.Errorf("%s:%d: empty string to replace", name, n)
+		}
+		if len(s) == 1 {
+			s = append(s, "")
+		}
+		key := norm.NFC.String(s[0])
+		if prev, ok := lines[key]; ok {
+			return nil, fmt.Errorf("%s:%d: %s is already replaced on line %d", name, n, f[0], prev)
+		}
+		lines[key] = n
+		mappings[s[0]] = s[1]
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return New(name, norm.NFC, mappings), nil
+}
+
+// unescape interprets Go escapes in s, as well as \s for a space.
+// A quote can be given either as it is or escaped as \".
+func unescape(s string) (string, error) {
+	// escaped backslashes and quotes are kept as they are, so that
+	// for example \\s stays a backslash followed by s
+	q := strings.NewReplacer(`\\`, `\\`, `\"`, `\"`, `\s`, " ", `"`, `\"`).Replace(s)
+	u, err := strconv.Unquote(`"` + q + `"`)
+	if err != nil {
+		return "", fmt.Errorf("invalid escape in %s", s)
+	}
+	return u, nil
+}
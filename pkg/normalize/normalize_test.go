@@ -0,0 +1,78 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package normalize
+
+import (
+	"strings"
+	"testing"
+
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestProfiles(t *testing.T) {
+	mapping := `# expand abbreviations
+q̄ que
+⁊ et
+ꝰ
+a\se a_e
+\"q\" 'q'
+x\\s "
+`
+	custom, err := Read(strings.NewReader(mapping), "custom")
+	if err != nil {
+		t.Fatalf("Error reading mapping: %v", err)
+	}
+
+	cases := []struct {
+		name     string
+		p        *Profile
+		in       string
+		expected string
+	}{
+		// e + combining macron, and the precomposed form
+		{"nfc", NFC, "dē", "dē"},
+		{"nfd", NFD, "dē", "dē"},
+		{"diplomatic keeps", Diplomatic, "ſuﬁcit æquus ⁊", "ſuﬁcit æquus ⁊"},
+		{"diplomatic overline", Diplomatic, "de̅", "de̅"},
+		{"modernised overline", Modernised, "de̅", "dē"},
+		{"modernised", Modernised, "ſuﬁcit æquus ﬅat", "suficit æquus stat"},
+		{"modernised long s dot", Modernised, "ẛ", "ṡ"},
+		{"modernised macron", Modernised, "dq̄ꝛ", "dq̄r"},
+		{"custom", custom, "atq̄ ⁊ eꝰ a e", "atque et e a_e"},
+		{"custom quotes", custom, `"q" x\s`, `'q' "`},
+		{"longest first", New("overlap", norm.NFC, map[string]string{"ſ": "s", "ſt": "ST", "ſte": "E"}), "ſtet ſtſ", "Et STs"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			actual := c.p.String(c.in)
+			if actual != c.expected {
+				t.Errorf("Expected %q, got %q", c.expected, actual)
+			}
+		})
+	}
+}
+
+func TestReadErrors(t *testing.T) {
+	cases := []struct {
+		name     string
+		mapping  string
+		expected string
+	}{
+		{"fields", "a b c\n", "bad:1: too many fields"},
+		{"escape", "\n\\q a\n", "bad:2: invalid escape in \\q"},
+		{"duplicate", "ſ s\nſt st\nſ f\n", "bad:3: ſ is already replaced on line 1"},
+		{"duplicate normalised", "e\u0301 e\n\u00e9 f\n", "bad:2: \u00e9 is already replaced on line 1"},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := Read(strings.NewReader(c.mapping), "bad")
+			if err == nil || err.Error() != c.expected {
+				t.Errorf("Expected error %q, got %v", c.expected, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package page
+
+import (
+	"fmt"
+	"image"
+	"time"
+
+	"rescribe.xyz/utils/pkg/hocr"
+)
+
+// ToHocr converts a PAGE document to a hOCR page. Each text region
+// becomes an ocr_carea, including nested regions, which are
+// flattened, and lines, words and glyphs become ocr_line,
+// ocrx_word and ocrx_cinfo elements.
+func ToHocr(p PcGts) hocr.Page {
+	var props hocr.Properties
+	props.Image = p.Page.ImageFilename
+	props.Bbox = image.Rect(0, 0, p.Page.ImageWidth, p.Page.ImageHeight)
+	pg := hocr.Page{Class: "ocr_page", Id: "page_1", Title: props.String()}
+
+	for _, r := range p.Regions() {
+		a := hocr.Area{Class: "ocr_carea", Id: r.Id, Title: bboxTitle(r.Coords.Points.Bounds())}
+		if len(r.TextLines) == 0 {
+			pg.Areas = append(pg.Areas, a)
+			continue
+		}
+		var par hocr.Paragraph
+		for _, l := range r.TextLines {
+			par.Lines = append(par.Lines, lineToHocr(l))
+		}
+		a.Paragraphs = []hocr.Paragraph{par}
+		pg.Areas = append(pg.Areas, a)
+	}
+
+	return pg
+}
+
+// bboxTitle returns a title containing just a bbox, or nothing if
+// the box is empty
+func bboxTitle(r image.Rectangle) string {
+	if r.Empty() {
+		return ""
+	}
+	return hocr.Properties{Bbox: r}.String()
+}
+
+func lineToHocr(l TextLine) hocr.OcrLine {
+	var props hocr.Properties
+	b := l.Coords.Points.Bounds()
+	props.Bbox = b
+	if l.Baseline != nil && len(l.Baseline.Points) > 1 && !b.Empty() {
+		// hOCR baselines are a slope and an offset from the bottom
+		// left of the bounding box, so use the first and last points
+		pts := l.Baseline.Points
+		first, last := pts[0], pts[len(pts)-1]
+		if last.X != first.X {
+			slope := float64(last.Y-first.Y) / float64(last.X-first.X)
+			y := float64(first.Y) + slope*float64(b.Min.X-first.X)
+			props.Baseline = hocr.Baseline{Slope: slope, Offset: y - float64(b.Max.Y)}
+		}
+		props.Keys = []string{"bbox", "baseline"}
+	}
+
+	ol := hocr.OcrLine{Class: "ocr_line", Id: l.Id, Title: props.String()}
+	if len(l.Words) == 0 {
+		ol.Text = l.Text()
+		return ol
+	}
+	for _, w := range l.Words {
+		ol.Words = append(ol.Words, wordToHocr(w))
+	}
+	return ol
+}
+
+func wordToHocr(w Word) hocr.OcrWord {
+	var props hocr.Properties
+	props.Bbox = w.Coords.Points.Bounds()
+	props.Wconf = w.Conf() * 100
+	ow := hocr.OcrWord{Class: "ocrx_word", Id: w.Id, Title: props.String()}
+	if len(w.Glyphs) == 0 {
+		ow.Text = w.Text()
+		return ow
+	}
+	for _, g := range w.Glyphs {
+		var gprops hocr.Properties
+		if b := g.Coords.Points.Bounds(); !b.Empty() {
+			gprops.Bboxes = []image.Rectangle{b}
+		}
+		gprops.Conf = g.Conf() * 100
+		ow.Chars = append(ow.Chars, hocr.OcrChar{Class: "ocrx_cinfo", Id: g.Id, Title: gprops.String(), Text: g.Text()})
+	}
+	return ow
+}
+
+// FromHocr converts a hOCR page to a PAGE document. Each paragraph
+// becomes a text region, as PAGE has no separate paragraphs, and
+// lines, words and glyphs become TextLine, Word and Glyph elements.
+// Ids are generated for any elements which don't have them, as
+// they are required in PAGE. Problems which don't stop the
+// conversion are reported with warn, or printed to standard error
+// if it is nil.
+func FromHocr(pg hocr.Page, warn func(error)) (PcGts, error) {
+	var p PcGts
+	p.Metadata.Creator = "rescribe.xyz/utils"
+	now := time.Now().UTC().Format(time.RFC3339)
+	p.Metadata.Created = now
+	p.Metadata.LastChange = now
+
+	props, err := pg.Properties()
+	if err != nil {
+		return p, fmt.Errorf("Error parsing page properties: %v", err)
+	}
+	p.Page.ImageFilename = props.Image
+	p.Page.ImageWidth = props.Bbox.Dx()
+	p.Page.ImageHeight = props.Bbox.Dy()
+
+	ids := idMaker{}
+	for _, a := range pg.Areas {
+		aprops, err := a.Properties()
+		if err != nil {
+			return p, fmt.Errorf("Error parsing properties of area %s: %v", a.Id, err)
+		}
+		for _, par := range a.Paragraphs {
+			pprops, err := par.Properties()
+			if err != nil {
+				return p, fmt.Errorf("Error parsing properties of paragraph %s: %v", par.Id, err)
+			}
+			r := TextRegion{Type: "paragraph"}
+			r.Id = ids.id(par.Id, "r")
+			if par.Class == "" && len(a.Paragraphs) == 1 {
+				r.Id = ids.id(a.Id, "r")
+			}
+			b := pprops.Bbox
+			if b.Empty() {
+				b = aprops.Bbox
+			}
+			for _, l := range par.Lines {
+				tl, err := lineFromHocr(l, ids, warn)
+				if err != nil {
+					return p, err
+				}
+				if pprops.Bbox.Empty() && aprops.Bbox.Empty() {
+					b = b.Union(tl.Coords.Points.Bounds())
+				}
+				r.TextLines = append(r.TextLines, tl)
+			}
+			r.Coords.Points = rectPoints(b)
+			p.Page.TextRegions = append(p.Page.TextRegions, r)
+		}
+	}
+
+	return p, nil
+}
+
+// idMaker makes unique ids
+type idMaker map[string]bool
+
+// id returns id if it is set and unused, otherwise a new id
+// starting with prefix
+func (m idMaker) id(id string, prefix string) string {
+	if id != "" && !m[id] {
+		m[id] = true
+		return id
+	}
+	for n := len(m) + 1; ; n++ {
+		id = fmt.Sprintf("%s%d", prefix, n)
+		if !m[id] {
+			m[id] = true
+			return id
+		}
+	}
+}
+
+func lineFromHocr(l hocr.OcrLine, ids idMaker, warn func(error)) (TextLine, error) {
+	var tl TextLine
+	props, err := l.Properties()
+	if err != nil {
+		return tl, fmt.Errorf("Error parsing properties of line %s: %v", l.Id, err)
+	}
+	tl.Id = ids.id(l.Id, "l")
+	b := props.Bbox
+	tl.Coords.Points = rectPoints(b)
+	if props.Has("baseline") && !b.Empty() {
+		y := float64(b.Max.Y) + props.Baseline.Offset
+		y2 := y + props.Baseline.Slope*float64(b.Dx())
+		tl.Baseline = &Coords{Points{image.Pt(b.Min.X, int(y)), image.Pt(b.Max.X, int(y2))}}
+	}
+
+	for _, w := range l.Words {
+		wprops, err := w.Properties()
+		if err != nil {
+			return tl, fmt.Errorf("Error parsing properties of word %s: %v", w.Id, err)
+		}
+		var word Word
+		word.Id = ids.id(w.Id, "w")
+		word.Coords.Points = rectPoints(wprops.Bbox)
+		glyphs, err := w.Glyphs()
+		if err != nil {
+			// glyph information is optional, so the word is kept
+			// without it
+			(&Options{Warn: warn}).warn(fmt.Errorf("word %s: %w", w.Id, err))
+			glyphs = hocr.TextGlyphs(w)
+		}
+		var txt string
+		for _, g := range glyphs {
+			txt += g.Text
+			if len(w.Chars) == 0 && g.Conf < 0 && g.Bbox.Empty() {
+				// no useful information beyond the word text
+				continue
+			}
+			glyph := Glyph{Id: ids.id("", word.Id+"_g")}
+			glyph.Coords.Points = rectPoints(g.Bbox)
+			glyph.TextEquiv = []TextEquiv{{Conf: confFromHocr(g.Conf), Unicode: g.Text}}
+			word.Glyphs = append(word.Glyphs, glyph)
+		}
+		word.TextEquiv = []TextEquiv{{Conf: wprops.Wconf / 100, Unicode: txt}}
+		tl.Words = append(tl.Words, word)
+	}
+
+	tl.TextEquiv = []TextEquiv{{Unicode: hocr.LineText(l)}}
+	return tl, nil
+}
+
+// confFromHocr converts a hOCR confidence, from 0 to 100 or -1 if
+// unknown, to a PAGE confidence, from 0 to 1 or 0 if unknown
+func confFromHocr(c float64) float64 {
+	if c < 0 {
+		return 0
+	}
+	return c / 100
+}
@@ -0,0 +1,226 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package page
+
+import (
+	"errors"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"rescribe.xyz/utils/pkg/line"
+)
+
+// cropPolygon returns the part of img inside a polygon, as a gray
+// image covering the bounding box of the polygon, with anything
+// outside of the polygon set to white
+func cropPolygon(img *image.Gray, poly Points) *image.Gray {
+	r := poly.Bounds().Intersect(img.Bounds())
+	crop := image.NewGray(r)
+	for y := r.Min.Y; y < r.Max.Y; y++ {
+		for x := r.Min.X; x < r.Max.X; x++ {
+			if inPolygon(float64(x)+0.5, float64(y)+0.5, poly) {
+				crop.SetGray(x, y, img.GrayAt(x, y))
+			} else {
+				crop.SetGray(x, y, color.Gray{255})
+			}
+		}
+	}
+	return crop
+}
+
+// inPolygon reports whether a point is inside a polygon, using the
+// even-odd rule
+func inPolygon(x, y float64, poly Points) bool {
+	in := false
+	for i, j := 0, len(poly)-1; i < len(poly); j, i = i, i+1 {
+		xi, yi := float64(poly[i].X), float64(poly[i].Y)
+		xj, yj := float64(poly[j].X), float64(poly[j].Y)
+		if (yi > y) != (yj > y) && x < (xj-xi)*(y-yi)/(yj-yi)+xi {
+			in = !in
+		}
+	}
+	return in
+}
+
+// Options configures how line details are read from PAGE XML
+type Options struct {
+	// Lenient causes page images which can't be decoded to be
+	// skipped and reported with Warn, leaving the lines without
+	// images, rather than stopping with an error.
+	Lenient bool
+	// Warn is called with any problems which have been skipped.
+	// If it is nil, warnings are printed to standard error.
+	Warn func(error)
+}
+
+// skip returns err if the options aren't lenient, otherwise it
+// reports err with warn and returns nil
+func (o *Options) skip(err error) error {
+	if !o.Lenient {
+		return err
+	}
+	o.warn(err)
+	return nil
+}
+
+// warn reports a problem which doesn't stop processing
+func (o *Options) warn(err error) {
+	if o.Warn != nil {
+		o.Warn(err)
+		return
+	}
+	fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+}
+
+// lineDetails converts a PAGE document into a line.Details,
+// including images of each line cropped to its polygon. The page
+// image named imgpath is opened from imgs.
+func (o *Options) lineDetails(p PcGts, imgs fs.FS, imgpath string) (line.Details, error) {
+	lines := make(line.Details, 0)
+
+	imgpath = path.Base(filepath.ToSlash(imgpath))
+
+	gray, err := line.DecodeGray(imgs, imgpath, 0)
+	if errors.Is(err, fs.ErrNotExist) {
+		o.warn(err)
+	} else if err != nil {
+		// an image which can't be decoded, such as one in an
+		// unsupported format, is skipped if lenient, leaving the
+		// lines without images
+		err = o.skip(err)
+		if err != nil {
+			return lines, err
+		}
+		gray = nil
+	}
+
+	for _, l := range p.Lines() {
+		var ln line.Detail
+		ln.Name = l.Id
+		ln.Avgconf = unknownConf(l.Conf())
+		ln.Text = l.Text()
+		ln.OcrName = strings.TrimSuffix(imgpath, path.Ext(imgpath))
+		for _, w := range l.Words {
+			if len(w.Glyphs) == 0 {
+				for _, c := range w.Text() {
+					ln.Glyphs = append(ln.Glyphs, line.Glyph{Text: string(c), Conf: unknownConf(w.Conf())})
+				}
+				continue
+			}
+			for _, g := range w.Glyphs {
+				ln.Glyphs = append(ln.Glyphs, line.Glyph{Text: g.Text(), Conf: unknownConf(g.Conf()), Bbox: g.Coords.Points.Bounds()})
+			}
+		}
+		if gray != nil && len(l.Coords.Points) > 2 {
+			ln.Img = line.ImgDirect{Img: cropPolygon(gray, l.Coords.Points)}
+		}
+		lines = append(lines, ln)
+	}
+
+	return lines, nil
+}
+
+// unknownConf converts a PAGE confidence to a line.Details
+// confidence, which is -1 if it isn't known
+func unknownConf(c float64) float64 {
+	if c == 0 {
+		return -1
+	}
+	return c
+}
+
+// ReadLineDetails parses PAGE XML from r and returns a corresponding
+// line.Details, including images of each line, cropped to its
+// polygon. The page image is opened from imgs, using the image name
+// embedded in the PAGE XML.
+func ReadLineDetails(r io.Reader, imgs fs.FS) (line.Details, error) {
+	p, err := Read(r)
+	if err != nil {
+		return make(line.Details, 0), err
+	}
+	return (&Options{}).lineDetails(p, imgs, p.Page.ImageFilename)
+}
+
+// ReadLineDetailsCustomImg is a variant of ReadLineDetails that
+// uses the image named imgname in imgs, rather than the image name
+// embedded in the PAGE XML
+func ReadLineDetailsCustomImg(r io.Reader, imgs fs.FS, imgname string) (line.Details, error) {
+	p, err := Read(r)
+	if err != nil {
+		return make(line.Details, 0), err
+	}
+	return (&Options{}).lineDetails(p, imgs, imgname)
+}
+
+// GetLineDetails parses a PAGE XML file and returns a corresponding
+// line.Details, including images of each line, cropped to its
+// polygon, with the page image opened from the same directory
+func GetLineDetails(fn string) (line.Details, error) {
+	f, err := os.Open(fn)
+	if err != nil {
+		return make(line.Details, 0), err
+	}
+	defer f.Close()
+
+	return ReadLineDetails(f, os.DirFS(filepath.Dir(fn)))
+}
+
+// GetLineDetailsCustomImg is a variant of GetLineDetails that uses
+// a provided image path, rather than the image name embedded in
+// the PAGE XML
+func GetLineDetailsCustomImg(fn string, imgfn string) (line.Details, error) {
+	f, err := os.Open(fn)
+	if err != nil {
+		return make(line.Details, 0), err
+	}
+	defer f.Close()
+
+	return ReadLineDetailsCustomImg(f, os.DirFS(filepath.Dir(imgfn)), filepath.Base(imgfn))
+}
+
+// OpenLineDetails opens a PAGE XML file and returns a line.Details
+// for all of its lines, with the page image opened from the same
+// directory as the file. If imgname is set it is used as the page
+// image, rather than the image name embedded in the PAGE XML. The
+// Options used can be configured by setup, if it is not nil, and
+// any warnings are prefixed with the file name.
+func OpenLineDetails(fn string, imgname string, setup func(*Options)) (line.Details, error) {
+	f, err := os.Open(fn)
+	if err != nil {
+		return make(line.Details, 0), err
+	}
+	defer f.Close()
+
+	p, err := Read(f)
+	if err != nil {
+		return make(line.Details, 0), err
+	}
+
+	o := &Options{}
+	if setup != nil {
+		setup(o)
+	}
+	warn := o.Warn
+	o.Warn = func(err error) {
+		err = fmt.Errorf("%s: %w", fn, err)
+		if warn != nil {
+			warn(err)
+			return
+		}
+		fmt.Fprintf(os.Stderr, "Warning: %v\n", err)
+	}
+
+	if imgname == "" {
+		imgname = p.Page.ImageFilename
+	}
+	return o.lineDetails(p, os.DirFS(filepath.Dir(fn)), imgname)
+}
@@ -0,0 +1,335 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+// page contains structures and functions for parsing, writing and
+// converting PAGE XML files, as used by Transkribus, OCR-D,
+// eScriptorium and others
+package page
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"image"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Namespace is the PAGE XML namespace used when writing documents
+const Namespace = "http://schema.primaresearch.org/PAGE/gts/pagecontent/2019-07-15"
+
+// PcGts is a PAGE XML document. Elements are matched by their local
+// name, so documents from any version of the schema can be parsed.
+type PcGts struct {
+	XMLName  xml.Name `xml:"PcGts"`
+	Xmlns    string   `xml:"xmlns,attr,omitempty"`
+	Metadata Metadata `xml:"Metadata"`
+	Page     Page     `xml:"Page"`
+}
+
+type Metadata struct {
+	Creator    string `xml:"Creator"`
+	Created    string `xml:"Created"`
+	LastChange string `xml:"LastChange"`
+	Comments   string `xml:"Comments,omitempty"`
+}
+
+type Page struct {
+	ImageFilename string       `xml:"imageFilename,attr"`
+	ImageWidth    int          `xml:"imageWidth,attr"`
+	ImageHeight   int          `xml:"imageHeight,attr"`
+	TextRegions   []TextRegion `xml:"TextRegion"`
+}
+
+// TextRegion is a region of text, which can contain lines and
+// further nested regions
+type TextRegion struct {
+	Id          string       `xml:"id,attr"`
+	Type        string       `xml:"type,attr,omitempty"`
+	Coords      Coords       `xml:"Coords"`
+	TextRegions []TextRegion `xml:"TextRegion"`
+	TextLines   []TextLine   `xml:"TextLine"`
+	TextEquiv   []TextEquiv  `xml:"TextEquiv"`
+}
+
+type TextLine struct {
+	Id        string      `xml:"id,attr"`
+	Coords    Coords      `xml:"Coords"`
+	Baseline  *Coords     `xml:"Baseline"`
+	Words     []Word      `xml:"Word"`
+	TextEquiv []TextEquiv `xml:"TextEquiv"`
+}
+
+type Word struct {
+	Id        string      `xml:"id,attr"`
+	Coords    Coords      `xml:"Coords"`
+	Glyphs    []Glyph     `xml:"Glyph"`
+	TextEquiv []TextEquiv `xml:"TextEquiv"`
+}
+
+type Glyph struct {
+	Id        string      `xml:"id,attr"`
+	Coords    Coords      `xml:"Coords"`
+	TextEquiv []TextEquiv `xml:"TextEquiv"`
+}
+
+// TextEquiv is a transcription of a region, line, word or glyph.
+// There can be several, distinguished by their index, in which case
+// the one with the lowest index is preferred.
+type TextEquiv struct {
+	Index     int     `xml:"index,attr,omitempty"`
+	Conf      float64 `xml:"conf,attr,omitempty"` // from 0 to 1, or 0 if unknown
+	PlainText string  `xml:"PlainText,omitempty"`
+	Unicode   string  `xml:"Unicode"`
+}
+
+// Coords is a polygon, or for a Baseline a polyline. Both the
+// points attribute of current versions of PAGE and the Point
+// elements of the 2010 version are read, but only the points
+// attribute is written.
+type Coords struct {
+	Points Points `xml:"points,attr"`
+}
+
+// Points is a list of points, written as "x1,y1 x2,y2 ..."
+type Points []image.Point
+
+// UnmarshalXML reads a Coords or Baseline element
+func (c *Coords) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var v struct {
+		Points string `xml:"points,attr"`
+		Point  []struct {
+			X string `xml:"x,attr"`
+			Y string `xml:"y,attr"`
+		} `xml:"Point"`
+	}
+	err := d.DecodeElement(&v, &start)
+	if err != nil {
+		return err
+	}
+	c.Points, err = parsePoints(v.Points)
+	if err != nil {
+		return err
+	}
+	for _, p := range v.Point {
+		pt, err := parsePoints(p.X + "," + p.Y)
+		if err != nil {
+			return err
+		}
+		c.Points = append(c.Points, pt...)
+	}
+	return nil
+}
+
+// MarshalXMLAttr writes the points attribute
+func (p Points) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	return xml.Attr{Name: name, Value: p.String()}, nil
+}
+
+func (p Points) String() string {
+	var s []string
+	for _, pt := range p {
+		s = append(s, fmt.Sprintf("%d,%d", pt.X, pt.Y))
+	}
+	return strings.Join(s, " ")
+}
+
+// Bounds returns the bounding box of the points
+func (p Points) Bounds() image.Rectangle {
+	if len(p) == 0 {
+		return image.Rectangle{}
+	}
+	r := image.Rectangle{p[0], p[0]}
+	for _, pt := range p[1:] {
+		if pt.X < r.Min.X {
+			r.Min.X = pt.X
+		}
+		if pt.Y < r.Min.Y {
+			r.Min.Y = pt.Y
+		}
+		if pt.X > r.Max.X {
+			r.Max.X = pt.X
+		}
+		if pt.Y > r.Max.Y {
+			r.Max.Y = pt.Y
+		}
+	}
+	return r
+}
+
+// parsePoints parses a points attribute. Decimal coordinates, which
+// some tools write, are truncated.
+func parsePoints(s string) (Points, error) {
+	var pts Points
+	for _, f := range strings.Fields(s) {
+		xy := strings.Split(f, ",")
+		if len(xy) != 2 {
+			return pts, fmt.Errorf("Invalid point '%s'", f)
+		}
+		var c [2]int
+		for i, v := range xy {
+			n, err := strconv.ParseFloat(v, 64)
+			if err != nil {
+				return pts, fmt.Errorf("Invalid point '%s': %v", f, err)
+			}
+			c[i] = int(n)
+		}
+		pts = append(pts, image.Pt(c[0], c[1]))
+	}
+	return pts, nil
+}
+
+// rectPoints returns the points of the corners of a rectangle
+func rectPoints(r image.Rectangle) Points {
+	if r.Empty() {
+		return nil
+	}
+	return Points{r.Min, image.Pt(r.Max.X, r.Min.Y), r.Max, image.Pt(r.Min.X, r.Max.Y)}
+}
+
+// text returns the preferred transcription from a list
+func text(te []TextEquiv) (TextEquiv, bool) {
+	if len(te) == 0 {
+		return TextEquiv{}, false
+	}
+	best := te[0]
+	for _, t := range te[1:] {
+		if t.Index < best.Index {
+			best = t
+		}
+	}
+	return best, true
+}
+
+// Text returns the text of the line, from its TextEquiv, or if it
+// has none, from the text of its words
+func (l TextLine) Text() string {
+	if t, ok := text(l.TextEquiv); ok && t.Unicode != "" {
+		return t.Unicode
+	}
+	var words []string
+	for _, w := range l.Words {
+		if s := w.Text(); s != "" {
+			words = append(words, s)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// Conf returns the confidence of the line, from 0 to 1, from its
+// TextEquiv, or if it has none, as an average of the confidence of
+// its words. 0 is returned if no confidence is known.
+func (l TextLine) Conf() float64 {
+	if t, ok := text(l.TextEquiv); ok && t.Conf != 0 {
+		return t.Conf
+	}
+	var total, num float64
+	for _, w := range l.Words {
+		if c := w.Conf(); c != 0 {
+			total += c
+			num++
+		}
+	}
+	if num == 0 {
+		return 0
+	}
+	return total / num
+}
+
+// Text returns the text of the word, from its TextEquiv, or if it
+// has none, from the text of its glyphs
+func (w Word) Text() string {
+	if t, ok := text(w.TextEquiv); ok && t.Unicode != "" {
+		return t.Unicode
+	}
+	var s string
+	for _, g := range w.Glyphs {
+		s += g.Text()
+	}
+	return s
+}
+
+// Conf returns the confidence of the word, from 0 to 1, or 0 if
+// it isn't known
+func (w Word) Conf() float64 {
+	t, _ := text(w.TextEquiv)
+	return t.Conf
+}
+
+// Text returns the text of the glyph
+func (g Glyph) Text() string {
+	t, _ := text(g.TextEquiv)
+	return t.Unicode
+}
+
+// Conf returns the confidence of the glyph, from 0 to 1, or 0 if
+// it isn't known
+func (g Glyph) Conf() float64 {
+	t, _ := text(g.TextEquiv)
+	return t.Conf
+}
+
+// Regions returns all text regions in the document, including
+// nested ones, in order
+func (p *PcGts) Regions() []*TextRegion {
+	var regions []*TextRegion
+	var add func(r []TextRegion)
+	add = func(r []TextRegion) {
+		for i := range r {
+			regions = append(regions, &r[i])
+			add(r[i].TextRegions)
+		}
+	}
+	add(p.Page.TextRegions)
+	return regions
+}
+
+// Lines returns all lines in the document, in order
+func (p *PcGts) Lines() []*TextLine {
+	var lines []*TextLine
+	for _, r := range p.Regions() {
+		for i := range r.TextLines {
+			lines = append(lines, &r.TextLines[i])
+		}
+	}
+	return lines
+}
+
+// Read parses a PAGE XML document from r
+func Read(r io.Reader) (PcGts, error) {
+	var p PcGts
+	err := xml.NewDecoder(r).Decode(&p)
+	if err != nil {
+		return p, fmt.Errorf("Error parsing PAGE XML: %v", err)
+	}
+	return p, nil
+}
+
+// Parse parses a PAGE XML document
+func Parse(b []byte) (PcGts, error) {
+	return Read(bytes.NewReader(b))
+}
+
+// Write writes a PAGE XML document to w
+func Write(w io.Writer, p PcGts) error {
+	if p.Xmlns == "" {
+		p.Xmlns = Namespace
+	}
+	// ensure any namespace from parsing isn't written as well
+	p.XMLName = xml.Name{}
+
+	_, err := io.WriteString(w, xml.Header)
+	if err != nil {
+		return err
+	}
+	e := xml.NewEncoder(w)
+	e.Indent("", "  ")
+	err = e.Encode(p)
+	if err != nil {
+		return err
+	}
+	_, err = io.WriteString(w, "\n")
+	return err
+}
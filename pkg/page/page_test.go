@@ -0,0 +1,218 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package page
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"rescribe.xyz/utils/pkg/hocr"
+	"rescribe.xyz/utils/pkg/line"
+)
+
+const pageXml = `<?xml version="1.0" encoding="UTF-8"?>
+<PcGts xmlns="http://schema.primaresearch.org/PAGE/gts/pagecontent/2019-07-15">
+  <Metadata>
+    <Creator>Transkribus</Creator>
+    <Created>2021-03-01T10:00:00</Created>
+    <LastChange>2021-03-01T10:00:00</LastChange>
+  </Metadata>
+  <Page imageFilename="test.png" imageWidth="100" imageHeight="60">
+    <TextRegion id="r1" type="paragraph">
+      <Coords points="0,0 100,0 100,60 0,60"/>
+      <TextLine id="r1l1">
+        <Coords points="10,10 90,10 90,30 10,30"/>
+        <Baseline points="10,26 90,26"/>
+        <Word id="r1l1w1">
+          <Coords points="10,10 40,10 40,30 10,30"/>
+          <Glyph id="r1l1w1g1">
+            <Coords points="10,10 25,10 25,30 10,30"/>
+            <TextEquiv conf="0.9"><Unicode>u</Unicode></TextEquiv>
+          </Glyph>
+          <Glyph id="r1l1w1g2">
+            <Coords points="25,10 40,10 40,30 25,30"/>
+            <TextEquiv conf="0.4"><Unicode>t</Unicode></TextEquiv>
+          </Glyph>
+          <TextEquiv conf="0.6"><Unicode>ut</Unicode></TextEquiv>
+        </Word>
+        <Word id="r1l1w2">
+          <Coords points="50,10 90,10 90,30 50,30"/>
+          <TextEquiv conf="0.8"><Unicode>est</Unicode></TextEquiv>
+        </Word>
+      </TextLine>
+      <TextRegion id="r2">
+        <Coords>
+          <Point x="10" y="40"/><Point x="90" y="40"/><Point x="90" y="55"/><Point x="10" y="55"/>
+        </Coords>
+        <TextLine id="r2l1">
+          <Coords points="10,40 90,40 50,55"/>
+          <TextEquiv index="2"><Unicode>alia</Unicode></TextEquiv>
+          <TextEquiv index="1" conf="0.75"><Unicode>alio</Unicode></TextEquiv>
+        </TextLine>
+      </TextRegion>
+    </TextRegion>
+  </Page>
+</PcGts>
+`
+
+func TestParse(t *testing.T) {
+	p, err := Parse([]byte(pageXml))
+	if err != nil {
+		t.Fatalf("Error parsing: %v", err)
+	}
+	if p.Page.ImageFilename != "test.png" || len(p.Regions()) != 2 {
+		t.Fatalf("Unexpected document parsed: %+v", p)
+	}
+	lines := p.Lines()
+	expected := []struct {
+		text string
+		conf float64
+		pts  int
+	}{
+		{"ut est", 0.7, 4},
+		{"alio", 0.75, 3},
+	}
+	if len(lines) != len(expected) {
+		t.Fatalf("Expected %d lines, got %d", len(expected), len(lines))
+	}
+	for i, l := range lines {
+		if l.Text() != expected[i].text || l.Conf() != expected[i].conf || len(l.Coords.Points) != expected[i].pts {
+			t.Errorf("Line %d: expected %v, got '%s' %f %d", i, expected[i], l.Text(), l.Conf(), len(l.Coords.Points))
+		}
+	}
+
+	var out bytes.Buffer
+	err = Write(&out, p)
+	if err != nil {
+		t.Fatalf("Error writing: %v", err)
+	}
+	for _, s := range []string{
+		`<PcGts xmlns="` + Namespace + `">`,
+		`<Coords points="10,40 90,40 90,55 10,55"></Coords>`,
+		`<Baseline points="10,26 90,26"></Baseline>`,
+	} {
+		if !strings.Contains(out.String(), s) {
+			t.Errorf("Expected output to contain '%s':\n%s", s, out.String())
+		}
+	}
+	p2, err := Parse(out.Bytes())
+	if err != nil {
+		t.Fatalf("Error parsing written PAGE: %v", err)
+	}
+	if len(p2.Lines()) != 2 || p2.Lines()[1].Text() != "alio" {
+		t.Errorf("Unexpected lines after round trip: %+v", p2.Lines())
+	}
+}
+
+func TestHocr(t *testing.T) {
+	p, err := Parse([]byte(pageXml))
+	if err != nil {
+		t.Fatalf("Error parsing: %v", err)
+	}
+	pg := ToHocr(p)
+	h := hocr.Hocr{Pages: []hocr.Page{pg}}
+	var lines []string
+	for _, l := range h.Lines() {
+		lines = append(lines, hocr.LineText(*l))
+	}
+	if strings.Join(lines, "|") != "ut est|alio" {
+		t.Errorf("Unexpected hOCR lines: %v", lines)
+	}
+	glyphs, err := h.Lines()[0].Glyphs()
+	if err != nil {
+		t.Fatalf("Error getting glyphs: %v", err)
+	}
+	if len(glyphs) != 5 || glyphs[1].Conf != 40 || glyphs[1].Bbox != image.Rect(25, 10, 40, 30) {
+		t.Errorf("Unexpected glyphs: %+v", glyphs)
+	}
+	if props, _ := h.Lines()[0].Properties(); props.Baseline.Offset != -4 {
+		t.Errorf("Unexpected baseline: %+v", props.Baseline)
+	}
+
+	back, err := FromHocr(pg, nil)
+	if err != nil {
+		t.Fatalf("Error converting from hOCR: %v", err)
+	}
+	if back.Page.ImageFilename != "test.png" || back.Page.ImageWidth != 100 {
+		t.Errorf("Unexpected page: %+v", back.Page)
+	}
+	if back.Metadata.Created == "" || back.Metadata.LastChange != back.Metadata.Created {
+		t.Errorf("Unexpected metadata: %+v", back.Metadata)
+	}
+	bl := back.Lines()
+	if len(bl) != 2 || bl[0].Text() != "ut est" || bl[0].Id != "r1l1" || bl[0].Words[1].Conf() != 0.8 {
+		t.Fatalf("Unexpected lines from hOCR: %+v", bl)
+	}
+	if b := bl[0].Baseline; b == nil || b.Points.String() != "10,26 90,26" {
+		t.Errorf("Unexpected baseline from hOCR: %+v", b)
+	}
+}
+
+func TestLineDetails(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 100, 60))
+	for i := range img.Pix {
+		img.Pix[i] = 0
+	}
+	var b bytes.Buffer
+	err := png.Encode(&b, img)
+	if err != nil {
+		t.Fatalf("Error encoding image: %v", err)
+	}
+	imgs := fstest.MapFS{"test.png": {Data: b.Bytes()}}
+
+	lines, err := ReadLineDetails(strings.NewReader(pageXml), imgs)
+	if err != nil {
+		t.Fatalf("Error getting line details: %v", err)
+	}
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 lines, got %d", len(lines))
+	}
+	if lines[0].OcrName != "test" || lines[0].Name != "r1l1" || len(lines[0].LowestGlyphs(1)) != 1 || lines[0].LowestGlyphs(1)[0].Text != "t" {
+		t.Errorf("Unexpected line: %+v", lines[0])
+	}
+	if lines[0].Avgconf != 0.7 || lines[1].Avgconf != 0.75 {
+		t.Errorf("Unexpected confidences: %v, %v", lines[0].Avgconf, lines[1].Avgconf)
+	}
+
+	// the second line is a triangle, so its corners should be white
+	crop := lines[1].Img.(line.ImgDirect).Img.(*image.Gray)
+	if crop.Bounds() != image.Rect(10, 40, 90, 55) {
+		t.Errorf("Unexpected crop bounds: %v", crop.Bounds())
+	}
+	if crop.GrayAt(50, 41) != (color.Gray{0}) || crop.GrayAt(11, 54) != (color.Gray{255}) {
+		t.Errorf("Polygon not cropped correctly")
+	}
+}
+
+func TestLineDetailsBadImage(t *testing.T) {
+	imgs := fstest.MapFS{"test.png": {Data: []byte("not an image")}}
+	p, err := Parse([]byte(pageXml))
+	if err != nil {
+		t.Fatalf("Error parsing: %v", err)
+	}
+
+	_, err = (&Options{}).lineDetails(p, imgs, p.Page.ImageFilename)
+	if err == nil {
+		t.Errorf("Expected an error for an undecodable image")
+	}
+
+	// without words or a conf of its own a line's conf is unknown
+	p.Page.TextRegions[0].TextLines[0].Words = nil
+
+	var warnings []error
+	o := &Options{Lenient: true, Warn: func(err error) { warnings = append(warnings, err) }}
+	lines, err := o.lineDetails(p, imgs, p.Page.ImageFilename)
+	if err != nil {
+		t.Fatalf("Error getting lenient line details: %v", err)
+	}
+	if len(lines) != 2 || lines[0].Img != nil || lines[0].Avgconf != -1 || len(warnings) != 1 {
+		t.Errorf("Unexpected lenient lines %+v, warnings %v", lines, warnings)
+	}
+}
@@ -0,0 +1,10 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package pdf
+
+// fontBytes is the zlib compressed Go Regular font, which is
+// released under the same BSD license as Go. It was made with
+// fonttobytes, which can also be used to embed a different font.
+var fontBytes = []byte{120, 156, 220, 253, 9, 124, 84, 229, 245, 48, 142, 159, 243, 220, 109, 150, 44, 115, 103, 201, 54, 217, 102, 38, 147, 4, 130, 32, 25, 134, 176, 132, 56, 44, 86, 197, 4, 65, 5, 141, 138, 97, 53, 4, 23, 16, 55, 20, 84, 68, 99, 181, 90, 68, 140, 27, 130, 59, 216, 170, 84, 92, 16, 215, 82, 151, 104, 180, 182, 86, 251, 213, 162, 130, 187, 181, 27, 173, 181, 42, 10, 153, 203, 255, 115, 158, 187, 204, 157, 201, 76, 192, 190, 239, 251, 121, 223, 255, 175, 22, 37, 115, 207, 205, 60, 103, 121, 206, 115, 158, 179, 2, 2, 64, 1, 172, 2, 1, 230, 28, 119, 194, 136, 198, 23, 126, 57, 230, 53, 0, 248, 7, 0, 204, 153, 127, 246, 220, 165, 191, 94, 119, 216, 12, 0, 108, 5, 144, 90, 230, 95, 120, 126, 245, 241, 167, 150, 238, 6, 22, 31, 6, 0, 91, 207, 88, 218, 121, 246, 188, 17, 194, 229, 192, 226, 187, 0, 10, 242, 58, 231, 158, 183, 20, 0, 60, 192, 226, 53, 0, 224, 232, 60, 235, 226, 51, 102, 191, 251, 195, 45, 0, 242, 245, 128, 123, 46, 92, 180, 112, 238, 130, 146, 175, 143, 255, 31, 192, 111, 68, 0, 24, 189, 104, 209, 194, 185, 5, 83, 29, 85, 128, 223, 52, 3, 64, 205, 162, 179, 207, 95, 254, 143, 91, 203, 74, 1, 191, 153, 3, 224, 174, 60, 107, 201, 252, 185, 127, 191, 127, 217, 99, 128, 7, 86, 1, 72, 235, 206, 158, 187, 124, 169, 252, 19, 207, 3, 192, 36, 63, 0, 84, 159, 51, 247, 236, 133, 79, 254, 110, 203, 225, 192, 164, 81, 0, 193, 9, 75, 151, 156, 119, 126, 255, 93, 158, 77, 192, 170, 207, 7, 240, 111, 93, 186, 108, 225, 210, 53, 127, 184, 251, 66, 96, 147, 255, 2, 0, 239, 130, 32, 62, 134, 107, 65, 2, 144, 214, 75, 49, 0, 12, 234, 255, 21, 222, 130, 51, 24, 56, 24, 115, 203, 160, 255, 239, 110, 96, 143, 76, 135, 139, 247, 27, 63, 66, 117, 117, 117, 53, 36, 0, 14, 36, 229, 6, 237, 2, 188, 86, 185, 1, 183, 86, 3, 220, 245, 209, 159, 0, 196, 22, 169, 143, 86, 67, 112, 32, 24, 127, 202, 57, 93, 65, 24, 5, 2, 255, 91, 57, 136, 66, 9, 0, 188, 8, 171, 64, 134, 73, 0, 144, 15, 151, 225, 229, 184, 14, 119, 225, 1, 22, 100, 175, 178, 215, 216, 110, 225, 50, 225, 103, 194, 245, 194, 61, 194, 239, 197, 14, 241, 198, 202, 238, 202, 255, 84, 7, 170, 43, 171, 195, 213, 181, 213, 135, 87, 199, 170, 199, 85, 55, 87, 183, 84, 79, 169, 94, 90, 189, 166, 250, 246, 234, 251, 171, 31, 168, 126, 56, 36, 133, 124, 161, 162, 80, 56, 84, 27, 26, 30, 58, 61, 116, 115, 232, 23, 97, 22, 150, 195, 133, 97, 111, 56, 16, 46, 11, 87, 133, 27, 194, 71, 135, 231, 134, 23, 70, 88, 196, 19, 9, 69, 33, 202, 162, 121, 81, 79, 212, 31, 45, 137, 150, 71, 107, 162, 195, 162, 163, 162, 205, 209, 179, 162, 171, 162, 87, 69, 175, 137, 94, 31, 189, 41, 122, 79, 244, 225, 232, 99, 209, 103, 162, 207, 69, 95, 142, 254, 54, 250, 102, 244, 189, 232, 159, 107, 155, 107, 19, 181, 147, 106, 231, 212, 206, 175, 61, 163, 246, 204, 239, 97, 63, 59, 144, 60, 112, 0, 8, 189, 124, 168, 134, 187, 113, 29, 254, 14, 247, 177, 18, 246, 10, 123, 141, 237, 20, 46, 19, 174, 18, 174, 23, 214, 8, 247, 137, 32, 174, 173, 92, 85, 249, 117, 181, 175, 186, 184, 186, 154, 227, 208, 88, 61, 214, 194, 225, 252, 234, 219, 171, 239, 27, 128, 195, 169, 161, 181, 22, 14, 106, 56, 16, 46, 13, 87, 134, 135, 132, 143, 14, 207, 9, 47, 224, 56, 84, 231, 192, 97, 186, 133, 195, 218, 232, 221, 209, 7, 45, 28, 94, 143, 190, 25, 221, 25, 253, 115, 237, 56, 11, 135, 133, 181, 139, 191, 135, 253, 200, 113, 192, 3, 223, 30, 248, 236, 192, 139, 7, 182, 30, 184, 240, 64, 251, 129, 137, 218, 173, 218, 45, 218, 53, 201, 191, 36, 63, 75, 126, 154, 252, 36, 249, 113, 242, 145, 228, 229, 159, 221, 248, 89, 244, 83, 249, 83, 233, 83, 241, 83, 252, 228, 251, 79, 246, 126, 242, 245, 39, 255, 254, 228, 171, 79, 254, 254, 201, 111, 62, 121, 254, 147, 199, 63, 121, 240, 147, 95, 124, 114, 255, 39, 173, 159, 28, 241, 201, 132, 79, 26, 63, 25, 241, 73, 253, 199, 253, 31, 255, 246, 227, 167, 63, 158, 243, 241, 236, 143, 103, 126, 124, 226, 199, 199, 127, 124, 220, 199, 173, 31, 79, 254, 184, 238, 99, 207, 199, 5, 31, 45, 251, 104, 225, 71, 179, 118, 47, 223, 125, 209, 238, 115, 118, 159, 185, 123, 209, 238, 133, 187, 231, 237, 62, 117, 247, 137, 187, 219, 118, 255, 100, 119, 108, 119, 245, 238, 138, 221, 101, 187, 139, 119, 251, 119, 59, 118, 195, 174, 253, 187, 190, 217, 245, 159, 93, 255, 216, 245, 229, 174, 207, 119, 237, 218, 245, 135, 93, 151, 238, 186, 100, 215, 197, 187, 78, 219, 117, 202, 174, 246, 93, 179, 92, 47, 42, 47, 178, 62, 64, 48, 5, 244, 255, 127, 255, 145, 153, 139, 254, 131, 252, 255, 105, 255, 32, 48, 227, 111, 230, 127, 115, 253, 15, 141, 189, 39, 130, 4, 50, 40, 224, 0, 39, 184, 192, 13, 121, 144, 15, 5, 80, 8, 30, 80, 193, 11, 62, 240, 67, 0, 138, 160, 24, 74, 160, 20, 202, 32, 8, 229, 80, 1, 149, 80, 5, 213, 16, 130, 48, 68, 160, 6, 162, 80, 11, 117, 80, 15, 67, 96, 40, 52, 192, 48, 56, 12, 134, 195, 8, 56, 28, 70, 66, 35, 196, 96, 20, 196, 97, 52, 52, 193, 24, 24, 11, 227, 96, 60, 52, 195, 4, 104, 129, 35, 32, 1, 19, 97, 18, 76, 134, 41, 112, 36, 252, 4, 142, 130, 163, 225, 24, 152, 10, 199, 66, 43, 180, 193, 52, 56, 14, 166, 195, 12, 56, 30, 78, 128, 19, 97, 38, 204, 130, 147, 224, 100, 104, 135, 83, 224, 84, 56, 13, 102, 195, 233, 208, 1, 115, 96, 46, 0, 92, 13, 63, 133, 107, 225, 58, 184, 9, 110, 131, 59, 225, 62, 184, 23, 238, 135, 205, 176, 9, 30, 128, 95, 194, 67, 240, 32, 60, 12, 91, 224, 17, 248, 21, 108, 133, 71, 225, 113, 216, 6, 79, 192, 147, 240, 20, 108, 135, 231, 224, 89, 120, 30, 126, 205, 106, 96, 25, 44, 128, 133, 208, 197, 106, 97, 57, 220, 3, 75, 225, 76, 118, 34, 92, 8, 139, 217, 82, 184, 6, 214, 179, 197, 112, 30, 91, 198, 206, 131, 78, 184, 136, 205, 99, 11, 217, 2, 188, 155, 157, 3, 103, 193, 74, 54, 19, 126, 1, 207, 192, 21, 48, 31, 206, 97, 93, 56, 137, 45, 97, 243, 225, 108, 184, 148, 213, 129, 0, 171, 161, 27, 110, 69, 63, 6, 88, 41, 43, 99, 33, 22, 102, 21, 172, 18, 158, 102, 143, 194, 11, 56, 142, 141, 96, 211, 89, 3, 27, 198, 94, 98, 47, 179, 40, 92, 204, 170, 88, 132, 213, 195, 85, 112, 61, 92, 9, 63, 135, 159, 193, 13, 176, 22, 110, 132, 53, 112, 51, 220, 2, 0, 61, 176, 1, 54, 194, 29, 240, 31, 156, 141, 11, 224, 92, 156, 131, 115, 113, 30, 92, 130, 11, 113, 62, 158, 78, 124, 70, 0, 144, 72, 65, 11, 160, 192, 208, 68, 29, 105, 83, 6, 66, 39, 48, 68, 118, 18, 48, 134, 29, 34, 34, 195, 227, 0, 20, 89, 18, 65, 0, 65, 149, 228, 226, 134, 144, 26, 82, 163, 33, 53, 132, 32, 66, 127, 66, 88, 213, 191, 74, 130, 253, 144, 16, 87, 1, 48, 232, 3, 192, 123, 164, 62, 16, 192, 9, 173, 199, 110, 29, 58, 253, 228, 68, 177, 132, 130, 192, 58, 128, 177, 49, 109, 36, 105, 244, 91, 113, 44, 78, 11, 38, 2, 192, 36, 20, 128, 9, 157, 214, 231, 205, 56, 173, 61, 225, 21, 69, 0, 209, 41, 58, 21, 153, 127, 171, 236, 44, 110, 136, 197, 99, 1, 65, 80, 99, 170, 208, 247, 222, 147, 165, 189, 37, 111, 190, 137, 183, 10, 78, 172, 215, 118, 246, 239, 5, 6, 167, 137, 213, 108, 133, 220, 192, 113, 169, 73, 132, 36, 65, 20, 16, 161, 131, 33, 192, 56, 156, 198, 127, 159, 34, 42, 178, 100, 253, 62, 159, 16, 227, 255, 92, 80, 218, 91, 210, 91, 250, 74, 169, 88, 205, 220, 201, 111, 233, 15, 209, 6, 74, 1, 196, 83, 164, 62, 8, 66, 21, 252, 242, 216, 173, 158, 233, 39, 39, 234, 11, 156, 12, 243, 92, 12, 220, 8, 157, 50, 138, 226, 152, 182, 66, 7, 99, 76, 232, 80, 36, 38, 8, 227, 219, 60, 249, 204, 237, 30, 235, 158, 22, 212, 241, 174, 165, 143, 9, 2, 5, 182, 104, 224, 187, 22, 116, 162, 86, 70, 81, 16, 187, 115, 129, 115, 192, 102, 247, 180, 246, 246, 68, 37, 64, 85, 101, 69, 57, 4, 33, 88, 86, 90, 82, 92, 20, 240, 251, 84, 235, 127, 206, 170, 134, 152, 47, 210, 164, 255, 137, 9, 33, 95, 76, 136, 41, 17, 254, 39, 226, 11, 9, 190, 144, 47, 116, 233, 146, 63, 150, 188, 127, 210, 63, 74, 255, 189, 244, 242, 37, 168, 44, 89, 165, 255, 88, 242, 245, 146, 85, 75, 180, 31, 150, 252, 28, 29, 39, 105, 223, 227, 115, 43, 177, 125, 5, 62, 167, 77, 161, 63, 43, 180, 7, 86, 234, 127, 99, 99, 176, 93, 223, 249, 43, 14, 116, 11, 187, 229, 41, 80, 5, 81, 24, 10, 235, 18, 193, 186, 218, 104, 117, 81, 192, 231, 85, 68, 23, 10, 2, 2, 178, 214, 124, 20, 42, 17, 216, 177, 199, 110, 173, 159, 126, 114, 34, 40, 161, 8, 226, 213, 192, 216, 184, 54, 16, 132, 49, 109, 128, 200, 183, 225, 88, 48, 169, 21, 4, 38, 176, 110, 3, 46, 19, 196, 120, 10, 2, 10, 38, 136, 241, 180, 25, 136, 46, 249, 0, 80, 5, 85, 106, 137, 79, 149, 157, 101, 13, 82, 60, 92, 23, 47, 138, 169, 181, 181, 241, 81, 163, 155, 226, 177, 64, 99, 113, 160, 182, 78, 45, 42, 46, 42, 10, 248, 101, 37, 160, 142, 142, 143, 170, 173, 19, 84, 57, 224, 103, 158, 103, 223, 255, 228, 137, 255, 185, 249, 133, 95, 204, 95, 255, 232, 142, 235, 227, 193, 7, 151, 62, 179, 245, 249, 73, 43, 230, 63, 191, 98, 197, 9, 15, 118, 49, 223, 202, 231, 187, 123, 94, 202, 91, 185, 114, 242, 61, 39, 184, 146, 255, 14, 121, 102, 223, 178, 228, 206, 143, 67, 43, 48, 56, 116, 251, 81, 167, 78, 69, 25, 251, 26, 30, 184, 146, 232, 34, 193, 114, 0, 249, 17, 46, 251, 133, 16, 132, 58, 136, 193, 35, 199, 110, 13, 78, 63, 57, 49, 12, 68, 16, 156, 162, 208, 5, 50, 56, 29, 178, 115, 49, 72, 18, 204, 37, 217, 28, 211, 6, 14, 135, 50, 23, 20, 101, 108, 155, 203, 216, 22, 58, 61, 134, 14, 120, 199, 1, 10, 58, 148, 46, 219, 187, 230, 27, 137, 122, 250, 93, 146, 8, 82, 87, 174, 183, 92, 198, 222, 106, 79, 148, 1, 52, 30, 62, 108, 104, 109, 77, 117, 101, 89, 137, 223, 91, 144, 231, 208, 55, 153, 155, 54, 25, 198, 16, 71, 141, 142, 53, 18, 157, 34, 225, 218, 38, 127, 81, 172, 145, 136, 21, 9, 203, 185, 62, 223, 39, 174, 90, 191, 255, 10, 237, 179, 135, 239, 216, 248, 208, 67, 27, 238, 250, 229, 253, 237, 83, 167, 158, 114, 202, 212, 99, 78, 17, 196, 39, 215, 221, 244, 196, 131, 27, 233, 163, 99, 143, 57, 229, 148, 99, 166, 158, 34, 245, 237, 27, 35, 158, 246, 64, 239, 43, 15, 63, 244, 106, 223, 35, 231, 223, 114, 243, 210, 115, 111, 238, 233, 127, 231, 129, 222, 87, 31, 122, 232, 213, 190, 77, 139, 111, 185, 249, 140, 21, 55, 247, 144, 140, 141, 57, 240, 129, 148, 47, 237, 129, 114, 136, 194, 97, 176, 36, 161, 86, 121, 29, 2, 147, 202, 252, 76, 100, 136, 130, 40, 24, 202, 165, 26, 36, 9, 231, 2, 98, 75, 27, 208, 86, 148, 81, 16, 198, 182, 129, 40, 114, 233, 56, 2, 166, 5, 19, 149, 128, 32, 49, 148, 186, 44, 128, 102, 11, 96, 18, 76, 107, 79, 120, 0, 26, 134, 68, 35, 80, 14, 229, 165, 245, 97, 197, 89, 218, 16, 170, 147, 35, 97, 136, 123, 162, 36, 64, 156, 26, 146, 183, 120, 116, 60, 30, 243, 40, 69, 197, 209, 90, 65, 14, 16, 97, 132, 209, 241, 81, 145, 112, 192, 47, 226, 49, 47, 110, 255, 72, 219, 137, 179, 79, 126, 122, 227, 205, 191, 210, 118, 60, 240, 192, 252, 151, 94, 63, 249, 66, 109, 230, 203, 47, 237, 126, 163, 103, 233, 149, 111, 95, 119, 243, 73, 43, 209, 243, 59, 60, 113, 229, 93, 203, 215, 63, 113, 239, 207, 246, 254, 76, 123, 243, 213, 203, 126, 57, 93, 219, 183, 107, 233, 217, 175, 127, 132, 103, 207, 127, 103, 253, 59, 66, 219, 204, 135, 55, 220, 191, 128, 100, 9, 225, 104, 49, 15, 143, 224, 250, 172, 52, 81, 196, 144, 116, 25, 112, 85, 198, 119, 160, 160, 10, 134, 10, 187, 120, 228, 222, 70, 49, 143, 85, 37, 63, 209, 223, 187, 82, 219, 201, 78, 150, 27, 32, 31, 228, 199, 21, 196, 198, 6, 41, 80, 203, 84, 143, 183, 41, 32, 211, 127, 216, 201, 59, 147, 201, 157, 55, 93, 122, 233, 221, 215, 220, 132, 63, 96, 37, 86, 224, 15, 55, 93, 115, 183, 182, 86, 251, 151, 246, 79, 109, 173, 254, 59, 142, 215, 118, 178, 58, 251, 239, 240, 197, 139, 188, 170, 135, 41, 241, 209, 244, 159, 227, 249, 175, 88, 185, 82, 186, 239, 154, 155, 52, 69, 251, 76, 251, 84, 83, 110, 186, 230, 110, 60, 23, 85, 84, 145, 46, 37, 32, 193, 207, 81, 22, 171, 196, 133, 32, 67, 1, 20, 65, 37, 212, 194, 145, 137, 34, 39, 34, 96, 107, 109, 56, 84, 89, 86, 90, 228, 85, 11, 92, 50, 203, 199, 99, 244, 61, 226, 6, 3, 199, 241, 116, 50, 120, 128, 126, 60, 137, 150, 51, 23, 16, 112, 70, 251, 19, 97, 15, 115, 150, 52, 96, 76, 168, 141, 212, 42, 82, 83, 147, 36, 43, 114, 49, 22, 23, 75, 163, 235, 106, 235, 80, 81, 132, 209, 77, 163, 155, 176, 174, 206, 87, 84, 92, 84, 140, 255, 250, 195, 225, 37, 213, 149, 197, 218, 153, 213, 213, 120, 180, 223, 233, 146, 240, 31, 213, 213, 218, 29, 130, 211, 225, 195, 224, 204, 19, 126, 21, 44, 43, 117, 105, 95, 157, 48, 115, 95, 126, 89, 113, 153, 184, 80, 187, 45, 31, 11, 26, 230, 46, 184, 211, 87, 25, 44, 67, 97, 193, 188, 73, 193, 202, 10, 85, 251, 89, 203, 120, 76, 136, 94, 111, 145, 182, 122, 124, 11, 42, 197, 62, 31, 3, 132, 69, 48, 95, 156, 44, 182, 128, 27, 234, 18, 53, 2, 162, 136, 32, 241, 35, 65, 146, 248, 49, 55, 94, 154, 6, 0, 110, 112, 115, 181, 172, 56, 131, 13, 81, 53, 20, 15, 169, 49, 53, 20, 8, 169, 204, 173, 205, 198, 251, 122, 240, 126, 237, 180, 249, 198, 127, 123, 180, 217, 68, 247, 62, 237, 94, 220, 1, 123, 193, 9, 167, 109, 67, 100, 128, 173, 199, 110, 45, 158, 126, 114, 66, 53, 73, 51, 182, 77, 64, 198, 38, 48, 83, 71, 168, 2, 50, 96, 87, 91, 143, 73, 218, 51, 62, 211, 245, 163, 27, 0, 156, 224, 84, 253, 162, 179, 164, 193, 23, 31, 29, 143, 71, 226, 177, 128, 167, 111, 206, 156, 189, 218, 189, 83, 131, 95, 248, 247, 190, 171, 93, 65, 196, 134, 69, 76, 22, 167, 176, 245, 32, 64, 101, 34, 152, 98, 66, 7, 35, 214, 29, 103, 23, 190, 120, 40, 176, 72, 216, 197, 228, 158, 30, 122, 175, 15, 0, 95, 67, 4, 1, 14, 215, 215, 150, 103, 190, 198, 117, 154, 237, 71, 210, 66, 9, 151, 237, 55, 197, 212, 144, 218, 135, 136, 168, 29, 48, 236, 203, 3, 231, 179, 177, 92, 143, 14, 55, 126, 23, 255, 246, 171, 1, 96, 12, 161, 232, 162, 47, 236, 166, 77, 113, 97, 250, 111, 10, 144, 250, 186, 243, 14, 109, 222, 245, 242, 137, 63, 60, 204, 191, 98, 250, 129, 15, 196, 176, 180, 7, 20, 40, 132, 98, 56, 238, 201, 226, 66, 198, 4, 52, 148, 71, 17, 8, 66, 74, 121, 192, 92, 17, 13, 149, 225, 7, 4, 129, 161, 208, 101, 125, 204, 21, 69, 33, 130, 79, 117, 59, 37, 1, 20, 84, 36, 103, 81, 131, 84, 173, 122, 66, 213, 62, 15, 122, 99, 213, 234, 168, 218, 186, 58, 22, 169, 86, 253, 69, 197, 108, 172, 86, 140, 127, 197, 207, 228, 100, 69, 243, 175, 177, 66, 86, 2, 19, 126, 173, 125, 46, 43, 81, 193, 37, 56, 250, 191, 239, 255, 14, 183, 106, 159, 179, 101, 227, 150, 142, 190, 20, 131, 201, 235, 154, 207, 138, 17, 74, 111, 3, 136, 30, 105, 15, 56, 97, 252, 147, 178, 36, 10, 34, 76, 213, 215, 153, 143, 8, 192, 58, 136, 249, 99, 217, 180, 160, 253, 231, 102, 54, 205, 98, 111, 64, 37, 246, 198, 226, 33, 85, 138, 71, 213, 80, 224, 109, 108, 214, 94, 102, 12, 155, 123, 196, 181, 199, 221, 182, 106, 223, 3, 61, 58, 125, 207, 0, 16, 182, 72, 123, 160, 20, 102, 38, 10, 243, 16, 208, 77, 34, 211, 74, 182, 222, 177, 38, 97, 0, 236, 90, 85, 36, 173, 42, 24, 132, 1, 134, 208, 101, 125, 220, 44, 76, 107, 231, 220, 45, 133, 210, 146, 72, 189, 228, 44, 109, 136, 197, 71, 31, 193, 70, 199, 61, 145, 176, 18, 55, 206, 12, 69, 145, 149, 80, 224, 140, 41, 247, 158, 53, 239, 133, 175, 215, 252, 225, 229, 237, 47, 252, 249, 194, 77, 71, 254, 97, 8, 155, 177, 229, 174, 7, 207, 89, 240, 202, 243, 88, 184, 252, 87, 179, 63, 217, 177, 250, 181, 91, 90, 158, 127, 121, 139, 190, 206, 219, 15, 124, 32, 188, 34, 237, 129, 16, 116, 38, 138, 2, 40, 136, 126, 100, 66, 144, 150, 137, 164, 19, 36, 144, 12, 62, 86, 0, 109, 75, 134, 93, 32, 8, 226, 92, 16, 197, 150, 54, 82, 66, 115, 65, 146, 142, 144, 184, 245, 32, 130, 192, 232, 252, 53, 225, 140, 167, 147, 164, 105, 237, 9, 103, 93, 36, 18, 138, 212, 200, 206, 242, 134, 166, 120, 81, 113, 17, 103, 101, 36, 18, 111, 162, 19, 78, 199, 161, 90, 245, 72, 162, 234, 23, 35, 225, 219, 43, 10, 31, 56, 5, 15, 123, 237, 153, 105, 83, 126, 249, 194, 127, 86, 62, 177, 245, 26, 220, 170, 189, 129, 11, 181, 189, 31, 157, 235, 126, 42, 79, 154, 140, 53, 183, 221, 223, 141, 27, 175, 253, 240, 132, 135, 199, 107, 15, 104, 201, 37, 199, 107, 175, 191, 241, 31, 110, 83, 86, 1, 136, 35, 164, 62, 112, 65, 62, 204, 76, 184, 242, 145, 161, 128, 192, 152, 41, 141, 18, 50, 1, 65, 100, 176, 8, 16, 199, 180, 145, 153, 56, 86, 156, 22, 76, 20, 1, 50, 236, 78, 61, 165, 7, 205, 34, 201, 35, 64, 158, 27, 92, 224, 82, 85, 175, 170, 56, 203, 200, 52, 143, 99, 76, 141, 5, 34, 42, 134, 84, 182, 53, 185, 132, 221, 248, 244, 43, 175, 36, 47, 194, 221, 120, 223, 205, 194, 218, 254, 149, 247, 104, 179, 217, 20, 182, 142, 211, 247, 62, 78, 223, 62, 168, 134, 83, 19, 46, 147, 166, 230, 106, 42, 64, 4, 4, 17, 59, 193, 110, 188, 219, 105, 202, 64, 16, 201, 116, 55, 225, 210, 104, 90, 31, 82, 213, 250, 48, 209, 180, 56, 94, 20, 107, 108, 97, 241, 81, 195, 89, 36, 194, 53, 96, 172, 177, 146, 5, 252, 5, 44, 18, 174, 189, 175, 231, 170, 227, 47, 106, 173, 105, 232, 184, 245, 236, 7, 217, 63, 146, 47, 76, 188, 226, 181, 159, 29, 221, 126, 235, 171, 231, 140, 185, 76, 222, 58, 126, 228, 73, 23, 30, 121, 244, 178, 233, 67, 217, 167, 15, 107, 115, 155, 207, 127, 104, 233, 101, 143, 47, 27, 85, 200, 105, 121, 226, 129, 15, 68, 65, 250, 39, 248, 161, 18, 58, 18, 5, 30, 20, 152, 74, 218, 7, 37, 203, 52, 168, 0, 0, 81, 2, 145, 164, 130, 205, 5, 198, 90, 218, 76, 99, 225, 8, 52, 49, 0, 38, 116, 89, 112, 198, 211, 73, 56, 173, 61, 225, 172, 9, 71, 106, 106, 66, 132, 1, 234, 66, 204, 34, 97, 80, 61, 16, 106, 44, 14, 212, 70, 170, 209, 19, 9, 235, 102, 0, 14, 189, 242, 141, 167, 222, 222, 243, 225, 39, 90, 18, 71, 163, 127, 245, 77, 143, 46, 208, 174, 103, 197, 123, 87, 221, 126, 231, 138, 189, 130, 235, 225, 189, 59, 251, 181, 175, 176, 27, 143, 199, 171, 241, 201, 49, 15, 79, 239, 63, 29, 151, 62, 124, 227, 230, 119, 57, 15, 174, 49, 124, 90, 46, 56, 226, 9, 7, 130, 185, 1, 85, 122, 216, 193, 197, 192, 218, 245, 133, 198, 222, 235, 76, 219, 247, 196, 125, 174, 214, 99, 163, 71, 55, 97, 40, 30, 10, 128, 176, 167, 226, 204, 139, 177, 39, 41, 11, 79, 38, 95, 153, 188, 165, 127, 23, 251, 217, 211, 79, 247, 63, 165, 141, 5, 1, 230, 31, 248, 64, 156, 40, 237, 1, 31, 84, 66, 3, 76, 222, 230, 66, 129, 153, 234, 48, 192, 181, 29, 0, 180, 180, 153, 138, 145, 19, 202, 71, 20, 23, 128, 117, 153, 159, 18, 129, 158, 28, 90, 95, 95, 35, 58, 203, 26, 48, 101, 35, 233, 91, 164, 38, 238, 137, 26, 182, 97, 192, 95, 84, 44, 235, 84, 162, 79, 234, 240, 218, 247, 190, 123, 229, 169, 79, 181, 127, 224, 113, 218, 151, 31, 238, 210, 190, 101, 161, 55, 175, 185, 224, 156, 238, 21, 69, 23, 205, 188, 255, 234, 43, 238, 91, 176, 78, 168, 189, 117, 251, 47, 222, 122, 116, 221, 31, 31, 189, 95, 75, 62, 243, 183, 15, 159, 70, 105, 207, 181, 119, 118, 44, 233, 58, 249, 248, 171, 254, 113, 218, 181, 11, 87, 109, 188, 250, 204, 89, 23, 205, 50, 100, 224, 125, 187, 12, 0, 138, 146, 138, 2, 120, 144, 9, 41, 25, 16, 1, 4, 17, 44, 222, 114, 212, 184, 52, 28, 65, 154, 52, 72, 182, 161, 72, 182, 161, 9, 103, 60, 157, 196, 50, 100, 128, 204, 222, 248, 40, 111, 172, 145, 203, 0, 191, 95, 84, 163, 199, 180, 130, 133, 195, 184, 12, 236, 254, 4, 69, 237, 117, 237, 31, 87, 222, 244, 216, 2, 60, 63, 249, 215, 189, 171, 110, 191, 107, 197, 119, 236, 215, 15, 239, 125, 111, 63, 170, 218, 37, 218, 175, 180, 75, 180, 169, 99, 30, 62, 78, 184, 71, 91, 107, 200, 128, 126, 103, 222, 33, 78, 229, 119, 215, 105, 153, 119, 230, 241, 25, 119, 230, 172, 79, 108, 183, 230, 244, 91, 110, 44, 30, 11, 8, 241, 88, 160, 111, 47, 253, 79, 56, 97, 47, 221, 149, 185, 157, 193, 191, 47, 31, 174, 222, 38, 161, 200, 76, 75, 163, 92, 50, 45, 205, 241, 109, 230, 190, 31, 203, 21, 209, 4, 209, 52, 58, 202, 101, 20, 153, 120, 117, 54, 72, 162, 104, 238, 199, 36, 178, 237, 9, 63, 95, 101, 190, 152, 239, 118, 57, 29, 220, 154, 80, 184, 53, 65, 235, 180, 236, 146, 189, 123, 231, 204, 225, 171, 221, 127, 156, 101, 157, 164, 236, 46, 25, 228, 199, 25, 96, 99, 67, 20, 49, 128, 1, 20, 39, 247, 71, 133, 15, 146, 203, 216, 154, 249, 184, 7, 255, 185, 89, 123, 154, 105, 79, 3, 131, 74, 172, 21, 215, 8, 151, 112, 186, 142, 72, 12, 179, 57, 27, 44, 179, 70, 36, 181, 119, 92, 54, 247, 64, 60, 20, 192, 120, 40, 80, 41, 46, 221, 191, 86, 92, 138, 181, 15, 62, 136, 15, 62, 248, 32, 64, 182, 117, 248, 16, 227, 24, 199, 69, 194, 7, 253, 81, 182, 38, 185, 76, 108, 209, 188, 154, 111, 19, 30, 197, 240, 40, 96, 240, 32, 128, 240, 157, 180, 7, 4, 40, 131, 197, 9, 87, 33, 50, 161, 0, 69, 75, 239, 87, 17, 197, 128, 137, 176, 138, 14, 213, 185, 32, 8, 252, 248, 194, 14, 201, 228, 121, 69, 10, 66, 0, 38, 10, 172, 203, 122, 206, 57, 175, 211, 180, 76, 44, 243, 122, 242, 221, 22, 77, 67, 156, 247, 163, 154, 154, 70, 199, 71, 133, 140, 99, 204, 175, 40, 178, 28, 8, 224, 245, 189, 189, 183, 31, 183, 85, 219, 243, 171, 167, 31, 127, 9, 223, 221, 113, 212, 252, 230, 222, 94, 188, 110, 244, 183, 171, 142, 188, 118, 109, 239, 145, 15, 52, 106, 155, 159, 88, 222, 216, 178, 122, 229, 57, 180, 207, 146, 7, 62, 80, 70, 73, 123, 32, 6, 19, 96, 141, 46, 4, 238, 184, 143, 73, 142, 24, 42, 140, 181, 6, 249, 79, 78, 227, 167, 118, 29, 160, 14, 68, 112, 72, 162, 163, 203, 137, 146, 128, 76, 145, 88, 23, 200, 178, 185, 5, 21, 37, 117, 61, 27, 10, 8, 178, 136, 50, 109, 65, 135, 83, 116, 116, 129, 83, 98, 206, 118, 176, 94, 51, 128, 185, 5, 86, 48, 97, 124, 77, 77, 77, 205, 252, 218, 154, 154, 144, 203, 89, 213, 16, 149, 35, 213, 124, 67, 86, 131, 234, 135, 72, 56, 62, 170, 169, 41, 194, 34, 225, 184, 7, 98, 141, 197, 69, 177, 24, 93, 213, 98, 141, 222, 248, 40, 32, 64, 63, 196, 26, 155, 124, 77, 181, 145, 48, 163, 143, 197, 47, 158, 216, 162, 125, 169, 173, 99, 163, 240, 60, 44, 193, 229, 218, 219, 59, 55, 7, 234, 243, 182, 253, 254, 5, 156, 252, 122, 213, 225, 35, 43, 174, 189, 76, 62, 246, 234, 63, 104, 47, 126, 173, 189, 164, 249, 49, 254, 245, 157, 107, 61, 117, 167, 77, 249, 249, 115, 199, 255, 116, 216, 12, 60, 21, 93, 120, 33, 107, 210, 110, 211, 190, 253, 94, 219, 124, 222, 144, 196, 101, 218, 230, 221, 8, 120, 179, 32, 36, 175, 170, 174, 156, 140, 229, 79, 254, 25, 15, 215, 242, 181, 29, 159, 106, 159, 28, 205, 30, 121, 41, 164, 125, 250, 238, 26, 96, 224, 163, 248, 142, 212, 7, 10, 184, 160, 109, 155, 11, 69, 72, 105, 96, 17, 24, 138, 108, 17, 153, 186, 109, 146, 192, 12, 1, 8, 0, 136, 208, 109, 62, 228, 159, 115, 198, 23, 0, 56, 29, 160, 128, 162, 170, 170, 76, 150, 23, 198, 48, 34, 144, 175, 71, 240, 177, 198, 63, 176, 33, 159, 174, 79, 254, 106, 253, 187, 184, 107, 15, 221, 188, 113, 189, 118, 6, 27, 205, 46, 33, 187, 120, 19, 128, 248, 166, 212, 7, 5, 80, 12, 85, 48, 119, 155, 130, 130, 104, 174, 162, 146, 156, 8, 76, 20, 58, 201, 213, 64, 123, 120, 12, 89, 129, 216, 33, 155, 242, 88, 78, 214, 149, 8, 82, 167, 5, 104, 62, 230, 171, 242, 2, 84, 85, 148, 149, 20, 7, 212, 66, 40, 128, 252, 16, 109, 240, 152, 26, 170, 46, 10, 112, 43, 74, 145, 229, 72, 52, 86, 205, 21, 103, 36, 110, 88, 91, 155, 240, 125, 172, 249, 183, 246, 20, 94, 57, 35, 241, 204, 91, 218, 203, 119, 96, 253, 246, 127, 126, 242, 208, 227, 184, 238, 238, 207, 94, 148, 250, 110, 125, 74, 251, 122, 209, 98, 237, 253, 27, 230, 78, 139, 111, 60, 233, 250, 219, 238, 233, 198, 210, 149, 39, 3, 194, 249, 7, 62, 144, 142, 150, 246, 64, 0, 218, 18, 133, 46, 100, 24, 112, 51, 129, 172, 60, 235, 8, 160, 83, 45, 165, 250, 83, 114, 231, 35, 83, 80, 64, 126, 170, 89, 2, 246, 100, 77, 164, 38, 68, 167, 90, 84, 14, 233, 130, 213, 40, 5, 106, 204, 131, 191, 73, 58, 250, 3, 237, 107, 109, 157, 118, 37, 94, 133, 75, 222, 65, 151, 246, 251, 39, 14, 104, 223, 96, 37, 74, 31, 253, 187, 245, 92, 188, 30, 87, 226, 37, 184, 118, 98, 111, 151, 246, 130, 246, 7, 109, 183, 246, 194, 21, 180, 127, 54, 1, 72, 139, 57, 191, 189, 240, 19, 195, 214, 163, 47, 181, 145, 214, 82, 239, 254, 148, 23, 214, 248, 216, 100, 180, 215, 227, 34, 86, 203, 33, 201, 32, 39, 173, 46, 26, 163, 85, 214, 213, 234, 20, 100, 127, 215, 86, 104, 27, 181, 111, 251, 177, 0, 29, 151, 181, 189, 251, 238, 29, 82, 95, 242, 8, 237, 6, 109, 241, 70, 172, 195, 17, 40, 221, 124, 234, 100, 64, 248, 53, 128, 20, 148, 250, 192, 13, 179, 76, 134, 155, 182, 156, 161, 180, 199, 144, 131, 68, 234, 160, 27, 237, 88, 201, 96, 56, 93, 72, 59, 83, 70, 159, 241, 184, 153, 172, 189, 130, 140, 235, 174, 126, 209, 213, 255, 252, 90, 28, 217, 127, 15, 235, 79, 138, 194, 117, 82, 223, 70, 45, 122, 71, 242, 47, 27, 1, 97, 7, 128, 248, 176, 212, 7, 78, 48, 44, 158, 210, 129, 107, 144, 76, 3, 184, 56, 243, 235, 37, 211, 2, 206, 55, 46, 182, 92, 254, 203, 108, 95, 188, 67, 216, 211, 255, 5, 219, 158, 60, 134, 190, 212, 115, 71, 114, 14, 32, 204, 62, 240, 129, 116, 169, 180, 7, 138, 97, 113, 194, 75, 178, 226, 70, 137, 249, 73, 240, 17, 4, 209, 20, 126, 25, 37, 16, 5, 73, 236, 204, 33, 55, 20, 109, 100, 18, 178, 174, 20, 160, 77, 128, 136, 83, 80, 12, 197, 222, 72, 36, 18, 166, 171, 63, 170, 162, 206, 170, 106, 18, 36, 254, 183, 198, 38, 53, 18, 151, 46, 213, 190, 215, 254, 213, 255, 3, 174, 195, 11, 208, 129, 133, 218, 43, 187, 147, 239, 227, 8, 12, 158, 191, 117, 47, 219, 154, 92, 222, 38, 200, 184, 28, 123, 38, 191, 184, 40, 217, 163, 253, 143, 246, 155, 40, 94, 188, 30, 144, 203, 82, 136, 243, 238, 104, 83, 146, 16, 68, 1, 197, 78, 114, 1, 142, 105, 147, 201, 21, 205, 175, 106, 105, 15, 132, 14, 254, 160, 89, 200, 202, 45, 186, 129, 199, 212, 136, 26, 82, 55, 189, 205, 222, 123, 235, 173, 100, 157, 212, 151, 92, 203, 150, 238, 27, 195, 158, 77, 30, 73, 196, 135, 149, 0, 108, 27, 255, 94, 227, 78, 90, 34, 32, 162, 113, 49, 160, 11, 178, 77, 90, 138, 104, 95, 209, 61, 171, 51, 245, 32, 187, 156, 196, 99, 106, 36, 30, 10, 68, 212, 88, 96, 229, 19, 79, 176, 177, 79, 60, 177, 81, 92, 179, 113, 227, 254, 101, 27, 233, 59, 253, 218, 78, 161, 129, 235, 168, 169, 230, 253, 68, 176, 238, 39, 94, 218, 40, 32, 44, 4, 115, 21, 164, 152, 74, 140, 11, 94, 39, 208, 254, 34, 119, 181, 48, 151, 160, 102, 180, 63, 25, 86, 125, 97, 218, 216, 252, 58, 50, 58, 78, 223, 171, 122, 66, 97, 255, 230, 155, 239, 58, 115, 31, 190, 161, 85, 62, 240, 151, 109, 109, 151, 110, 23, 151, 111, 216, 247, 31, 141, 174, 14, 92, 70, 165, 40, 191, 175, 29, 177, 221, 41, 11, 164, 86, 140, 47, 47, 64, 78, 108, 81, 96, 134, 213, 195, 63, 96, 29, 252, 3, 178, 115, 248, 141, 216, 5, 46, 175, 87, 229, 55, 98, 53, 166, 146, 111, 34, 132, 234, 142, 94, 214, 249, 199, 228, 195, 236, 89, 237, 187, 100, 151, 212, 151, 172, 103, 59, 147, 45, 253, 251, 216, 63, 147, 94, 250, 78, 210, 203, 109, 82, 31, 72, 48, 62, 117, 19, 224, 206, 112, 54, 199, 186, 9, 168, 4, 217, 109, 125, 102, 221, 5, 36, 144, 84, 221, 7, 192, 125, 77, 155, 222, 102, 239, 74, 125, 251, 222, 161, 253, 182, 9, 64, 150, 164, 62, 200, 131, 233, 219, 221, 14, 65, 16, 44, 92, 138, 64, 160, 109, 197, 86, 17, 10, 99, 218, 36, 209, 196, 41, 237, 1, 235, 224, 15, 248, 87, 209, 166, 203, 131, 60, 175, 234, 53, 55, 29, 98, 72, 141, 168, 24, 65, 117, 19, 70, 240, 86, 188, 23, 197, 151, 181, 179, 126, 171, 45, 151, 250, 246, 255, 84, 188, 120, 223, 24, 241, 241, 253, 91, 197, 19, 247, 31, 3, 6, 142, 92, 134, 157, 208, 242, 164, 67, 96, 63, 150, 172, 180, 223, 45, 178, 162, 26, 83, 233, 107, 127, 199, 246, 63, 241, 251, 164, 44, 245, 237, 191, 86, 188, 104, 223, 24, 241, 162, 253, 215, 114, 219, 154, 246, 252, 110, 105, 15, 184, 161, 24, 218, 12, 219, 218, 188, 198, 180, 180, 73, 152, 230, 246, 9, 152, 110, 159, 212, 231, 220, 234, 240, 229, 231, 145, 231, 39, 175, 56, 191, 88, 145, 192, 141, 110, 153, 251, 126, 76, 195, 195, 3, 209, 70, 114, 140, 70, 194, 228, 98, 21, 124, 218, 171, 218, 149, 120, 57, 78, 196, 4, 174, 210, 86, 105, 79, 255, 53, 153, 252, 244, 179, 254, 253, 81, 124, 16, 59, 112, 62, 110, 214, 78, 209, 238, 214, 110, 211, 78, 219, 136, 71, 226, 80, 140, 224, 79, 180, 103, 180, 247, 181, 15, 181, 39, 129, 193, 3, 0, 162, 198, 247, 153, 207, 92, 111, 41, 93, 107, 153, 128, 157, 228, 180, 54, 53, 163, 65, 32, 210, 140, 162, 0, 98, 167, 9, 35, 153, 242, 80, 8, 224, 83, 11, 242, 104, 191, 69, 67, 50, 151, 137, 80, 99, 81, 113, 81, 192, 19, 10, 169, 116, 122, 140, 170, 141, 132, 30, 96, 229, 159, 191, 58, 241, 184, 228, 79, 181, 111, 80, 192, 251, 183, 124, 173, 125, 47, 245, 141, 106, 155, 179, 37, 169, 37, 251, 216, 159, 240, 196, 219, 73, 227, 207, 214, 118, 202, 157, 210, 30, 80, 161, 2, 142, 78, 184, 60, 8, 2, 182, 34, 194, 49, 255, 213, 1, 91, 95, 195, 175, 141, 146, 82, 83, 103, 51, 222, 60, 82, 145, 96, 82, 48, 214, 8, 114, 103, 183, 118, 223, 223, 231, 143, 214, 222, 213, 174, 198, 203, 176, 5, 167, 224, 21, 218, 202, 189, 51, 251, 255, 242, 97, 127, 255, 135, 159, 33, 94, 241, 192, 178, 59, 220, 248, 56, 158, 132, 115, 241, 23, 90, 187, 182, 65, 19, 251, 150, 8, 195, 240, 48, 60, 90, 219, 174, 237, 214, 118, 107, 219, 113, 138, 113, 246, 174, 231, 244, 244, 195, 73, 219, 100, 100, 150, 149, 83, 102, 152, 83, 54, 43, 71, 182, 12, 174, 18, 155, 133, 67, 54, 87, 167, 108, 217, 92, 30, 0, 138, 154, 16, 97, 85, 127, 72, 225, 187, 155, 52, 188, 132, 33, 12, 169, 170, 113, 35, 12, 109, 98, 139, 177, 79, 123, 7, 255, 169, 105, 218, 169, 218, 85, 127, 127, 247, 213, 123, 158, 213, 254, 71, 234, 211, 110, 212, 118, 174, 76, 30, 203, 150, 37, 215, 8, 133, 61, 247, 172, 60, 83, 247, 117, 45, 63, 240, 129, 248, 129, 180, 7, 170, 200, 142, 41, 68, 134, 30, 242, 201, 145, 194, 203, 102, 199, 232, 122, 77, 56, 66, 48, 201, 12, 68, 102, 227, 211, 73, 2, 221, 206, 35, 245, 92, 221, 53, 197, 197, 80, 53, 93, 189, 235, 106, 84, 143, 225, 186, 160, 91, 57, 15, 133, 137, 145, 234, 229, 88, 129, 35, 113, 194, 138, 231, 94, 211, 46, 101, 229, 223, 252, 235, 251, 239, 181, 231, 46, 190, 247, 247, 127, 253, 181, 182, 251, 7, 237, 63, 163, 254, 112, 253, 191, 22, 47, 153, 48, 105, 21, 150, 227, 186, 35, 94, 152, 191, 171, 125, 241, 232, 201, 173, 47, 221, 247, 202, 23, 180, 102, 63, 128, 248, 123, 110, 215, 52, 27, 58, 153, 161, 229, 182, 176, 92, 134, 30, 242, 94, 129, 128, 208, 153, 230, 46, 212, 141, 86, 218, 197, 228, 180, 226, 142, 113, 135, 150, 39, 62, 163, 229, 73, 13, 27, 55, 238, 123, 7, 16, 54, 31, 248, 64, 170, 150, 250, 32, 0, 163, 13, 93, 104, 132, 204, 16, 83, 232, 171, 12, 13, 101, 152, 66, 62, 234, 139, 122, 8, 121, 95, 140, 156, 177, 186, 190, 143, 169, 126, 89, 225, 2, 183, 249, 237, 216, 209, 243, 30, 236, 187, 231, 169, 105, 93, 215, 255, 77, 251, 157, 246, 103, 169, 175, 255, 148, 59, 111, 138, 253, 228, 217, 191, 8, 91, 250, 103, 189, 242, 70, 235, 66, 44, 193, 145, 58, 95, 106, 0, 164, 99, 165, 62, 144, 97, 220, 54, 193, 240, 201, 91, 46, 89, 155, 115, 134, 126, 102, 208, 157, 166, 142, 101, 144, 189, 92, 29, 135, 72, 247, 199, 144, 117, 36, 123, 119, 226, 78, 124, 249, 241, 228, 251, 82, 223, 254, 137, 226, 142, 125, 99, 232, 59, 74, 1, 148, 243, 185, 110, 158, 180, 221, 45, 219, 117, 115, 33, 67, 102, 40, 101, 131, 154, 252, 19, 1, 186, 249, 39, 205, 66, 74, 27, 171, 94, 93, 27, 27, 223, 133, 49, 140, 32, 226, 45, 218, 149, 175, 227, 8, 60, 245, 117, 108, 195, 35, 31, 210, 230, 252, 65, 219, 174, 61, 36, 245, 237, 95, 34, 222, 184, 255, 18, 241, 234, 125, 99, 196, 11, 246, 95, 71, 107, 40, 7, 144, 198, 241, 125, 50, 49, 225, 116, 41, 34, 93, 56, 127, 156, 90, 118, 131, 219, 235, 245, 18, 67, 205, 5, 208, 10, 202, 89, 40, 185, 247, 123, 188, 17, 31, 122, 53, 249, 47, 86, 254, 189, 118, 154, 54, 133, 125, 196, 254, 154, 124, 129, 29, 145, 28, 215, 175, 177, 89, 201, 7, 233, 251, 43, 1, 36, 242, 249, 59, 160, 121, 187, 34, 162, 240, 227, 40, 237, 0, 135, 215, 203, 41, 205, 143, 217, 24, 170, 108, 100, 114, 207, 87, 184, 9, 95, 122, 41, 249, 58, 235, 18, 58, 146, 39, 176, 45, 253, 155, 147, 235, 232, 187, 22, 2, 136, 43, 249, 249, 211, 182, 93, 70, 58, 70, 153, 97, 132, 22, 165, 220, 110, 217, 253, 222, 157, 3, 253, 222, 78, 112, 122, 85, 29, 239, 56, 146, 36, 99, 40, 176, 80, 8, 38, 139, 132, 63, 247, 127, 33, 4, 127, 41, 174, 222, 184, 113, 255, 101, 191, 4, 132, 115, 180, 157, 248, 131, 220, 0, 10, 132, 19, 85, 192, 8, 75, 97, 190, 105, 238, 142, 195, 105, 105, 187, 194, 167, 234, 118, 209, 57, 120, 221, 135, 31, 106, 59, 149, 19, 122, 126, 120, 199, 240, 223, 235, 241, 145, 91, 64, 128, 90, 61, 51, 193, 77, 88, 81, 200, 135, 27, 65, 78, 50, 148, 186, 1, 224, 194, 246, 39, 84, 30, 52, 147, 34, 24, 99, 99, 239, 208, 230, 221, 113, 189, 28, 214, 127, 71, 66, 219, 137, 191, 181, 214, 66, 219, 22, 230, 91, 184, 141, 99, 233, 107, 65, 53, 196, 45, 53, 252, 173, 118, 193, 135, 31, 202, 13, 223, 111, 233, 145, 27, 104, 49, 8, 63, 97, 59, 132, 75, 164, 61, 32, 65, 233, 163, 242, 130, 41, 137, 2, 1, 97, 42, 113, 236, 66, 175, 74, 95, 253, 168, 12, 83, 80, 136, 32, 70, 240, 227, 111, 55, 225, 122, 92, 191, 89, 188, 87, 171, 16, 134, 247, 191, 109, 224, 114, 150, 120, 30, 217, 105, 80, 203, 223, 31, 52, 230, 68, 191, 44, 16, 15, 5, 196, 243, 122, 122, 12, 90, 44, 150, 4, 118, 132, 60, 133, 226, 164, 252, 253, 2, 11, 121, 29, 117, 122, 5, 9, 253, 35, 122, 180, 29, 159, 75, 2, 78, 164, 247, 24, 116, 28, 248, 82, 156, 42, 206, 130, 114, 168, 129, 181, 199, 110, 13, 144, 69, 89, 128, 76, 200, 71, 100, 21, 40, 202, 165, 8, 162, 216, 26, 180, 127, 168, 24, 31, 182, 235, 224, 85, 150, 223, 194, 240, 218, 76, 108, 83, 144, 194, 202, 146, 110, 54, 4, 19, 67, 8, 66, 73, 131, 224, 206, 12, 14, 208, 6, 54, 216, 246, 132, 59, 28, 174, 241, 71, 194, 145, 144, 195, 89, 73, 94, 141, 112, 109, 220, 19, 138, 153, 241, 135, 198, 162, 128, 234, 143, 53, 54, 21, 203, 145, 176, 160, 214, 85, 147, 243, 66, 144, 31, 123, 226, 134, 199, 216, 105, 195, 223, 252, 229, 19, 175, 62, 245, 226, 214, 69, 249, 165, 5, 83, 102, 92, 59, 121, 162, 118, 229, 246, 43, 126, 118, 223, 230, 110, 92, 122, 229, 243, 115, 238, 155, 113, 207, 86, 205, 255, 75, 241, 236, 106, 44, 192, 82, 182, 235, 97, 96, 176, 254, 192, 151, 98, 185, 220, 0, 78, 40, 134, 213, 219, 93, 220, 109, 142, 173, 122, 24, 54, 8, 146, 52, 142, 155, 155, 228, 35, 157, 168, 31, 53, 36, 239, 147, 4, 211, 1, 88, 149, 9, 34, 138, 99, 173, 35, 137, 192, 178, 65, 52, 219, 33, 218, 219, 19, 78, 85, 173, 9, 71, 120, 128, 0, 85, 35, 228, 18, 86, 70, 199, 26, 189, 1, 15, 68, 194, 117, 138, 26, 195, 142, 107, 142, 196, 224, 146, 57, 87, 220, 118, 225, 239, 31, 124, 83, 251, 246, 219, 142, 211, 123, 123, 217, 142, 228, 187, 101, 248, 232, 163, 191, 27, 243, 185, 246, 254, 215, 218, 39, 90, 95, 105, 158, 220, 68, 60, 69, 152, 117, 224, 75, 225, 78, 113, 22, 248, 97, 120, 162, 129, 238, 119, 254, 148, 47, 192, 60, 60, 39, 90, 54, 202, 36, 152, 22, 177, 46, 251, 60, 218, 79, 46, 35, 114, 237, 171, 228, 175, 110, 18, 238, 124, 120, 235, 251, 218, 63, 177, 248, 251, 171, 126, 121, 247, 2, 237, 158, 39, 239, 94, 185, 49, 52, 14, 199, 239, 199, 60, 60, 172, 230, 254, 145, 218, 233, 47, 190, 67, 119, 35, 198, 191, 119, 39, 167, 103, 9, 252, 52, 145, 231, 71, 16, 136, 168, 200, 239, 42, 126, 74, 127, 1, 81, 28, 103, 17, 96, 162, 78, 25, 178, 212, 38, 89, 161, 220, 170, 76, 16, 73, 26, 107, 81, 144, 192, 178, 65, 52, 219, 33, 56, 81, 189, 41, 162, 90, 136, 200, 145, 48, 211, 145, 35, 91, 89, 240, 93, 51, 69, 251, 220, 164, 42, 186, 57, 85, 241, 50, 214, 80, 170, 29, 183, 245, 119, 77, 159, 99, 221, 215, 88, 137, 99, 74, 112, 207, 15, 239, 24, 184, 237, 23, 103, 129, 8, 1, 152, 144, 24, 39, 161, 36, 202, 200, 36, 214, 42, 35, 130, 40, 161, 216, 153, 182, 34, 115, 49, 0, 1, 159, 87, 45, 204, 119, 42, 32, 130, 200, 61, 64, 20, 48, 17, 48, 32, 147, 51, 101, 20, 196, 170, 85, 37, 228, 13, 53, 10, 141, 223, 38, 75, 216, 239, 94, 220, 190, 95, 123, 7, 157, 159, 224, 179, 152, 108, 40, 199, 174, 13, 172, 7, 71, 104, 111, 105, 13, 27, 154, 177, 25, 157, 26, 14, 75, 126, 57, 65, 187, 84, 231, 115, 21, 0, 187, 80, 158, 2, 126, 152, 159, 112, 57, 81, 96, 46, 68, 193, 188, 23, 86, 152, 30, 252, 68, 27, 109, 46, 221, 135, 50, 190, 77, 65, 89, 30, 43, 79, 11, 14, 246, 188, 89, 214, 237, 102, 240, 131, 95, 245, 69, 194, 170, 234, 112, 150, 55, 144, 226, 139, 197, 13, 219, 41, 44, 7, 2, 20, 93, 123, 100, 237, 90, 108, 58, 98, 198, 49, 163, 166, 142, 251, 228, 19, 225, 193, 158, 213, 120, 85, 217, 198, 200, 220, 75, 110, 237, 233, 215, 99, 19, 179, 181, 211, 132, 143, 196, 89, 224, 132, 48, 220, 158, 240, 232, 242, 80, 137, 50, 171, 64, 73, 38, 147, 142, 84, 72, 181, 181, 187, 12, 153, 0, 198, 198, 182, 129, 44, 243, 48, 219, 100, 137, 132, 131, 210, 167, 106, 64, 20, 199, 219, 89, 159, 21, 54, 17, 166, 187, 175, 76, 150, 96, 6, 120, 10, 168, 189, 61, 225, 138, 68, 249, 214, 83, 156, 21, 217, 165, 36, 166, 122, 100, 69, 54, 66, 28, 170, 80, 118, 205, 100, 237, 179, 165, 29, 87, 220, 118, 193, 155, 191, 120, 11, 221, 255, 153, 123, 122, 111, 252, 232, 43, 180, 175, 126, 253, 200, 91, 183, 99, 43, 110, 197, 31, 74, 181, 149, 91, 222, 24, 243, 57, 70, 254, 134, 110, 140, 150, 244, 255, 237, 0, 124, 51, 237, 103, 227, 31, 154, 129, 228, 86, 67, 88, 15, 32, 124, 33, 55, 128, 7, 102, 60, 89, 40, 216, 226, 239, 116, 123, 25, 103, 197, 154, 38, 218, 238, 158, 89, 159, 88, 151, 79, 15, 120, 34, 222, 176, 113, 249, 140, 169, 163, 99, 213, 170, 26, 33, 55, 98, 88, 81, 215, 247, 110, 124, 27, 139, 95, 105, 106, 125, 224, 22, 185, 33, 121, 212, 231, 218, 147, 253, 223, 176, 55, 174, 60, 253, 159, 201, 137, 196, 151, 181, 0, 216, 37, 237, 226, 49, 129, 214, 204, 88, 203, 24, 162, 241, 248, 182, 236, 249, 137, 227, 219, 6, 143, 180, 80, 106, 98, 60, 22, 88, 223, 251, 230, 123, 98, 203, 254, 23, 37, 225, 189, 247, 128, 29, 120, 88, 155, 141, 87, 74, 187, 32, 15, 60, 169, 40, 43, 179, 162, 172, 148, 24, 39, 10, 29, 102, 78, 28, 231, 26, 155, 7, 140, 77, 166, 45, 95, 10, 130, 132, 34, 10, 98, 103, 230, 179, 246, 68, 126, 126, 126, 190, 39, 223, 227, 13, 251, 194, 68, 7, 193, 112, 107, 196, 84, 79, 40, 236, 139, 199, 2, 39, 198, 143, 154, 57, 250, 21, 237, 55, 167, 254, 246, 125, 237, 188, 181, 222, 174, 205, 180, 40, 109, 182, 188, 249, 189, 247, 76, 158, 252, 83, 110, 0, 23, 28, 165, 251, 54, 208, 100, 138, 87, 39, 61, 125, 97, 202, 226, 203, 252, 112, 16, 31, 71, 4, 213, 245, 189, 120, 214, 115, 218, 38, 124, 241, 43, 109, 174, 220, 208, 47, 178, 194, 228, 129, 228, 75, 236, 176, 228, 31, 117, 121, 56, 240, 37, 115, 203, 13, 80, 8, 85, 137, 114, 210, 35, 216, 170, 103, 6, 26, 73, 7, 147, 112, 90, 32, 236, 23, 156, 165, 13, 232, 175, 100, 77, 164, 50, 134, 179, 56, 29, 8, 254, 195, 38, 14, 45, 170, 157, 120, 193, 9, 195, 122, 113, 250, 216, 25, 177, 18, 188, 65, 30, 118, 194, 197, 109, 226, 1, 3, 39, 185, 156, 159, 233, 43, 19, 206, 160, 223, 33, 136, 2, 191, 237, 209, 86, 43, 146, 145, 206, 91, 178, 158, 97, 124, 155, 67, 145, 4, 221, 160, 54, 239, 130, 196, 95, 2, 193, 185, 148, 87, 50, 209, 6, 145, 243, 33, 89, 128, 252, 58, 8, 229, 80, 30, 246, 134, 189, 145, 136, 234, 116, 86, 16, 33, 2, 163, 71, 199, 26, 139, 71, 235, 130, 41, 208, 33, 78, 242, 73, 255, 89, 223, 123, 234, 202, 5, 127, 106, 59, 117, 197, 2, 172, 233, 197, 158, 85, 87, 189, 114, 243, 170, 171, 196, 150, 55, 174, 107, 255, 252, 186, 118, 237, 55, 253, 127, 99, 123, 159, 125, 63, 89, 175, 255, 219, 220, 59, 226, 44, 240, 192, 217, 169, 189, 67, 8, 249, 248, 57, 70, 150, 214, 248, 212, 190, 73, 109, 171, 241, 57, 183, 213, 128, 39, 196, 203, 108, 251, 42, 144, 107, 95, 137, 45, 111, 164, 111, 43, 253, 156, 16, 137, 246, 110, 240, 193, 97, 137, 161, 182, 175, 176, 187, 82, 200, 143, 162, 22, 230, 249, 242, 125, 54, 63, 10, 119, 174, 235, 113, 213, 58, 158, 79, 163, 122, 88, 227, 7, 218, 1, 20, 62, 254, 8, 69, 173, 255, 51, 244, 106, 95, 107, 95, 151, 226, 40, 20, 81, 193, 195, 181, 183, 180, 125, 218, 55, 218, 219, 61, 248, 75, 188, 83, 155, 173, 157, 70, 251, 122, 189, 118, 1, 255, 254, 2, 40, 129, 142, 68, 158, 167, 80, 20, 68, 169, 0, 5, 145, 25, 244, 34, 195, 70, 103, 238, 120, 178, 71, 248, 145, 53, 137, 182, 219, 4, 242, 85, 84, 113, 209, 54, 15, 176, 137, 3, 32, 218, 19, 206, 72, 77, 77, 88, 245, 240, 179, 149, 236, 242, 148, 169, 162, 90, 246, 11, 118, 244, 246, 90, 182, 138, 97, 188, 104, 23, 72, 175, 189, 97, 217, 42, 150, 249, 66, 107, 158, 165, 93, 32, 236, 20, 103, 65, 62, 20, 195, 41, 219, 11, 11, 200, 153, 108, 178, 55, 200, 205, 67, 129, 179, 215, 58, 87, 105, 87, 78, 32, 19, 191, 138, 139, 164, 185, 200, 137, 3, 32, 104, 185, 17, 107, 185, 228, 218, 181, 212, 187, 207, 82, 249, 130, 79, 95, 45, 183, 1, 12, 163, 64, 108, 217, 215, 196, 102, 153, 70, 64, 178, 222, 48, 11, 192, 212, 227, 108, 3, 95, 239, 85, 250, 34, 243, 242, 200, 147, 200, 176, 85, 65, 152, 26, 76, 228, 41, 8, 168, 127, 196, 76, 43, 217, 203, 115, 10, 116, 49, 53, 111, 83, 169, 187, 214, 248, 182, 148, 93, 102, 221, 181, 178, 61, 208, 247, 26, 93, 51, 243, 33, 63, 18, 54, 21, 78, 128, 182, 89, 192, 144, 207, 83, 30, 44, 14, 199, 170, 151, 45, 39, 241, 148, 158, 82, 63, 76, 142, 226, 241, 161, 47, 133, 235, 185, 78, 24, 158, 104, 40, 64, 134, 133, 41, 191, 74, 234, 43, 204, 179, 114, 146, 48, 173, 62, 82, 103, 56, 78, 138, 98, 141, 241, 81, 117, 117, 181, 214, 217, 31, 240, 23, 23, 139, 60, 1, 246, 252, 23, 31, 250, 226, 174, 173, 73, 188, 103, 249, 230, 27, 239, 125, 237, 231, 27, 49, 250, 151, 215, 239, 171, 221, 54, 103, 211, 162, 248, 184, 19, 223, 199, 145, 213, 155, 70, 254, 236, 244, 17, 177, 185, 95, 222, 190, 149, 238, 247, 7, 190, 100, 109, 210, 40, 240, 67, 99, 98, 132, 31, 37, 114, 88, 72, 172, 213, 229, 100, 108, 42, 157, 41, 122, 38, 215, 120, 226, 162, 101, 153, 170, 62, 213, 203, 19, 15, 36, 57, 66, 187, 48, 30, 139, 55, 113, 203, 131, 155, 116, 204, 59, 110, 148, 246, 205, 229, 151, 247, 126, 245, 213, 176, 35, 135, 4, 101, 31, 30, 201, 46, 235, 249, 204, 247, 93, 79, 114, 243, 234, 19, 220, 128, 176, 134, 108, 82, 177, 133, 235, 141, 124, 100, 186, 122, 39, 91, 212, 39, 32, 23, 117, 198, 96, 158, 36, 50, 126, 253, 48, 245, 134, 254, 136, 142, 164, 177, 196, 24, 152, 7, 198, 77, 198, 246, 164, 217, 254, 36, 165, 55, 188, 17, 47, 63, 135, 66, 113, 190, 94, 114, 188, 196, 26, 155, 232, 76, 244, 109, 248, 163, 246, 183, 222, 166, 182, 7, 110, 238, 253, 237, 231, 120, 172, 144, 151, 28, 117, 229, 233, 123, 216, 111, 246, 191, 72, 50, 229, 3, 16, 190, 17, 91, 178, 251, 87, 198, 167, 223, 250, 141, 159, 115, 250, 87, 176, 71, 187, 252, 85, 60, 12, 91, 30, 209, 22, 138, 45, 253, 143, 11, 109, 250, 119, 184, 1, 164, 167, 197, 150, 156, 254, 149, 241, 153, 254, 21, 24, 223, 54, 152, 127, 197, 231, 139, 249, 124, 49, 140, 8, 136, 170, 182, 239, 133, 151, 247, 245, 238, 250, 252, 65, 237, 205, 87, 254, 172, 245, 139, 45, 253, 191, 23, 26, 251, 127, 43, 196, 247, 191, 40, 28, 211, 191, 29, 12, 223, 138, 240, 137, 216, 146, 219, 183, 98, 63, 105, 237, 31, 228, 242, 173, 248, 12, 223, 10, 206, 213, 30, 250, 28, 61, 223, 60, 169, 61, 129, 157, 159, 105, 239, 107, 251, 88, 11, 3, 173, 11, 111, 77, 238, 78, 126, 129, 79, 104, 173, 252, 251, 125, 218, 5, 156, 198, 10, 4, 19, 37, 58, 145, 245, 47, 1, 158, 76, 170, 122, 85, 58, 107, 13, 34, 70, 136, 138, 125, 88, 135, 83, 126, 149, 124, 241, 119, 98, 75, 255, 14, 97, 226, 62, 186, 96, 33, 76, 5, 16, 158, 20, 91, 192, 9, 199, 229, 242, 155, 140, 207, 240, 155, 100, 123, 48, 168, 231, 100, 42, 59, 59, 249, 152, 48, 49, 121, 19, 187, 184, 71, 40, 234, 233, 233, 255, 91, 15, 253, 10, 40, 213, 118, 178, 49, 114, 3, 12, 135, 17, 137, 97, 197, 40, 241, 42, 73, 144, 4, 144, 186, 64, 0, 81, 16, 23, 154, 187, 121, 28, 155, 54, 124, 88, 77, 36, 92, 29, 42, 169, 166, 152, 184, 207, 220, 198, 163, 70, 199, 2, 60, 39, 169, 152, 10, 39, 20, 250, 163, 240, 220, 164, 88, 128, 82, 123, 120, 158, 68, 36, 82, 58, 225, 118, 143, 207, 247, 246, 150, 248, 148, 83, 242, 85, 247, 77, 55, 185, 213, 252, 83, 39, 199, 183, 188, 237, 243, 121, 110, 159, 192, 222, 184, 103, 202, 209, 39, 205, 154, 113, 221, 47, 123, 142, 26, 93, 116, 244, 25, 147, 218, 159, 95, 121, 233, 243, 237, 147, 206, 56, 186, 56, 126, 84, 207, 131, 215, 205, 152, 121, 210, 209, 63, 185, 155, 214, 251, 172, 182, 19, 219, 109, 249, 219, 122, 250, 234, 56, 152, 102, 115, 103, 248, 212, 152, 250, 236, 157, 228, 219, 249, 126, 11, 189, 115, 190, 182, 147, 221, 148, 194, 17, 36, 108, 165, 242, 28, 38, 81, 186, 37, 121, 81, 23, 154, 249, 141, 227, 132, 116, 28, 81, 87, 78, 60, 107, 63, 78, 118, 32, 215, 91, 163, 154, 76, 180, 106, 35, 145, 120, 163, 137, 112, 44, 198, 110, 106, 185, 173, 208, 239, 251, 227, 195, 163, 166, 156, 146, 231, 37, 36, 189, 121, 167, 78, 30, 245, 240, 31, 125, 254, 194, 219, 90, 216, 152, 123, 167, 28, 125, 210, 204, 227, 77, 36, 39, 159, 252, 252, 165, 43, 57, 146, 37, 163, 142, 234, 249, 229, 245, 211, 103, 157, 116, 244, 145, 119, 83, 30, 12, 110, 16, 39, 11, 116, 51, 60, 155, 124, 47, 199, 110, 45, 152, 126, 114, 162, 22, 4, 148, 152, 176, 12, 128, 137, 18, 44, 75, 229, 222, 131, 200, 218, 129, 49, 113, 30, 136, 76, 60, 62, 152, 168, 39, 64, 20, 164, 85, 4, 201, 64, 92, 149, 11, 148, 242, 176, 66, 106, 77, 136, 159, 189, 228, 207, 241, 69, 60, 161, 198, 42, 70, 250, 57, 230, 9, 133, 71, 176, 218, 72, 184, 191, 7, 11, 79, 95, 176, 116, 82, 184, 225, 162, 30, 237, 235, 211, 23, 44, 157, 28, 106, 88, 142, 207, 225, 209, 71, 182, 13, 247, 63, 161, 61, 69, 255, 161, 51, 247, 107, 237, 2, 220, 46, 182, 240, 250, 164, 97, 137, 33, 102, 18, 16, 142, 111, 179, 110, 1, 19, 216, 180, 44, 245, 71, 24, 136, 196, 125, 62, 53, 162, 250, 112, 123, 239, 150, 146, 222, 82, 177, 229, 149, 87, 180, 11, 250, 255, 166, 237, 196, 122, 161, 136, 126, 247, 22, 158, 231, 211, 7, 69, 16, 132, 179, 19, 37, 10, 34, 4, 139, 189, 133, 249, 121, 50, 67, 31, 57, 251, 90, 17, 5, 51, 195, 175, 220, 112, 219, 175, 50, 98, 218, 139, 211, 67, 222, 217, 30, 139, 29, 105, 113, 239, 34, 40, 242, 5, 212, 18, 93, 235, 214, 178, 248, 40, 47, 149, 186, 20, 241, 82, 151, 70, 178, 154, 3, 130, 172, 122, 138, 217, 69, 191, 61, 240, 213, 251, 243, 187, 111, 125, 244, 204, 107, 122, 110, 254, 233, 252, 175, 191, 222, 226, 199, 113, 95, 254, 3, 107, 42, 158, 126, 90, 174, 223, 220, 236, 234, 127, 106, 242, 205, 227, 182, 136, 203, 139, 180, 75, 180, 39, 73, 64, 1, 225, 98, 0, 158, 123, 91, 14, 103, 39, 10, 243, 81, 96, 5, 252, 142, 141, 40, 155, 8, 84, 139, 72, 71, 153, 76, 78, 81, 227, 74, 221, 66, 247, 81, 165, 195, 129, 138, 50, 86, 225, 149, 23, 12, 4, 100, 66, 151, 13, 212, 4, 104, 86, 166, 89, 198, 179, 95, 245, 70, 34, 170, 159, 27, 207, 70, 18, 88, 124, 148, 121, 180, 7, 40, 157, 149, 82, 136, 67, 129, 139, 223, 126, 252, 241, 215, 119, 116, 93, 178, 226, 252, 167, 118, 238, 60, 122, 33, 155, 191, 101, 242, 15, 159, 245, 252, 233, 157, 79, 42, 31, 24, 249, 103, 109, 79, 207, 229, 119, 254, 234, 196, 45, 180, 126, 6, 151, 96, 84, 248, 94, 188, 159, 215, 225, 76, 78, 36, 10, 92, 76, 128, 128, 170, 16, 41, 75, 16, 153, 208, 234, 41, 116, 58, 68, 152, 90, 92, 196, 80, 196, 99, 68, 180, 54, 24, 63, 130, 199, 11, 211, 42, 202, 35, 161, 242, 186, 138, 186, 97, 13, 18, 213, 29, 40, 117, 124, 55, 213, 53, 21, 211, 194, 154, 138, 185, 254, 40, 86, 168, 80, 164, 201, 44, 133, 9, 248, 139, 240, 236, 13, 39, 221, 113, 68, 98, 195, 73, 27, 78, 63, 231, 156, 211, 55, 156, 180, 33, 113, 196, 29, 39, 109, 232, 56, 251, 236, 197, 11, 126, 122, 245, 188, 121, 87, 119, 227, 11, 27, 78, 218, 176, 96, 209, 162, 5, 27, 78, 222, 112, 196, 17, 27, 78, 214, 255, 126, 210, 134, 196, 138, 171, 231, 207, 189, 250, 234, 121, 115, 127, 10, 70, 76, 64, 140, 75, 125, 80, 12, 103, 110, 115, 219, 114, 115, 66, 50, 10, 10, 37, 14, 179, 69, 14, 68, 39, 130, 11, 161, 147, 196, 98, 76, 155, 27, 93, 174, 177, 174, 105, 193, 68, 72, 226, 245, 100, 217, 32, 9, 166, 217, 69, 41, 99, 122, 154, 66, 81, 64, 85, 85, 189, 134, 44, 207, 89, 197, 243, 135, 67, 241, 80, 60, 132, 49, 254, 79, 40, 192, 255, 81, 177, 151, 164, 156, 255, 57, 241, 115, 156, 196, 112, 242, 163, 218, 76, 227, 131, 157, 216, 190, 236, 23, 231, 178, 207, 147, 111, 179, 225, 201, 242, 115, 127, 177, 76, 123, 0, 24, 252, 70, 219, 137, 39, 26, 245, 120, 67, 18, 181, 0, 148, 100, 129, 243, 173, 77, 54, 78, 200, 90, 148, 167, 198, 84, 65, 141, 169, 191, 233, 233, 233, 209, 118, 178, 207, 147, 229, 226, 18, 250, 183, 206, 211, 43, 180, 199, 132, 175, 165, 61, 48, 4, 70, 193, 241, 9, 111, 0, 25, 30, 30, 41, 42, 64, 41, 61, 80, 102, 101, 6, 24, 7, 66, 11, 29, 171, 37, 198, 126, 234, 26, 152, 25, 48, 60, 50, 156, 91, 124, 190, 184, 153, 166, 90, 87, 163, 235, 206, 26, 67, 4, 245, 51, 195, 56, 52, 196, 72, 24, 117, 40, 250, 248, 138, 111, 54, 173, 190, 119, 214, 218, 71, 181, 143, 214, 253, 28, 133, 63, 222, 242, 210, 107, 55, 174, 190, 251, 145, 107, 222, 188, 235, 230, 251, 181, 111, 63, 187, 29, 175, 152, 218, 113, 233, 135, 83, 255, 172, 245, 63, 209, 181, 96, 250, 228, 182, 150, 214, 75, 255, 120, 107, 207, 233, 235, 54, 245, 141, 184, 123, 252, 25, 39, 156, 245, 147, 166, 19, 238, 188, 116, 205, 230, 142, 45, 27, 159, 97, 247, 205, 239, 56, 122, 118, 124, 246, 41, 29, 221, 36, 129, 192, 96, 172, 36, 176, 115, 165, 173, 156, 134, 141, 164, 97, 19, 117, 20, 236, 66, 56, 137, 113, 207, 22, 47, 114, 204, 158, 190, 200, 213, 100, 60, 22, 136, 197, 99, 129, 177, 91, 62, 220, 34, 9, 91, 182, 108, 217, 194, 43, 211, 64, 250, 19, 143, 243, 20, 195, 112, 152, 195, 127, 235, 204, 8, 202, 210, 240, 26, 166, 200, 37, 40, 42, 66, 43, 121, 76, 37, 42, 126, 144, 64, 86, 36, 185, 11, 20, 16, 153, 34, 118, 57, 145, 1, 176, 118, 39, 191, 209, 57, 136, 158, 51, 232, 66, 119, 88, 67, 93, 52, 84, 21, 44, 181, 7, 200, 93, 206, 34, 190, 6, 35, 137, 138, 255, 59, 106, 251, 187, 145, 78, 75, 91, 201, 164, 48, 183, 107, 255, 166, 189, 163, 77, 199, 199, 176, 17, 27, 241, 49, 173, 77, 123, 5, 243, 241, 34, 237, 58, 109, 159, 182, 79, 187, 30, 151, 177, 162, 159, 158, 185, 237, 139, 143, 159, 107, 191, 188, 228, 242, 174, 75, 215, 223, 117, 237, 89, 103, 226, 54, 28, 129, 49, 124, 84, 59, 78, 123, 83, 123, 77, 155, 177, 24, 151, 161, 19, 37, 188, 128, 191, 149, 212, 174, 69, 54, 226, 111, 79, 189, 240, 69, 137, 116, 97, 252, 177, 117, 235, 30, 28, 175, 211, 118, 150, 48, 154, 245, 73, 255, 228, 254, 255, 117, 199, 110, 29, 153, 233, 255, 47, 201, 230, 255, 47, 49, 253, 255, 4, 30, 161, 244, 8, 164, 92, 76, 35, 14, 160, 32, 241, 161, 221, 10, 2, 136, 48, 35, 152, 168, 179, 67, 241, 88, 128, 34, 130, 210, 14, 28, 212, 12, 1, 136, 48, 131, 34, 0, 145, 26, 31, 143, 0, 184, 43, 205, 116, 99, 91, 4, 32, 21, 0, 168, 171, 139, 211, 113, 31, 107, 196, 47, 22, 116, 206, 58, 7, 103, 29, 126, 81, 231, 217, 43, 206, 251, 122, 172, 179, 80, 30, 57, 98, 97, 105, 65, 237, 27, 243, 142, 20, 110, 154, 189, 120, 198, 231, 63, 57, 103, 252, 146, 145, 111, 106, 239, 158, 202, 78, 240, 205, 158, 177, 254, 98, 18, 44, 6, 231, 193, 124, 225, 239, 194, 7, 32, 129, 27, 28, 219, 220, 78, 73, 96, 141, 13, 78, 166, 144, 146, 70, 5, 81, 248, 59, 109, 236, 121, 218, 5, 120, 157, 246, 39, 235, 175, 194, 141, 218, 26, 109, 205, 84, 124, 14, 159, 155, 106, 253, 141, 235, 170, 89, 88, 47, 142, 20, 150, 131, 4, 53, 137, 16, 169, 209, 171, 129, 238, 8, 20, 196, 225, 87, 27, 96, 199, 217, 147, 83, 124, 113, 74, 22, 153, 37, 124, 208, 195, 62, 239, 73, 110, 197, 231, 232, 119, 156, 204, 100, 118, 252, 161, 214, 29, 157, 140, 251, 244, 186, 35, 49, 77, 158, 35, 48, 20, 186, 184, 68, 159, 94, 137, 178, 3, 91, 243, 20, 38, 201, 76, 150, 24, 217, 22, 130, 72, 34, 45, 130, 211, 33, 58, 187, 192, 1, 178, 228, 144, 59, 221, 186, 72, 187, 185, 72, 187, 184, 72, 151, 148, 144, 80, 15, 173, 175, 173, 41, 137, 148, 68, 194, 161, 234, 12, 209, 206, 63, 136, 104, 55, 169, 177, 198, 128, 95, 241, 69, 132, 136, 42, 144, 237, 25, 137, 28, 76, 172, 253, 253, 95, 95, 255, 239, 155, 119, 46, 116, 212, 252, 207, 163, 71, 29, 84, 160, 191, 20, 170, 95, 94, 123, 178, 118, 61, 78, 211, 30, 195, 71, 55, 174, 34, 58, 193, 124, 105, 171, 232, 145, 167, 28, 122, 28, 141, 232, 56, 95, 216, 34, 109, 37, 58, 50, 88, 38, 108, 100, 47, 26, 121, 50, 99, 57, 13, 135, 219, 146, 97, 104, 219, 91, 57, 49, 198, 182, 183, 211, 68, 54, 104, 130, 198, 166, 38, 67, 180, 206, 126, 42, 222, 114, 209, 150, 95, 93, 114, 201, 175, 126, 181, 242, 152, 51, 206, 56, 234, 168, 51, 22, 10, 27, 31, 185, 120, 197, 150, 45, 151, 172, 220, 178, 114, 193, 49, 71, 45, 92, 120, 244, 79, 206, 208, 247, 229, 34, 0, 113, 178, 120, 55, 184, 161, 16, 230, 25, 161, 145, 84, 137, 29, 48, 7, 74, 50, 147, 58, 185, 157, 225, 52, 237, 140, 65, 33, 184, 161, 81, 148, 151, 7, 144, 87, 152, 87, 88, 144, 111, 101, 160, 185, 120, 118, 94, 170, 48, 143, 210, 189, 245, 226, 188, 155, 241, 62, 109, 118, 178, 79, 248, 0, 235, 113, 89, 15, 46, 211, 214, 244, 104, 107, 180, 157, 102, 204, 177, 149, 109, 99, 47, 200, 147, 161, 20, 70, 37, 70, 102, 212, 79, 153, 133, 83, 179, 82, 247, 157, 153, 194, 180, 84, 121, 148, 187, 148, 234, 239, 168, 60, 106, 212, 128, 242, 168, 214, 216, 37, 51, 142, 185, 241, 201, 197, 27, 110, 186, 246, 198, 135, 79, 94, 57, 106, 67, 37, 62, 199, 182, 117, 206, 57, 99, 82, 243, 69, 231, 222, 119, 244, 162, 49, 63, 61, 111, 218, 242, 83, 106, 207, 189, 144, 220, 209, 128, 176, 140, 221, 197, 254, 71, 158, 12, 33, 56, 34, 209, 156, 189, 62, 202, 42, 120, 50, 46, 14, 179, 172, 34, 158, 147, 164, 105, 70, 221, 147, 187, 188, 193, 199, 235, 158, 26, 141, 140, 92, 91, 221, 83, 192, 175, 232, 30, 143, 101, 69, 238, 203, 38, 127, 122, 235, 117, 227, 99, 151, 223, 184, 125, 246, 79, 175, 58, 3, 167, 124, 137, 121, 175, 62, 48, 147, 61, 187, 196, 33, 212, 63, 114, 218, 188, 233, 29, 199, 119, 55, 118, 214, 188, 185, 97, 210, 200, 103, 86, 220, 72, 116, 58, 83, 18, 88, 130, 203, 100, 21, 151, 40, 127, 170, 110, 47, 83, 24, 125, 49, 60, 243, 223, 159, 107, 59, 40, 64, 171, 237, 0, 132, 155, 181, 11, 132, 143, 196, 22, 240, 194, 37, 9, 119, 158, 194, 16, 60, 40, 32, 51, 162, 148, 197, 116, 0, 142, 39, 119, 152, 48, 151, 72, 60, 150, 124, 39, 19, 36, 211, 181, 20, 52, 30, 243, 7, 136, 28, 243, 73, 38, 136, 237, 105, 243, 128, 167, 20, 68, 243, 134, 85, 111, 132, 95, 54, 124, 41, 55, 70, 36, 46, 71, 194, 117, 106, 228, 102, 221, 147, 209, 123, 219, 47, 18, 137, 94, 177, 197, 242, 101, 252, 246, 195, 97, 218, 105, 128, 176, 64, 219, 41, 92, 38, 245, 65, 62, 76, 221, 70, 174, 29, 211, 110, 243, 138, 2, 227, 154, 145, 49, 61, 217, 136, 71, 57, 204, 15, 83, 234, 18, 24, 176, 227, 44, 79, 151, 170, 214, 146, 167, 11, 213, 90, 190, 175, 72, 111, 70, 84, 252, 207, 163, 31, 254, 230, 51, 188, 232, 220, 95, 104, 59, 197, 188, 130, 247, 159, 124, 244, 230, 31, 60, 242, 5, 63, 240, 60, 142, 91, 132, 163, 240, 231, 98, 75, 70, 221, 240, 248, 244, 186, 225, 120, 44, 112, 203, 94, 225, 168, 189, 123, 117, 57, 250, 133, 54, 157, 81, 47, 29, 21, 22, 113, 62, 185, 200, 224, 116, 33, 227, 49, 181, 98, 35, 212, 45, 2, 46, 51, 146, 205, 186, 128, 28, 209, 237, 134, 213, 47, 176, 25, 193, 68, 181, 14, 33, 174, 202, 5, 210, 190, 221, 27, 169, 81, 61, 146, 51, 200, 25, 30, 138, 41, 70, 45, 57, 69, 153, 72, 73, 98, 249, 220, 230, 105, 231, 95, 112, 226, 81, 199, 14, 31, 223, 249, 236, 217, 29, 137, 35, 59, 2, 51, 10, 167, 82, 222, 238, 70, 182, 77, 200, 147, 39, 83, 44, 45, 81, 101, 20, 70, 166, 42, 32, 103, 178, 105, 86, 249, 163, 155, 170, 91, 99, 170, 18, 143, 170, 177, 192, 198, 61, 123, 112, 245, 30, 182, 237, 100, 246, 252, 136, 83, 219, 250, 95, 63, 153, 244, 203, 84, 97, 52, 187, 135, 235, 57, 31, 140, 60, 168, 142, 75, 243, 85, 187, 139, 26, 210, 245, 27, 237, 146, 128, 31, 151, 93, 115, 247, 61, 215, 253, 236, 158, 123, 174, 95, 187, 230, 6, 97, 244, 243, 119, 221, 253, 236, 51, 119, 223, 247, 204, 25, 223, 127, 243, 221, 119, 68, 95, 6, 215, 192, 124, 17, 50, 207, 219, 38, 196, 38, 196, 40, 255, 247, 53, 100, 70, 207, 199, 159, 105, 23, 98, 61, 253, 117, 30, 94, 167, 93, 176, 5, 151, 225, 178, 169, 218, 20, 109, 202, 84, 235, 111, 244, 251, 68, 202, 121, 151, 15, 231, 57, 23, 30, 240, 65, 49, 156, 197, 185, 54, 151, 132, 73, 100, 138, 15, 129, 57, 17, 5, 161, 21, 20, 166, 116, 59, 41, 9, 143, 129, 176, 202, 141, 14, 201, 113, 53, 48, 144, 216, 73, 50, 221, 40, 168, 48, 99, 17, 48, 38, 117, 184, 80, 98, 210, 113, 126, 191, 44, 3, 248, 139, 253, 197, 69, 1, 175, 42, 123, 100, 79, 97, 65, 126, 158, 219, 229, 80, 64, 2, 41, 207, 84, 241, 156, 186, 24, 55, 11, 13, 3, 24, 82, 145, 92, 71, 99, 123, 177, 93, 152, 163, 117, 225, 77, 215, 175, 88, 161, 205, 195, 162, 253, 235, 196, 196, 79, 247, 255, 134, 77, 103, 123, 71, 46, 59, 169, 255, 220, 228, 214, 175, 207, 101, 129, 228, 191, 46, 248, 26, 59, 241, 172, 164, 67, 246, 254, 240, 79, 46, 149, 38, 62, 197, 16, 132, 144, 33, 131, 179, 171, 171, 42, 43, 68, 20, 221, 8, 232, 66, 25, 4, 67, 189, 138, 40, 118, 59, 81, 6, 6, 50, 91, 229, 64, 73, 144, 174, 166, 44, 18, 25, 97, 49, 48, 65, 224, 125, 75, 132, 14, 133, 110, 219, 199, 149, 151, 151, 148, 0, 148, 135, 202, 67, 37, 193, 146, 96, 89, 41, 93, 120, 138, 35, 117, 46, 103, 41, 199, 36, 20, 31, 221, 212, 148, 166, 135, 117, 53, 44, 97, 12, 125, 28, 75, 97, 127, 226, 194, 159, 108, 184, 175, 99, 213, 229, 151, 94, 121, 247, 83, 241, 238, 98, 236, 222, 55, 71, 76, 92, 179, 255, 55, 209, 94, 108, 191, 228, 220, 197, 45, 63, 187, 232, 230, 35, 47, 136, 255, 116, 201, 117, 183, 54, 156, 189, 224, 146, 40, 161, 196, 46, 48, 240, 229, 188, 90, 114, 224, 3, 121, 130, 180, 7, 42, 160, 30, 26, 96, 4, 188, 198, 177, 11, 34, 74, 64, 245, 106, 84, 118, 209, 80, 200, 152, 82, 128, 200, 66, 40, 59, 228, 214, 99, 183, 214, 76, 63, 57, 49, 174, 16, 243, 93, 249, 87, 231, 217, 251, 58, 40, 84, 193, 170, 48, 151, 114, 18, 169, 117, 7, 195, 46, 55, 58, 156, 40, 187, 28, 242, 34, 80, 20, 87, 71, 1, 186, 20, 215, 113, 193, 4, 185, 58, 243, 186, 245, 223, 0, 63, 254, 23, 180, 39, 42, 135, 13, 171, 172, 28, 54, 98, 216, 136, 225, 135, 13, 29, 82, 89, 95, 89, 95, 87, 171, 122, 189, 225, 80, 56, 82, 23, 246, 56, 171, 12, 3, 66, 63, 31, 210, 182, 113, 136, 142, 15, 218, 23, 3, 5, 100, 197, 57, 231, 99, 217, 142, 93, 91, 110, 57, 243, 162, 43, 167, 61, 165, 245, 199, 134, 125, 179, 225, 52, 113, 6, 9, 204, 117, 43, 87, 106, 243, 177, 104, 255, 167, 92, 96, 164, 99, 150, 214, 190, 189, 113, 98, 227, 115, 151, 252, 188, 226, 146, 216, 253, 219, 102, 111, 190, 98, 95, 119, 86, 209, 1, 6, 79, 107, 211, 197, 40, 215, 119, 101, 144, 72, 76, 40, 64, 38, 22, 34, 197, 31, 100, 20, 185, 35, 105, 149, 85, 42, 165, 251, 38, 231, 129, 32, 76, 22, 166, 101, 173, 152, 226, 62, 30, 195, 75, 22, 226, 46, 148, 106, 149, 28, 104, 163, 227, 113, 161, 169, 183, 247, 190, 246, 173, 232, 251, 213, 123, 127, 124, 73, 27, 186, 99, 198, 252, 159, 232, 14, 160, 166, 111, 168, 102, 234, 149, 99, 31, 24, 131, 167, 62, 177, 188, 113, 194, 149, 43, 150, 144, 76, 243, 26, 31, 229, 6, 94, 227, 83, 0, 11, 211, 170, 124, 170, 64, 150, 228, 110, 218, 84, 86, 73, 15, 157, 8, 109, 138, 149, 126, 106, 135, 176, 87, 254, 40, 86, 229, 143, 23, 128, 54, 167, 85, 253, 227, 200, 168, 254, 137, 96, 44, 163, 2, 104, 51, 79, 128, 178, 149, 1, 225, 157, 56, 17, 6, 174, 245, 204, 180, 181, 86, 131, 36, 75, 221, 14, 148, 65, 206, 181, 216, 52, 144, 236, 171, 45, 114, 187, 1, 220, 5, 238, 130, 252, 60, 107, 197, 206, 244, 21, 11, 190, 24, 102, 172, 120, 53, 183, 8, 50, 86, 172, 237, 24, 176, 102, 47, 156, 159, 112, 121, 80, 150, 104, 225, 102, 100, 61, 196, 87, 229, 84, 216, 128, 149, 59, 172, 149, 167, 195, 216, 151, 238, 176, 150, 94, 194, 151, 238, 117, 123, 11, 237, 139, 119, 165, 47, 30, 125, 49, 95, 164, 46, 162, 100, 32, 160, 61, 241, 239, 247, 255, 221, 243, 26, 123, 45, 147, 236, 218, 142, 215, 95, 7, 27, 30, 173, 28, 143, 74, 184, 58, 141, 246, 245, 10, 74, 224, 116, 72, 206, 46, 144, 243, 92, 204, 1, 178, 99, 113, 26, 38, 110, 11, 147, 6, 0, 135, 232, 0, 113, 213, 32, 175, 184, 45, 156, 42, 57, 78, 149, 238, 202, 138, 96, 9, 239, 33, 84, 144, 194, 44, 63, 3, 51, 126, 69, 167, 187, 121, 140, 14, 235, 58, 138, 181, 101, 98, 217, 155, 183, 229, 152, 150, 230, 49, 149, 83, 156, 43, 220, 191, 50, 254, 150, 134, 241, 99, 127, 171, 141, 70, 206, 249, 91, 93, 52, 124, 14, 233, 68, 29, 231, 66, 67, 222, 188, 176, 60, 13, 235, 144, 130, 146, 219, 225, 18, 100, 144, 100, 51, 253, 90, 23, 57, 167, 133, 110, 13, 128, 44, 202, 58, 186, 3, 96, 157, 41, 60, 11, 11, 9, 211, 66, 111, 161, 87, 245, 100, 136, 95, 94, 6, 158, 134, 179, 36, 19, 183, 239, 200, 121, 146, 134, 11, 119, 164, 164, 201, 96, 17, 148, 66, 20, 150, 111, 43, 69, 217, 194, 162, 6, 16, 28, 10, 58, 168, 229, 143, 40, 200, 226, 34, 23, 42, 202, 184, 54, 50, 61, 199, 180, 57, 173, 34, 29, 114, 232, 40, 178, 2, 242, 170, 236, 240, 78, 171, 106, 167, 36, 88, 6, 16, 170, 42, 139, 6, 163, 37, 197, 80, 4, 69, 170, 90, 84, 171, 186, 157, 65, 194, 162, 182, 206, 178, 75, 20, 89, 177, 144, 242, 217, 174, 96, 62, 214, 56, 99, 218, 216, 73, 87, 117, 156, 222, 61, 185, 249, 196, 153, 54, 28, 125, 205, 199, 31, 63, 110, 252, 140, 25, 82, 159, 99, 252, 228, 142, 217, 221, 87, 157, 62, 103, 82, 179, 98, 67, 121, 201, 241, 205, 227, 102, 76, 31, 63, 238, 68, 96, 132, 179, 242, 138, 212, 7, 12, 188, 176, 108, 27, 179, 85, 143, 215, 81, 28, 6, 4, 158, 16, 166, 72, 160, 80, 170, 172, 153, 75, 43, 73, 114, 135, 211, 193, 140, 20, 172, 168, 81, 24, 208, 57, 224, 13, 11, 142, 167, 98, 169, 130, 32, 120, 5, 47, 191, 172, 169, 62, 143, 203, 89, 217, 192, 141, 13, 179, 198, 139, 255, 65, 54, 19, 239, 237, 47, 23, 30, 22, 118, 37, 135, 179, 115, 146, 235, 88, 178, 127, 100, 114, 191, 182, 143, 141, 101, 235, 246, 199, 173, 154, 51, 188, 83, 155, 199, 99, 44, 211, 121, 189, 96, 61, 92, 158, 40, 169, 64, 89, 170, 175, 100, 138, 236, 7, 6, 74, 62, 10, 98, 30, 50, 193, 236, 42, 81, 103, 20, 80, 117, 153, 73, 86, 182, 194, 213, 54, 195, 178, 213, 243, 116, 162, 150, 167, 204, 124, 195, 172, 89, 181, 195, 181, 39, 220, 53, 145, 58, 50, 131, 141, 36, 207, 80, 36, 211, 8, 110, 170, 169, 171, 205, 94, 116, 232, 175, 75, 25, 199, 109, 218, 23, 119, 255, 58, 107, 5, 226, 209, 150, 217, 124, 67, 112, 243, 43, 217, 170, 17, 201, 30, 229, 245, 127, 202, 13, 252, 142, 125, 142, 161, 106, 64, 145, 149, 110, 224, 186, 114, 64, 29, 30, 47, 246, 115, 152, 213, 93, 181, 54, 80, 160, 92, 38, 92, 100, 196, 40, 58, 45, 64, 94, 237, 229, 5, 32, 157, 105, 221, 183, 157, 25, 149, 129, 148, 150, 107, 171, 14, 212, 230, 233, 7, 148, 193, 47, 121, 26, 78, 204, 88, 235, 249, 250, 90, 135, 128, 172, 200, 221, 78, 84, 64, 57, 232, 98, 235, 236, 176, 131, 174, 54, 151, 135, 32, 109, 197, 190, 24, 218, 86, 156, 28, 167, 31, 80, 182, 21, 107, 59, 210, 214, 236, 131, 203, 183, 169, 168, 200, 230, 30, 25, 202, 151, 227, 114, 176, 65, 214, 238, 52, 215, 94, 159, 6, 156, 109, 241, 78, 115, 241, 37, 124, 241, 190, 60, 159, 199, 190, 124, 247, 192, 229, 243, 83, 202, 134, 66, 255, 121, 214, 9, 149, 134, 197, 235, 175, 131, 96, 224, 81, 200, 105, 239, 131, 203, 12, 20, 28, 40, 231, 57, 221, 130, 2, 178, 210, 153, 3, 5, 151, 133, 66, 26, 112, 182, 26, 82, 151, 89, 67, 90, 233, 241, 16, 18, 30, 159, 199, 231, 85, 51, 248, 144, 159, 137, 136, 161, 172, 237, 136, 108, 230, 138, 218, 68, 98, 158, 174, 165, 249, 173, 154, 109, 83, 110, 176, 251, 147, 194, 134, 0, 51, 185, 123, 64, 13, 163, 37, 56, 213, 41, 32, 179, 154, 113, 81, 10, 36, 183, 124, 219, 42, 26, 133, 8, 198, 140, 170, 198, 107, 73, 180, 205, 210, 70, 46, 217, 180, 54, 170, 169, 124, 207, 88, 219, 34, 125, 109, 17, 83, 94, 153, 50, 200, 226, 66, 54, 168, 140, 90, 203, 131, 203, 179, 109, 133, 232, 139, 161, 177, 66, 237, 56, 146, 101, 251, 18, 201, 208, 98, 48, 30, 128, 237, 53, 100, 249, 188, 52, 89, 174, 177, 196, 51, 219, 90, 45, 41, 14, 219, 193, 50, 22, 123, 40, 242, 155, 190, 92, 46, 191, 198, 146, 147, 87, 27, 178, 155, 190, 106, 178, 172, 4, 189, 94, 213, 146, 221, 101, 198, 146, 77, 113, 100, 178, 210, 57, 96, 201, 150, 212, 134, 237, 96, 25, 75, 62, 116, 121, 181, 47, 220, 144, 87, 115, 225, 175, 110, 249, 104, 139, 181, 104, 67, 84, 129, 113, 95, 246, 249, 220, 151, 93, 6, 115, 12, 243, 91, 70, 84, 168, 189, 14, 208, 225, 104, 44, 151, 242, 190, 58, 28, 102, 138, 5, 21, 111, 75, 200, 164, 78, 27, 168, 9, 192, 83, 45, 252, 0, 188, 89, 164, 159, 23, 173, 185, 66, 134, 233, 77, 57, 69, 3, 171, 200, 105, 135, 61, 114, 247, 221, 217, 106, 201, 177, 77, 123, 156, 61, 176, 145, 93, 149, 89, 82, 174, 149, 110, 4, 179, 206, 61, 164, 180, 130, 19, 42, 224, 252, 244, 202, 206, 16, 93, 199, 28, 10, 117, 119, 144, 220, 78, 166, 128, 164, 44, 38, 23, 206, 152, 54, 151, 149, 249, 146, 11, 134, 117, 184, 172, 100, 152, 114, 151, 11, 192, 85, 225, 170, 40, 47, 43, 14, 248, 125, 252, 246, 166, 215, 131, 230, 165, 213, 131, 250, 178, 152, 173, 102, 141, 232, 73, 89, 205, 213, 84, 221, 168, 60, 207, 110, 174, 130, 192, 235, 198, 119, 243, 61, 64, 30, 138, 133, 6, 70, 32, 137, 82, 55, 247, 71, 152, 201, 142, 45, 109, 10, 247, 31, 201, 102, 61, 105, 149, 13, 70, 119, 52, 45, 78, 65, 240, 178, 143, 98, 242, 50, 5, 203, 74, 75, 236, 222, 116, 199, 32, 213, 165, 116, 104, 230, 172, 48, 197, 139, 72, 207, 28, 188, 206, 84, 122, 23, 39, 14, 192, 235, 76, 83, 59, 138, 146, 216, 237, 64, 9, 165, 220, 136, 85, 219, 129, 178, 99, 22, 212, 227, 40, 186, 231, 197, 142, 157, 115, 16, 236, 40, 173, 42, 39, 118, 92, 67, 29, 26, 114, 218, 142, 76, 190, 85, 193, 133, 219, 42, 80, 178, 226, 232, 17, 142, 129, 83, 102, 233, 120, 58, 56, 18, 138, 137, 103, 40, 13, 202, 64, 52, 5, 195, 89, 88, 97, 32, 90, 85, 82, 85, 158, 129, 170, 107, 16, 84, 5, 67, 153, 229, 68, 119, 133, 161, 221, 14, 21, 99, 93, 239, 233, 56, 183, 114, 158, 54, 192, 149, 198, 153, 109, 219, 89, 121, 20, 232, 147, 148, 197, 41, 156, 173, 224, 151, 142, 115, 125, 54, 96, 195, 77, 154, 2, 229, 168, 71, 12, 212, 27, 74, 26, 134, 214, 71, 41, 86, 86, 57, 48, 86, 150, 147, 0, 89, 182, 104, 78, 98, 44, 206, 178, 103, 15, 129, 48, 127, 179, 237, 100, 209, 160, 77, 33, 167, 13, 245, 245, 189, 192, 16, 5, 25, 69, 183, 226, 162, 84, 123, 169, 51, 69, 22, 43, 204, 109, 136, 66, 26, 148, 65, 143, 20, 140, 78, 143, 242, 114, 157, 34, 229, 85, 229, 85, 149, 21, 3, 101, 63, 111, 16, 217, 23, 140, 67, 34, 39, 13, 40, 142, 127, 40, 56, 27, 231, 9, 194, 89, 112, 134, 152, 16, 199, 129, 27, 228, 199, 157, 2, 154, 62, 231, 98, 58, 39, 240, 44, 188, 66, 187, 188, 11, 175, 192, 43, 186, 180, 203, 249, 191, 180, 203, 223, 76, 255, 177, 139, 64, 76, 153, 226, 61, 195, 130, 16, 129, 142, 132, 234, 64, 9, 34, 65, 55, 138, 162, 228, 69, 102, 53, 92, 43, 225, 9, 172, 164, 178, 91, 200, 12, 103, 115, 101, 210, 219, 188, 207, 86, 17, 166, 28, 153, 230, 3, 222, 98, 203, 3, 80, 85, 81, 28, 0, 31, 248, 162, 222, 168, 226, 44, 109, 40, 110, 210, 175, 64, 197, 77, 49, 165, 136, 254, 22, 174, 83, 124, 69, 177, 70, 175, 74, 73, 109, 68, 43, 127, 241, 162, 95, 61, 141, 151, 99, 98, 255, 214, 197, 15, 63, 254, 184, 118, 133, 182, 227, 235, 71, 207, 248, 211, 138, 237, 159, 38, 143, 63, 241, 242, 103, 62, 77, 158, 20, 221, 189, 19, 103, 226, 60, 220, 220, 221, 253, 217, 123, 218, 227, 218, 70, 237, 148, 85, 171, 208, 115, 30, 254, 4, 35, 95, 247, 156, 187, 92, 123, 70, 251, 240, 187, 219, 233, 204, 226, 53, 198, 202, 13, 16, 128, 82, 179, 222, 62, 104, 104, 109, 16, 187, 179, 212, 27, 167, 63, 205, 168, 60, 78, 56, 85, 31, 21, 31, 91, 193, 163, 1, 197, 199, 164, 191, 7, 20, 32, 179, 15, 73, 111, 103, 47, 67, 22, 135, 226, 196, 140, 117, 158, 104, 102, 144, 145, 114, 162, 46, 36, 82, 182, 133, 102, 60, 206, 92, 105, 65, 81, 81, 81, 105, 81, 169, 159, 150, 75, 125, 74, 114, 44, 215, 23, 195, 1, 203, 197, 39, 73, 19, 231, 94, 46, 105, 223, 116, 218, 86, 192, 233, 219, 130, 54, 237, 91, 193, 215, 166, 200, 44, 199, 218, 51, 159, 103, 46, 190, 176, 168, 168, 168, 162, 168, 66, 229, 171, 119, 228, 36, 182, 207, 208, 177, 3, 48, 248, 135, 161, 91, 7, 195, 65, 215, 167, 58, 14, 133, 156, 238, 21, 48, 215, 88, 190, 140, 162, 83, 113, 8, 18, 136, 82, 103, 150, 229, 87, 51, 10, 70, 75, 128, 171, 236, 128, 105, 24, 20, 149, 149, 21, 21, 149, 85, 148, 85, 148, 7, 45, 54, 184, 114, 179, 193, 208, 13, 3, 89, 49, 156, 95, 123, 178, 98, 209, 98, 222, 129, 120, 109, 181, 114, 3, 56, 32, 15, 78, 201, 168, 174, 46, 1, 65, 20, 186, 73, 229, 139, 221, 70, 3, 16, 51, 229, 58, 227, 17, 131, 110, 171, 185, 132, 207, 233, 4, 112, 230, 57, 243, 220, 46, 189, 246, 90, 177, 215, 94, 11, 190, 24, 218, 235, 175, 63, 39, 113, 177, 23, 97, 155, 215, 11, 189, 223, 133, 84, 203, 227, 165, 1, 179, 27, 17, 165, 62, 74, 34, 74, 220, 161, 39, 136, 172, 147, 223, 235, 101, 211, 222, 205, 246, 216, 104, 235, 76, 162, 225, 161, 210, 67, 79, 1, 228, 67, 126, 52, 164, 42, 70, 97, 82, 150, 214, 23, 111, 227, 50, 163, 247, 197, 235, 120, 24, 239, 125, 241, 123, 169, 79, 251, 107, 188, 173, 227, 62, 77, 209, 222, 192, 63, 226, 212, 27, 232, 119, 35, 92, 113, 224, 75, 241, 231, 242, 20, 168, 163, 74, 97, 202, 161, 119, 81, 160, 221, 71, 249, 207, 173, 65, 243, 7, 234, 231, 214, 174, 63, 46, 54, 179, 8, 19, 20, 113, 192, 185, 146, 200, 16, 39, 89, 157, 189, 131, 169, 199, 86, 170, 189, 89, 247, 20, 204, 124, 218, 108, 127, 106, 164, 219, 215, 65, 93, 67, 164, 33, 162, 151, 233, 140, 162, 42, 29, 191, 98, 164, 53, 123, 66, 166, 47, 73, 79, 0, 230, 225, 45, 57, 160, 94, 177, 125, 15, 94, 186, 186, 115, 201, 211, 15, 107, 63, 223, 122, 229, 83, 203, 94, 58, 239, 79, 87, 44, 155, 247, 242, 133, 179, 197, 203, 15, 188, 162, 253, 115, 245, 181, 103, 55, 77, 62, 225, 218, 85, 79, 105, 207, 54, 61, 124, 220, 29, 11, 78, 186, 127, 222, 249, 115, 110, 188, 116, 214, 61, 243, 86, 238, 31, 206, 109, 96, 94, 47, 45, 79, 225, 249, 82, 245, 240, 215, 255, 170, 98, 122, 24, 56, 156, 130, 211, 33, 172, 178, 42, 167, 157, 206, 113, 109, 185, 202, 167, 245, 206, 241, 163, 237, 239, 40, 3, 223, 201, 81, 80, 29, 76, 196, 192, 233, 112, 118, 131, 67, 112, 116, 91, 175, 30, 236, 173, 246, 246, 68, 65, 125, 157, 106, 150, 98, 27, 13, 230, 14, 94, 138, 237, 35, 163, 124, 176, 114, 236, 243, 73, 191, 231, 174, 201, 22, 87, 224, 196, 1, 52, 254, 234, 191, 162, 241, 97, 46, 116, 58, 4, 135, 211, 70, 100, 135, 227, 96, 68, 110, 74, 123, 73, 25, 248, 82, 78, 42, 143, 2, 135, 211, 209, 237, 66, 167, 224, 252, 81, 100, 246, 70, 163, 209, 250, 104, 189, 215, 36, 181, 251, 80, 73, 77, 186, 101, 80, 82, 111, 35, 101, 51, 56, 169, 185, 234, 201, 160, 247, 48, 72, 26, 133, 130, 67, 209, 233, 200, 164, 185, 212, 26, 204, 124, 160, 24, 15, 12, 186, 15, 119, 187, 216, 143, 39, 252, 152, 244, 183, 126, 12, 229, 227, 156, 242, 244, 254, 143, 36, 189, 63, 26, 141, 14, 139, 14, 27, 146, 34, 126, 222, 161, 18, 223, 116, 192, 12, 202, 0, 109, 181, 113, 184, 30, 140, 9, 220, 199, 200, 245, 138, 180, 151, 243, 96, 12, 22, 252, 87, 50, 63, 10, 156, 5, 249, 204, 45, 56, 221, 139, 45, 218, 231, 229, 57, 230, 186, 208, 225, 104, 25, 132, 3, 116, 216, 180, 164, 191, 171, 228, 122, 55, 39, 31, 154, 93, 232, 128, 60, 183, 35, 175, 43, 203, 111, 58, 216, 235, 237, 237, 137, 178, 104, 52, 58, 38, 58, 166, 41, 222, 120, 248, 136, 225, 135, 53, 132, 77, 166, 20, 30, 50, 83, 178, 221, 163, 6, 101, 208, 121, 89, 46, 83, 131, 48, 171, 59, 205, 29, 2, 162, 190, 111, 164, 103, 12, 61, 53, 12, 126, 248, 239, 52, 149, 201, 171, 124, 119, 158, 224, 116, 58, 58, 136, 93, 99, 14, 194, 174, 38, 147, 180, 185, 94, 202, 201, 167, 184, 11, 29, 252, 37, 193, 225, 236, 252, 81, 12, 26, 50, 36, 26, 29, 50, 108, 200, 176, 134, 161, 105, 42, 171, 224, 144, 25, 100, 94, 236, 6, 101, 202, 163, 91, 62, 220, 50, 24, 19, 184, 49, 103, 208, 94, 126, 141, 211, 254, 112, 104, 134, 151, 255, 43, 218, 143, 4, 39, 184, 93, 78, 119, 87, 62, 186, 242, 208, 33, 184, 28, 86, 22, 115, 46, 250, 39, 198, 103, 127, 231, 208, 200, 152, 8, 54, 142, 172, 141, 142, 25, 61, 178, 185, 177, 121, 216, 208, 232, 225, 181, 135, 167, 200, 120, 168, 253, 78, 114, 228, 150, 14, 74, 214, 200, 105, 87, 81, 196, 179, 123, 78, 243, 9, 39, 140, 27, 127, 252, 241, 131, 80, 120, 69, 247, 233, 167, 83, 236, 243, 170, 89, 20, 238, 156, 49, 126, 220, 9, 134, 221, 35, 111, 18, 103, 209, 132, 36, 24, 129, 63, 57, 118, 107, 249, 244, 147, 19, 254, 58, 116, 176, 90, 84, 28, 197, 94, 106, 235, 224, 67, 9, 197, 214, 224, 177, 91, 67, 169, 103, 174, 204, 103, 135, 13, 120, 38, 24, 207, 178, 124, 76, 93, 102, 218, 219, 245, 47, 139, 184, 80, 161, 52, 127, 5, 40, 221, 199, 193, 230, 146, 211, 129, 170, 98, 17, 165, 185, 212, 90, 113, 146, 52, 205, 248, 238, 122, 112, 41, 224, 58, 9, 14, 5, 158, 214, 51, 4, 20, 0, 65, 129, 46, 112, 129, 128, 46, 161, 51, 247, 11, 137, 216, 96, 176, 96, 129, 18, 219, 173, 183, 218, 233, 164, 31, 113, 216, 176, 134, 161, 245, 145, 72, 36, 18, 242, 134, 195, 250, 97, 99, 238, 12, 178, 93, 3, 212, 6, 132, 231, 218, 212, 201, 41, 57, 168, 53, 4, 193, 23, 242, 68, 170, 217, 129, 99, 181, 165, 175, 95, 135, 193, 181, 47, 227, 243, 201, 242, 32, 94, 116, 231, 35, 47, 61, 175, 189, 117, 235, 138, 237, 151, 223, 188, 141, 45, 27, 62, 111, 197, 214, 109, 125, 47, 252, 117, 3, 243, 247, 31, 128, 111, 177, 148, 237, 126, 72, 56, 238, 242, 228, 151, 19, 180, 75, 39, 109, 104, 254, 115, 247, 220, 251, 175, 198, 115, 175, 236, 58, 113, 206, 125, 51, 180, 22, 164, 172, 191, 89, 218, 116, 222, 43, 166, 22, 166, 39, 218, 202, 81, 150, 106, 43, 152, 34, 3, 130, 226, 67, 132, 60, 20, 68, 55, 50, 65, 110, 205, 140, 1, 79, 108, 203, 26, 219, 141, 232, 129, 93, 85, 15, 236, 42, 3, 226, 186, 20, 160, 31, 208, 95, 166, 231, 150, 17, 169, 160, 238, 241, 207, 92, 114, 109, 122, 175, 153, 70, 121, 150, 21, 206, 189, 173, 240, 250, 59, 51, 26, 207, 8, 188, 239, 204, 126, 121, 10, 239, 205, 82, 10, 87, 38, 92, 169, 238, 44, 186, 121, 209, 0, 178, 34, 40, 178, 176, 202, 97, 53, 107, 161, 36, 132, 172, 29, 91, 130, 86, 12, 88, 144, 187, 83, 240, 217, 64, 219, 169, 222, 147, 34, 10, 105, 61, 94, 156, 185, 123, 188, 144, 121, 156, 163, 207, 203, 114, 178, 140, 179, 55, 123, 17, 175, 54, 242, 147, 210, 241, 188, 118, 32, 158, 195, 156, 168, 200, 130, 172, 216, 17, 149, 229, 156, 136, 90, 1, 100, 65, 57, 40, 166, 101, 69, 69, 0, 116, 67, 47, 41, 78, 195, 214, 149, 19, 91, 10, 170, 229, 194, 86, 155, 67, 214, 105, 110, 116, 181, 29, 153, 248, 86, 192, 141, 137, 130, 32, 42, 178, 137, 179, 96, 224, 124, 24, 69, 145, 117, 164, 157, 135, 130, 116, 42, 244, 44, 40, 221, 169, 55, 178, 99, 93, 206, 177, 174, 40, 170, 40, 203, 196, 219, 61, 24, 222, 186, 67, 59, 7, 238, 201, 123, 12, 187, 112, 48, 244, 95, 127, 29, 68, 93, 174, 165, 103, 12, 126, 87, 192, 186, 116, 142, 147, 29, 48, 204, 101, 98, 192, 99, 209, 138, 220, 225, 64, 89, 30, 147, 19, 121, 51, 162, 39, 200, 74, 167, 235, 32, 200, 135, 201, 53, 3, 144, 114, 206, 100, 144, 32, 63, 55, 9, 204, 147, 62, 23, 9, 62, 223, 242, 225, 150, 28, 232, 175, 53, 243, 138, 216, 129, 157, 0, 184, 133, 231, 205, 43, 176, 88, 231, 118, 41, 13, 96, 16, 24, 172, 2, 65, 24, 151, 222, 165, 198, 172, 192, 22, 152, 64, 233, 16, 172, 59, 237, 105, 214, 7, 212, 196, 134, 119, 109, 78, 205, 245, 51, 58, 216, 248, 34, 24, 91, 223, 59, 141, 54, 37, 245, 139, 73, 245, 75, 107, 5, 96, 33, 99, 77, 231, 234, 107, 42, 147, 168, 77, 31, 19, 96, 149, 217, 189, 37, 115, 81, 197, 198, 76, 55, 1, 132, 140, 85, 101, 125, 162, 47, 43, 87, 115, 29, 218, 87, 235, 123, 181, 61, 180, 131, 204, 181, 209, 126, 97, 7, 238, 4, 96, 167, 240, 181, 185, 225, 210, 109, 78, 35, 39, 137, 150, 24, 148, 69, 54, 96, 141, 102, 91, 125, 211, 1, 70, 43, 33, 56, 115, 41, 230, 243, 28, 143, 244, 85, 234, 45, 247, 221, 162, 219, 97, 174, 83, 73, 173, 147, 239, 131, 245, 189, 218, 17, 134, 196, 167, 150, 75, 119, 158, 3, 31, 3, 176, 18, 233, 25, 78, 75, 55, 156, 98, 180, 160, 113, 72, 10, 117, 206, 232, 16, 209, 106, 68, 36, 155, 141, 136, 74, 68, 100, 252, 185, 213, 139, 200, 106, 182, 94, 230, 112, 208, 82, 28, 110, 135, 219, 229, 180, 145, 205, 105, 146, 205, 144, 201, 245, 189, 218, 21, 91, 62, 220, 194, 151, 146, 111, 201, 26, 156, 72, 125, 91, 228, 69, 48, 4, 26, 225, 200, 196, 164, 2, 55, 3, 28, 194, 80, 16, 160, 6, 37, 65, 104, 205, 207, 99, 56, 213, 44, 106, 25, 71, 27, 133, 111, 153, 241, 116, 206, 155, 91, 102, 228, 136, 195, 26, 194, 213, 101, 37, 106, 128, 250, 204, 98, 93, 83, 109, 93, 109, 36, 222, 88, 92, 196, 203, 100, 139, 43, 153, 55, 224, 81, 232, 110, 82, 219, 196, 154, 200, 130, 43, 30, 46, 212, 9, 30, 138, 61, 135, 194, 50, 46, 156, 117, 122, 93, 221, 204, 211, 47, 59, 43, 54, 242, 152, 153, 147, 221, 238, 43, 159, 187, 120, 236, 21, 171, 62, 125, 191, 251, 106, 198, 174, 188, 242, 163, 166, 243, 134, 150, 28, 61, 171, 227, 240, 187, 209, 139, 30, 237, 95, 171, 111, 16, 239, 29, 117, 123, 129, 211, 119, 83, 69, 190, 239, 226, 216, 18, 73, 110, 125, 117, 143, 246, 205, 90, 109, 223, 237, 183, 223, 122, 219, 1, 20, 214, 245, 20, 21, 29, 222, 62, 125, 114, 105, 255, 219, 218, 60, 188, 21, 111, 251, 35, 225, 73, 253, 142, 190, 144, 246, 130, 7, 106, 224, 153, 244, 78, 58, 245, 182, 84, 73, 167, 83, 154, 171, 160, 36, 25, 173, 108, 201, 35, 58, 190, 205, 109, 246, 207, 49, 116, 143, 14, 143, 25, 240, 233, 157, 117, 172, 119, 18, 67, 51, 82, 50, 249, 123, 89, 128, 169, 217, 67, 123, 162, 66, 85, 1, 212, 26, 181, 38, 18, 170, 164, 112, 98, 177, 223, 107, 182, 229, 201, 207, 214, 150, 71, 200, 114, 251, 179, 181, 234, 185, 36, 203, 85, 47, 163, 125, 143, 148, 159, 126, 191, 227, 231, 143, 88, 46, 79, 225, 57, 31, 197, 166, 6, 170, 161, 99, 82, 18, 113, 149, 222, 253, 207, 90, 123, 90, 216, 55, 152, 45, 44, 62, 177, 45, 91, 208, 155, 122, 48, 216, 203, 43, 28, 57, 90, 1, 81, 192, 36, 91, 59, 32, 188, 151, 148, 84, 238, 166, 64, 226, 53, 56, 113, 0, 46, 75, 117, 92, 162, 14, 138, 69, 136, 18, 174, 2, 81, 28, 4, 153, 108, 177, 240, 1, 216, 4, 253, 126, 194, 71, 175, 155, 176, 99, 228, 204, 129, 17, 5, 185, 179, 97, 116, 9, 105, 182, 193, 17, 210, 118, 100, 242, 39, 8, 43, 182, 149, 26, 145, 21, 66, 173, 150, 66, 218, 89, 113, 75, 11, 91, 7, 179, 7, 192, 51, 160, 218, 19, 21, 6, 114, 65, 127, 176, 36, 3, 61, 87, 14, 244, 204, 192, 118, 54, 20, 167, 27, 10, 241, 96, 88, 234, 177, 23, 142, 167, 180, 151, 243, 174, 14, 126, 106, 238, 190, 84, 84, 218, 225, 16, 231, 202, 200, 199, 125, 89, 8, 164, 133, 168, 131, 131, 134, 190, 51, 128, 219, 19, 17, 3, 219, 58, 127, 93, 109, 77, 168, 170, 146, 103, 153, 216, 113, 206, 207, 133, 115, 150, 93, 152, 13, 255, 35, 178, 108, 199, 65, 104, 177, 206, 190, 51, 69, 131, 30, 207, 24, 178, 28, 132, 139, 117, 138, 68, 121, 240, 90, 18, 59, 136, 20, 99, 108, 164, 72, 139, 78, 7, 179, 199, 185, 51, 160, 218, 19, 145, 146, 18, 157, 10, 122, 244, 122, 160, 88, 231, 229, 16, 107, 51, 126, 157, 13, 239, 130, 45, 31, 110, 25, 12, 79, 243, 60, 18, 172, 250, 79, 253, 108, 92, 96, 205, 167, 80, 204, 62, 124, 50, 82, 174, 120, 42, 121, 147, 103, 108, 13, 6, 208, 44, 29, 228, 148, 244, 81, 207, 94, 99, 78, 210, 34, 225, 131, 228, 173, 123, 247, 238, 165, 242, 98, 188, 255, 187, 239, 250, 103, 241, 34, 57, 1, 110, 56, 240, 165, 56, 83, 156, 101, 196, 192, 15, 79, 28, 230, 66, 9, 35, 193, 124, 65, 20, 37, 214, 106, 245, 22, 227, 157, 195, 244, 190, 93, 147, 96, 90, 109, 36, 234, 141, 170, 20, 12, 142, 42, 145, 38, 227, 6, 72, 97, 109, 189, 113, 152, 62, 106, 142, 218, 151, 84, 171, 254, 98, 60, 109, 194, 218, 139, 47, 70, 241, 163, 7, 207, 162, 191, 104, 201, 143, 31, 112, 178, 201, 103, 162, 191, 38, 193, 90, 231, 105, 255, 168, 25, 127, 226, 3, 119, 253, 27, 93, 56, 252, 132, 19, 30, 184, 235, 43, 237, 123, 237, 205, 79, 89, 43, 254, 226, 178, 51, 206, 97, 199, 106, 11, 111, 57, 149, 199, 211, 120, 223, 39, 121, 10, 120, 192, 15, 59, 210, 59, 63, 81, 157, 6, 202, 164, 244, 232, 154, 96, 107, 3, 165, 164, 218, 64, 145, 10, 169, 201, 2, 167, 152, 129, 42, 179, 243, 147, 113, 22, 134, 140, 66, 21, 148, 186, 115, 1, 102, 135, 201, 104, 35, 213, 158, 80, 1, 252, 62, 175, 106, 246, 146, 114, 100, 235, 37, 69, 103, 129, 189, 159, 148, 182, 159, 206, 128, 140, 174, 82, 41, 155, 213, 78, 139, 87, 211, 105, 17, 114, 16, 37, 36, 217, 56, 206, 114, 19, 35, 154, 13, 48, 23, 53, 194, 102, 37, 12, 202, 185, 201, 145, 29, 104, 0, 61, 2, 220, 16, 240, 171, 126, 159, 215, 164, 137, 51, 43, 77, 232, 186, 105, 163, 73, 242, 85, 58, 70, 178, 208, 68, 219, 145, 78, 147, 18, 120, 59, 225, 46, 66, 89, 226, 132, 49, 91, 213, 134, 169, 26, 38, 59, 101, 28, 233, 148, 169, 205, 10, 233, 200, 65, 154, 136, 85, 106, 99, 161, 61, 16, 52, 23, 212, 0, 226, 20, 115, 226, 148, 168, 37, 1, 27, 121, 92, 57, 200, 99, 164, 89, 165, 72, 212, 159, 52, 142, 161, 172, 84, 210, 207, 30, 78, 39, 233, 25, 190, 143, 74, 96, 83, 134, 244, 240, 154, 19, 89, 234, 32, 211, 111, 140, 157, 70, 206, 20, 141, 72, 32, 162, 217, 0, 157, 89, 240, 54, 235, 88, 80, 146, 59, 83, 80, 25, 120, 39, 42, 2, 92, 42, 2, 37, 129, 146, 226, 162, 116, 217, 200, 203, 138, 188, 161, 146, 211, 228, 227, 136, 45, 31, 110, 201, 68, 220, 188, 16, 48, 189, 87, 152, 60, 133, 107, 223, 19, 172, 142, 108, 198, 117, 79, 20, 104, 70, 158, 126, 7, 181, 119, 16, 163, 198, 146, 148, 43, 32, 89, 105, 4, 230, 147, 246, 68, 190, 195, 65, 90, 215, 28, 22, 98, 118, 25, 35, 35, 40, 173, 211, 88, 55, 137, 173, 217, 110, 76, 94, 99, 202, 43, 239, 235, 40, 55, 240, 190, 142, 77, 137, 81, 246, 190, 142, 230, 133, 36, 71, 203, 198, 244, 126, 141, 234, 161, 247, 107, 84, 158, 76, 30, 149, 165, 97, 163, 160, 211, 70, 122, 134, 211, 166, 16, 78, 75, 235, 87, 87, 230, 144, 21, 129, 210, 110, 169, 195, 205, 152, 12, 234, 148, 72, 40, 240, 231, 84, 30, 147, 78, 158, 64, 94, 158, 195, 161, 167, 15, 155, 100, 114, 218, 201, 100, 112, 48, 141, 84, 121, 252, 30, 103, 80, 234, 182, 212, 217, 25, 0, 144, 18, 10, 24, 117, 85, 139, 211, 170, 170, 42, 65, 114, 96, 90, 153, 84, 70, 21, 95, 200, 44, 169, 26, 0, 248, 163, 202, 248, 168, 137, 66, 32, 163, 134, 234, 45, 182, 58, 173, 132, 234, 57, 222, 72, 65, 128, 174, 3, 95, 138, 39, 74, 15, 25, 113, 164, 191, 252, 87, 145, 12, 107, 122, 156, 11, 41, 28, 4, 131, 135, 144, 194, 60, 90, 104, 68, 45, 178, 190, 145, 35, 128, 17, 76, 52, 130, 195, 133, 63, 58, 120, 244, 95, 199, 185, 137, 142, 130, 103, 176, 128, 209, 42, 182, 122, 144, 104, 198, 13, 6, 141, 117, 153, 208, 107, 59, 139, 224, 252, 52, 153, 136, 40, 72, 234, 22, 100, 234, 134, 226, 232, 202, 81, 107, 87, 107, 64, 25, 5, 134, 153, 208, 169, 106, 187, 34, 202, 188, 81, 61, 133, 246, 178, 212, 204, 58, 201, 152, 94, 73, 72, 126, 254, 76, 41, 233, 93, 17, 122, 244, 177, 234, 21, 133, 79, 94, 115, 205, 54, 187, 184, 176, 143, 182, 108, 89, 247, 200, 175, 76, 121, 49, 226, 245, 135, 195, 183, 255, 149, 188, 140, 48, 228, 101, 49, 5, 14, 199, 181, 129, 219, 237, 156, 11, 78, 231, 96, 66, 67, 202, 175, 137, 130, 95, 130, 211, 109, 134, 188, 204, 215, 15, 38, 2, 193, 68, 147, 11, 41, 80, 159, 249, 254, 65, 223, 36, 225, 25, 113, 88, 195, 208, 33, 117, 234, 143, 143, 211, 219, 200, 60, 184, 16, 221, 100, 35, 122, 110, 105, 146, 158, 211, 89, 192, 207, 135, 53, 124, 118, 161, 31, 138, 225, 166, 132, 175, 24, 101, 208, 39, 169, 81, 123, 59, 21, 145, 186, 195, 17, 193, 170, 168, 238, 17, 101, 209, 40, 22, 150, 72, 154, 44, 114, 77, 176, 154, 192, 150, 91, 96, 52, 105, 74, 88, 104, 131, 54, 6, 13, 202, 208, 157, 1, 163, 72, 134, 196, 241, 100, 175, 162, 0, 248, 193, 239, 143, 68, 168, 91, 59, 47, 134, 196, 136, 76, 97, 87, 10, 41, 209, 60, 72, 163, 20, 146, 75, 219, 69, 171, 150, 141, 137, 52, 79, 123, 227, 78, 83, 234, 72, 208, 218, 206, 152, 83, 56, 163, 226, 238, 75, 103, 154, 18, 71, 231, 78, 135, 182, 134, 207, 135, 104, 128, 24, 124, 109, 180, 228, 53, 133, 106, 24, 138, 142, 161, 165, 12, 196, 8, 202, 80, 131, 138, 44, 183, 6, 237, 79, 157, 153, 79, 13, 217, 163, 121, 16, 14, 145, 106, 60, 45, 230, 59, 83, 178, 198, 123, 231, 205, 5, 69, 153, 160, 152, 82, 23, 163, 23, 156, 105, 47, 128, 211, 57, 80, 90, 210, 94, 77, 28, 110, 189, 37, 131, 34, 43, 11, 15, 242, 50, 215, 83, 5, 177, 145, 181, 145, 168, 255, 208, 210, 158, 234, 210, 232, 91, 91, 103, 72, 93, 246, 64, 172, 162, 156, 170, 211, 252, 246, 150, 120, 206, 209, 20, 71, 206, 226, 44, 88, 49, 51, 250, 54, 23, 56, 58, 207, 24, 245, 185, 144, 142, 54, 114, 94, 207, 204, 49, 221, 177, 218, 204, 114, 165, 52, 254, 236, 115, 168, 42, 237, 32, 122, 229, 233, 98, 19, 96, 18, 164, 82, 97, 125, 52, 251, 145, 82, 97, 179, 207, 126, 36, 255, 243, 96, 243, 31, 147, 23, 147, 5, 147, 125, 10, 164, 100, 213, 83, 81, 108, 227, 110, 62, 187, 160, 4, 150, 38, 10, 51, 166, 84, 232, 108, 143, 200, 233, 238, 169, 108, 163, 43, 130, 89, 49, 79, 3, 105, 79, 20, 4, 2, 100, 43, 250, 34, 22, 98, 89, 230, 92, 16, 94, 25, 179, 46, 54, 63, 122, 247, 202, 141, 218, 97, 132, 79, 218, 196, 139, 217, 47, 188, 115, 164, 56, 198, 72, 160, 178, 243, 167, 2, 150, 37, 124, 148, 224, 155, 198, 35, 115, 164, 98, 200, 202, 230, 205, 205, 164, 170, 52, 152, 108, 92, 50, 115, 126, 57, 155, 40, 231, 55, 39, 155, 184, 193, 63, 24, 171, 250, 31, 49, 46, 0, 131, 113, 139, 238, 1, 140, 124, 46, 194, 243, 156, 95, 229, 112, 81, 194, 87, 102, 224, 104, 241, 76, 52, 120, 86, 163, 100, 248, 221, 178, 113, 36, 152, 157, 20, 25, 92, 43, 12, 4, 2, 229, 129, 114, 149, 179, 77, 71, 52, 59, 219, 56, 158, 217, 88, 151, 156, 111, 224, 151, 131, 123, 175, 191, 110, 219, 95, 35, 249, 254, 234, 204, 177, 191, 42, 65, 36, 17, 19, 165, 220, 227, 48, 45, 128, 44, 227, 222, 14, 117, 111, 197, 99, 129, 65, 247, 214, 131, 189, 57, 88, 117, 86, 111, 175, 217, 127, 94, 184, 83, 218, 197, 247, 213, 153, 89, 246, 149, 81, 76, 39, 73, 98, 7, 112, 47, 89, 54, 218, 7, 179, 160, 251, 95, 109, 41, 186, 115, 101, 219, 82, 167, 247, 14, 228, 72, 107, 111, 111, 182, 189, 36, 74, 217, 247, 18, 201, 142, 229, 97, 207, 206, 146, 170, 52, 152, 255, 133, 189, 36, 8, 17, 33, 86, 28, 27, 116, 236, 237, 205, 131, 110, 37, 249, 6, 109, 7, 78, 52, 228, 109, 192, 94, 18, 165, 52, 62, 137, 173, 25, 161, 6, 194, 67, 202, 189, 151, 178, 145, 226, 191, 218, 75, 62, 3, 207, 108, 60, 171, 202, 181, 149, 164, 139, 45, 212, 244, 121, 202, 210, 98, 222, 143, 196, 11, 101, 176, 100, 91, 9, 185, 224, 13, 158, 133, 29, 230, 66, 129, 23, 11, 154, 165, 210, 212, 31, 65, 49, 67, 124, 213, 105, 64, 250, 16, 224, 69, 124, 8, 176, 98, 14, 1, 46, 241, 249, 0, 124, 101, 190, 50, 138, 157, 88, 227, 128, 157, 217, 199, 1, 163, 129, 83, 214, 177, 192, 236, 70, 3, 171, 129, 243, 129, 217, 2, 3, 45, 238, 127, 151, 70, 25, 115, 141, 194, 176, 46, 225, 171, 44, 47, 43, 21, 5, 49, 53, 224, 72, 48, 6, 28, 209, 208, 247, 131, 77, 56, 170, 150, 241, 96, 35, 142, 178, 129, 100, 206, 56, 114, 21, 171, 222, 65, 199, 215, 240, 2, 80, 107, 148, 124, 246, 113, 71, 167, 182, 181, 189, 146, 99, 230, 145, 184, 112, 124, 224, 110, 181, 247, 33, 237, 135, 255, 79, 212, 228, 82, 204, 224, 44, 185, 1, 138, 160, 26, 30, 76, 228, 21, 161, 67, 41, 41, 206, 19, 156, 14, 211, 225, 23, 213, 123, 39, 42, 132, 13, 15, 227, 59, 28, 220, 148, 228, 214, 162, 158, 201, 53, 214, 106, 172, 55, 36, 55, 176, 36, 141, 165, 55, 228, 185, 32, 203, 147, 228, 105, 193, 193, 97, 155, 237, 176, 100, 127, 86, 87, 214, 212, 132, 85, 250, 159, 135, 210, 238, 145, 186, 16, 199, 248, 192, 131, 72, 220, 198, 91, 139, 225, 130, 143, 196, 180, 183, 167, 103, 224, 8, 139, 191, 173, 236, 237, 93, 185, 191, 232, 183, 22, 91, 147, 9, 115, 140, 133, 217, 155, 129, 166, 21, 22, 194, 82, 179, 207, 135, 236, 196, 193, 218, 25, 88, 97, 134, 104, 10, 50, 91, 47, 131, 131, 215, 222, 167, 245, 48, 72, 155, 141, 221, 127, 1, 91, 157, 106, 95, 112, 86, 79, 143, 153, 7, 36, 61, 100, 228, 193, 92, 149, 158, 5, 67, 174, 140, 33, 86, 246, 18, 53, 186, 148, 59, 96, 144, 20, 24, 29, 75, 202, 127, 249, 63, 144, 242, 20, 15, 229, 206, 121, 121, 142, 173, 206, 145, 242, 178, 158, 251, 40, 76, 158, 232, 117, 186, 151, 24, 81, 62, 7, 82, 186, 18, 40, 224, 4, 197, 217, 117, 176, 62, 19, 67, 51, 192, 179, 53, 203, 176, 42, 247, 9, 59, 191, 87, 245, 216, 123, 55, 12, 104, 149, 97, 187, 65, 219, 185, 180, 220, 118, 95, 182, 216, 165, 220, 96, 92, 143, 51, 114, 183, 66, 240, 82, 58, 207, 232, 22, 57, 220, 74, 63, 162, 140, 165, 113, 180, 15, 248, 182, 200, 197, 56, 35, 195, 141, 80, 19, 20, 103, 87, 230, 219, 89, 223, 177, 147, 47, 237, 165, 172, 224, 148, 132, 14, 80, 77, 233, 6, 153, 57, 125, 131, 100, 123, 217, 93, 12, 185, 88, 191, 217, 70, 173, 236, 50, 32, 189, 106, 146, 206, 218, 155, 35, 255, 95, 216, 155, 105, 189, 69, 146, 29, 189, 182, 173, 217, 219, 107, 241, 89, 218, 149, 125, 111, 14, 253, 127, 106, 111, 230, 206, 71, 211, 214, 246, 230, 218, 154, 132, 37, 213, 190, 253, 90, 91, 35, 5, 165, 62, 40, 133, 7, 18, 46, 47, 202, 146, 15, 21, 217, 204, 187, 28, 146, 131, 41, 78, 7, 227, 199, 65, 154, 67, 131, 136, 82, 107, 189, 96, 184, 38, 178, 189, 71, 220, 172, 25, 192, 68, 227, 5, 11, 140, 182, 50, 143, 26, 66, 41, 148, 146, 247, 135, 254, 161, 22, 81, 118, 70, 166, 123, 130, 108, 44, 189, 204, 116, 4, 89, 140, 181, 188, 64, 70, 127, 112, 109, 13, 159, 123, 88, 9, 17, 184, 60, 225, 5, 178, 71, 145, 73, 212, 77, 147, 239, 38, 131, 2, 245, 14, 84, 64, 148, 20, 158, 123, 153, 201, 176, 54, 51, 111, 106, 2, 25, 120, 209, 20, 40, 17, 22, 23, 102, 121, 131, 88, 236, 169, 170, 170, 138, 84, 69, 194, 33, 111, 77, 36, 82, 75, 51, 17, 162, 1, 89, 73, 67, 36, 157, 195, 2, 229, 34, 10, 223, 94, 190, 98, 129, 142, 211, 249, 114, 6, 155, 253, 223, 38, 75, 118, 108, 168, 203, 63, 234, 84, 142, 226, 89, 109, 118, 86, 179, 98, 18, 0, 142, 51, 205, 187, 47, 183, 245, 45, 146, 21, 211, 130, 29, 234, 114, 176, 84, 215, 167, 28, 76, 55, 119, 98, 125, 26, 240, 255, 134, 190, 69, 230, 53, 100, 71, 138, 127, 159, 90, 246, 171, 193, 62, 197, 186, 104, 100, 205, 159, 149, 149, 1, 249, 179, 102, 110, 180, 149, 11, 75, 203, 206, 157, 29, 157, 34, 129, 32, 255, 31, 201, 159, 181, 46, 33, 57, 54, 235, 12, 3, 229, 92, 138, 212, 196, 159, 241, 122, 253, 75, 149, 27, 120, 215, 139, 181, 137, 34, 242, 129, 211, 61, 203, 141, 18, 243, 243, 198, 175, 32, 72, 3, 26, 82, 33, 101, 5, 75, 32, 10, 146, 152, 235, 170, 159, 106, 72, 133, 10, 247, 164, 73, 200, 22, 167, 94, 50, 64, 249, 189, 171, 132, 58, 175, 154, 45, 49, 160, 24, 138, 189, 145, 72, 36, 76, 167, 44, 170, 162, 110, 224, 86, 147, 215, 134, 255, 173, 177, 137, 102, 11, 155, 126, 155, 75, 181, 239, 181, 127, 245, 255, 128, 235, 240, 2, 116, 96, 161, 246, 202, 238, 228, 251, 56, 2, 131, 231, 111, 221, 171, 153, 249, 67, 108, 107, 114, 121, 155, 32, 227, 114, 236, 153, 252, 226, 162, 100, 143, 246, 63, 218, 111, 162, 120, 241, 122, 33, 173, 183, 34, 159, 193, 41, 79, 161, 190, 193, 48, 20, 222, 76, 248, 235, 209, 161, 164, 79, 226, 20, 141, 73, 156, 35, 92, 78, 230, 80, 4, 133, 138, 83, 45, 33, 56, 248, 96, 206, 88, 230, 107, 135, 52, 167, 115, 56, 40, 14, 165, 155, 94, 213, 139, 83, 15, 62, 179, 147, 168, 55, 52, 50, 84, 245, 25, 179, 59, 41, 140, 116, 8, 179, 59, 45, 31, 209, 224, 51, 60, 147, 31, 25, 100, 61, 200, 48, 79, 241, 81, 147, 186, 118, 25, 139, 192, 154, 132, 55, 83, 190, 76, 119, 133, 205, 16, 65, 110, 136, 12, 46, 97, 54, 59, 142, 192, 77, 25, 115, 101, 149, 177, 34, 128, 112, 117, 101, 69, 121, 89, 105, 201, 161, 73, 152, 205, 100, 25, 84, 202, 230, 218, 205, 150, 28, 146, 38, 26, 81, 169, 76, 57, 27, 14, 191, 205, 53, 237, 245, 112, 39, 111, 233, 8, 46, 151, 99, 174, 30, 117, 60, 52, 33, 107, 4, 7, 184, 4, 135, 171, 203, 137, 135, 46, 99, 244, 101, 14, 165, 219, 124, 245, 144, 164, 204, 115, 88, 195, 144, 250, 144, 247, 71, 202, 152, 221, 16, 60, 136, 156, 125, 101, 35, 236, 65, 100, 77, 250, 218, 164, 175, 41, 107, 35, 121, 206, 219, 85, 185, 101, 77, 183, 162, 144, 223, 112, 6, 23, 179, 168, 5, 105, 72, 88, 151, 35, 187, 132, 113, 45, 102, 245, 142, 230, 18, 230, 26, 68, 194, 40, 5, 110, 48, 201, 218, 214, 155, 83, 113, 61, 215, 219, 155, 146, 37, 105, 23, 215, 89, 181, 240, 109, 46, 89, 106, 112, 162, 195, 161, 116, 164, 238, 13, 7, 23, 163, 17, 246, 87, 14, 69, 134, 116, 170, 30, 102, 138, 206, 32, 175, 219, 94, 74, 52, 128, 226, 68, 135, 160, 56, 58, 15, 69, 230, 218, 19, 106, 36, 18, 169, 141, 212, 154, 154, 141, 44, 184, 67, 145, 58, 242, 176, 14, 46, 109, 218, 239, 123, 15, 166, 207, 122, 137, 232, 52, 171, 121, 186, 116, 41, 239, 63, 30, 133, 119, 18, 193, 76, 249, 170, 42, 101, 178, 82, 130, 14, 153, 122, 115, 187, 120, 12, 89, 161, 128, 219, 178, 193, 206, 75, 218, 90, 142, 121, 224, 112, 76, 118, 152, 148, 60, 156, 220, 117, 160, 200, 171, 14, 249, 189, 196, 112, 243, 21, 83, 78, 83, 175, 26, 47, 76, 178, 191, 208, 206, 29, 75, 209, 72, 168, 186, 188, 236, 16, 5, 182, 136, 218, 117, 215, 197, 171, 3, 254, 72, 120, 48, 209, 253, 161, 241, 240, 179, 239, 196, 232, 123, 45, 185, 36, 120, 255, 67, 51, 157, 147, 91, 11, 218, 126, 113, 187, 237, 236, 125, 141, 203, 241, 48, 216, 154, 40, 206, 148, 227, 250, 218, 154, 136, 168, 76, 213, 197, 57, 10, 138, 160, 116, 31, 162, 32, 15, 209, 129, 15, 69, 132, 19, 117, 58, 236, 33, 72, 98, 194, 93, 98, 136, 160, 227, 208, 68, 80, 8, 232, 179, 192, 227, 158, 131, 8, 226, 89, 228, 108, 28, 92, 18, 229, 139, 116, 167, 35, 42, 169, 62, 122, 186, 29, 126, 110, 90, 207, 197, 84, 51, 69, 234, 31, 170, 151, 38, 46, 202, 236, 41, 156, 19, 72, 232, 176, 181, 19, 30, 196, 242, 142, 169, 52, 18, 37, 162, 134, 172, 6, 58, 155, 222, 102, 239, 189, 245, 86, 178, 174, 192, 48, 20, 164, 190, 228, 90, 182, 116, 223, 24, 246, 108, 242, 200, 84, 215, 69, 171, 206, 66, 121, 147, 231, 23, 46, 215, 243, 11, 121, 177, 5, 107, 205, 204, 3, 164, 155, 68, 250, 144, 111, 135, 85, 51, 113, 80, 40, 42, 150, 24, 152, 5, 72, 85, 18, 174, 108, 67, 193, 205, 156, 116, 91, 101, 132, 217, 94, 45, 115, 72, 184, 158, 122, 246, 250, 235, 192, 64, 5, 144, 182, 73, 125, 32, 64, 177, 217, 159, 51, 172, 72, 76, 112, 48, 70, 194, 76, 198, 191, 203, 9, 174, 78, 242, 90, 143, 105, 203, 115, 51, 167, 115, 172, 115, 90, 48, 55, 144, 179, 131, 3, 53, 59, 201, 11, 37, 138, 98, 177, 72, 158, 124, 125, 252, 154, 234, 85, 61, 249, 134, 231, 83, 160, 222, 140, 241, 88, 192, 114, 129, 170, 33, 21, 47, 98, 239, 37, 235, 222, 238, 233, 33, 86, 244, 244, 188, 149, 172, 19, 78, 214, 246, 63, 40, 206, 90, 249, 221, 119, 223, 125, 183, 114, 255, 131, 196, 11, 226, 1, 130, 76, 181, 46, 114, 3, 148, 64, 215, 147, 197, 110, 150, 186, 198, 133, 236, 115, 114, 200, 37, 171, 40, 70, 81, 107, 170, 57, 243, 65, 96, 244, 198, 204, 0, 80, 2, 37, 68, 238, 212, 173, 219, 88, 114, 32, 148, 78, 250, 245, 61, 61, 189, 52, 211, 194, 34, 188, 238, 159, 213, 174, 177, 19, 61, 85, 99, 246, 103, 222, 187, 174, 10, 86, 233, 43, 110, 160, 92, 52, 151, 83, 118, 117, 129, 146, 239, 102, 78, 166, 56, 23, 15, 104, 220, 153, 103, 222, 60, 135, 100, 129, 206, 232, 223, 153, 103, 245, 239, 228, 27, 160, 42, 175, 170, 178, 188, 148, 184, 224, 45, 76, 109, 131, 130, 204, 254, 157, 89, 210, 251, 205, 94, 158, 183, 102, 73, 233, 79, 245, 246, 180, 37, 240, 179, 3, 143, 0, 176, 99, 164, 61, 118, 121, 10, 129, 224, 84, 152, 4, 130, 180, 24, 100, 153, 205, 165, 226, 47, 163, 150, 200, 97, 70, 134, 170, 104, 56, 161, 44, 49, 185, 203, 6, 108, 66, 240, 192, 80, 25, 229, 182, 115, 105, 242, 171, 133, 5, 249, 121, 46, 69, 47, 73, 115, 153, 37, 105, 89, 214, 191, 190, 87, 155, 158, 101, 233, 188, 62, 76, 176, 215, 29, 48, 160, 193, 41, 239, 27, 62, 115, 35, 35, 63, 196, 45, 168, 172, 109, 84, 45, 143, 92, 149, 5, 147, 193, 131, 131, 251, 226, 210, 122, 167, 134, 2, 38, 173, 239, 99, 171, 45, 210, 146, 139, 28, 216, 129, 103, 1, 216, 4, 233, 33, 179, 86, 50, 60, 216, 60, 127, 125, 233, 89, 134, 250, 15, 54, 236, 127, 144, 130, 196, 120, 40, 176, 190, 87, 59, 141, 173, 230, 68, 43, 238, 233, 177, 100, 152, 116, 119, 177, 217, 91, 187, 54, 117, 169, 97, 138, 179, 43, 119, 223, 217, 154, 116, 64, 147, 106, 139, 50, 58, 207, 230, 244, 95, 219, 169, 102, 179, 202, 45, 73, 181, 89, 223, 38, 25, 83, 174, 107, 83, 62, 121, 29, 101, 33, 108, 208, 15, 230, 114, 18, 200, 84, 217, 95, 70, 93, 162, 145, 24, 12, 2, 144, 4, 119, 25, 176, 233, 16, 68, 242, 50, 145, 134, 9, 117, 155, 112, 105, 191, 35, 231, 67, 78, 124, 125, 208, 69, 158, 203, 233, 48, 43, 85, 173, 50, 75, 27, 134, 36, 203, 54, 228, 136, 29, 242, 20, 19, 49, 132, 149, 218, 26, 70, 122, 188, 20, 110, 30, 232, 195, 140, 12, 224, 71, 78, 239, 101, 53, 183, 197, 148, 133, 57, 222, 48, 10, 81, 12, 81, 55, 96, 109, 0, 185, 61, 150, 54, 198, 165, 123, 44, 13, 214, 89, 222, 74, 147, 109, 118, 103, 37, 247, 85, 226, 195, 210, 46, 158, 171, 190, 38, 81, 40, 35, 48, 133, 22, 209, 74, 179, 248, 140, 124, 188, 180, 220, 7, 65, 224, 9, 200, 99, 211, 28, 148, 102, 103, 62, 162, 24, 46, 204, 6, 78, 110, 176, 32, 207, 7, 17, 68, 201, 242, 98, 26, 79, 233, 68, 110, 79, 20, 168, 42, 229, 165, 123, 41, 224, 78, 25, 71, 161, 116, 191, 165, 26, 171, 163, 100, 230, 14, 19, 159, 83, 122, 223, 124, 207, 194, 69, 108, 233, 125, 239, 61, 179, 47, 246, 54, 229, 200, 255, 91, 250, 198, 234, 211, 172, 93, 244, 158, 73, 112, 249, 44, 90, 154, 62, 3, 31, 59, 248, 188, 153, 195, 245, 149, 209, 164, 174, 241, 109, 204, 148, 230, 212, 143, 36, 191, 9, 151, 109, 236, 22, 73, 237, 250, 94, 18, 78, 194, 81, 219, 41, 109, 54, 226, 202, 75, 19, 174, 124, 148, 165, 60, 116, 202, 166, 153, 20, 2, 25, 156, 178, 115, 33, 207, 189, 102, 29, 14, 76, 225, 107, 169, 139, 42, 7, 50, 122, 110, 72, 27, 129, 15, 212, 20, 101, 124, 192, 135, 167, 32, 167, 166, 80, 104, 254, 214, 232, 56, 253, 172, 122, 66, 97, 3, 245, 218, 205, 55, 223, 117, 230, 87, 248, 162, 86, 249, 128, 73, 130, 191, 108, 107, 187, 116, 187, 184, 124, 195, 190, 255, 104, 36, 36, 32, 194, 122, 109, 182, 208, 192, 237, 35, 202, 136, 47, 129, 213, 28, 15, 204, 67, 135, 133, 71, 212, 45, 185, 108, 85, 205, 84, 87, 72, 5, 16, 136, 99, 237, 247, 150, 96, 34, 34, 34, 227, 160, 164, 151, 83, 80, 205, 105, 183, 155, 68, 181, 94, 197, 85, 82, 228, 247, 121, 10, 6, 212, 114, 229, 25, 52, 22, 12, 203, 9, 13, 204, 98, 170, 39, 20, 94, 223, 219, 219, 139, 74, 175, 54, 42, 126, 212, 204, 209, 189, 218, 11, 167, 18, 31, 36, 161, 183, 183, 183, 247, 135, 165, 107, 189, 93, 155, 233, 103, 109, 54, 233, 65, 24, 169, 237, 20, 254, 163, 220, 0, 5, 80, 4, 75, 18, 5, 126, 238, 251, 7, 180, 207, 152, 173, 180, 242, 184, 24, 165, 70, 211, 247, 47, 4, 83, 48, 73, 171, 213, 164, 1, 80, 122, 11, 195, 69, 3, 102, 207, 38, 10, 11, 11, 11, 139, 10, 139, 84, 111, 88, 245, 133, 41, 63, 165, 56, 157, 29, 166, 1, 62, 146, 248, 177, 15, 223, 208, 42, 31, 88, 110, 24, 174, 118, 126, 40, 23, 218, 108, 240, 3, 15, 107, 179, 217, 153, 242, 20, 200, 3, 63, 116, 38, 10, 188, 40, 210, 118, 64, 114, 196, 155, 222, 239, 42, 89, 98, 162, 64, 206, 96, 189, 154, 67, 159, 208, 14, 192, 230, 153, 51, 45, 202, 121, 69, 7, 129, 81, 41, 111, 198, 227, 246, 68, 65, 126, 126, 190, 63, 223, 175, 122, 195, 190, 48, 109, 116, 193, 78, 108, 211, 220, 62, 145, 136, 253, 138, 246, 155, 83, 135, 127, 245, 193, 87, 61, 125, 66, 159, 118, 94, 138, 212, 242, 102, 219, 154, 97, 135, 54, 93, 138, 74, 125, 224, 130, 18, 184, 35, 161, 58, 101, 65, 96, 224, 205, 99, 162, 228, 70, 153, 119, 152, 165, 155, 119, 21, 72, 76, 20, 165, 101, 84, 67, 193, 71, 16, 241, 11, 159, 40, 202, 243, 64, 150, 39, 203, 41, 69, 45, 49, 145, 73, 226, 170, 156, 112, 233, 32, 172, 131, 131, 52, 219, 65, 40, 255, 90, 23, 181, 194, 124, 112, 129, 203, 235, 85, 29, 70, 51, 73, 42, 97, 9, 161, 234, 51, 26, 45, 234, 151, 231, 29, 189, 172, 243, 143, 201, 135, 217, 179, 218, 119, 201, 174, 249, 230, 109, 89, 234, 75, 214, 179, 157, 201, 150, 254, 125, 236, 159, 73, 175, 118, 170, 237, 142, 76, 181, 45, 211, 133, 127, 202, 13, 28, 231, 7, 12, 156, 113, 0, 206, 17, 3, 103, 26, 31, 79, 247, 226, 241, 185, 241, 174, 49, 145, 26, 20, 54, 39, 216, 143, 193, 63, 146, 137, 255, 250, 94, 60, 235, 57, 109, 19, 190, 248, 149, 54, 55, 106, 162, 47, 55, 244, 139, 172, 48, 121, 32, 249, 18, 59, 44, 249, 199, 52, 244, 117, 93, 42, 252, 83, 108, 1, 23, 28, 177, 157, 35, 111, 85, 38, 20, 16, 87, 198, 183, 137, 86, 111, 120, 251, 7, 116, 210, 240, 1, 137, 250, 170, 164, 244, 85, 173, 239, 197, 85, 207, 105, 55, 242, 117, 136, 45, 201, 127, 167, 190, 222, 236, 81, 47, 182, 41, 55, 128, 4, 78, 104, 55, 115, 208, 83, 125, 78, 193, 232, 229, 202, 230, 88, 109, 80, 211, 31, 235, 157, 104, 245, 199, 124, 33, 62, 26, 154, 39, 59, 101, 167, 62, 38, 79, 85, 169, 21, 42, 173, 135, 207, 219, 192, 77, 111, 179, 119, 251, 23, 24, 131, 172, 222, 49, 70, 20, 0, 131, 227, 14, 124, 201, 106, 148, 55, 249, 100, 141, 145, 137, 225, 50, 109, 208, 86, 179, 136, 138, 241, 124, 182, 113, 214, 241, 60, 9, 167, 121, 60, 124, 50, 70, 32, 236, 151, 157, 101, 13, 232, 175, 100, 220, 70, 24, 206, 226, 106, 44, 74, 181, 84, 29, 254, 195, 38, 14, 45, 170, 157, 120, 193, 9, 195, 122, 181, 175, 232, 11, 113, 250, 216, 25, 177, 18, 188, 65, 30, 118, 194, 197, 109, 226, 129, 249, 86, 222, 238, 38, 109, 186, 216, 38, 245, 129, 4, 62, 216, 152, 112, 231, 43, 76, 224, 135, 58, 51, 36, 46, 12, 34, 19, 4, 113, 89, 138, 24, 50, 17, 131, 12, 1, 105, 158, 229, 138, 33, 210, 69, 8, 146, 137, 194, 170, 193, 64, 19, 17, 157, 108, 38, 168, 9, 213, 108, 135, 210, 45, 35, 159, 90, 88, 224, 114, 12, 160, 99, 154, 160, 17, 69, 147, 231, 167, 54, 216, 190, 119, 54, 14, 220, 87, 204, 45, 55, 64, 62, 84, 192, 153, 137, 66, 63, 138, 130, 130, 44, 165, 0, 9, 199, 16, 208, 180, 102, 92, 102, 118, 51, 48, 167, 128, 218, 85, 96, 152, 96, 68, 132, 85, 185, 129, 218, 19, 206, 64, 56, 16, 246, 135, 169, 206, 44, 125, 67, 248, 210, 120, 244, 144, 185, 224, 11, 236, 124, 178, 214, 45, 64, 26, 175, 32, 37, 171, 210, 107, 32, 65, 33, 76, 77, 56, 221, 78, 69, 22, 209, 218, 35, 94, 193, 108, 60, 204, 230, 136, 166, 176, 210, 135, 166, 136, 138, 166, 136, 230, 3, 228, 231, 233, 68, 149, 108, 68, 53, 83, 228, 136, 160, 218, 241, 173, 173, 189, 156, 150, 162, 144, 150, 255, 182, 254, 192, 151, 236, 30, 158, 3, 88, 10, 245, 137, 168, 36, 240, 108, 54, 15, 50, 100, 173, 180, 128, 113, 150, 231, 109, 18, 155, 22, 8, 23, 23, 137, 36, 158, 86, 250, 93, 186, 160, 226, 174, 214, 214, 94, 237, 215, 54, 10, 164, 178, 237, 250, 255, 148, 65, 1, 19, 127, 146, 83, 39, 204, 52, 110, 18, 32, 200, 40, 162, 62, 51, 42, 115, 167, 150, 145, 173, 213, 157, 130, 56, 228, 125, 26, 143, 5, 56, 17, 214, 235, 36, 96, 87, 234, 126, 97, 194, 125, 59, 159, 97, 239, 131, 198, 196, 8, 23, 111, 44, 3, 32, 33, 9, 83, 167, 153, 230, 109, 246, 116, 6, 240, 121, 243, 169, 242, 201, 188, 35, 34, 153, 186, 233, 248, 255, 251, 21, 237, 23, 54, 236, 217, 234, 222, 94, 237, 247, 25, 120, 35, 249, 157, 196, 86, 222, 19, 121, 106, 34, 223, 229, 116, 40, 52, 217, 211, 145, 226, 188, 154, 194, 93, 48, 113, 87, 83, 170, 73, 48, 85, 147, 91, 31, 34, 27, 8, 136, 156, 235, 74, 188, 73, 141, 169, 77, 1, 69, 13, 5, 54, 245, 244, 188, 253, 221, 119, 236, 29, 246, 196, 244, 95, 28, 207, 22, 107, 142, 171, 127, 121, 117, 242, 66, 26, 227, 1, 8, 174, 3, 95, 178, 102, 185, 1, 138, 97, 104, 162, 142, 124, 35, 170, 167, 176, 192, 1, 140, 148, 20, 107, 205, 212, 76, 165, 97, 191, 64, 51, 106, 237, 152, 90, 95, 133, 221, 41, 116, 159, 124, 178, 119, 251, 246, 52, 165, 132, 155, 230, 109, 94, 192, 30, 75, 62, 123, 218, 125, 115, 109, 126, 79, 39, 228, 195, 105, 233, 243, 67, 74, 117, 115, 10, 169, 95, 56, 242, 126, 225, 178, 117, 54, 100, 62, 99, 29, 178, 117, 76, 248, 249, 188, 144, 124, 87, 126, 158, 57, 39, 196, 145, 54, 39, 4, 125, 49, 52, 231, 130, 160, 139, 180, 102, 218, 36, 16, 163, 108, 195, 244, 107, 90, 181, 228, 105, 253, 99, 120, 45, 57, 72, 114, 74, 79, 152, 141, 99, 20, 211, 161, 153, 181, 150, 156, 223, 20, 82, 126, 77, 27, 112, 102, 45, 121, 46, 200, 131, 2, 53, 179, 108, 181, 228, 228, 183, 115, 102, 107, 38, 227, 227, 45, 150, 44, 79, 93, 11, 209, 35, 179, 91, 140, 81, 73, 110, 156, 35, 82, 72, 234, 3, 39, 20, 195, 250, 132, 135, 51, 76, 117, 51, 202, 234, 254, 191, 104, 178, 21, 7, 124, 222, 130, 188, 172, 220, 78, 87, 208, 38, 223, 183, 164, 206, 146, 20, 235, 83, 170, 217, 240, 107, 107, 211, 133, 47, 196, 89, 224, 129, 74, 248, 56, 225, 225, 2, 80, 226, 101, 146, 172, 162, 34, 153, 184, 214, 0, 37, 172, 203, 203, 108, 34, 192, 189, 213, 124, 60, 181, 50, 15, 20, 101, 50, 119, 38, 144, 103, 37, 74, 176, 76, 150, 86, 29, 12, 216, 24, 121, 102, 0, 167, 51, 57, 203, 11, 131, 195, 54, 219, 97, 169, 61, 163, 15, 128, 178, 186, 3, 190, 193, 5, 35, 141, 112, 118, 17, 49, 105, 151, 33, 38, 54, 242, 217, 247, 181, 10, 11, 19, 238, 66, 20, 37, 46, 43, 230, 37, 177, 204, 158, 250, 110, 231, 63, 237, 238, 1, 15, 77, 206, 51, 186, 224, 242, 237, 173, 186, 212, 130, 212, 6, 119, 166, 111, 112, 35, 171, 198, 100, 54, 251, 139, 113, 125, 178, 51, 219, 74, 30, 178, 239, 115, 163, 63, 130, 36, 91, 241, 139, 128, 177, 45, 65, 150, 96, 21, 5, 39, 228, 180, 221, 110, 133, 47, 140, 254, 8, 102, 27, 13, 19, 50, 27, 235, 172, 237, 30, 33, 40, 179, 77, 70, 46, 208, 131, 67, 53, 179, 236, 253, 17, 108, 145, 145, 116, 206, 154, 101, 2, 54, 158, 178, 163, 12, 18, 101, 240, 84, 158, 98, 166, 24, 209, 244, 96, 0, 241, 40, 185, 1, 60, 80, 6, 27, 117, 95, 97, 65, 145, 223, 171, 138, 96, 198, 123, 130, 198, 7, 104, 126, 96, 84, 65, 150, 100, 106, 73, 57, 157, 18, 229, 217, 148, 163, 5, 51, 200, 99, 142, 123, 162, 16, 160, 164, 216, 68, 89, 201, 138, 178, 101, 157, 244, 167, 144, 214, 18, 173, 173, 189, 25, 8, 167, 140, 19, 210, 1, 8, 155, 180, 211, 184, 190, 243, 194, 169, 9, 143, 42, 48, 17, 10, 221, 76, 160, 122, 48, 171, 196, 168, 216, 156, 161, 192, 91, 60, 164, 250, 131, 6, 245, 39, 98, 135, 209, 211, 63, 245, 68, 111, 233, 239, 5, 111, 36, 172, 119, 72, 48, 100, 215, 234, 228, 31, 71, 149, 11, 175, 182, 227, 152, 99, 39, 204, 188, 161, 255, 31, 186, 228, 254, 176, 75, 123, 42, 176, 62, 248, 94, 161, 120, 215, 254, 107, 141, 251, 148, 118, 26, 215, 81, 37, 176, 46, 81, 88, 44, 48, 73, 204, 71, 65, 202, 51, 150, 71, 44, 42, 231, 101, 159, 6, 217, 201, 143, 148, 182, 70, 83, 19, 211, 83, 16, 69, 147, 182, 3, 224, 178, 130, 164, 35, 197, 187, 11, 66, 9, 148, 212, 68, 34, 196, 134, 96, 138, 13, 22, 98, 6, 59, 116, 201, 35, 236, 90, 142, 88, 71, 172, 48, 184, 112, 155, 142, 159, 32, 24, 17, 34, 193, 152, 223, 3, 198, 252, 158, 78, 115, 185, 162, 195, 168, 216, 50, 196, 97, 192, 24, 174, 202, 20, 136, 49, 183, 39, 179, 133, 215, 127, 51, 171, 74, 136, 135, 114, 207, 234, 153, 205, 86, 31, 194, 168, 158, 111, 122, 122, 82, 125, 174, 30, 50, 250, 58, 157, 169, 71, 49, 194, 142, 52, 15, 172, 65, 233, 180, 133, 7, 179, 32, 255, 191, 222, 158, 140, 240, 202, 214, 195, 41, 198, 86, 15, 210, 194, 105, 131, 209, 111, 192, 62, 115, 43, 2, 231, 26, 94, 64, 25, 201, 68, 3, 9, 168, 255, 86, 87, 206, 25, 75, 145, 12, 56, 157, 91, 139, 51, 58, 84, 5, 9, 31, 51, 243, 203, 206, 45, 215, 96, 220, 178, 133, 11, 114, 114, 173, 203, 22, 68, 56, 56, 251, 148, 98, 51, 196, 144, 209, 147, 173, 10, 238, 211, 85, 93, 157, 81, 207, 100, 132, 80, 115, 247, 230, 50, 236, 67, 19, 113, 227, 181, 28, 192, 185, 200, 153, 1, 215, 110, 80, 138, 142, 247, 146, 140, 102, 123, 57, 123, 183, 217, 168, 148, 77, 6, 142, 176, 17, 40, 183, 48, 72, 175, 153, 132, 17, 51, 102, 204, 253, 63, 55, 115, 203, 23, 195, 216, 96, 51, 231, 242, 255, 189, 67, 219, 241, 239, 175, 118, 104, 191, 57, 184, 60, 232, 99, 216, 232, 143, 209, 171, 109, 174, 60, 197, 222, 171, 173, 62, 179, 87, 219, 184, 255, 107, 189, 218, 124, 6, 222, 217, 120, 92, 103, 161, 60, 200, 118, 191, 198, 66, 85, 207, 207, 83, 94, 149, 246, 64, 17, 132, 97, 77, 194, 229, 70, 7, 67, 84, 156, 166, 121, 215, 8, 34, 72, 50, 105, 95, 135, 195, 224, 51, 164, 2, 180, 178, 172, 116, 184, 80, 81, 120, 245, 88, 42, 69, 143, 122, 116, 59, 4, 222, 250, 192, 76, 28, 55, 126, 137, 249, 66, 179, 245, 2, 167, 133, 31, 32, 76, 105, 79, 124, 50, 182, 74, 53, 130, 110, 103, 133, 222, 18, 137, 48, 15, 53, 22, 199, 83, 25, 229, 200, 207, 30, 146, 128, 88, 181, 216, 181, 241, 37, 237, 13, 237, 26, 188, 4, 91, 158, 190, 67, 104, 76, 222, 141, 73, 77, 96, 215, 247, 223, 123, 239, 227, 31, 253, 176, 239, 83, 156, 49, 174, 3, 31, 194, 211, 113, 1, 110, 58, 125, 172, 145, 110, 206, 198, 226, 172, 127, 188, 195, 57, 255, 190, 70, 157, 92, 103, 29, 248, 82, 241, 139, 179, 160, 12, 194, 80, 7, 19, 18, 227, 84, 55, 99, 152, 135, 2, 99, 173, 224, 0, 100, 14, 236, 164, 252, 77, 189, 255, 194, 196, 54, 114, 128, 233, 89, 123, 147, 132, 105, 117, 81, 234, 241, 17, 137, 132, 189, 188, 249, 2, 82, 175, 34, 53, 84, 84, 100, 68, 206, 106, 235, 228, 144, 193, 68, 212, 187, 166, 86, 99, 136, 146, 254, 63, 252, 233, 119, 127, 121, 43, 217, 228, 121, 230, 197, 123, 54, 60, 247, 216, 61, 175, 158, 186, 90, 211, 254, 165, 253, 25, 131, 95, 239, 79, 190, 134, 19, 177, 76, 251, 66, 251, 141, 48, 20, 255, 253, 249, 127, 132, 53, 125, 218, 23, 90, 239, 239, 183, 31, 181, 177, 229, 228, 37, 175, 114, 206, 202, 56, 50, 249, 7, 109, 38, 110, 194, 123, 181, 87, 112, 28, 215, 99, 100, 171, 175, 231, 53, 0, 126, 40, 129, 11, 182, 201, 200, 172, 169, 93, 81, 179, 111, 54, 229, 21, 233, 205, 98, 168, 2, 210, 40, 2, 112, 88, 29, 99, 172, 33, 182, 4, 167, 143, 125, 91, 100, 194, 59, 172, 118, 49, 37, 129, 128, 217, 202, 202, 79, 23, 54, 10, 80, 249, 67, 188, 97, 12, 205, 104, 242, 72, 24, 194, 144, 170, 82, 84, 129, 230, 53, 145, 200, 110, 98, 139, 177, 79, 123, 7, 255, 169, 105, 218, 169, 218, 85, 127, 127, 247, 213, 123, 158, 213, 254, 167, 135, 174, 169, 82, 159, 118, 163, 182, 115, 101, 242, 88, 182, 44, 185, 70, 40, 236, 185, 103, 229, 153, 24, 49, 124, 173, 235, 1, 216, 219, 242, 20, 200, 7, 21, 118, 235, 154, 57, 79, 65, 144, 242, 28, 20, 154, 34, 75, 149, 126, 68, 243, 71, 195, 78, 173, 86, 80, 18, 65, 148, 192, 42, 232, 79, 89, 78, 122, 153, 167, 89, 249, 159, 222, 173, 129, 32, 204, 196, 190, 137, 54, 88, 91, 39, 4, 76, 153, 239, 3, 0, 15, 6, 67, 35, 93, 41, 207, 175, 160, 0, 160, 64, 45, 80, 61, 133, 228, 227, 137, 132, 205, 171, 110, 128, 114, 61, 2, 102, 214, 19, 174, 239, 61, 229, 193, 226, 112, 172, 122, 217, 242, 121, 230, 109, 94, 122, 74, 253, 48, 57, 202, 186, 200, 11, 176, 73, 155, 46, 173, 231, 177, 72, 63, 132, 97, 7, 69, 144, 153, 88, 94, 196, 100, 37, 128, 14, 217, 188, 220, 54, 128, 130, 148, 245, 56, 144, 243, 188, 5, 80, 122, 64, 207, 200, 254, 166, 87, 80, 145, 87, 29, 210, 59, 137, 161, 22, 184, 46, 52, 102, 7, 170, 78, 14, 156, 30, 11, 180, 210, 191, 75, 139, 15, 73, 122, 210, 111, 254, 217, 229, 40, 229, 7, 24, 32, 75, 63, 28, 110, 187, 207, 174, 215, 166, 179, 13, 226, 44, 200, 135, 32, 104, 186, 176, 4, 116, 225, 9, 20, 50, 81, 42, 224, 193, 27, 5, 97, 106, 48, 17, 72, 9, 150, 245, 76, 108, 109, 183, 34, 59, 2, 143, 236, 164, 68, 75, 33, 41, 72, 115, 123, 232, 191, 191, 6, 36, 129, 242, 62, 87, 29, 4, 150, 168, 94, 111, 193, 166, 75, 207, 64, 248, 65, 65, 51, 92, 43, 220, 183, 18, 44, 45, 46, 242, 122, 114, 11, 92, 134, 155, 192, 20, 189, 151, 237, 94, 2, 93, 252, 210, 253, 43, 233, 122, 167, 28, 46, 75, 184, 202, 40, 23, 6, 153, 104, 158, 34, 181, 86, 253, 13, 165, 4, 230, 144, 39, 210, 62, 53, 105, 128, 25, 234, 39, 213, 173, 170, 140, 171, 159, 242, 64, 121, 105, 186, 2, 114, 231, 18, 33, 171, 160, 62, 171, 240, 176, 14, 203, 175, 48, 64, 120, 172, 146, 122, 106, 207, 14, 192, 126, 205, 117, 81, 0, 254, 174, 243, 214, 227, 67, 81, 162, 130, 114, 93, 78, 132, 214, 160, 245, 145, 33, 58, 130, 169, 147, 72, 55, 16, 66, 171, 28, 86, 103, 132, 52, 105, 176, 148, 82, 212, 108, 161, 96, 2, 102, 17, 5, 75, 43, 165, 250, 19, 160, 216, 157, 3, 242, 160, 64, 134, 94, 42, 226, 122, 41, 80, 16, 240, 166, 52, 147, 115, 128, 160, 152, 180, 188, 195, 210, 78, 120, 186, 205, 233, 160, 139, 72, 154, 191, 97, 249, 129, 15, 196, 15, 148, 27, 160, 10, 34, 20, 211, 41, 68, 134, 30, 36, 114, 162, 0, 7, 233, 5, 100, 228, 251, 30, 33, 100, 233, 5, 4, 212, 25, 195, 0, 224, 115, 25, 11, 170, 171, 171, 35, 213, 17, 239, 208, 72, 61, 143, 107, 55, 197, 197, 80, 117, 124, 84, 109, 93, 93, 141, 234, 9, 241, 245, 87, 7, 252, 69, 197, 197, 69, 69, 1, 191, 24, 169, 246, 249, 98, 184, 28, 43, 112, 36, 78, 88, 241, 220, 107, 218, 165, 172, 252, 155, 127, 125, 255, 189, 246, 220, 197, 247, 254, 254, 175, 191, 214, 118, 255, 160, 253, 167, 157, 244, 238, 168, 63, 92, 255, 175, 197, 75, 38, 76, 90, 133, 229, 184, 238, 136, 23, 230, 239, 106, 95, 60, 122, 114, 235, 75, 247, 189, 242, 133, 124, 158, 161, 135, 169, 7, 200, 151, 194, 245, 188, 191, 89, 53, 245, 6, 42, 64, 134, 133, 118, 28, 7, 239, 13, 148, 194, 34, 103, 111, 160, 52, 68, 43, 42, 42, 170, 43, 170, 189, 245, 145, 58, 3, 81, 107, 108, 160, 129, 103, 152, 70, 10, 138, 124, 142, 9, 97, 121, 254, 139, 15, 125, 113, 215, 214, 36, 222, 179, 124, 243, 141, 247, 190, 246, 243, 141, 24, 253, 203, 235, 247, 157, 66, 200, 213, 110, 155, 179, 105, 81, 124, 220, 137, 239, 227, 200, 234, 77, 35, 127, 118, 250, 136, 216, 220, 47, 111, 223, 42, 149, 155, 246, 97, 138, 119, 117, 212, 219, 36, 138, 146, 152, 198, 63, 177, 53, 237, 116, 148, 217, 32, 12, 28, 208, 39, 104, 0, 7, 11, 171, 171, 171, 235, 170, 235, 84, 206, 66, 74, 169, 56, 24, 11, 205, 108, 133, 193, 217, 120, 174, 33, 159, 7, 227, 228, 235, 175, 103, 240, 178, 134, 250, 6, 133, 81, 18, 211, 248, 121, 8, 125, 131, 82, 72, 229, 238, 27, 148, 134, 120, 69, 69, 69, 77, 69, 141, 202, 89, 170, 35, 62, 8, 75, 77, 172, 179, 178, 117, 169, 129, 236, 96, 156, 37, 68, 17, 150, 107, 211, 197, 15, 164, 61, 112, 56, 92, 145, 40, 78, 227, 106, 61, 202, 74, 29, 181, 14, 52, 184, 91, 15, 34, 40, 178, 168, 116, 101, 112, 150, 154, 102, 28, 145, 158, 181, 95, 107, 48, 182, 203, 122, 197, 4, 156, 100, 7, 108, 79, 228, 169, 52, 117, 167, 44, 82, 31, 118, 58, 171, 14, 198, 232, 129, 67, 121, 6, 227, 120, 93, 106, 52, 79, 235, 231, 185, 153, 126, 180, 53, 162, 231, 231, 249, 68, 143, 243, 181, 233, 194, 245, 226, 44, 24, 14, 211, 18, 199, 166, 113, 188, 22, 101, 37, 202, 233, 145, 73, 136, 137, 109, 217, 241, 227, 200, 149, 70, 234, 12, 228, 114, 49, 83, 201, 54, 111, 104, 32, 91, 207, 159, 127, 143, 125, 220, 208, 77, 89, 121, 123, 242, 177, 238, 212, 208, 161, 91, 21, 128, 172, 251, 87, 148, 178, 239, 223, 108, 13, 121, 50, 247, 111, 26, 204, 255, 150, 253, 107, 30, 38, 131, 239, 95, 118, 236, 65, 55, 176, 242, 68, 234, 156, 30, 176, 127, 69, 41, 141, 155, 135, 208, 171, 40, 133, 84, 238, 94, 69, 255, 253, 254, 53, 177, 206, 186, 127, 89, 235, 160, 27, 88, 158, 109, 32, 138, 224, 215, 166, 139, 191, 231, 185, 177, 231, 241, 220, 88, 69, 69, 41, 149, 189, 103, 74, 42, 67, 0, 236, 0, 196, 49, 109, 78, 7, 213, 153, 216, 197, 148, 46, 124, 200, 139, 29, 168, 203, 141, 254, 2, 135, 106, 182, 67, 89, 153, 175, 36, 212, 102, 230, 107, 72, 13, 241, 91, 249, 192, 189, 201, 28, 90, 158, 248, 140, 150, 55, 162, 57, 37, 179, 29, 82, 195, 198, 141, 251, 222, 57, 219, 18, 208, 71, 72, 62, 17, 74, 181, 233, 172, 77, 26, 5, 117, 112, 124, 226, 184, 58, 116, 74, 1, 96, 224, 44, 64, 65, 204, 71, 38, 136, 173, 21, 229, 76, 158, 106, 20, 194, 80, 165, 159, 36, 119, 208, 85, 102, 124, 202, 129, 96, 247, 93, 215, 214, 168, 62, 213, 79, 171, 28, 73, 151, 113, 137, 86, 53, 112, 127, 81, 194, 100, 44, 222, 68, 5, 64, 122, 125, 36, 243, 142, 27, 229, 243, 218, 118, 216, 236, 27, 47, 191, 188, 247, 171, 175, 134, 29, 57, 36, 40, 251, 88, 106, 87, 109, 105, 249, 138, 93, 214, 243, 153, 239, 187, 158, 228, 230, 213, 39, 184, 129, 129, 31, 64, 252, 61, 239, 29, 85, 8, 11, 183, 229, 219, 58, 71, 85, 218, 141, 46, 27, 19, 44, 171, 172, 60, 205, 42, 51, 184, 176, 200, 180, 199, 18, 69, 14, 7, 128, 163, 208, 81, 152, 231, 118, 57, 109, 13, 123, 77, 186, 155, 53, 231, 38, 181, 191, 53, 196, 70, 39, 116, 170, 166, 158, 65, 233, 129, 47, 217, 155, 242, 109, 224, 135, 74, 104, 78, 140, 165, 180, 82, 151, 83, 102, 178, 31, 37, 202, 56, 145, 132, 86, 144, 153, 220, 77, 89, 243, 172, 131, 50, 16, 198, 83, 164, 209, 116, 207, 148, 68, 84, 159, 234, 13, 81, 207, 38, 137, 247, 168, 206, 32, 158, 96, 133, 100, 136, 140, 218, 55, 22, 229, 28, 173, 173, 189, 178, 15, 143, 180, 83, 76, 108, 181, 194, 50, 168, 211, 78, 234, 131, 66, 107, 42, 186, 132, 40, 235, 109, 135, 40, 1, 70, 232, 224, 105, 235, 14, 242, 48, 41, 60, 71, 68, 16, 145, 161, 192, 58, 83, 112, 244, 176, 89, 161, 214, 11, 0, 80, 8, 133, 36, 157, 156, 80, 21, 58, 161, 12, 98, 17, 193, 152, 67, 123, 11, 71, 232, 196, 194, 17, 218, 91, 236, 183, 61, 248, 198, 198, 141, 218, 168, 158, 100, 92, 151, 197, 3, 95, 114, 89, 44, 135, 137, 137, 150, 98, 116, 64, 9, 82, 175, 37, 218, 15, 83, 245, 102, 80, 14, 132, 78, 202, 74, 17, 58, 200, 251, 51, 158, 250, 34, 241, 22, 180, 147, 156, 211, 130, 165, 148, 21, 238, 51, 155, 32, 249, 136, 74, 22, 165, 72, 212, 2, 126, 195, 39, 84, 173, 222, 182, 98, 5, 167, 210, 219, 111, 147, 132, 17, 209, 216, 152, 21, 123, 57, 149, 246, 174, 248, 203, 234, 19, 220, 215, 251, 240, 72, 251, 28, 246, 86, 8, 192, 16, 56, 211, 200, 20, 180, 141, 197, 112, 57, 153, 2, 146, 178, 56, 203, 32, 243, 58, 70, 20, 82, 244, 65, 230, 153, 240, 38, 36, 183, 186, 188, 69, 69, 69, 67, 138, 134, 68, 66, 106, 164, 134, 134, 178, 187, 157, 85, 185, 134, 178, 103, 41, 70, 25, 48, 215, 60, 153, 165, 52, 37, 215, 152, 115, 123, 141, 141, 209, 183, 221, 156, 243, 147, 214, 181, 189, 62, 235, 220, 30, 91, 243, 118, 119, 122, 243, 246, 97, 131, 192, 187, 7, 246, 112, 207, 54, 231, 39, 5, 60, 160, 149, 123, 246, 57, 63, 212, 199, 62, 63, 107, 31, 251, 44, 84, 75, 107, 235, 94, 144, 133, 98, 3, 218, 188, 167, 215, 245, 232, 114, 1, 188, 131, 205, 9, 102, 126, 21, 101, 2, 230, 24, 107, 95, 97, 141, 181, 183, 160, 210, 100, 192, 232, 245, 200, 103, 217, 43, 131, 204, 178, 15, 101, 153, 99, 239, 100, 171, 115, 240, 247, 232, 158, 30, 139, 175, 15, 241, 252, 155, 231, 211, 249, 90, 73, 141, 148, 164, 14, 200, 104, 197, 111, 27, 228, 96, 196, 211, 50, 160, 114, 77, 113, 168, 230, 29, 208, 83, 157, 248, 179, 204, 112, 200, 6, 146, 193, 225, 31, 49, 193, 33, 30, 10, 164, 177, 178, 140, 173, 206, 228, 92, 113, 79, 143, 125, 47, 83, 207, 213, 144, 57, 57, 172, 42, 21, 14, 2, 73, 233, 202, 194, 184, 176, 14, 97, 109, 102, 19, 52, 141, 123, 238, 176, 26, 242, 17, 235, 168, 254, 53, 199, 214, 181, 133, 135, 6, 110, 89, 91, 108, 40, 59, 43, 165, 51, 205, 184, 144, 193, 79, 158, 79, 85, 14, 7, 210, 249, 73, 109, 201, 201, 101, 225, 112, 240, 6, 113, 185, 230, 43, 144, 63, 164, 46, 59, 232, 192, 9, 11, 166, 87, 68, 6, 218, 255, 93, 250, 107, 185, 129, 137, 172, 209, 84, 119, 116, 122, 37, 23, 112, 110, 184, 76, 129, 104, 79, 4, 116, 15, 90, 192, 54, 231, 36, 251, 208, 10, 27, 157, 211, 55, 185, 141, 198, 25, 34, 98, 21, 60, 9, 134, 140, 252, 155, 203, 200, 97, 102, 21, 90, 132, 152, 46, 75, 74, 151, 19, 101, 7, 138, 32, 139, 217, 36, 165, 150, 81, 126, 167, 104, 200, 72, 58, 120, 154, 180, 20, 71, 194, 197, 69, 67, 234, 194, 135, 69, 14, 171, 8, 22, 133, 138, 67, 36, 57, 132, 76, 142, 77, 159, 99, 120, 239, 0, 41, 98, 71, 101, 76, 236, 205, 161, 21, 148, 44, 211, 122, 173, 185, 29, 242, 107, 92, 174, 234, 225, 241, 116, 201, 226, 252, 87, 100, 71, 151, 27, 21, 154, 223, 163, 72, 93, 217, 101, 203, 200, 185, 202, 14, 156, 69, 6, 6, 133, 205, 144, 131, 68, 69, 101, 133, 87, 5, 168, 9, 87, 212, 87, 214, 151, 20, 169, 229, 222, 114, 83, 26, 242, 178, 74, 67, 174, 193, 199, 54, 201, 208, 78, 206, 32, 91, 166, 2, 17, 114, 208, 43, 165, 79, 74, 161, 2, 230, 26, 69, 96, 50, 138, 78, 197, 33, 228, 58, 8, 170, 173, 131, 192, 14, 152, 38, 31, 69, 101, 101, 69, 69, 169, 17, 154, 252, 76, 112, 229, 62, 19, 140, 120, 228, 0, 113, 192, 201, 86, 52, 50, 135, 32, 12, 77, 69, 34, 13, 254, 139, 35, 141, 60, 205, 18, 120, 43, 157, 255, 33, 251, 56, 150, 113, 109, 217, 121, 95, 159, 57, 183, 229, 96, 138, 34, 199, 240, 150, 12, 17, 201, 1, 149, 169, 36, 126, 252, 140, 23, 131, 116, 105, 122, 98, 170, 69, 181, 1, 114, 96, 145, 11, 97, 179, 182, 70, 170, 150, 250, 32, 2, 23, 37, 92, 37, 40, 72, 165, 40, 10, 102, 93, 100, 208, 226, 59, 191, 231, 29, 97, 229, 191, 79, 16, 77, 196, 75, 64, 0, 81, 16, 23, 102, 64, 210, 141, 176, 132, 209, 173, 160, 219, 0, 48, 31, 241, 145, 208, 9, 103, 36, 50, 196, 23, 245, 240, 140, 249, 1, 210, 144, 209, 232, 172, 26, 84, 155, 76, 204, 254, 105, 187, 94, 56, 56, 223, 167, 253, 78, 251, 243, 0, 137, 152, 92, 52, 69, 111, 36, 63, 127, 42, 201, 6, 217, 227, 107, 180, 53, 194, 78, 177, 5, 42, 225, 109, 93, 6, 124, 92, 34, 42, 17, 88, 49, 74, 80, 130, 178, 196, 71, 112, 243, 79, 205, 79, 132, 214, 74, 20, 241, 216, 118, 253, 141, 114, 155, 156, 80, 129, 221, 36, 186, 217, 240, 163, 105, 130, 108, 10, 76, 181, 1, 147, 198, 246, 12, 56, 34, 89, 5, 72, 52, 13, 107, 97, 54, 112, 50, 34, 7, 60, 31, 32, 31, 174, 72, 68, 37, 97, 80, 156, 21, 3, 133, 33, 189, 122, 212, 46, 18, 39, 154, 37, 151, 25, 2, 145, 170, 36, 101, 80, 10, 160, 156, 175, 220, 192, 235, 198, 186, 18, 121, 84, 41, 235, 150, 5, 65, 0, 211, 31, 16, 180, 183, 101, 96, 200, 136, 237, 14, 209, 236, 59, 49, 224, 169, 0, 221, 252, 41, 191, 138, 22, 231, 83, 25, 2, 149, 140, 121, 10, 11, 32, 15, 242, 84, 175, 87, 117, 165, 70, 221, 96, 12, 35, 136, 102, 189, 27, 222, 162, 93, 249, 58, 142, 192, 83, 95, 199, 54, 60, 242, 33, 109, 206, 31, 180, 237, 218, 67, 169, 246, 19, 251, 151, 136, 55, 238, 191, 68, 188, 122, 223, 24, 241, 130, 253, 215, 89, 45, 40, 24, 184, 1, 164, 167, 141, 218, 183, 181, 153, 56, 208, 214, 174, 164, 5, 218, 210, 181, 57, 26, 227, 83, 104, 228, 194, 212, 6, 146, 251, 41, 97, 58, 56, 170, 132, 160, 47, 134, 17, 1, 77, 7, 44, 170, 218, 190, 23, 94, 222, 215, 187, 235, 243, 7, 181, 55, 95, 249, 179, 214, 63, 213, 192, 82, 108, 233, 255, 189, 208, 216, 255, 91, 33, 190, 255, 69, 225, 152, 254, 237, 169, 241, 84, 192, 160, 18, 64, 26, 171, 220, 0, 14, 240, 192, 252, 68, 94, 1, 213, 184, 136, 40, 164, 146, 106, 75, 121, 49, 145, 34, 177, 84, 82, 173, 108, 22, 11, 100, 62, 99, 208, 45, 155, 69, 3, 1, 167, 19, 192, 233, 113, 122, 242, 243, 220, 46, 112, 128, 195, 235, 117, 56, 75, 26, 66, 170, 206, 35, 171, 167, 24, 27, 153, 220, 243, 21, 110, 194, 151, 94, 74, 190, 174, 205, 53, 150, 204, 186, 132, 142, 228, 9, 108, 75, 255, 230, 228, 186, 244, 190, 32, 169, 185, 82, 133, 112, 42, 213, 137, 138, 2, 141, 79, 178, 180, 13, 21, 26, 230, 156, 46, 85, 98, 213, 34, 26, 184, 152, 143, 218, 19, 5, 14, 7, 249, 55, 84, 26, 156, 68, 119, 14, 67, 154, 34, 22, 117, 237, 131, 147, 154, 82, 148, 181, 141, 153, 226, 189, 174, 117, 122, 22, 130, 3, 242, 192, 3, 157, 219, 117, 98, 162, 73, 76, 9, 5, 167, 236, 72, 13, 112, 26, 195, 211, 236, 56, 49, 203, 169, 127, 32, 19, 129, 173, 178, 3, 41, 38, 61, 131, 249, 249, 68, 209, 124, 79, 190, 167, 176, 192, 153, 231, 180, 168, 234, 74, 163, 170, 49, 231, 41, 141, 170, 247, 209, 180, 104, 59, 69, 231, 153, 179, 158, 24, 44, 4, 16, 87, 26, 181, 18, 103, 110, 151, 145, 170, 65, 216, 177, 89, 179, 12, 12, 159, 17, 149, 170, 116, 88, 89, 6, 70, 28, 197, 12, 132, 1, 67, 88, 100, 65, 52, 11, 3, 75, 39, 188, 170, 151, 103, 24, 196, 169, 53, 115, 0, 245, 234, 182, 133, 66, 48, 89, 36, 252, 185, 255, 11, 33, 152, 156, 75, 161, 160, 95, 138, 171, 55, 110, 220, 127, 217, 47, 173, 58, 183, 169, 0, 194, 147, 188, 7, 95, 62, 172, 183, 173, 51, 123, 230, 4, 95, 234, 248, 244, 165, 230, 198, 41, 3, 240, 96, 48, 132, 213, 161, 161, 53, 149, 157, 157, 124, 76, 152, 152, 188, 137, 93, 156, 124, 146, 208, 234, 17, 138, 122, 122, 250, 255, 214, 99, 38, 77, 152, 244, 31, 201, 241, 234, 204, 160, 127, 21, 136, 138, 145, 149, 153, 131, 250, 21, 41, 8, 157, 246, 157, 63, 146, 246, 241, 88, 192, 78, 251, 59, 123, 45, 194, 243, 129, 8, 6, 221, 165, 93, 217, 229, 67, 177, 165, 150, 14, 164, 147, 41, 31, 25, 56, 140, 111, 251, 241, 107, 180, 19, 242, 243, 94, 139, 138, 197, 189, 189, 233, 50, 172, 194, 133, 137, 2, 170, 11, 192, 214, 244, 165, 102, 70, 157, 237, 212, 180, 156, 160, 169, 214, 249, 40, 166, 11, 115, 202, 17, 154, 89, 40, 224, 85, 189, 206, 180, 229, 154, 77, 70, 109, 100, 53, 7, 214, 155, 180, 181, 251, 67, 83, 114, 173, 194, 230, 129, 107, 207, 17, 131, 183, 19, 114, 240, 24, 124, 54, 200, 131, 2, 17, 170, 63, 6, 87, 27, 123, 102, 26, 184, 154, 60, 74, 5, 223, 17, 28, 132, 48, 183, 169, 103, 39, 92, 84, 5, 231, 70, 20, 82, 54, 129, 225, 58, 79, 216, 16, 148, 200, 254, 21, 167, 5, 115, 63, 109, 22, 141, 204, 119, 202, 212, 143, 168, 70, 230, 59, 57, 59, 71, 153, 105, 2, 1, 117, 237, 53, 215, 220, 117, 67, 201, 208, 160, 122, 185, 240, 96, 207, 234, 237, 191, 147, 110, 18, 63, 216, 223, 64, 191, 136, 106, 244, 69, 148, 246, 128, 15, 22, 38, 92, 52, 242, 221, 101, 95, 147, 130, 50, 200, 87, 139, 72, 206, 105, 153, 118, 151, 177, 138, 22, 18, 237, 144, 254, 148, 58, 11, 35, 19, 186, 168, 182, 31, 240, 36, 242, 92, 67, 7, 119, 102, 31, 199, 171, 8, 192, 7, 62, 213, 27, 9, 171, 42, 197, 82, 125, 228, 140, 109, 242, 134, 26, 139, 149, 218, 72, 88, 80, 98, 129, 136, 48, 242, 181, 205, 47, 7, 206, 198, 43, 207, 185, 176, 114, 241, 236, 119, 91, 234, 158, 255, 224, 53, 109, 167, 240, 77, 79, 23, 171, 41, 223, 88, 171, 189, 254, 114, 79, 127, 30, 221, 73, 124, 0, 82, 139, 49, 131, 205, 11, 231, 39, 92, 30, 148, 100, 26, 196, 102, 174, 55, 100, 149, 117, 128, 100, 230, 182, 232, 131, 179, 28, 86, 90, 75, 58, 12, 136, 96, 1, 218, 82, 234, 248, 124, 62, 175, 219, 91, 104, 159, 208, 151, 57, 131, 205, 96, 190, 47, 125, 254, 26, 158, 105, 72, 64, 250, 236, 53, 83, 10, 8, 143, 142, 3, 95, 138, 83, 141, 152, 216, 48, 72, 234, 46, 152, 162, 161, 232, 112, 102, 206, 96, 147, 90, 131, 153, 15, 20, 227, 129, 145, 201, 50, 28, 28, 78, 193, 73, 237, 86, 141, 249, 125, 110, 23, 115, 58, 199, 29, 100, 12, 219, 24, 251, 91, 74, 182, 183, 114, 206, 97, 139, 211, 23, 56, 156, 221, 96, 52, 93, 165, 41, 110, 93, 7, 125, 143, 50, 237, 162, 209, 232, 176, 232, 176, 33, 245, 222, 31, 63, 135, 205, 32, 181, 32, 15, 50, 131, 13, 143, 51, 8, 63, 216, 252, 53, 131, 13, 12, 198, 1, 176, 239, 140, 222, 112, 231, 165, 245, 104, 78, 229, 61, 49, 185, 123, 64, 151, 20, 167, 217, 53, 36, 108, 7, 203, 104, 49, 116, 40, 125, 153, 109, 173, 67, 204, 232, 145, 209, 51, 100, 162, 129, 135, 217, 48, 36, 165, 46, 129, 29, 184, 19, 128, 157, 194, 123, 11, 185, 225, 210, 109, 78, 212, 199, 239, 19, 79, 131, 192, 4, 154, 118, 178, 74, 38, 11, 218, 72, 85, 146, 140, 126, 42, 198, 69, 147, 30, 49, 161, 155, 242, 167, 186, 211, 158, 231, 120, 68, 189, 87, 218, 19, 126, 106, 22, 34, 186, 69, 183, 195, 108, 17, 98, 54, 15, 50, 87, 190, 190, 119, 191, 177, 104, 234, 73, 145, 210, 121, 233, 117, 12, 85, 112, 225, 182, 10, 91, 84, 46, 226, 180, 69, 229, 204, 228, 236, 22, 219, 232, 116, 61, 121, 61, 13, 202, 40, 101, 200, 28, 175, 110, 20, 158, 24, 141, 146, 15, 181, 152, 193, 146, 171, 92, 105, 235, 108, 146, 129, 214, 161, 84, 49, 24, 72, 167, 215, 48, 232, 115, 229, 117, 156, 137, 75, 181, 230, 97, 230, 52, 14, 51, 43, 39, 61, 13, 165, 96, 118, 226, 252, 239, 152, 43, 111, 34, 157, 45, 103, 157, 157, 101, 224, 59, 88, 81, 130, 129, 167, 221, 135, 94, 1, 167, 111, 11, 218, 56, 91, 97, 101, 39, 128, 216, 157, 197, 241, 149, 249, 156, 92, 28, 118, 175, 151, 57, 87, 137, 187, 187, 28, 89, 29, 28, 220, 221, 101, 160, 50, 192, 221, 37, 220, 97, 224, 145, 221, 217, 37, 157, 105, 225, 48, 96, 142, 179, 36, 167, 207, 113, 206, 168, 62, 52, 28, 10, 217, 230, 56, 103, 133, 116, 228, 114, 118, 89, 199, 144, 53, 206, 123, 32, 104, 46, 168, 1, 206, 140, 1, 117, 138, 57, 93, 226, 166, 201, 98, 247, 105, 152, 117, 138, 25, 46, 141, 212, 54, 6, 81, 231, 181, 99, 129, 225, 223, 12, 193, 10, 157, 209, 181, 224, 200, 67, 167, 232, 112, 118, 154, 133, 22, 57, 92, 157, 67, 82, 49, 175, 108, 111, 164, 113, 191, 178, 178, 146, 188, 158, 149, 161, 202, 80, 117, 85, 134, 239, 51, 127, 144, 120, 152, 49, 226, 55, 107, 92, 108, 248, 150, 15, 183, 36, 111, 201, 25, 28, 107, 161, 27, 33, 158, 67, 209, 33, 81, 143, 169, 40, 133, 134, 239, 179, 28, 250, 210, 189, 159, 67, 193, 89, 128, 46, 201, 233, 234, 204, 119, 228, 89, 222, 205, 49, 131, 68, 64, 135, 15, 250, 70, 150, 24, 232, 48, 235, 5, 5, 37, 254, 45, 41, 111, 103, 182, 40, 104, 180, 180, 84, 119, 119, 150, 150, 151, 150, 7, 203, 178, 57, 61, 11, 179, 58, 61, 205, 177, 200, 153, 161, 51, 26, 110, 157, 188, 111, 96, 252, 44, 223, 78, 39, 93, 38, 254, 99, 201, 196, 229, 102, 130, 26, 13, 106, 37, 38, 59, 187, 109, 60, 94, 148, 69, 42, 26, 108, 160, 118, 95, 184, 245, 78, 86, 185, 248, 255, 81, 247, 30, 224, 81, 85, 233, 255, 248, 251, 158, 115, 203, 148, 180, 201, 204, 100, 146, 76, 218, 100, 210, 27, 36, 147, 66, 13, 3, 4, 8, 33, 9, 69, 8, 132, 22, 58, 68, 20, 132, 136, 162, 32, 69, 13, 98, 197, 18, 27, 246, 2, 42, 130, 101, 5, 20, 119, 87, 108, 40, 22, 44, 236, 218, 203, 238, 90, 214, 93, 93, 191, 171, 174, 171, 43, 201, 220, 252, 159, 247, 204, 189, 51, 147, 6, 184, 255, 223, 239, 121, 190, 63, 124, 198, 153, 220, 123, 238, 185, 167, 188, 231, 125, 223, 243, 57, 111, 249, 47, 233, 162, 95, 92, 156, 232, 66, 187, 154, 246, 166, 39, 165, 140, 41, 193, 253, 170, 65, 27, 15, 134, 104, 227, 141, 158, 180, 81, 4, 102, 139, 185, 157, 230, 206, 210, 126, 186, 212, 49, 248, 20, 207, 244, 67, 31, 37, 17, 143, 68, 80, 200, 138, 255, 139, 20, 210, 27, 26, 39, 10, 209, 118, 246, 151, 35, 63, 72, 35, 179, 141, 179, 132, 222, 116, 146, 13, 87, 28, 240, 162, 201, 108, 200, 138, 252, 104, 67, 161, 148, 76, 68, 47, 81, 170, 117, 64, 122, 41, 138, 44, 27, 73, 48, 161, 135, 122, 16, 76, 134, 206, 72, 178, 211, 178, 51, 251, 146, 76, 204, 41, 73, 198, 80, 111, 250, 39, 155, 138, 147, 202, 152, 32, 225, 176, 235, 34, 120, 104, 79, 218, 201, 128, 247, 253, 214, 52, 52, 91, 122, 200, 155, 226, 88, 43, 179, 152, 45, 237, 96, 150, 205, 237, 49, 166, 232, 254, 41, 40, 170, 39, 5, 149, 158, 234, 161, 168, 190, 36, 52, 40, 242, 25, 21, 101, 241, 88, 152, 134, 162, 250, 161, 161, 220, 16, 13, 101, 36, 101, 164, 14, 64, 69, 113, 39, 163, 34, 99, 64, 251, 80, 146, 161, 103, 245, 75, 75, 236, 54, 99, 16, 251, 242, 156, 11, 130, 35, 144, 11, 145, 36, 116, 50, 150, 83, 0, 253, 18, 80, 255, 28, 39, 153, 200, 199, 147, 145, 158, 214, 139, 116, 162, 78, 73, 58, 220, 139, 190, 254, 201, 230, 79, 29, 218, 225, 47, 78, 197, 108, 250, 161, 151, 20, 120, 185, 39, 175, 41, 132, 208, 252, 157, 46, 171, 25, 116, 242, 71, 250, 225, 52, 197, 225, 39, 78, 143, 209, 120, 130, 36, 146, 226, 78, 78, 234, 143, 60, 98, 78, 70, 30, 52, 104, 125, 72, 227, 56, 141, 215, 128, 60, 38, 132, 11, 152, 198, 131, 27, 210, 160, 8, 206, 244, 71, 113, 84, 32, 205, 30, 197, 37, 217, 64, 6, 210, 245, 4, 216, 114, 200, 231, 101, 69, 184, 227, 98, 3, 20, 81, 66, 69, 93, 55, 21, 229, 184, 17, 131, 50, 206, 147, 158, 151, 147, 94, 228, 41, 74, 182, 217, 220, 34, 73, 54, 97, 156, 222, 4, 138, 236, 163, 42, 170, 192, 5, 188, 152, 147, 155, 83, 81, 94, 85, 41, 210, 101, 251, 42, 171, 194, 199, 204, 108, 217, 43, 95, 104, 95, 177, 210, 220, 177, 99, 227, 19, 88, 130, 72, 216, 254, 2, 75, 143, 183, 141, 25, 147, 91, 250, 91, 252, 248, 91, 187, 185, 50, 167, 64, 28, 58, 171, 197, 152, 164, 125, 21, 151, 59, 102, 254, 130, 49, 246, 232, 19, 103, 17, 140, 32, 223, 28, 101, 27, 59, 127, 254, 152, 188, 184, 78, 51, 187, 16, 19, 121, 78, 126, 248, 0, 90, 10, 98, 10, 234, 111, 5, 166, 80, 5, 99, 225, 21, 29, 85, 200, 67, 171, 169, 95, 84, 161, 231, 141, 94, 168, 66, 25, 152, 172, 166, 118, 176, 90, 172, 237, 81, 104, 1, 51, 183, 136, 36, 34, 138, 212, 99, 175, 223, 19, 89, 240, 143, 24, 224, 161, 211, 3, 8, 252, 9, 67, 135, 140, 26, 57, 100, 236, 208, 177, 165, 131, 66, 8, 65, 244, 233, 34, 4, 60, 52, 19, 162, 52, 77, 128, 61, 242, 136, 255, 100, 192, 193, 42, 18, 98, 52, 47, 237, 11, 231, 94, 58, 38, 183, 116, 130, 126, 244, 127, 18, 28, 225, 7, 18, 110, 98, 126, 46, 109, 23, 115, 162, 221, 22, 50, 7, 16, 244, 168, 190, 164, 238, 0, 6, 241, 144, 0, 215, 30, 32, 51, 73, 67, 218, 149, 9, 201, 29, 133, 22, 180, 136, 83, 93, 58, 227, 0, 0, 85, 6, 10, 183, 202, 12, 92, 86, 150, 149, 22, 107, 40, 150, 248, 224, 200, 135, 130, 121, 104, 87, 244, 121, 56, 244, 136, 8, 45, 158, 100, 183, 115, 110, 79, 176, 39, 56, 29, 60, 158, 199, 11, 220, 193, 102, 143, 139, 166, 152, 252, 30, 27, 162, 97, 136, 41, 62, 72, 132, 204, 102, 224, 189, 93, 41, 124, 47, 255, 56, 80, 194, 86, 5, 174, 103, 129, 174, 210, 64, 167, 118, 130, 103, 139, 241, 25, 202, 174, 239, 172, 8, 165, 137, 194, 59, 181, 69, 250, 217, 132, 160, 61, 101, 151, 56, 147, 42, 128, 65, 224, 195, 103, 39, 61, 150, 50, 101, 150, 223, 145, 139, 38, 150, 131, 170, 201, 21, 79, 54, 205, 118, 130, 193, 235, 221, 147, 30, 243, 132, 239, 89, 122, 223, 43, 238, 115, 143, 235, 247, 250, 185, 44, 73, 245, 205, 205, 205, 193, 151, 149, 198, 96, 116, 20, 139, 138, 102, 91, 44, 168, 82, 240, 54, 21, 90, 33, 42, 106, 88, 131, 21, 77, 38, 182, 144, 28, 190, 71, 139, 24, 213, 193, 84, 156, 99, 68, 232, 61, 106, 73, 101, 232, 65, 176, 168, 96, 153, 9, 167, 253, 52, 109, 231, 171, 194, 79, 171, 0, 156, 94, 106, 1, 142, 22, 190, 252, 148, 143, 83, 87, 203, 33, 42, 58, 170, 61, 6, 163, 89, 116, 123, 239, 10, 6, 126, 214, 95, 125, 154, 143, 65, 232, 41, 90, 107, 161, 10, 132, 13, 85, 242, 224, 193, 131, 125, 131, 125, 101, 165, 131, 138, 139, 10, 11, 242, 188, 94, 175, 215, 19, 159, 153, 233, 137, 165, 37, 167, 175, 42, 164, 64, 35, 78, 79, 188, 71, 248, 79, 103, 228, 42, 225, 165, 152, 163, 175, 69, 187, 39, 78, 120, 254, 32, 235, 158, 164, 157, 243, 234, 149, 232, 190, 246, 69, 252, 125, 32, 197, 141, 231, 223, 249, 232, 11, 191, 215, 222, 190, 121, 195, 147, 155, 111, 60, 192, 214, 150, 44, 218, 240, 216, 129, 163, 207, 253, 253, 118, 230, 232, 234, 134, 91, 137, 166, 254, 141, 73, 236, 147, 135, 249, 228, 205, 34, 243, 214, 152, 219, 71, 252, 181, 125, 225, 253, 219, 112, 205, 37, 173, 211, 23, 220, 55, 85, 171, 198, 18, 28, 109, 232, 221, 6, 142, 68, 136, 138, 23, 114, 225, 34, 191, 141, 114, 205, 120, 221, 36, 131, 228, 120, 100, 146, 225, 189, 214, 195, 107, 154, 76, 167, 9, 151, 174, 166, 1, 96, 11, 41, 92, 18, 27, 69, 167, 141, 158, 136, 82, 84, 128, 188, 27, 207, 12, 151, 161, 116, 142, 254, 196, 172, 44, 128, 172, 220, 172, 220, 156, 236, 244, 84, 151, 19, 236, 96, 207, 142, 207, 38, 24, 216, 85, 149, 19, 244, 133, 175, 242, 169, 9, 244, 43, 51, 87, 181, 39, 144, 183, 57, 57, 150, 16, 184, 228, 112, 145, 175, 215, 138, 71, 14, 225, 102, 244, 119, 62, 118, 230, 222, 39, 158, 208, 182, 106, 135, 127, 120, 124, 217, 123, 27, 158, 252, 44, 48, 109, 250, 230, 167, 63, 11, 204, 252, 23, 13, 67, 246, 39, 239, 227, 12, 92, 132, 187, 219, 219, 63, 255, 64, 123, 66, 187, 67, 155, 189, 101, 11, 198, 181, 225, 120, 244, 254, 208, 177, 102, 189, 246, 180, 246, 233, 79, 183, 74, 255, 214, 21, 106, 9, 118, 116, 127, 37, 205, 80, 106, 66, 99, 113, 174, 63, 154, 140, 165, 188, 238, 104, 114, 131, 53, 142, 130, 41, 79, 158, 130, 138, 138, 91, 64, 228, 199, 148, 101, 92, 200, 80, 120, 84, 16, 223, 229, 6, 219, 14, 166, 185, 48, 161, 138, 106, 251, 0, 133, 154, 253, 113, 89, 89, 52, 16, 206, 28, 111, 118, 124, 182, 176, 232, 206, 86, 189, 85, 57, 193, 68, 70, 52, 8, 206, 56, 240, 102, 230, 186, 40, 147, 81, 249, 32, 38, 146, 126, 136, 17, 192, 185, 35, 175, 189, 224, 2, 148, 254, 180, 231, 44, 250, 161, 5, 254, 252, 128, 153, 141, 93, 137, 142, 44, 63, 171, 95, 164, 253, 35, 107, 25, 13, 193, 240, 233, 15, 220, 245, 61, 90, 176, 228, 140, 51, 30, 184, 235, 59, 237, 63, 218, 155, 159, 177, 122, 124, 112, 211, 178, 85, 108, 146, 182, 244, 166, 57, 252, 88, 200, 7, 78, 247, 147, 74, 135, 98, 216, 231, 79, 236, 225, 63, 147, 235, 97, 146, 156, 129, 138, 36, 235, 174, 214, 57, 97, 199, 224, 222, 62, 52, 145, 78, 185, 186, 114, 148, 27, 114, 226, 61, 69, 113, 127, 118, 168, 164, 225, 95, 165, 151, 27, 19, 89, 142, 140, 76, 50, 29, 94, 114, 159, 35, 35, 147, 83, 185, 223, 244, 140, 13, 118, 114, 39, 156, 217, 134, 35, 240, 192, 78, 56, 218, 150, 8, 199, 96, 22, 242, 167, 74, 129, 2, 216, 236, 79, 236, 225, 131, 147, 149, 198, 36, 57, 245, 36, 227, 54, 186, 161, 223, 14, 186, 251, 14, 217, 0, 37, 131, 35, 65, 254, 116, 193, 145, 56, 137, 63, 78, 207, 97, 232, 215, 43, 103, 136, 209, 251, 254, 189, 114, 180, 115, 67, 61, 103, 33, 191, 28, 21, 156, 112, 151, 223, 26, 107, 102, 18, 153, 28, 75, 172, 190, 167, 239, 120, 111, 167, 144, 30, 253, 212, 15, 6, 140, 206, 158, 180, 172, 63, 51, 52, 38, 134, 51, 79, 63, 94, 224, 228, 4, 238, 180, 219, 226, 162, 44, 250, 225, 146, 41, 194, 155, 4, 123, 12, 129, 225, 83, 162, 5, 140, 110, 7, 189, 74, 34, 61, 191, 89, 200, 119, 135, 34, 15, 180, 251, 19, 13, 119, 146, 80, 244, 1, 169, 222, 98, 102, 172, 46, 184, 46, 242, 244, 196, 75, 253, 122, 153, 244, 140, 37, 144, 111, 100, 78, 58, 101, 217, 102, 127, 84, 166, 35, 43, 232, 160, 66, 142, 118, 253, 58, 168, 244, 234, 90, 79, 39, 21, 237, 46, 163, 135, 189, 93, 85, 2, 239, 132, 251, 138, 221, 63, 203, 156, 61, 33, 244, 141, 220, 199, 149, 37, 53, 254, 20, 57, 24, 245, 151, 44, 229, 56, 57, 42, 156, 71, 74, 17, 13, 171, 100, 78, 44, 124, 92, 129, 26, 195, 138, 197, 108, 108, 217, 195, 38, 64, 253, 215, 7, 136, 245, 84, 21, 32, 157, 147, 244, 169, 47, 132, 161, 63, 161, 87, 24, 129, 149, 34, 56, 100, 23, 187, 73, 228, 210, 200, 17, 245, 185, 129, 242, 186, 206, 164, 123, 45, 140, 154, 58, 57, 34, 154, 61, 53, 207, 94, 225, 113, 58, 216, 197, 178, 139, 240, 71, 4, 147, 204, 217, 189, 34, 230, 77, 158, 120, 62, 213, 64, 200, 57, 199, 102, 125, 127, 138, 124, 106, 166, 205, 19, 71, 81, 98, 69, 13, 17, 150, 206, 166, 8, 227, 230, 112, 250, 6, 64, 248, 65, 118, 225, 147, 242, 199, 191, 166, 93, 62, 231, 15, 71, 100, 151, 200, 169, 198, 96, 173, 204, 217, 16, 229, 21, 17, 55, 108, 168, 168, 161, 196, 8, 38, 36, 147, 105, 23, 107, 150, 197, 25, 134, 68, 92, 113, 42, 157, 75, 68, 30, 196, 40, 230, 132, 224, 216, 13, 96, 134, 139, 211, 122, 91, 44, 247, 111, 107, 139, 176, 71, 187, 134, 209, 175, 104, 152, 38, 90, 97, 81, 145, 220, 129, 40, 148, 110, 144, 190, 19, 68, 199, 206, 1, 218, 45, 211, 128, 81, 40, 98, 100, 211, 220, 100, 56, 46, 6, 178, 231, 245, 230, 3, 94, 111, 28, 197, 94, 165, 230, 121, 124, 145, 182, 127, 88, 187, 82, 55, 248, 51, 12, 252, 40, 158, 142, 28, 205, 238, 149, 127, 6, 59, 148, 139, 247, 231, 3, 69, 134, 111, 38, 182, 32, 113, 144, 90, 105, 138, 22, 41, 50, 227, 200, 167, 5, 207, 191, 201, 157, 199, 67, 102, 85, 244, 134, 254, 28, 119, 76, 253, 249, 233, 244, 112, 51, 1, 214, 125, 76, 230, 236, 99, 113, 246, 167, 66, 153, 120, 51, 249, 21, 209, 20, 210, 171, 177, 133, 216, 19, 81, 87, 63, 121, 86, 244, 69, 32, 44, 92, 125, 97, 83, 96, 30, 97, 244, 203, 224, 168, 118, 47, 30, 150, 234, 128, 67, 52, 108, 59, 32, 163, 36, 118, 45, 46, 221, 25, 44, 152, 97, 184, 48, 20, 115, 40, 191, 65, 65, 73, 42, 14, 89, 177, 166, 40, 20, 112, 123, 91, 127, 37, 73, 225, 26, 248, 118, 17, 11, 31, 50, 70, 75, 209, 86, 139, 30, 251, 87, 53, 185, 10, 137, 2, 195, 78, 109, 71, 127, 254, 121, 193, 130, 159, 249, 25, 63, 255, 220, 57, 185, 206, 253, 165, 227, 231, 119, 181, 173, 128, 176, 95, 230, 108, 129, 178, 27, 56, 164, 139, 81, 113, 136, 89, 38, 93, 231, 60, 131, 160, 77, 58, 65, 219, 125, 184, 255, 232, 23, 218, 199, 50, 199, 251, 180, 121, 192, 187, 191, 150, 163, 217, 243, 170, 42, 198, 212, 10, 181, 226, 249, 145, 226, 88, 138, 212, 75, 66, 24, 183, 8, 152, 72, 31, 227, 5, 42, 87, 104, 127, 48, 37, 152, 65, 161, 79, 238, 4, 179, 254, 34, 35, 119, 2, 133, 20, 79, 216, 139, 155, 247, 105, 222, 227, 31, 106, 199, 131, 184, 69, 19, 222, 175, 205, 5, 14, 9, 0, 242, 104, 165, 80, 216, 51, 196, 64, 219, 1, 11, 42, 161, 64, 189, 94, 19, 42, 64, 222, 5, 70, 204, 151, 21, 32, 203, 5, 36, 93, 243, 40, 110, 61, 98, 62, 129, 22, 217, 64, 22, 148, 160, 108, 33, 145, 38, 43, 210, 166, 30, 133, 69, 185, 34, 164, 189, 181, 48, 104, 136, 177, 70, 154, 51, 152, 77, 61, 204, 25, 100, 106, 105, 79, 83, 134, 192, 250, 151, 191, 212, 62, 138, 180, 99, 200, 19, 163, 70, 195, 123, 15, 159, 128, 183, 136, 252, 29, 73, 254, 4, 22, 154, 84, 108, 140, 24, 113, 154, 188, 123, 126, 230, 19, 126, 254, 153, 86, 171, 88, 178, 74, 148, 82, 40, 242, 145, 24, 249, 233, 205, 168, 34, 67, 149, 109, 9, 197, 167, 82, 148, 130, 6, 35, 37, 114, 94, 56, 57, 121, 190, 220, 232, 246, 23, 132, 139, 3, 109, 241, 34, 243, 90, 43, 5, 225, 194, 69, 3, 102, 41, 49, 185, 11, 245, 77, 175, 248, 208, 198, 23, 191, 225, 255, 238, 250, 132, 61, 27, 240, 243, 201, 191, 252, 40, 168, 195, 216, 230, 74, 219, 131, 29, 14, 182, 61, 70, 196, 176, 14, 229, 84, 207, 50, 33, 165, 227, 65, 190, 5, 56, 40, 50, 87, 200, 196, 171, 160, 129, 188, 37, 243, 26, 204, 42, 211, 219, 124, 146, 98, 114, 139, 40, 38, 90, 27, 204, 61, 21, 43, 145, 201, 9, 45, 1, 131, 162, 44, 38, 87, 161, 141, 90, 25, 145, 78, 142, 218, 136, 227, 143, 179, 59, 222, 126, 59, 176, 56, 216, 198, 206, 245, 145, 185, 228, 168, 185, 76, 251, 35, 0, 123, 92, 31, 111, 221, 123, 37, 135, 6, 144, 70, 142, 54, 5, 5, 13, 33, 243, 134, 60, 221, 188, 33, 52, 210, 89, 225, 130, 100, 219, 160, 82, 214, 13, 122, 34, 92, 236, 164, 99, 28, 105, 222, 64, 99, 188, 62, 104, 218, 208, 229, 165, 166, 27, 134, 13, 198, 232, 2, 240, 238, 75, 186, 63, 146, 223, 21, 109, 117, 129, 59, 20, 239, 144, 194, 77, 2, 6, 67, 71, 21, 132, 112, 220, 146, 6, 133, 140, 211, 22, 146, 109, 232, 32, 70, 199, 164, 225, 114, 28, 80, 230, 72, 25, 10, 11, 34, 74, 149, 177, 147, 70, 167, 52, 37, 20, 70, 154, 5, 184, 34, 204, 5, 168, 241, 124, 240, 7, 95, 127, 125, 252, 15, 127, 255, 219, 235, 218, 123, 218, 77, 120, 22, 150, 226, 96, 60, 91, 187, 161, 51, 153, 122, 179, 190, 183, 37, 192, 29, 189, 109, 5, 164, 125, 70, 63, 69, 188, 87, 229, 46, 65, 71, 78, 88, 237, 143, 181, 9, 104, 78, 66, 86, 31, 135, 28, 117, 99, 189, 116, 138, 140, 46, 49, 20, 225, 132, 10, 66, 70, 57, 121, 130, 225, 230, 147, 57, 88, 106, 184, 4, 7, 130, 151, 104, 7, 66, 29, 150, 164, 34, 169, 209, 96, 166, 78, 201, 105, 165, 116, 10, 33, 102, 42, 200, 40, 142, 121, 43, 124, 101, 96, 175, 132, 42, 167, 2, 54, 27, 30, 255, 66, 59, 206, 151, 116, 191, 25, 251, 26, 14, 170, 153, 131, 57, 191, 249, 68, 251, 222, 32, 42, 252, 19, 46, 192, 79, 246, 106, 239, 104, 239, 254, 136, 227, 82, 58, 198, 104, 9, 218, 123, 154, 71, 244, 165, 251, 6, 0, 121, 187, 82, 24, 140, 71, 120, 32, 57, 142, 129, 209, 3, 34, 51, 9, 36, 131, 204, 140, 8, 167, 37, 13, 196, 68, 121, 139, 137, 210, 39, 230, 115, 157, 204, 244, 130, 72, 150, 146, 82, 171, 160, 203, 136, 98, 69, 132, 195, 39, 164, 164, 128, 30, 152, 16, 220, 224, 206, 182, 57, 178, 116, 50, 243, 132, 166, 138, 32, 173, 138, 44, 91, 121, 112, 18, 101, 97, 87, 251, 24, 78, 211, 30, 193, 197, 104, 70, 51, 46, 214, 30, 193, 105, 26, 199, 244, 63, 63, 178, 239, 47, 152, 222, 245, 87, 154, 188, 157, 24, 131, 183, 96, 17, 110, 213, 182, 104, 127, 208, 150, 107, 255, 218, 185, 243, 75, 124, 162, 27, 51, 181, 79, 187, 65, 155, 252, 215, 157, 97, 26, 149, 0, 186, 191, 98, 239, 171, 170, 136, 127, 70, 231, 15, 87, 147, 253, 53, 73, 96, 96, 6, 206, 92, 36, 242, 100, 90, 209, 196, 37, 110, 146, 182, 88, 20, 51, 151, 36, 222, 34, 35, 231, 121, 68, 191, 133, 33, 180, 188, 140, 246, 222, 5, 61, 139, 203, 200, 197, 19, 200, 165, 21, 189, 11, 55, 251, 83, 19, 18, 226, 227, 99, 98, 232, 16, 34, 209, 21, 239, 140, 119, 58, 236, 20, 184, 204, 238, 181, 123, 162, 76, 201, 58, 78, 106, 160, 234, 24, 33, 128, 216, 225, 185, 75, 31, 188, 238, 200, 132, 166, 137, 147, 3, 163, 195, 194, 200, 86, 240, 251, 15, 217, 179, 129, 51, 110, 217, 84, 32, 173, 8, 203, 37, 70, 248, 165, 92, 45, 31, 21, 114, 169, 225, 0, 25, 216, 25, 82, 201, 25, 146, 47, 36, 135, 228, 144, 28, 114, 246, 48, 164, 147, 67, 114, 39, 6, 32, 36, 111, 148, 158, 242, 166, 151, 217, 92, 164, 148, 209, 227, 88, 73, 111, 202, 71, 33, 6, 92, 144, 14, 11, 15, 168, 148, 99, 71, 111, 69, 26, 33, 159, 76, 226, 161, 176, 85, 121, 52, 170, 216, 66, 201, 210, 68, 115, 82, 244, 104, 121, 203, 67, 5, 141, 219, 66, 26, 198, 3, 164, 167, 38, 39, 186, 156, 182, 88, 136, 129, 104, 15, 173, 13, 159, 205, 147, 145, 224, 140, 147, 37, 155, 67, 85, 20, 111, 182, 47, 67, 160, 199, 222, 10, 2, 52, 114, 188, 222, 93, 248, 33, 102, 125, 175, 61, 133, 151, 76, 245, 63, 253, 182, 246, 226, 109, 152, 247, 228, 255, 252, 229, 225, 39, 240, 250, 187, 63, 127, 94, 62, 122, 243, 83, 218, 15, 43, 206, 212, 62, 220, 177, 176, 177, 226, 142, 153, 87, 221, 114, 79, 59, 38, 109, 156, 69, 186, 9, 128, 84, 41, 198, 114, 168, 145, 116, 32, 36, 219, 56, 234, 186, 17, 89, 73, 138, 92, 8, 116, 165, 200, 72, 57, 32, 196, 180, 100, 74, 212, 115, 248, 59, 109, 251, 249, 197, 129, 41, 4, 193, 198, 221, 22, 88, 0, 12, 178, 0, 228, 114, 145, 127, 196, 4, 117, 254, 40, 19, 85, 81, 79, 226, 199, 88, 134, 182, 208, 216, 44, 224, 198, 216, 216, 136, 11, 181, 135, 174, 137, 1, 137, 2, 80, 21, 50, 136, 140, 151, 104, 40, 42, 208, 135, 206, 108, 15, 102, 49, 207, 91, 44, 189, 243, 126, 126, 161, 86, 115, 72, 142, 61, 241, 253, 161, 67, 82, 30, 32, 60, 3, 32, 187, 69, 156, 187, 38, 99, 66, 12, 41, 220, 83, 110, 43, 134, 52, 73, 49, 58, 24, 42, 104, 220, 22, 82, 132, 98, 28, 27, 210, 67, 165, 101, 29, 33, 161, 159, 145, 74, 187, 238, 97, 93, 1, 137, 95, 25, 66, 159, 1, 131, 118, 227, 34, 102, 126, 67, 47, 171, 236, 4, 195, 64, 86, 244, 60, 24, 29, 64, 240, 26, 135, 110, 210, 188, 92, 152, 52, 211, 229, 34, 174, 103, 192, 9, 26, 247, 202, 166, 72, 227, 222, 8, 3, 102, 195, 112, 25, 80, 196, 87, 163, 216, 213, 86, 168, 53, 94, 23, 12, 136, 33, 252, 251, 242, 26, 148, 16, 107, 235, 113, 131, 183, 40, 33, 102, 214, 183, 183, 97, 201, 110, 36, 136, 237, 37, 204, 117, 59, 58, 249, 219, 254, 98, 54, 179, 136, 208, 166, 37, 17, 97, 139, 7, 17, 135, 73, 163, 29, 154, 132, 188, 53, 84, 52, 92, 160, 236, 228, 49, 155, 77, 255, 101, 204, 230, 50, 86, 116, 106, 115, 57, 86, 113, 247, 221, 164, 71, 138, 28, 117, 98, 60, 235, 116, 27, 197, 62, 58, 73, 136, 138, 18, 122, 229, 163, 27, 152, 126, 34, 180, 15, 221, 168, 210, 208, 57, 232, 157, 135, 1, 244, 28, 89, 253, 230, 75, 202, 11, 166, 71, 202, 15, 229, 75, 98, 45, 226, 66, 81, 175, 124, 73, 166, 112, 190, 36, 15, 218, 122, 228, 173, 234, 153, 174, 138, 222, 233, 4, 144, 71, 201, 71, 65, 129, 66, 35, 223, 29, 81, 40, 17, 77, 62, 205, 83, 20, 165, 213, 33, 83, 56, 40, 130, 198, 230, 3, 54, 155, 141, 155, 146, 10, 41, 19, 147, 15, 189, 236, 118, 109, 196, 11, 172, 148, 116, 115, 233, 177, 206, 41, 196, 43, 169, 206, 93, 0, 138, 44, 31, 133, 40, 152, 242, 164, 149, 2, 220, 133, 250, 145, 0, 156, 86, 26, 19, 41, 30, 242, 26, 100, 201, 232, 79, 143, 27, 172, 69, 220, 16, 253, 162, 224, 233, 81, 16, 69, 238, 63, 138, 137, 130, 167, 123, 16, 61, 54, 175, 13, 189, 104, 219, 133, 94, 188, 25, 239, 69, 233, 69, 237, 172, 215, 180, 245, 242, 209, 206, 203, 164, 11, 78, 12, 145, 158, 232, 124, 76, 154, 222, 57, 17, 244, 182, 232, 57, 44, 170, 123, 102, 28, 57, 189, 33, 13, 102, 153, 144, 77, 145, 41, 7, 140, 84, 3, 145, 25, 6, 196, 90, 152, 2, 32, 125, 30, 202, 151, 183, 204, 88, 11, 76, 13, 166, 35, 13, 5, 252, 206, 163, 237, 82, 132, 88, 72, 11, 47, 23, 163, 108, 168, 128, 224, 131, 201, 39, 217, 213, 249, 200, 228, 95, 255, 76, 145, 110, 232, 82, 121, 75, 215, 40, 41, 235, 240, 97, 118, 223, 179, 207, 178, 101, 135, 245, 92, 30, 145, 235, 180, 65, 143, 101, 31, 94, 156, 33, 124, 36, 184, 56, 157, 17, 240, 137, 126, 93, 172, 73, 123, 31, 236, 228, 100, 107, 113, 160, 117, 120, 234, 69, 24, 49, 111, 42, 140, 8, 182, 54, 158, 116, 14, 218, 160, 139, 101, 160, 143, 92, 28, 245, 13, 129, 45, 151, 66, 162, 61, 202, 144, 80, 250, 172, 17, 177, 120, 108, 187, 164, 141, 130, 123, 157, 24, 34, 219, 79, 124, 11, 12, 30, 0, 144, 52, 177, 190, 237, 198, 120, 36, 1, 7, 178, 91, 166, 204, 63, 250, 155, 100, 67, 18, 186, 232, 128, 131, 131, 180, 220, 40, 35, 27, 18, 49, 22, 192, 110, 11, 70, 151, 204, 246, 40, 65, 169, 88, 70, 39, 190, 113, 30, 143, 141, 226, 76, 82, 108, 201, 7, 88, 202, 23, 47, 143, 158, 28, 184, 76, 251, 17, 57, 222, 191, 239, 7, 237, 63, 242, 209, 242, 134, 5, 251, 2, 90, 224, 40, 123, 15, 167, 223, 186, 28, 16, 206, 1, 144, 46, 21, 109, 154, 225, 143, 53, 49, 66, 12, 235, 137, 235, 68, 202, 144, 176, 32, 235, 37, 67, 66, 130, 172, 183, 12, 177, 130, 53, 222, 230, 208, 101, 8, 5, 231, 160, 21, 228, 60, 135, 101, 6, 126, 226, 159, 6, 138, 240, 251, 192, 139, 124, 228, 239, 88, 53, 43, 189, 227, 142, 192, 215, 129, 7, 126, 7, 16, 17, 119, 39, 60, 254, 33, 116, 57, 175, 33, 244, 218, 184, 80, 96, 168, 30, 175, 12, 143, 191, 1, 29, 27, 96, 113, 16, 34, 6, 132, 161, 0, 114, 170, 124, 20, 108, 48, 209, 31, 19, 141, 128, 102, 225, 45, 24, 133, 168, 243, 91, 155, 145, 130, 168, 135, 62, 66, 220, 1, 161, 181, 135, 62, 98, 3, 155, 199, 78, 250, 136, 199, 22, 7, 61, 247, 16, 108, 168, 246, 193, 7, 177, 63, 226, 144, 154, 57, 216, 248, 187, 175, 180, 87, 78, 178, 111, 224, 176, 15, 64, 105, 22, 109, 74, 132, 116, 56, 83, 247, 221, 224, 136, 102, 20, 198, 2, 38, 43, 83, 37, 4, 153, 78, 53, 105, 107, 106, 49, 4, 192, 128, 101, 130, 185, 58, 133, 44, 72, 142, 39, 245, 46, 45, 57, 41, 62, 49, 62, 209, 97, 7, 27, 216, 28, 148, 75, 53, 202, 228, 46, 244, 84, 100, 64, 69, 57, 16, 48, 147, 1, 78, 7, 120, 156, 220, 38, 176, 71, 79, 16, 135, 228, 57, 218, 159, 181, 7, 176, 25, 51, 14, 161, 7, 155, 181, 7, 180, 63, 29, 122, 241, 205, 55, 241, 162, 35, 111, 188, 113, 228, 83, 76, 255, 234, 111, 152, 246, 233, 167, 218, 103, 127, 251, 74, 251, 203, 167, 120, 62, 251, 240, 240, 35, 143, 28, 166, 15, 173, 125, 132, 20, 0, 121, 152, 160, 171, 209, 126, 179, 69, 149, 80, 250, 181, 108, 144, 104, 40, 62, 158, 38, 212, 240, 229, 37, 103, 222, 20, 230, 9, 252, 252, 31, 188, 14, 31, 126, 57, 240, 79, 150, 242, 31, 109, 174, 86, 195, 254, 196, 254, 30, 120, 142, 141, 10, 12, 235, 210, 88, 83, 96, 15, 189, 127, 19, 128, 82, 34, 31, 133, 66, 88, 120, 176, 0, 153, 26, 50, 58, 72, 165, 204, 215, 42, 48, 117, 11, 137, 125, 92, 40, 20, 244, 188, 6, 138, 215, 148, 175, 18, 99, 140, 188, 207, 16, 154, 69, 1, 181, 133, 10, 20, 25, 1, 157, 10, 161, 48, 211, 89, 106, 119, 102, 186, 204, 38, 10, 232, 148, 147, 147, 155, 155, 147, 227, 37, 66, 16, 135, 88, 65, 83, 30, 50, 165, 171, 170, 170, 172, 244, 249, 156, 222, 76, 69, 85, 21, 69, 181, 177, 127, 253, 238, 185, 52, 135, 115, 244, 162, 24, 219, 163, 59, 50, 28, 105, 11, 23, 201, 81, 47, 89, 149, 69, 11, 83, 157, 25, 59, 30, 181, 197, 44, 30, 237, 112, 164, 61, 247, 59, 182, 58, 230, 55, 191, 223, 180, 121, 220, 206, 11, 30, 111, 187, 111, 35, 146, 234, 131, 27, 238, 111, 123, 252, 130, 157, 227, 54, 111, 250, 253, 111, 98, 2, 215, 1, 194, 56, 0, 185, 89, 254, 22, 220, 48, 181, 199, 142, 51, 177, 247, 30, 179, 151, 34, 6, 40, 181, 70, 220, 8, 41, 98, 198, 110, 82, 61, 197, 110, 114, 156, 216, 73, 158, 141, 169, 152, 138, 103, 211, 78, 50, 240, 79, 244, 125, 253, 252, 115, 223, 160, 239, 148, 123, 72, 146, 89, 164, 231, 188, 162, 198, 10, 44, 198, 14, 107, 67, 8, 146, 18, 101, 182, 242, 158, 9, 121, 13, 189, 39, 68, 246, 153, 145, 197, 122, 233, 64, 33, 186, 79, 139, 139, 139, 138, 2, 16, 217, 13, 109, 189, 16, 153, 232, 222, 136, 140, 110, 111, 181, 241, 153, 103, 88, 195, 51, 207, 4, 158, 216, 247, 175, 125, 134, 130, 20, 114, 142, 229, 65, 222, 161, 198, 10, 57, 155, 4, 109, 254, 88, 39, 74, 178, 77, 28, 158, 209, 249, 146, 62, 242, 116, 58, 97, 226, 42, 39, 184, 148, 88, 186, 136, 80, 144, 215, 96, 70, 69, 201, 87, 26, 221, 126, 119, 143, 251, 50, 72, 138, 44, 81, 240, 20, 165, 136, 236, 84, 82, 116, 145, 155, 100, 74, 138, 141, 142, 178, 70, 8, 93, 2, 190, 140, 134, 98, 31, 142, 131, 191, 39, 251, 211, 154, 94, 108, 39, 216, 248, 95, 238, 28, 136, 253, 4, 125, 51, 86, 11, 172, 120, 48, 76, 130, 189, 79, 78, 74, 140, 229, 170, 98, 152, 212, 101, 210, 50, 106, 39, 192, 14, 219, 69, 30, 121, 18, 63, 133, 228, 212, 20, 140, 49, 159, 31, 242, 51, 44, 48, 74, 202, 72, 155, 169, 194, 80, 136, 190, 82, 130, 189, 243, 233, 17, 17, 229, 161, 76, 106, 116, 159, 162, 112, 81, 100, 97, 10, 253, 11, 48, 110, 108, 85, 69, 81, 65, 90, 138, 61, 222, 98, 14, 105, 32, 4, 104, 96, 53, 175, 242, 197, 112, 53, 33, 222, 229, 45, 225, 185, 49, 220, 155, 89, 34, 85, 148, 87, 75, 190, 178, 52, 230, 82, 75, 184, 55, 51, 70, 114, 58, 210, 36, 95, 89, 181, 84, 133, 123, 222, 254, 72, 123, 27, 83, 98, 211, 147, 108, 236, 41, 53, 183, 106, 140, 119, 216, 234, 225, 111, 196, 164, 121, 188, 246, 84, 255, 244, 53, 13, 19, 90, 27, 202, 210, 162, 162, 51, 170, 206, 104, 107, 152, 184, 104, 226, 112, 239, 205, 233, 249, 185, 37, 89, 217, 190, 44, 167, 149, 115, 171, 51, 187, 50, 39, 115, 132, 175, 40, 59, 61, 58, 8, 152, 7, 94, 200, 89, 176, 108, 113, 254, 136, 203, 175, 189, 174, 230, 38, 173, 243, 130, 164, 218, 25, 243, 138, 71, 109, 88, 52, 178, 164, 97, 197, 185, 23, 14, 29, 127, 253, 229, 235, 155, 203, 179, 234, 207, 159, 190, 188, 110, 185, 63, 165, 176, 118, 206, 130, 249, 217, 158, 25, 179, 103, 142, 43, 200, 29, 53, 101, 250, 228, 52, 226, 147, 12, 26, 187, 191, 226, 21, 98, 14, 188, 48, 211, 63, 157, 242, 234, 146, 53, 79, 20, 197, 144, 64, 85, 145, 145, 169, 114, 189, 49, 100, 28, 201, 157, 79, 81, 101, 133, 64, 61, 1, 132, 149, 210, 128, 9, 63, 196, 50, 214, 8, 224, 245, 164, 186, 147, 19, 19, 28, 182, 88, 147, 210, 115, 176, 156, 186, 49, 88, 228, 57, 179, 71, 48, 38, 17, 0, 14, 47, 23, 195, 83, 116, 239, 181, 135, 255, 245, 243, 113, 220, 182, 243, 194, 77, 23, 124, 129, 103, 230, 94, 117, 209, 206, 171, 214, 108, 8, 246, 184, 243, 153, 187, 134, 63, 117, 221, 177, 150, 218, 135, 81, 245, 118, 120, 47, 126, 116, 231, 170, 89, 83, 151, 6, 251, 49, 83, 91, 199, 191, 84, 118, 11, 235, 204, 123, 15, 218, 21, 202, 4, 28, 145, 222, 74, 100, 157, 163, 32, 219, 70, 106, 171, 66, 97, 49, 151, 79, 42, 69, 113, 40, 77, 80, 142, 81, 50, 152, 122, 174, 48, 228, 17, 84, 218, 179, 244, 169, 10, 22, 233, 5, 41, 77, 93, 56, 35, 0, 56, 192, 225, 181, 123, 237, 102, 161, 211, 151, 231, 250, 18, 92, 65, 3, 58, 35, 137, 19, 217, 104, 220, 58, 230, 99, 119, 220, 77, 111, 109, 187, 237, 72, 99, 243, 213, 215, 204, 163, 65, 97, 223, 31, 188, 121, 214, 121, 127, 125, 229, 104, 231, 99, 210, 144, 245, 235, 223, 15, 12, 215, 79, 76, 168, 223, 135, 186, 191, 98, 135, 149, 221, 2, 7, 171, 240, 151, 133, 49, 48, 35, 239, 42, 121, 44, 245, 130, 175, 98, 98, 12, 172, 74, 233, 139, 85, 245, 194, 168, 78, 80, 11, 34, 209, 169, 249, 97, 12, 142, 108, 168, 59, 85, 53, 100, 255, 123, 105, 112, 57, 22, 11, 211, 95, 242, 57, 144, 37, 179, 188, 37, 50, 59, 72, 112, 147, 85, 72, 232, 27, 95, 4, 156, 151, 233, 142, 7, 145, 229, 123, 219, 12, 247, 40, 61, 160, 67, 74, 66, 150, 195, 27, 71, 188, 213, 30, 4, 221, 42, 43, 226, 184, 47, 222, 230, 32, 75, 152, 156, 156, 10, 123, 4, 4, 119, 205, 145, 165, 151, 173, 186, 125, 231, 187, 231, 105, 63, 62, 247, 219, 213, 53, 101, 113, 97, 24, 78, 170, 14, 252, 229, 167, 71, 142, 62, 129, 67, 49, 86, 251, 183, 246, 73, 167, 118, 124, 229, 232, 39, 30, 229, 137, 97, 72, 14, 152, 224, 91, 43, 164, 153, 80, 4, 19, 96, 211, 147, 19, 28, 86, 46, 75, 6, 215, 114, 133, 108, 117, 10, 195, 102, 62, 249, 33, 175, 191, 116, 58, 152, 105, 136, 88, 60, 136, 249, 84, 206, 88, 65, 238, 254, 74, 20, 69, 150, 104, 110, 246, 155, 139, 242, 139, 242, 19, 157, 138, 41, 165, 240, 215, 241, 32, 62, 42, 46, 35, 57, 158, 61, 101, 202, 169, 170, 201, 25, 122, 246, 136, 55, 204, 41, 158, 172, 120, 193, 125, 198, 159, 25, 230, 62, 53, 243, 39, 142, 244, 94, 59, 16, 247, 97, 135, 243, 22, 45, 91, 90, 48, 226, 242, 235, 110, 172, 221, 161, 5, 250, 231, 59, 222, 250, 245, 39, 231, 59, 59, 181, 117, 82, 141, 176, 155, 202, 131, 9, 254, 26, 11, 42, 60, 29, 101, 37, 30, 145, 194, 110, 6, 207, 112, 136, 201, 136, 104, 155, 131, 105, 0, 196, 66, 44, 19, 152, 74, 49, 177, 154, 188, 28, 202, 141, 146, 224, 0, 59, 216, 11, 188, 38, 83, 98, 161, 157, 114, 159, 136, 179, 108, 53, 24, 70, 55, 51, 215, 102, 247, 133, 142, 181, 109, 9, 250, 111, 239, 206, 175, 223, 222, 251, 199, 237, 151, 61, 241, 154, 246, 237, 123, 91, 47, 172, 203, 218, 250, 220, 146, 197, 235, 206, 221, 177, 234, 130, 135, 190, 126, 49, 87, 91, 39, 63, 132, 18, 102, 238, 191, 225, 188, 151, 167, 14, 253, 231, 206, 151, 187, 11, 180, 37, 114, 202, 219, 87, 46, 92, 244, 244, 161, 174, 205, 163, 31, 221, 112, 59, 109, 23, 0, 33, 154, 226, 127, 72, 213, 224, 5, 183, 63, 49, 154, 182, 34, 245, 65, 214, 162, 179, 137, 100, 23, 225, 6, 217, 37, 114, 174, 47, 141, 187, 196, 76, 201, 42, 25, 40, 123, 115, 115, 42, 202, 241, 142, 248, 178, 145, 19, 26, 166, 229, 127, 62, 120, 114, 205, 200, 180, 180, 113, 13, 211, 139, 15, 100, 215, 79, 154, 56, 222, 159, 154, 51, 234, 38, 107, 193, 153, 195, 239, 61, 248, 236, 111, 118, 86, 204, 222, 255, 212, 179, 75, 27, 159, 122, 241, 165, 57, 99, 110, 127, 232, 177, 61, 119, 142, 61, 115, 203, 150, 59, 60, 237, 83, 166, 27, 180, 152, 166, 212, 128, 15, 38, 64, 145, 63, 63, 14, 17, 124, 54, 198, 145, 213, 211, 185, 157, 62, 120, 186, 167, 120, 25, 107, 28, 53, 178, 168, 160, 106, 40, 109, 28, 176, 132, 87, 148, 87, 51, 33, 171, 92, 206, 156, 28, 157, 80, 152, 203, 149, 198, 157, 142, 24, 38, 146, 158, 150, 199, 87, 185, 98, 56, 93, 246, 149, 85, 179, 138, 242, 18, 134, 183, 212, 46, 29, 149, 90, 113, 246, 158, 117, 137, 35, 199, 78, 200, 204, 157, 122, 215, 196, 184, 65, 21, 229, 5, 201, 195, 91, 206, 27, 49, 116, 236, 249, 205, 190, 202, 243, 158, 110, 223, 180, 255, 220, 170, 3, 79, 95, 52, 237, 172, 209, 238, 244, 49, 43, 38, 214, 173, 24, 147, 150, 59, 126, 145, 164, 12, 158, 60, 105, 114, 169, 127, 238, 136, 20, 174, 152, 20, 229, 126, 87, 2, 42, 86, 71, 70, 66, 202, 216, 186, 201, 249, 185, 37, 173, 155, 111, 109, 105, 125, 190, 99, 214, 228, 237, 135, 90, 15, 162, 220, 112, 134, 119, 222, 234, 173, 181, 53, 155, 23, 143, 24, 183, 122, 123, 205, 164, 243, 23, 77, 13, 142, 59, 201, 172, 50, 169, 9, 210, 97, 148, 127, 68, 208, 122, 138, 51, 19, 74, 116, 222, 68, 158, 239, 178, 76, 20, 36, 201, 92, 10, 133, 163, 46, 13, 217, 201, 148, 65, 99, 150, 39, 211, 155, 155, 73, 203, 39, 251, 20, 82, 137, 151, 245, 47, 143, 158, 27, 64, 16, 33, 88, 181, 185, 252, 122, 165, 20, 70, 194, 213, 126, 71, 50, 109, 173, 233, 76, 2, 101, 224, 245, 222, 76, 55, 231, 117, 122, 174, 122, 224, 140, 183, 3, 147, 88, 59, 41, 203, 139, 130, 124, 0, 64, 104, 34, 197, 161, 92, 212, 41, 17, 197, 128, 64, 225, 165, 161, 210, 65, 108, 125, 160, 219, 4, 161, 52, 251, 205, 121, 163, 146, 146, 202, 189, 212, 83, 172, 72, 8, 145, 191, 152, 217, 106, 86, 149, 89, 194, 114, 43, 210, 184, 175, 178, 202, 165, 40, 170, 62, 207, 68, 16, 193, 249, 207, 193, 89, 213, 139, 115, 102, 45, 159, 60, 166, 242, 252, 7, 90, 75, 75, 167, 174, 28, 62, 114, 193, 204, 25, 190, 161, 45, 173, 91, 102, 159, 243, 207, 134, 210, 71, 215, 215, 44, 25, 157, 145, 81, 179, 188, 54, 119, 212, 178, 218, 220, 172, 249, 247, 110, 78, 27, 163, 45, 187, 199, 26, 53, 101, 114, 229, 240, 81, 23, 253, 110, 83, 203, 209, 223, 239, 25, 163, 198, 37, 199, 63, 154, 86, 156, 22, 183, 171, 97, 197, 184, 141, 185, 115, 158, 249, 253, 147, 211, 23, 94, 60, 217, 147, 59, 110, 126, 229, 144, 243, 23, 143, 146, 105, 78, 133, 252, 150, 154, 192, 1, 23, 244, 148, 222, 174, 8, 145, 45, 247, 21, 217, 238, 94, 226, 183, 71, 145, 126, 239, 70, 8, 103, 2, 218, 116, 161, 172, 12, 36, 148, 123, 10, 228, 190, 178, 88, 215, 99, 83, 133, 141, 80, 60, 36, 65, 190, 63, 7, 24, 72, 50, 35, 224, 132, 247, 230, 95, 216, 152, 25, 159, 25, 159, 37, 168, 207, 78, 236, 74, 7, 143, 236, 30, 241, 133, 30, 113, 173, 73, 251, 235, 151, 154, 246, 229, 183, 63, 31, 97, 35, 239, 189, 104, 227, 189, 108, 100, 224, 133, 59, 55, 95, 116, 31, 119, 226, 149, 100, 176, 160, 157, 167, 173, 211, 230, 226, 205, 248, 53, 50, 1, 33, 173, 213, 190, 214, 14, 160, 110, 147, 115, 132, 116, 2, 169, 26, 162, 193, 235, 207, 136, 208, 8, 122, 169, 1, 94, 187, 71, 240, 167, 30, 242, 63, 66, 246, 71, 72, 253, 112, 110, 251, 31, 165, 106, 136, 135, 122, 191, 217, 22, 67, 231, 106, 161, 109, 173, 61, 148, 182, 163, 144, 32, 78, 125, 187, 215, 231, 106, 17, 15, 39, 135, 116, 100, 58, 116, 124, 211, 103, 163, 93, 42, 173, 62, 69, 85, 131, 9, 239, 143, 92, 125, 239, 234, 132, 104, 223, 133, 155, 171, 241, 240, 255, 104, 205, 82, 117, 224, 31, 63, 62, 118, 206, 111, 248, 138, 155, 39, 6, 14, 177, 130, 192, 187, 128, 144, 8, 192, 191, 81, 10, 33, 21, 70, 30, 112, 99, 120, 127, 31, 130, 81, 10, 194, 48, 74, 175, 107, 4, 164, 28, 116, 37, 123, 178, 37, 83, 114, 33, 230, 150, 112, 177, 119, 78, 147, 92, 152, 144, 32, 36, 41, 71, 47, 110, 170, 115, 228, 87, 76, 44, 79, 205, 30, 57, 111, 66, 229, 224, 226, 100, 172, 47, 153, 55, 244, 117, 87, 110, 78, 182, 243, 14, 237, 95, 135, 249, 151, 135, 202, 206, 24, 158, 177, 207, 146, 92, 60, 126, 209, 232, 174, 151, 207, 253, 195, 154, 146, 51, 206, 104, 46, 199, 75, 3, 68, 119, 148, 187, 114, 157, 84, 41, 85, 131, 19, 46, 246, 91, 227, 76, 12, 193, 129, 60, 228, 93, 150, 24, 86, 110, 130, 251, 25, 145, 114, 50, 98, 237, 235, 247, 197, 14, 6, 49, 184, 41, 9, 149, 137, 184, 93, 212, 247, 182, 158, 171, 210, 9, 78, 175, 61, 222, 43, 112, 120, 187, 49, 195, 244, 237, 242, 230, 228, 82, 200, 93, 219, 206, 35, 115, 230, 92, 115, 195, 145, 225, 239, 59, 237, 87, 221, 186, 172, 94, 91, 39, 191, 18, 40, 111, 109, 253, 148, 61, 27, 136, 62, 116, 253, 164, 173, 95, 150, 105, 115, 69, 95, 104, 19, 250, 134, 84, 13, 201, 48, 236, 64, 116, 196, 88, 71, 7, 39, 55, 52, 206, 17, 127, 135, 192, 170, 100, 72, 78, 113, 74, 166, 196, 66, 79, 142, 133, 229, 250, 210, 100, 87, 101, 124, 5, 45, 53, 167, 131, 43, 76, 197, 246, 194, 233, 115, 207, 244, 191, 149, 51, 174, 186, 178, 200, 99, 221, 190, 45, 233, 133, 216, 115, 134, 92, 62, 237, 122, 180, 161, 25, 77, 87, 76, 219, 183, 247, 254, 235, 55, 20, 254, 7, 55, 110, 108, 174, 246, 141, 186, 64, 251, 251, 213, 218, 23, 55, 0, 118, 107, 218, 92, 126, 185, 50, 30, 102, 192, 83, 254, 132, 146, 60, 111, 102, 170, 204, 153, 51, 40, 3, 132, 223, 128, 17, 208, 190, 128, 142, 40, 25, 151, 182, 232, 9, 215, 206, 4, 198, 6, 211, 46, 81, 80, 132, 96, 188, 234, 66, 80, 213, 226, 80, 114, 226, 220, 62, 79, 8, 132, 117, 105, 207, 7, 137, 7, 159, 86, 201, 32, 59, 182, 77, 159, 86, 55, 97, 244, 168, 234, 17, 190, 178, 226, 162, 114, 161, 178, 244, 199, 150, 73, 48, 87, 86, 149, 112, 226, 205, 164, 53, 184, 98, 120, 136, 57, 59, 189, 153, 49, 140, 68, 83, 36, 155, 94, 84, 189, 32, 125, 65, 107, 203, 100, 111, 235, 222, 141, 53, 195, 22, 92, 52, 182, 122, 225, 24, 111, 73, 118, 122, 69, 229, 200, 156, 130, 9, 211, 207, 106, 44, 110, 154, 189, 116, 68, 97, 122, 117, 211, 5, 179, 109, 41, 14, 235, 176, 5, 155, 199, 141, 190, 96, 248, 214, 179, 39, 92, 187, 211, 59, 238, 156, 169, 69, 189, 56, 119, 241, 194, 91, 207, 154, 122, 217, 217, 83, 147, 227, 134, 79, 91, 58, 108, 244, 42, 31, 183, 68, 199, 71, 221, 227, 72, 79, 178, 171, 222, 162, 68, 11, 174, 116, 23, 123, 18, 20, 71, 254, 160, 170, 204, 250, 149, 99, 211, 59, 82, 39, 109, 94, 116, 254, 249, 17, 252, 60, 168, 151, 164, 72, 77, 2, 107, 46, 246, 23, 68, 178, 96, 3, 118, 47, 131, 70, 194, 214, 35, 227, 36, 40, 166, 254, 227, 36, 244, 23, 31, 97, 224, 176, 8, 68, 175, 133, 0, 242, 60, 169, 26, 236, 48, 197, 111, 145, 17, 80, 194, 112, 204, 63, 135, 196, 152, 14, 236, 22, 70, 156, 18, 246, 189, 28, 130, 166, 236, 96, 183, 219, 201, 110, 144, 144, 75, 91, 208, 223, 130, 44, 106, 28, 46, 111, 78, 133, 205, 99, 195, 113, 69, 103, 93, 188, 110, 135, 84, 242, 115, 195, 219, 99, 180, 149, 252, 142, 241, 7, 202, 238, 9, 92, 241, 224, 230, 107, 239, 98, 171, 186, 22, 19, 255, 100, 176, 85, 91, 39, 85, 73, 77, 16, 13, 73, 208, 226, 183, 196, 32, 151, 162, 34, 35, 252, 24, 234, 110, 105, 72, 98, 229, 55, 200, 24, 18, 101, 125, 238, 22, 25, 119, 69, 108, 31, 113, 132, 30, 13, 209, 89, 94, 37, 66, 23, 22, 174, 0, 182, 144, 234, 155, 169, 56, 183, 158, 56, 241, 238, 63, 180, 151, 255, 185, 114, 126, 203, 121, 59, 127, 127, 211, 150, 43, 175, 212, 214, 241, 34, 92, 130, 83, 255, 253, 169, 246, 181, 118, 131, 87, 155, 198, 206, 110, 212, 222, 188, 239, 153, 47, 191, 160, 118, 35, 52, 105, 115, 249, 123, 210, 12, 168, 128, 43, 253, 241, 100, 201, 76, 38, 102, 110, 228, 18, 233, 55, 198, 26, 163, 125, 139, 129, 163, 232, 236, 40, 63, 164, 121, 134, 229, 117, 10, 29, 122, 113, 182, 180, 159, 210, 132, 206, 12, 124, 155, 108, 105, 154, 253, 230, 202, 33, 217, 57, 67, 130, 26, 77, 80, 105, 101, 17, 90, 11, 73, 178, 92, 177, 162, 130, 202, 170, 151, 36, 155, 174, 228, 242, 35, 229, 91, 135, 157, 117, 223, 170, 33, 233, 19, 214, 53, 165, 76, 219, 54, 223, 247, 234, 123, 169, 35, 178, 70, 206, 203, 25, 54, 177, 32, 174, 112, 116, 99, 102, 222, 63, 190, 91, 186, 247, 175, 91, 75, 107, 135, 150, 72, 67, 111, 141, 113, 212, 93, 252, 216, 210, 169, 235, 38, 101, 165, 143, 89, 54, 225, 158, 123, 100, 249, 30, 107, 84, 114, 65, 121, 114, 246, 224, 84, 235, 103, 63, 220, 241, 221, 189, 103, 200, 170, 5, 12, 90, 255, 64, 106, 2, 21, 92, 208, 26, 212, 90, 188, 33, 182, 46, 52, 79, 227, 103, 15, 43, 40, 114, 124, 209, 173, 168, 68, 33, 113, 78, 86, 216, 219, 82, 202, 101, 54, 33, 216, 41, 243, 84, 180, 201, 101, 118, 201, 28, 84, 84, 77, 166, 132, 194, 108, 125, 109, 24, 235, 197, 229, 113, 122, 104, 190, 89, 69, 112, 125, 160, 26, 92, 51, 205, 117, 24, 208, 62, 95, 160, 117, 109, 48, 214, 71, 135, 177, 114, 18, 247, 93, 251, 103, 237, 71, 237, 120, 240, 236, 131, 59, 165, 106, 136, 133, 58, 191, 133, 172, 205, 148, 136, 245, 18, 62, 7, 41, 12, 159, 131, 244, 190, 24, 58, 9, 137, 133, 88, 155, 215, 75, 192, 185, 39, 167, 34, 180, 86, 60, 54, 135, 11, 183, 212, 140, 61, 111, 117, 235, 21, 44, 70, 251, 118, 242, 182, 131, 108, 198, 224, 39, 115, 246, 5, 246, 190, 120, 65, 144, 206, 4, 158, 32, 228, 165, 219, 159, 216, 103, 235, 79, 27, 124, 146, 214, 253, 111, 240, 123, 111, 234, 7, 216, 201, 27, 88, 145, 244, 129, 52, 19, 242, 97, 56, 92, 228, 55, 23, 100, 164, 90, 245, 211, 1, 146, 203, 118, 41, 140, 203, 151, 26, 154, 100, 112, 86, 83, 131, 183, 132, 42, 73, 63, 249, 66, 25, 57, 15, 237, 247, 196, 26, 213, 111, 67, 239, 187, 205, 2, 185, 175, 170, 128, 124, 200, 79, 79, 115, 208, 209, 174, 171, 16, 157, 58, 1, 187, 108, 250, 158, 157, 120, 186, 106, 243, 218, 72, 8, 84, 51, 172, 150, 5, 29, 83, 41, 252, 217, 63, 173, 50, 57, 185, 250, 156, 201, 82, 124, 110, 237, 217, 147, 151, 94, 217, 148, 83, 214, 246, 219, 237, 71, 174, 56, 120, 102, 97, 69, 235, 237, 184, 160, 174, 101, 116, 142, 67, 177, 120, 70, 13, 207, 245, 165, 69, 73, 213, 29, 37, 179, 54, 79, 93, 120, 243, 186, 198, 88, 140, 155, 190, 231, 182, 203, 150, 12, 157, 116, 241, 99, 75, 215, 190, 191, 119, 109, 180, 182, 14, 175, 140, 91, 116, 219, 235, 27, 214, 61, 121, 209, 232, 174, 122, 213, 55, 105, 254, 242, 117, 101, 35, 214, 205, 27, 90, 176, 232, 206, 54, 178, 171, 214, 214, 73, 62, 169, 26, 146, 32, 221, 159, 226, 138, 177, 242, 240, 238, 150, 100, 125, 49, 107, 116, 58, 157, 9, 52, 31, 200, 75, 120, 174, 47, 33, 193, 229, 66, 31, 218, 73, 125, 202, 201, 17, 234, 211, 158, 167, 135, 214, 151, 165, 71, 125, 93, 60, 101, 240, 121, 232, 222, 163, 237, 248, 199, 180, 51, 83, 62, 159, 52, 61, 249, 86, 237, 224, 94, 60, 11, 103, 180, 182, 159, 81, 20, 55, 242, 214, 153, 239, 50, 30, 88, 168, 213, 223, 178, 191, 160, 237, 206, 193, 152, 20, 216, 13, 8, 35, 181, 117, 242, 40, 153, 67, 161, 17, 17, 62, 13, 56, 242, 118, 178, 104, 38, 114, 51, 204, 38, 242, 197, 193, 73, 177, 56, 56, 233, 183, 64, 145, 81, 32, 116, 112, 146, 152, 96, 179, 37, 39, 218, 232, 224, 4, 109, 36, 115, 41, 13, 143, 47, 33, 193, 41, 160, 90, 155, 207, 86, 205, 117, 229, 36, 70, 82, 109, 108, 253, 189, 175, 159, 91, 144, 144, 244, 186, 219, 161, 38, 20, 78, 88, 49, 241, 200, 146, 141, 227, 18, 39, 31, 153, 236, 28, 212, 216, 118, 235, 18, 26, 199, 51, 238, 253, 225, 174, 91, 103, 238, 24, 58, 104, 251, 156, 91, 71, 110, 92, 179, 184, 182, 64, 90, 221, 121, 157, 60, 249, 178, 167, 207, 57, 241, 240, 107, 129, 51, 118, 110, 111, 155, 94, 174, 104, 235, 4, 141, 175, 236, 254, 74, 254, 163, 84, 13, 181, 48, 200, 95, 84, 144, 206, 40, 102, 20, 197, 108, 67, 206, 182, 68, 180, 125, 145, 110, 19, 146, 239, 206, 79, 202, 79, 202, 82, 77, 169, 133, 217, 6, 184, 19, 95, 229, 83, 152, 142, 233, 176, 170, 28, 218, 190, 146, 42, 165, 168, 105, 156, 144, 102, 34, 152, 92, 95, 66, 188, 211, 17, 35, 81, 172, 117, 119, 226, 136, 73, 243, 134, 79, 57, 111, 122, 69, 186, 181, 174, 254, 216, 148, 25, 82, 84, 98, 222, 136, 130, 170, 169, 67, 189, 102, 87, 250, 160, 3, 37, 105, 174, 184, 188, 33, 13, 21, 249, 195, 115, 93, 209, 220, 85, 52, 50, 231, 216, 148, 241, 206, 194, 81, 211, 151, 87, 159, 115, 151, 54, 108, 105, 125, 209, 160, 169, 103, 95, 212, 62, 250, 22, 116, 94, 120, 161, 246, 231, 29, 121, 205, 205, 83, 71, 102, 87, 183, 172, 26, 92, 125, 149, 255, 138, 77, 27, 174, 246, 223, 56, 102, 232, 217, 211, 203, 139, 38, 204, 153, 55, 51, 99, 194, 53, 219, 55, 15, 217, 162, 125, 115, 253, 208, 45, 27, 215, 54, 249, 174, 162, 243, 150, 52, 218, 143, 200, 79, 235, 182, 122, 173, 125, 45, 245, 210, 76, 138, 122, 82, 219, 188, 20, 25, 185, 40, 131, 228, 162, 219, 235, 118, 179, 223, 25, 31, 31, 19, 211, 211, 10, 207, 220, 175, 21, 158, 8, 71, 26, 129, 110, 182, 236, 251, 108, 95, 36, 184, 185, 34, 116, 222, 34, 120, 145, 252, 180, 142, 109, 46, 208, 209, 59, 17, 64, 252, 100, 88, 102, 159, 40, 227, 61, 238, 247, 9, 33, 46, 80, 75, 203, 73, 81, 75, 106, 114, 111, 230, 214, 184, 239, 179, 125, 39, 133, 42, 35, 243, 119, 239, 214, 243, 53, 251, 253, 35, 69, 44, 81, 83, 48, 150, 168, 174, 67, 148, 70, 24, 41, 149, 65, 227, 128, 137, 213, 7, 80, 204, 236, 3, 36, 92, 190, 142, 96, 227, 129, 53, 52, 195, 146, 51, 152, 51, 161, 83, 217, 45, 198, 153, 244, 68, 106, 161, 130, 50, 200, 237, 125, 70, 47, 152, 137, 67, 12, 153, 58, 224, 144, 97, 63, 0, 47, 198, 81, 107, 6, 26, 176, 152, 16, 150, 46, 214, 166, 178, 27, 106, 161, 158, 50, 221, 24, 171, 83, 53, 169, 237, 102, 52, 161, 169, 253, 36, 11, 117, 226, 196, 137, 245, 19, 235, 237, 250, 122, 181, 152, 210, 254, 235, 245, 74, 70, 206, 255, 191, 214, 236, 43, 212, 219, 255, 223, 11, 87, 186, 54, 52, 46, 194, 198, 80, 221, 17, 105, 187, 158, 7, 225, 116, 164, 189, 236, 13, 147, 195, 166, 255, 110, 218, 168, 230, 68, 20, 13, 26, 108, 172, 232, 97, 121, 72, 5, 83, 201, 90, 32, 30, 128, 12, 238, 67, 39, 165, 102, 181, 167, 245, 33, 217, 95, 69, 88, 32, 106, 139, 40, 24, 69, 200, 14, 145, 60, 224, 129, 7, 237, 33, 67, 103, 187, 155, 244, 3, 66, 227, 208, 22, 20, 117, 249, 0, 237, 181, 24, 237, 205, 235, 81, 184, 63, 91, 73, 139, 209, 226, 147, 158, 243, 246, 110, 189, 190, 150, 35, 122, 208, 181, 123, 223, 167, 251, 194, 29, 208, 15, 123, 17, 210, 186, 127, 84, 118, 200, 71, 33, 31, 46, 9, 182, 63, 150, 178, 101, 122, 108, 140, 241, 56, 242, 95, 160, 100, 170, 161, 43, 100, 173, 46, 225, 164, 230, 240, 249, 2, 103, 192, 91, 41, 162, 134, 210, 2, 138, 146, 76, 250, 7, 46, 148, 16, 209, 141, 186, 179, 53, 82, 64, 246, 229, 70, 81, 144, 164, 212, 80, 142, 183, 84, 108, 108, 246, 83, 15, 236, 121, 217, 121, 153, 38, 53, 173, 16, 43, 43, 35, 54, 155, 61, 212, 235, 76, 133, 84, 21, 97, 237, 194, 222, 157, 245, 209, 121, 91, 95, 106, 175, 169, 221, 122, 224, 172, 130, 51, 146, 98, 253, 105, 245, 231, 54, 230, 22, 76, 219, 56, 237, 194, 151, 166, 190, 173, 37, 73, 87, 106, 197, 188, 126, 76, 253, 132, 205, 143, 182, 174, 248, 253, 142, 233, 38, 233, 14, 201, 58, 98, 233, 165, 19, 70, 158, 61, 173, 116, 106, 109, 96, 173, 92, 120, 199, 29, 196, 183, 152, 176, 211, 165, 216, 162, 50, 152, 97, 178, 177, 55, 138, 56, 206, 210, 67, 51, 36, 211, 190, 221, 13, 161, 216, 223, 198, 97, 151, 112, 3, 166, 80, 163, 169, 64, 96, 147, 162, 40, 102, 197, 28, 111, 179, 197, 41, 106, 114, 161, 199, 75, 243, 65, 108, 11, 183, 29, 231, 87, 4, 106, 27, 190, 255, 66, 59, 44, 31, 189, 67, 207, 172, 77, 50, 154, 236, 198, 166, 201, 223, 66, 38, 44, 246, 199, 27, 248, 178, 129, 45, 27, 137, 1, 83, 117, 136, 121, 185, 1, 49, 167, 135, 32, 230, 12, 106, 147, 155, 26, 194, 201, 31, 217, 40, 167, 223, 205, 164, 86, 153, 179, 108, 142, 220, 156, 76, 69, 77, 41, 68, 167, 66, 70, 157, 229, 241, 149, 89, 100, 189, 225, 20, 231, 198, 76, 245, 56, 61, 180, 177, 41, 147, 167, 125, 172, 105, 218, 106, 237, 194, 179, 222, 194, 193, 47, 46, 127, 123, 243, 136, 85, 43, 150, 248, 54, 188, 117, 251, 226, 24, 238, 238, 250, 116, 218, 173, 159, 92, 249, 7, 132, 253, 107, 112, 11, 110, 127, 25, 179, 159, 157, 159, 158, 254, 108, 162, 215, 21, 53, 106, 243, 51, 155, 118, 238, 120, 247, 154, 9, 52, 166, 8, 235, 187, 63, 210, 125, 181, 27, 6, 200, 52, 235, 140, 232, 136, 238, 55, 156, 65, 34, 205, 222, 219, 177, 58, 147, 55, 54, 31, 36, 79, 106, 73, 77, 62, 165, 39, 245, 201, 125, 167, 7, 118, 153, 62, 133, 61, 106, 114, 132, 61, 170, 123, 32, 123, 212, 212, 62, 246, 168, 234, 41, 236, 81, 131, 182, 33, 7, 78, 207, 54, 196, 104, 67, 136, 115, 156, 202, 54, 228, 244, 120, 70, 127, 182, 33, 193, 118, 6, 94, 222, 247, 167, 190, 182, 33, 8, 83, 180, 247, 249, 114, 97, 159, 95, 231, 183, 144, 239, 40, 68, 238, 221, 104, 218, 128, 47, 5, 163, 213, 196, 4, 18, 105, 70, 169, 113, 0, 156, 67, 179, 62, 179, 192, 167, 54, 31, 204, 180, 217, 197, 196, 186, 200, 59, 184, 178, 130, 218, 98, 139, 243, 100, 78, 217, 125, 227, 93, 43, 255, 138, 79, 105, 105, 15, 252, 237, 64, 195, 69, 79, 74, 235, 111, 63, 241, 47, 45, 85, 172, 215, 68, 178, 25, 17, 182, 175, 131, 96, 174, 97, 1, 26, 116, 216, 91, 78, 218, 183, 216, 216, 38, 55, 112, 177, 177, 165, 160, 252, 110, 102, 88, 128, 170, 178, 68, 225, 82, 130, 101, 195, 5, 82, 105, 231, 27, 23, 29, 29, 61, 40, 122, 80, 158, 199, 229, 201, 203, 203, 48, 171, 169, 133, 217, 33, 206, 227, 69, 167, 131, 177, 24, 38, 96, 219, 106, 22, 31, 95, 81, 225, 177, 133, 216, 148, 199, 38, 125, 252, 206, 153, 59, 23, 15, 42, 89, 124, 235, 89, 239, 4, 166, 71, 231, 142, 91, 115, 239, 234, 212, 164, 81, 11, 107, 203, 210, 163, 249, 210, 35, 87, 254, 237, 193, 121, 139, 30, 254, 234, 82, 173, 117, 103, 138, 127, 89, 93, 237, 178, 209, 105, 108, 195, 170, 215, 180, 71, 180, 31, 94, 187, 108, 236, 206, 172, 5, 187, 81, 198, 85, 255, 185, 53, 208, 158, 183, 240, 190, 13, 91, 239, 159, 239, 149, 75, 104, 29, 49, 216, 5, 160, 30, 18, 125, 245, 194, 44, 29, 210, 144, 145, 147, 215, 43, 167, 185, 38, 178, 32, 255, 180, 5, 148, 192, 203, 173, 16, 85, 244, 188, 173, 180, 208, 157, 212, 112, 161, 84, 50, 189, 137, 178, 217, 242, 60, 54, 155, 141, 250, 153, 222, 163, 159, 65, 123, 244, 136, 174, 121, 108, 94, 233, 218, 231, 140, 222, 61, 215, 229, 60, 206, 38, 28, 127, 60, 212, 163, 139, 2, 135, 142, 135, 58, 197, 157, 129, 118, 182, 33, 220, 11, 246, 68, 128, 94, 12, 8, 110, 0, 197, 39, 31, 5, 55, 204, 243, 91, 56, 114, 76, 32, 255, 57, 157, 102, 220, 97, 91, 88, 128, 96, 98, 73, 34, 118, 125, 226, 146, 84, 84, 100, 66, 8, 96, 185, 81, 78, 50, 230, 44, 86, 176, 100, 183, 205, 158, 237, 244, 218, 76, 130, 187, 121, 108, 186, 0, 161, 35, 149, 8, 161, 33, 61, 164, 13, 158, 118, 124, 245, 57, 123, 218, 134, 29, 247, 140, 95, 85, 191, 240, 247, 19, 143, 107, 14, 18, 135, 137, 227, 199, 151, 159, 123, 232, 82, 45, 1, 191, 156, 125, 65, 93, 70, 125, 99, 96, 189, 92, 168, 203, 134, 93, 0, 210, 86, 117, 7, 112, 168, 128, 77, 7, 50, 41, 190, 140, 222, 232, 28, 90, 10, 237, 230, 160, 189, 16, 7, 85, 225, 68, 89, 50, 91, 72, 141, 75, 110, 176, 152, 152, 62, 33, 185, 145, 5, 101, 206, 228, 102, 163, 56, 149, 84, 90, 68, 73, 49, 43, 41, 100, 14, 37, 85, 72, 21, 229, 190, 162, 194, 68, 87, 66, 188, 217, 48, 136, 178, 170, 186, 89, 139, 152, 155, 106, 86, 85, 85, 205, 125, 101, 101, 85, 180, 159, 87, 213, 24, 174, 166, 113, 98, 129, 46, 111, 137, 156, 235, 181, 225, 87, 36, 100, 180, 130, 227, 105, 249, 99, 134, 87, 166, 52, 230, 250, 107, 231, 141, 197, 232, 232, 130, 225, 131, 114, 157, 163, 147, 7, 85, 213, 12, 174, 93, 92, 63, 38, 175, 178, 96, 70, 225, 199, 158, 113, 19, 27, 166, 205, 28, 52, 155, 20, 26, 237, 240, 47, 191, 145, 143, 6, 218, 115, 198, 204, 44, 187, 188, 102, 97, 117, 42, 238, 116, 21, 140, 200, 110, 43, 105, 24, 51, 52, 217, 81, 57, 173, 109, 242, 89, 51, 110, 111, 28, 118, 249, 213, 219, 215, 156, 97, 11, 156, 1, 12, 246, 0, 200, 9, 122, 14, 133, 166, 158, 214, 223, 137, 16, 10, 243, 74, 32, 125, 114, 131, 66, 6, 144, 98, 74, 123, 221, 98, 45, 226, 150, 152, 209, 56, 0, 114, 130, 212, 173, 194, 85, 213, 48, 180, 39, 123, 116, 210, 200, 246, 28, 103, 71, 143, 31, 15, 12, 193, 15, 131, 234, 88, 231, 50, 105, 231, 137, 33, 210, 206, 206, 101, 212, 1, 49, 103, 69, 221, 31, 201, 76, 221, 1, 113, 144, 9, 87, 28, 224, 200, 197, 73, 77, 20, 165, 152, 161, 36, 110, 178, 116, 142, 158, 188, 237, 204, 96, 187, 8, 53, 92, 4, 140, 101, 132, 80, 195, 158, 233, 224, 250, 41, 102, 164, 131, 67, 36, 47, 46, 224, 91, 140, 162, 70, 161, 76, 113, 224, 103, 37, 251, 27, 143, 215, 110, 19, 138, 13, 146, 203, 173, 15, 21, 69, 112, 145, 202, 42, 238, 115, 80, 12, 185, 170, 202, 10, 159, 162, 42, 158, 140, 220, 28, 86, 21, 248, 247, 215, 216, 33, 225, 13, 207, 5, 158, 95, 249, 143, 79, 114, 242, 239, 188, 171, 246, 169, 123, 212, 140, 246, 246, 12, 245, 30, 52, 231, 107, 127, 213, 254, 154, 111, 198, 39, 36, 103, 215, 7, 60, 183, 243, 146, 119, 119, 238, 91, 120, 149, 146, 92, 155, 217, 214, 150, 89, 59, 33, 237, 227, 55, 11, 201, 174, 91, 91, 167, 251, 232, 24, 51, 65, 123, 155, 100, 177, 183, 89, 32, 224, 103, 55, 233, 204, 105, 82, 68, 146, 161, 208, 173, 84, 253, 86, 63, 146, 76, 247, 85, 33, 107, 239, 93, 199, 217, 31, 222, 214, 114, 95, 144, 143, 158, 248, 163, 92, 116, 98, 8, 225, 18, 0, 167, 237, 63, 150, 220, 32, 224, 46, 247, 0, 254, 99, 169, 125, 252, 199, 212, 211, 247, 31, 11, 174, 217, 47, 4, 207, 76, 53, 244, 185, 212, 144, 0, 210, 113, 79, 193, 53, 69, 98, 83, 55, 41, 29, 110, 58, 119, 9, 122, 7, 24, 130, 42, 120, 55, 149, 55, 54, 251, 205, 196, 45, 243, 50, 72, 123, 202, 246, 24, 172, 210, 83, 17, 201, 36, 157, 30, 60, 31, 101, 131, 75, 106, 157, 255, 14, 49, 200, 161, 252, 64, 32, 53, 196, 33, 119, 134, 24, 252, 209, 59, 126, 181, 175, 91, 114, 216, 215, 205, 125, 114, 95, 183, 212, 126, 125, 221, 212, 255, 187, 190, 110, 163, 133, 63, 90, 105, 176, 241, 113, 125, 213, 230, 24, 93, 179, 91, 174, 235, 202, 7, 108, 182, 56, 174, 38, 233, 58, 50, 233, 199, 87, 7, 198, 137, 113, 97, 48, 66, 91, 39, 15, 18, 58, 134, 19, 86, 232, 30, 193, 160, 170, 122, 141, 10, 29, 156, 136, 121, 116, 7, 127, 235, 14, 163, 105, 52, 151, 94, 64, 80, 1, 213, 229, 225, 82, 169, 189, 75, 137, 221, 31, 129, 220, 16, 3, 49, 54, 178, 17, 55, 171, 238, 66, 187, 205, 23, 31, 111, 171, 48, 28, 178, 236, 52, 175, 113, 108, 196, 208, 199, 174, 100, 111, 61, 250, 66, 215, 13, 19, 217, 114, 237, 217, 14, 109, 29, 203, 195, 56, 102, 193, 237, 151, 159, 120, 39, 240, 62, 94, 73, 23, 164, 235, 18, 181, 103, 2, 47, 2, 156, 150, 143, 156, 190, 7, 12, 105, 147, 3, 251, 200, 245, 175, 83, 70, 236, 243, 34, 246, 119, 161, 173, 29, 32, 92, 4, 160, 124, 37, 191, 2, 227, 225, 204, 39, 135, 228, 196, 115, 12, 197, 231, 78, 7, 217, 106, 97, 186, 178, 68, 249, 200, 84, 133, 73, 82, 114, 131, 153, 70, 72, 108, 111, 50, 76, 200, 101, 137, 55, 135, 11, 138, 34, 208, 34, 138, 208, 204, 9, 95, 136, 241, 48, 222, 63, 202, 150, 111, 75, 240, 164, 38, 216, 108, 81, 106, 122, 33, 218, 188, 54, 175, 162, 42, 92, 81, 189, 85, 194, 20, 188, 74, 183, 20, 175, 168, 42, 75, 72, 103, 9, 9, 46, 155, 207, 86, 89, 25, 188, 231, 115, 57, 117, 43, 113, 2, 115, 19, 92, 116, 72, 204, 114, 115, 114, 37, 231, 75, 155, 230, 150, 79, 88, 89, 16, 247, 126, 194, 136, 210, 65, 117, 27, 91, 171, 198, 143, 201, 30, 85, 59, 220, 185, 252, 202, 25, 233, 121, 35, 38, 157, 253, 210, 170, 41, 149, 238, 232, 244, 25, 87, 46, 119, 14, 175, 29, 149, 61, 102, 124, 85, 235, 198, 186, 146, 178, 17, 9, 239, 219, 10, 90, 107, 203, 47, 101, 135, 2, 19, 216, 161, 146, 150, 139, 181, 175, 87, 103, 151, 93, 182, 254, 156, 253, 29, 158, 140, 133, 219, 27, 183, 214, 239, 196, 205, 15, 251, 167, 174, 159, 200, 217, 166, 192, 197, 166, 250, 115, 42, 221, 254, 135, 55, 227, 206, 250, 173, 141, 219, 23, 102, 120, 58, 246, 159, 179, 254, 178, 178, 156, 85, 232, 184, 180, 69, 232, 95, 8, 171, 186, 63, 146, 70, 201, 223, 130, 23, 150, 251, 19, 156, 200, 37, 7, 50, 158, 66, 178, 92, 183, 179, 145, 235, 123, 114, 152, 86, 35, 199, 91, 122, 200, 190, 38, 67, 214, 57, 12, 45, 230, 214, 80, 57, 253, 110, 38, 205, 176, 57, 223, 155, 229, 201, 18, 251, 51, 17, 33, 201, 88, 148, 250, 41, 26, 29, 255, 149, 73, 206, 56, 153, 118, 57, 224, 205, 92, 245, 247, 103, 110, 62, 240, 63, 95, 250, 74, 143, 125, 118, 235, 45, 79, 188, 117, 248, 173, 127, 161, 69, 123, 102, 223, 115, 218, 11, 95, 255, 53, 233, 80, 211, 45, 155, 110, 185, 171, 227, 198, 237, 103, 157, 213, 176, 167, 250, 208, 30, 237, 151, 150, 148, 215, 110, 121, 65, 251, 133, 250, 35, 228, 245, 128, 190, 90, 201, 65, 39, 5, 119, 111, 39, 133, 212, 94, 190, 90, 61, 164, 178, 33, 145, 35, 101, 49, 64, 15, 221, 192, 13, 219, 195, 111, 139, 210, 179, 35, 246, 21, 196, 38, 227, 229, 186, 162, 219, 159, 20, 14, 149, 233, 239, 54, 107, 49, 25, 205, 21, 52, 154, 228, 114, 216, 227, 98, 66, 218, 132, 185, 71, 187, 237, 125, 196, 175, 209, 147, 216, 62, 18, 55, 178, 111, 234, 142, 72, 113, 75, 242, 150, 116, 68, 249, 40, 148, 194, 188, 3, 105, 40, 163, 49, 164, 164, 214, 202, 18, 10, 15, 77, 88, 24, 148, 193, 98, 37, 185, 245, 163, 71, 4, 222, 108, 148, 161, 219, 82, 139, 184, 157, 74, 110, 252, 54, 58, 122, 133, 210, 193, 131, 242, 114, 109, 19, 61, 54, 147, 74, 246, 66, 167, 163, 252, 237, 10, 107, 125, 19, 231, 246, 210, 250, 38, 46, 106, 24, 173, 107, 125, 53, 19, 27, 167, 205, 42, 153, 221, 75, 219, 75, 40, 24, 145, 19, 212, 246, 236, 85, 164, 237, 77, 191, 179, 33, 172, 237, 17, 253, 216, 1, 164, 157, 194, 127, 103, 156, 223, 98, 37, 231, 219, 122, 11, 194, 68, 195, 16, 198, 136, 19, 21, 102, 250, 177, 28, 195, 34, 53, 21, 194, 254, 68, 54, 155, 164, 38, 10, 150, 225, 113, 170, 106, 28, 147, 43, 136, 49, 216, 42, 164, 157, 199, 181, 37, 104, 250, 92, 251, 67, 251, 182, 244, 74, 225, 78, 86, 226, 185, 248, 63, 90, 140, 118, 126, 226, 206, 184, 213, 23, 190, 193, 205, 243, 1, 126, 133, 15, 100, 114, 208, 7, 210, 61, 144, 15, 100, 106, 111, 31, 72, 245, 191, 244, 129, 204, 28, 208, 55, 56, 57, 120, 188, 239, 30, 200, 55, 56, 149, 159, 68, 223, 210, 125, 131, 63, 124, 251, 237, 64, 78, 47, 223, 224, 147, 251, 28, 166, 71, 24, 63, 100, 12, 224, 115, 152, 217, 191, 207, 161, 250, 127, 211, 231, 208, 45, 244, 195, 114, 131, 102, 116, 159, 67, 218, 176, 235, 122, 77, 172, 225, 114, 200, 117, 85, 230, 32, 165, 115, 39, 148, 192, 78, 115, 226, 177, 121, 119, 73, 231, 189, 29, 40, 62, 46, 168, 163, 240, 196, 59, 68, 15, 167, 231, 111, 152, 44, 198, 196, 61, 160, 191, 97, 106, 95, 127, 67, 245, 255, 143, 191, 225, 185, 221, 31, 201, 181, 242, 183, 224, 132, 6, 127, 172, 5, 25, 58, 173, 140, 192, 61, 224, 253, 225, 96, 186, 253, 158, 152, 44, 123, 24, 208, 227, 33, 32, 239, 96, 150, 55, 203, 67, 3, 145, 45, 64, 60, 138, 67, 41, 59, 179, 188, 153, 52, 73, 158, 178, 42, 185, 246, 35, 237, 7, 237, 122, 237, 18, 188, 20, 87, 191, 131, 22, 237, 141, 253, 221, 218, 143, 152, 134, 242, 159, 190, 175, 95, 131, 87, 225, 70, 188, 16, 175, 29, 125, 164, 85, 123, 78, 123, 75, 251, 68, 123, 110, 235, 41, 252, 16, 147, 133, 31, 162, 187, 175, 31, 98, 106, 47, 63, 68, 245, 36, 126, 136, 98, 63, 38, 31, 133, 56, 24, 25, 218, 141, 81, 207, 109, 253, 236, 171, 232, 26, 135, 118, 227, 90, 38, 217, 205, 209, 6, 138, 250, 220, 103, 3, 213, 239, 118, 169, 215, 230, 8, 56, 140, 7, 144, 223, 60, 109, 159, 195, 228, 134, 16, 192, 54, 96, 153, 8, 124, 109, 64, 159, 67, 245, 212, 62, 135, 236, 17, 237, 43, 237, 113, 156, 140, 73, 135, 48, 9, 39, 107, 143, 107, 95, 29, 250, 253, 145, 35, 120, 222, 239, 95, 124, 241, 247, 255, 7, 124, 14, 79, 41, 206, 117, 159, 67, 245, 191, 243, 57, 220, 165, 173, 147, 111, 19, 60, 79, 71, 166, 210, 24, 26, 235, 88, 94, 64, 218, 171, 91, 252, 150, 22, 24, 187, 205, 180, 224, 110, 51, 92, 32, 181, 71, 129, 129, 121, 160, 207, 216, 115, 110, 49, 182, 156, 127, 12, 124, 32, 182, 156, 128, 48, 27, 64, 122, 88, 204, 239, 84, 178, 114, 98, 136, 17, 103, 189, 78, 160, 77, 0, 35, 127, 86, 218, 139, 200, 168, 139, 223, 30, 215, 165, 22, 186, 46, 228, 46, 217, 232, 218, 192, 22, 239, 181, 103, 10, 252, 222, 214, 227, 80, 151, 178, 168, 124, 240, 187, 47, 126, 250, 251, 241, 142, 125, 207, 239, 57, 206, 166, 207, 254, 230, 23, 252, 90, 75, 249, 205, 85, 205, 236, 21, 242, 204, 215, 117, 44, 133, 218, 99, 133, 145, 186, 177, 170, 48, 244, 162, 151, 211, 54, 219, 216, 115, 138, 171, 8, 237, 161, 171, 198, 94, 147, 152, 29, 105, 130, 104, 236, 183, 125, 54, 15, 175, 57, 134, 199, 142, 253, 210, 125, 12, 143, 201, 71, 79, 188, 35, 23, 158, 24, 66, 223, 122, 76, 188, 117, 42, 237, 149, 98, 65, 63, 149, 201, 16, 187, 157, 228, 96, 176, 48, 121, 1, 208, 68, 136, 223, 225, 153, 16, 69, 16, 218, 195, 69, 82, 123, 22, 9, 158, 202, 208, 127, 113, 2, 188, 8, 207, 3, 125, 123, 248, 152, 99, 248, 218, 177, 237, 47, 156, 168, 62, 134, 175, 233, 45, 18, 83, 66, 191, 131, 185, 85, 149, 88, 177, 230, 83, 13, 220, 146, 118, 65, 178, 196, 194, 217, 16, 197, 46, 40, 152, 47, 81, 112, 152, 148, 112, 144, 14, 189, 160, 113, 91, 140, 76, 28, 64, 170, 219, 110, 131, 56, 136, 245, 16, 117, 232, 28, 39, 12, 195, 230, 134, 247, 230, 248, 182, 214, 192, 218, 127, 52, 54, 225, 63, 162, 20, 218, 154, 119, 201, 133, 119, 132, 17, 202, 208, 198, 60, 184, 23, 87, 206, 17, 177, 5, 226, 33, 221, 136, 45, 144, 162, 59, 135, 232, 114, 28, 100, 25, 23, 152, 36, 149, 235, 162, 42, 43, 242, 54, 182, 4, 239, 164, 70, 22, 35, 249, 229, 79, 36, 100, 47, 61, 213, 105, 151, 226, 37, 91, 8, 213, 51, 171, 174, 66, 99, 134, 195, 189, 8, 131, 177, 242, 157, 199, 79, 84, 28, 63, 108, 244, 226, 240, 155, 70, 39, 222, 20, 130, 239, 104, 127, 221, 0, 6, 187, 117, 252, 35, 6, 220, 6, 254, 145, 100, 40, 29, 96, 112, 220, 5, 33, 57, 152, 164, 7, 70, 212, 11, 24, 247, 12, 73, 232, 78, 210, 225, 3, 91, 80, 18, 250, 250, 29, 240, 221, 199, 67, 131, 253, 239, 72, 28, 164, 255, 70, 34, 236, 239, 254, 72, 94, 44, 127, 11, 137, 116, 230, 21, 139, 92, 138, 137, 240, 93, 8, 159, 121, 25, 170, 227, 233, 237, 169, 150, 247, 220, 83, 101, 121, 51, 109, 241, 225, 61, 21, 84, 120, 42, 60, 57, 250, 110, 74, 87, 110, 246, 127, 247, 135, 175, 209, 27, 200, 98, 239, 164, 116, 127, 254, 250, 247, 129, 55, 112, 22, 110, 210, 182, 107, 187, 53, 173, 126, 255, 86, 28, 161, 237, 12, 4, 230, 63, 91, 173, 45, 215, 118, 104, 87, 105, 103, 209, 250, 22, 185, 175, 212, 167, 228, 111, 33, 30, 210, 224, 92, 35, 209, 61, 130, 164, 132, 198, 87, 119, 81, 76, 111, 160, 195, 102, 183, 240, 135, 13, 31, 112, 229, 2, 3, 21, 153, 218, 26, 241, 140, 126, 44, 29, 46, 73, 135, 94, 254, 4, 135, 29, 32, 57, 209, 158, 230, 72, 35, 3, 246, 248, 172, 76, 155, 205, 98, 112, 197, 120, 93, 55, 243, 100, 0, 247, 216, 34, 53, 180, 221, 199, 113, 141, 11, 71, 99, 12, 166, 225, 36, 237, 128, 246, 153, 246, 47, 237, 80, 188, 182, 131, 31, 127, 230, 248, 241, 67, 135, 222, 126, 75, 62, 26, 88, 137, 13, 120, 182, 214, 162, 221, 162, 221, 162, 181, 224, 58, 28, 31, 56, 115, 29, 78, 196, 124, 204, 196, 58, 237, 128, 246, 177, 246, 39, 237, 55, 212, 215, 197, 122, 140, 136, 68, 240, 64, 227, 129, 24, 130, 13, 244, 201, 73, 2, 25, 104, 178, 8, 171, 208, 207, 167, 66, 10, 156, 43, 228, 145, 160, 151, 49, 20, 57, 191, 57, 203, 99, 43, 118, 198, 209, 164, 160, 135, 128, 2, 213, 83, 57, 138, 87, 6, 205, 138, 42, 61, 66, 189, 19, 34, 210, 231, 147, 38, 107, 95, 220, 252, 146, 187, 36, 89, 251, 110, 198, 148, 66, 247, 240, 182, 13, 183, 29, 219, 113, 22, 230, 226, 119, 199, 181, 47, 238, 187, 239, 229, 103, 62, 97, 235, 174, 214, 158, 46, 158, 84, 176, 120, 211, 216, 252, 217, 143, 142, 79, 255, 243, 13, 207, 109, 156, 71, 251, 133, 75, 46, 190, 250, 234, 224, 92, 137, 220, 8, 34, 246, 113, 22, 92, 27, 180, 170, 139, 15, 167, 62, 144, 68, 234, 3, 138, 48, 31, 113, 81, 213, 47, 234, 249, 16, 210, 123, 39, 63, 240, 68, 164, 85, 204, 36, 77, 45, 191, 119, 42, 68, 79, 56, 211, 65, 102, 56, 211, 1, 233, 111, 126, 171, 145, 222, 128, 152, 234, 233, 165, 55, 56, 89, 254, 130, 129, 243, 20, 144, 221, 97, 247, 87, 82, 181, 178, 0, 92, 224, 133, 42, 127, 121, 60, 114, 150, 136, 146, 204, 234, 109, 200, 234, 104, 27, 4, 140, 183, 26, 199, 196, 41, 13, 134, 49, 97, 38, 54, 102, 101, 251, 202, 179, 60, 98, 146, 42, 125, 101, 241, 34, 192, 55, 35, 90, 11, 6, 71, 245, 102, 42, 92, 37, 123, 223, 32, 58, 129, 41, 183, 124, 251, 244, 231, 218, 223, 190, 248, 225, 23, 116, 98, 65, 193, 13, 235, 230, 117, 36, 30, 63, 148, 128, 183, 119, 92, 122, 219, 214, 45, 251, 248, 228, 127, 106, 31, 126, 167, 5, 180, 231, 241, 108, 60, 27, 95, 195, 187, 42, 238, 42, 8, 104, 69, 94, 237, 31, 218, 63, 62, 59, 252, 228, 83, 192, 133, 175, 199, 155, 82, 181, 142, 131, 46, 62, 96, 234, 7, 7, 109, 53, 112, 208, 148, 94, 56, 232, 201, 10, 156, 12, 9, 13, 251, 238, 81, 212, 47, 221, 44, 190, 130, 226, 83, 147, 211, 30, 222, 113, 240, 224, 218, 21, 155, 182, 191, 184, 175, 27, 214, 61, 176, 241, 174, 11, 182, 108, 251, 102, 221, 153, 215, 75, 213, 231, 157, 183, 228, 234, 130, 252, 235, 207, 220, 122, 93, 199, 240, 25, 115, 214, 92, 252, 164, 127, 52, 137, 52, 184, 10, 128, 125, 35, 85, 131, 12, 195, 141, 189, 141, 142, 247, 165, 8, 7, 5, 193, 109, 123, 93, 75, 53, 156, 22, 100, 144, 131, 59, 226, 160, 9, 202, 85, 172, 69, 91, 38, 85, 239, 235, 90, 29, 164, 227, 60, 237, 1, 233, 2, 49, 62, 78, 184, 98, 210, 99, 113, 145, 120, 104, 202, 73, 241, 80, 125, 12, 233, 111, 224, 103, 244, 251, 140, 212, 232, 62, 105, 1, 82, 140, 6, 68, 76, 43, 123, 35, 166, 14, 150, 215, 60, 103, 53, 187, 236, 129, 253, 1, 215, 28, 252, 187, 246, 222, 2, 237, 1, 252, 105, 23, 238, 66, 108, 234, 106, 209, 44, 20, 92, 3, 127, 226, 209, 246, 215, 181, 75, 12, 123, 102, 222, 41, 53, 129, 4, 78, 24, 233, 31, 70, 57, 18, 21, 100, 50, 171, 87, 144, 210, 157, 6, 57, 164, 177, 176, 116, 207, 212, 76, 114, 201, 116, 218, 227, 109, 177, 209, 102, 149, 14, 146, 196, 156, 98, 156, 55, 147, 35, 57, 217, 129, 173, 28, 124, 25, 54, 149, 18, 24, 240, 178, 127, 7, 18, 217, 177, 231, 159, 236, 212, 222, 65, 243, 95, 240, 183, 24, 40, 76, 193, 214, 219, 89, 7, 14, 210, 222, 214, 10, 111, 31, 129, 35, 208, 172, 97, 81, 224, 171, 145, 218, 69, 52, 222, 72, 188, 77, 158, 33, 85, 195, 36, 88, 225, 183, 38, 35, 103, 67, 236, 4, 40, 232, 20, 153, 76, 246, 129, 226, 216, 123, 185, 66, 216, 66, 74, 131, 197, 28, 82, 9, 6, 186, 41, 40, 49, 9, 0, 38, 193, 164, 186, 137, 163, 253, 131, 74, 242, 114, 115, 178, 179, 188, 169, 238, 68, 155, 85, 77, 36, 59, 127, 69, 85, 84, 37, 150, 121, 43, 171, 42, 71, 177, 202, 42, 194, 78, 115, 115, 114, 141, 40, 27, 46, 66, 79, 9, 84, 37, 239, 37, 159, 147, 10, 171, 138, 74, 184, 170, 43, 193, 229, 13, 150, 205, 201, 245, 218, 88, 221, 164, 65, 165, 124, 66, 82, 110, 113, 236, 31, 139, 179, 115, 148, 138, 188, 225, 231, 76, 27, 237, 80, 227, 6, 77, 140, 159, 179, 116, 164, 171, 180, 118, 80, 238, 75, 185, 131, 106, 7, 39, 142, 92, 58, 215, 54, 113, 80, 156, 234, 24, 125, 198, 154, 225, 185, 149, 74, 78, 118, 241, 59, 49, 37, 57, 73, 19, 120, 233, 160, 73, 248, 125, 241, 124, 101, 125, 97, 221, 44, 119, 109, 109, 189, 117, 81, 253, 202, 156, 236, 43, 10, 98, 115, 86, 153, 58, 230, 110, 175, 56, 187, 209, 130, 248, 39, 205, 131, 150, 198, 179, 43, 182, 207, 237, 48, 173, 202, 137, 45, 184, 34, 59, 103, 101, 253, 34, 107, 125, 109, 173, 123, 86, 93, 225, 122, 101, 126, 177, 22, 11, 8, 77, 221, 95, 241, 233, 82, 19, 100, 193, 40, 255, 8, 3, 67, 77, 237, 129, 161, 246, 6, 79, 61, 33, 65, 159, 41, 55, 22, 156, 26, 27, 141, 116, 194, 109, 186, 253, 186, 182, 29, 119, 222, 89, 82, 113, 213, 213, 155, 54, 157, 185, 115, 199, 173, 47, 126, 178, 100, 241, 185, 235, 180, 127, 189, 118, 78, 194, 238, 17, 173, 211, 23, 47, 190, 120, 242, 228, 198, 201, 85, 119, 149, 92, 117, 209, 228, 75, 42, 178, 218, 23, 222, 240, 188, 152, 243, 235, 0, 248, 87, 82, 245, 0, 216, 104, 74, 239, 205, 84, 74, 67, 207, 205, 84, 127, 216, 232, 117, 135, 240, 253, 35, 135, 180, 124, 169, 186, 235, 85, 94, 217, 249, 60, 175, 236, 122, 85, 96, 163, 226, 93, 74, 205, 233, 98, 163, 41, 167, 129, 141, 166, 156, 28, 27, 77, 105, 248, 21, 216, 104, 223, 163, 73, 163, 39, 43, 251, 96, 163, 145, 125, 83, 106, 122, 97, 163, 196, 215, 183, 72, 213, 80, 2, 115, 15, 184, 79, 138, 141, 166, 68, 96, 163, 3, 222, 13, 65, 163, 37, 80, 82, 92, 148, 155, 227, 73, 178, 133, 160, 81, 3, 25, 117, 122, 35, 113, 209, 52, 46, 252, 235, 188, 182, 157, 191, 29, 52, 102, 144, 39, 214, 156, 56, 104, 244, 220, 113, 214, 212, 130, 140, 164, 168, 40, 123, 161, 111, 120, 102, 118, 213, 160, 18, 119, 98, 116, 97, 105, 169, 227, 237, 180, 186, 134, 218, 148, 209, 82, 181, 86, 146, 81, 89, 155, 159, 52, 122, 238, 48, 119, 135, 205, 91, 230, 201, 40, 27, 87, 156, 108, 138, 170, 154, 56, 171, 56, 47, 161, 166, 110, 66, 82, 249, 182, 237, 155, 138, 52, 135, 160, 155, 60, 0, 201, 42, 252, 20, 199, 29, 32, 99, 29, 163, 143, 241, 6, 180, 37, 40, 69, 231, 14, 189, 47, 166, 26, 1, 181, 226, 33, 222, 102, 115, 9, 242, 169, 168, 172, 172, 168, 170, 170, 16, 202, 144, 211, 161, 114, 198, 242, 46, 222, 96, 146, 216, 135, 71, 180, 59, 165, 232, 184, 119, 58, 44, 95, 127, 247, 209, 150, 149, 157, 207, 243, 187, 227, 19, 199, 117, 131, 246, 149, 246, 55, 64, 184, 27, 64, 250, 179, 144, 17, 83, 158, 140, 54, 15, 136, 135, 166, 12, 132, 135, 166, 52, 244, 196, 67, 99, 32, 198, 110, 179, 235, 120, 168, 128, 94, 108, 94, 94, 133, 94, 228, 119, 191, 135, 83, 24, 150, 125, 249, 34, 99, 218, 119, 207, 104, 255, 195, 164, 234, 174, 71, 249, 212, 206, 231, 249, 80, 37, 96, 101, 31, 117, 125, 100, 172, 167, 15, 165, 234, 1, 48, 209, 148, 129, 48, 209, 148, 134, 211, 195, 68, 175, 59, 130, 135, 143, 28, 209, 104, 182, 38, 224, 161, 206, 231, 241, 123, 45, 22, 78, 226, 11, 230, 137, 128, 67, 51, 251, 241, 5, 83, 255, 79, 249, 130, 81, 191, 95, 148, 170, 65, 133, 234, 255, 134, 16, 194, 136, 154, 17, 89, 237, 58, 238, 59, 162, 205, 164, 244, 202, 252, 238, 174, 5, 162, 143, 55, 105, 235, 164, 68, 169, 9, 98, 32, 17, 90, 252, 81, 113, 177, 18, 151, 100, 242, 240, 34, 59, 44, 210, 114, 221, 161, 104, 16, 41, 161, 232, 14, 153, 196, 85, 211, 72, 101, 77, 23, 18, 222, 16, 166, 158, 62, 37, 154, 253, 102, 111, 86, 86, 166, 142, 67, 80, 36, 231, 144, 30, 152, 107, 179, 137, 76, 47, 57, 222, 76, 156, 115, 228, 200, 121, 111, 236, 121, 83, 251, 247, 191, 91, 230, 111, 31, 135, 238, 213, 11, 182, 106, 235, 228, 87, 94, 255, 66, 251, 240, 7, 237, 47, 218, 209, 36, 118, 56, 240, 110, 50, 62, 254, 248, 49, 162, 135, 150, 238, 175, 248, 3, 194, 231, 186, 196, 95, 72, 88, 168, 35, 140, 133, 26, 32, 168, 39, 4, 130, 102, 66, 163, 55, 4, 118, 122, 51, 33, 152, 111, 134, 50, 167, 8, 59, 236, 42, 254, 192, 222, 199, 62, 212, 254, 7, 93, 255, 185, 244, 161, 187, 151, 104, 247, 28, 188, 123, 227, 29, 158, 97, 56, 188, 19, 163, 176, 56, 235, 254, 82, 109, 254, 243, 239, 140, 19, 235, 51, 31, 128, 95, 220, 99, 62, 66, 248, 102, 74, 24, 223, 236, 125, 241, 36, 8, 39, 182, 105, 7, 121, 179, 118, 144, 223, 125, 231, 157, 98, 62, 16, 172, 218, 58, 254, 47, 177, 246, 242, 252, 217, 38, 153, 209, 193, 136, 62, 248, 0, 124, 17, 85, 152, 198, 41, 64, 122, 12, 68, 199, 219, 61, 52, 187, 118, 18, 97, 85, 132, 185, 249, 144, 41, 222, 41, 41, 119, 46, 41, 211, 86, 254, 17, 139, 177, 248, 105, 109, 237, 170, 221, 95, 107, 235, 246, 77, 222, 33, 85, 119, 125, 204, 179, 58, 63, 210, 190, 37, 178, 130, 38, 109, 157, 50, 74, 41, 132, 98, 168, 134, 201, 112, 190, 223, 158, 148, 192, 20, 54, 121, 84, 117, 137, 164, 42, 197, 140, 161, 106, 224, 202, 94, 225, 203, 111, 70, 10, 101, 68, 134, 70, 41, 194, 51, 5, 23, 18, 220, 228, 14, 17, 194, 201, 74, 165, 234, 165, 154, 253, 49, 13, 147, 178, 243, 114, 243, 178, 157, 121, 217, 113, 22, 58, 232, 245, 218, 132, 181, 57, 11, 133, 116, 16, 126, 44, 33, 96, 128, 60, 253, 121, 132, 187, 92, 89, 101, 149, 175, 167, 29, 47, 223, 115, 56, 190, 100, 216, 132, 130, 117, 247, 46, 45, 41, 109, 221, 181, 174, 160, 166, 178, 48, 190, 191, 75, 69, 11, 198, 214, 46, 25, 149, 146, 52, 124, 97, 237, 216, 214, 226, 94, 127, 106, 235, 48, 144, 88, 156, 229, 92, 120, 231, 75, 203, 150, 189, 116, 231, 66, 103, 86, 113, 34, 107, 9, 220, 211, 231, 218, 91, 131, 70, 76, 108, 187, 166, 166, 230, 154, 182, 137, 195, 7, 71, 254, 214, 113, 84, 254, 23, 169, 122, 96, 28, 245, 148, 162, 191, 23, 142, 106, 215, 113, 84, 92, 168, 61, 252, 5, 198, 253, 120, 80, 219, 143, 203, 63, 215, 62, 212, 78, 176, 106, 6, 90, 43, 222, 28, 248, 36, 240, 37, 238, 215, 234, 5, 237, 92, 167, 61, 32, 221, 40, 222, 223, 22, 212, 236, 9, 38, 133, 148, 129, 113, 84, 195, 190, 74, 66, 105, 122, 159, 162, 114, 163, 123, 128, 91, 169, 33, 71, 237, 129, 32, 214, 235, 142, 224, 135, 71, 238, 222, 47, 85, 119, 181, 240, 123, 130, 186, 58, 32, 44, 0, 224, 215, 73, 213, 39, 199, 87, 207, 164, 38, 164, 244, 197, 87, 35, 174, 15, 140, 175, 218, 42, 194, 248, 42, 123, 109, 221, 53, 175, 222, 121, 164, 105, 221, 37, 179, 94, 194, 135, 74, 95, 67, 249, 39, 237, 139, 251, 206, 47, 99, 82, 231, 243, 65, 124, 245, 110, 0, 249, 53, 49, 94, 53, 145, 254, 181, 125, 70, 161, 239, 229, 1, 172, 41, 194, 56, 235, 221, 47, 224, 217, 47, 224, 186, 23, 140, 17, 224, 247, 136, 151, 34, 220, 173, 61, 160, 108, 19, 254, 138, 27, 131, 115, 228, 209, 43, 23, 134, 76, 234, 2, 90, 53, 238, 160, 85, 147, 178, 0, 20, 37, 45, 148, 58, 40, 25, 20, 84, 166, 247, 83, 90, 109, 116, 15, 120, 51, 85, 165, 169, 138, 211, 189, 27, 109, 186, 127, 66, 106, 143, 182, 250, 108, 94, 219, 221, 47, 224, 121, 47, 96, 219, 11, 251, 67, 115, 22, 154, 55, 6, 57, 0, 210, 30, 169, 26, 226, 192, 13, 179, 7, 192, 96, 83, 122, 97, 176, 39, 43, 96, 160, 176, 238, 164, 147, 162, 176, 66, 67, 39, 248, 18, 27, 181, 247, 241, 219, 175, 207, 185, 183, 181, 204, 183, 114, 119, 219, 159, 63, 220, 124, 86, 210, 144, 89, 163, 62, 225, 119, 223, 169, 205, 47, 110, 218, 56, 249, 140, 139, 166, 23, 116, 44, 108, 46, 24, 87, 154, 76, 188, 109, 39, 128, 156, 35, 120, 104, 60, 164, 194, 12, 67, 81, 4, 80, 100, 80, 150, 211, 14, 58, 133, 246, 127, 184, 64, 149, 12, 33, 58, 224, 93, 161, 86, 217, 98, 99, 83, 221, 78, 123, 108, 124, 108, 124, 188, 45, 47, 131, 20, 69, 12, 181, 211, 19, 12, 54, 155, 29, 110, 110, 203, 35, 70, 83, 181, 155, 143, 112, 124, 169, 107, 232, 157, 193, 22, 223, 195, 110, 55, 154, 75, 66, 152, 62, 161, 102, 3, 19, 216, 197, 59, 162, 221, 73, 253, 225, 173, 41, 125, 240, 214, 126, 239, 25, 120, 107, 146, 107, 96, 188, 53, 212, 214, 157, 71, 94, 49, 218, 122, 248, 80, 176, 145, 191, 147, 170, 251, 25, 85, 226, 47, 243, 187, 191, 226, 135, 165, 153, 144, 1, 195, 253, 67, 108, 200, 165, 184, 30, 88, 107, 111, 144, 181, 199, 222, 43, 47, 59, 211, 22, 239, 213, 247, 94, 100, 152, 82, 165, 131, 168, 138, 90, 209, 195, 81, 99, 254, 164, 91, 106, 246, 254, 38, 74, 27, 141, 135, 163, 30, 186, 177, 250, 222, 57, 147, 118, 78, 216, 241, 214, 246, 49, 53, 151, 29, 109, 111, 124, 48, 253, 214, 244, 180, 67, 135, 110, 188, 245, 129, 196, 164, 123, 236, 241, 141, 55, 28, 191, 228, 162, 183, 111, 153, 158, 164, 199, 102, 234, 254, 74, 222, 35, 124, 247, 211, 224, 183, 193, 176, 86, 233, 32, 19, 33, 178, 229, 194, 29, 155, 66, 121, 5, 5, 233, 66, 85, 39, 84, 195, 237, 185, 159, 98, 156, 11, 241, 38, 34, 44, 100, 70, 68, 88, 48, 138, 138, 66, 136, 202, 66, 80, 20, 79, 223, 226, 39, 43, 153, 26, 89, 146, 50, 41, 90, 9, 146, 181, 197, 103, 101, 138, 51, 18, 135, 177, 59, 205, 84, 184, 215, 230, 165, 53, 90, 25, 14, 185, 194, 254, 178, 105, 211, 69, 155, 54, 109, 218, 116, 209, 27, 251, 143, 28, 217, 111, 255, 230, 181, 143, 127, 248, 225, 227, 215, 255, 198, 178, 222, 249, 227, 241, 227, 111, 31, 127, 83, 251, 76, 43, 148, 170, 181, 194, 191, 162, 170, 189, 174, 5, 180, 46, 237, 117, 164, 70, 251, 1, 248, 111, 165, 106, 72, 128, 116, 152, 114, 32, 234, 100, 24, 108, 74, 24, 131, 29, 224, 94, 8, 133, 45, 212, 81, 88, 175, 64, 17, 188, 132, 42, 232, 40, 172, 143, 180, 78, 61, 36, 145, 143, 253, 251, 217, 241, 245, 163, 242, 211, 62, 25, 63, 36, 121, 254, 236, 101, 23, 60, 48, 227, 127, 48, 227, 165, 139, 182, 172, 219, 208, 190, 22, 15, 142, 187, 100, 213, 196, 170, 51, 207, 44, 125, 162, 60, 251, 238, 69, 143, 79, 41, 232, 124, 158, 239, 105, 106, 154, 61, 219, 240, 7, 228, 157, 74, 141, 192, 117, 146, 96, 146, 191, 54, 140, 236, 232, 62, 90, 92, 105, 55, 157, 2, 227, 73, 74, 116, 37, 244, 192, 121, 204, 3, 227, 60, 94, 244, 13, 132, 245, 172, 39, 107, 225, 254, 1, 31, 105, 155, 158, 115, 185, 73, 247, 193, 148, 116, 47, 204, 235, 253, 150, 112, 139, 131, 235, 186, 200, 98, 52, 87, 56, 94, 168, 65, 235, 250, 228, 134, 126, 27, 239, 14, 59, 130, 113, 69, 93, 110, 57, 105, 79, 155, 253, 153, 201, 201, 9, 9, 0, 97, 111, 205, 68, 87, 143, 142, 71, 15, 216, 113, 195, 111, 99, 160, 206, 7, 190, 216, 247, 233, 190, 1, 58, 127, 173, 225, 191, 137, 96, 161, 152, 72, 74, 33, 120, 97, 171, 63, 54, 211, 202, 204, 38, 23, 170, 230, 4, 84, 66, 58, 101, 30, 229, 210, 106, 231, 136, 18, 17, 33, 144, 39, 133, 201, 44, 155, 90, 45, 104, 54, 187, 9, 244, 22, 75, 133, 100, 223, 73, 139, 166, 70, 22, 21, 192, 34, 120, 193, 155, 237, 205, 166, 197, 100, 179, 89, 213, 244, 66, 114, 74, 161, 46, 17, 22, 170, 227, 226, 20, 64, 70, 119, 18, 161, 37, 166, 218, 118, 94, 123, 237, 17, 92, 165, 93, 191, 241, 157, 171, 31, 124, 232, 150, 137, 147, 70, 85, 79, 172, 155, 48, 237, 193, 45, 210, 99, 219, 255, 245, 175, 237, 218, 244, 47, 158, 184, 37, 144, 177, 243, 17, 231, 78, 247, 217, 103, 177, 13, 23, 46, 255, 54, 240, 168, 224, 55, 2, 167, 85, 106, 132, 191, 151, 126, 98, 154, 34, 60, 186, 20, 164, 228, 79, 97, 128, 54, 196, 178, 7, 190, 45, 184, 182, 93, 81, 0, 200, 235, 203, 164, 82, 208, 44, 155, 77, 13, 225, 184, 220, 238, 67, 129, 229, 86, 147, 73, 190, 0, 116, 101, 78, 134, 246, 58, 46, 246, 164, 193, 151, 227, 144, 161, 13, 57, 203, 64, 89, 18, 232, 71, 125, 111, 135, 47, 79, 200, 225, 43, 19, 26, 51, 109, 241, 217, 121, 2, 19, 203, 166, 196, 111, 134, 38, 158, 16, 12, 173, 166, 168, 20, 156, 129, 198, 170, 138, 63, 121, 198, 131, 141, 237, 71, 47, 171, 25, 179, 253, 173, 29, 19, 118, 78, 154, 115, 111, 245, 141, 15, 69, 225, 97, 109, 116, 212, 227, 251, 106, 110, 153, 148, 158, 158, 52, 253, 150, 183, 47, 186, 228, 248, 13, 141, 241, 246, 123, 146, 18, 31, 184, 245, 198, 67, 135, 210, 210, 137, 30, 206, 237, 254, 74, 207, 27, 89, 226, 47, 236, 145, 53, 178, 199, 126, 77, 167, 230, 76, 222, 152, 231, 205, 213, 157, 180, 78, 146, 228, 177, 223, 180, 142, 253, 103, 115, 4, 6, 215, 2, 96, 171, 200, 91, 167, 130, 78, 132, 46, 10, 130, 160, 159, 56, 5, 247, 212, 6, 203, 115, 2, 147, 67, 177, 221, 105, 23, 167, 179, 187, 248, 222, 89, 209, 4, 252, 239, 179, 241, 10, 159, 115, 231, 145, 55, 63, 32, 129, 46, 243, 15, 62, 0, 222, 253, 103, 0, 150, 36, 63, 173, 199, 151, 215, 181, 166, 100, 147, 172, 114, 122, 103, 200, 223, 38, 165, 33, 116, 248, 144, 40, 33, 19, 247, 67, 239, 13, 29, 59, 12, 24, 83, 94, 127, 191, 177, 110, 119, 30, 209, 182, 238, 251, 211, 62, 209, 140, 104, 99, 61, 178, 238, 251, 180, 117, 56, 73, 216, 177, 199, 193, 28, 195, 211, 138, 133, 60, 173, 220, 164, 107, 11, 35, 117, 97, 218, 47, 4, 164, 48, 154, 73, 163, 125, 74, 18, 112, 114, 80, 36, 250, 233, 117, 175, 217, 31, 29, 29, 29, 29, 23, 29, 23, 159, 25, 212, 193, 185, 190, 170, 124, 182, 56, 79, 38, 249, 128, 207, 139, 155, 48, 163, 242, 37, 237, 217, 57, 239, 188, 164, 109, 185, 86, 109, 189, 134, 90, 166, 173, 83, 174, 161, 100, 128, 12, 70, 3, 40, 95, 75, 213, 144, 9, 197, 134, 167, 122, 6, 201, 69, 41, 152, 199, 58, 120, 150, 73, 35, 36, 73, 184, 48, 36, 143, 78, 94, 196, 56, 166, 41, 46, 204, 246, 66, 38, 100, 122, 114, 109, 121, 30, 179, 234, 46, 196, 176, 250, 35, 14, 23, 98, 36, 129, 253, 86, 243, 248, 138, 10, 12, 233, 67, 210, 130, 79, 66, 186, 219, 10, 237, 65, 158, 88, 213, 122, 223, 5, 133, 158, 242, 166, 177, 165, 25, 113, 236, 173, 99, 65, 21, 233, 173, 72, 21, 137, 223, 93, 190, 248, 205, 23, 246, 110, 153, 212, 225, 172, 94, 115, 8, 45, 79, 173, 236, 106, 50, 180, 38, 6, 59, 1, 148, 34, 33, 115, 61, 48, 71, 63, 141, 14, 226, 250, 42, 7, 225, 119, 149, 66, 76, 12, 23, 152, 21, 67, 23, 61, 201, 125, 209, 59, 59, 128, 39, 61, 201, 5, 9, 224, 244, 16, 155, 179, 89, 212, 20, 82, 158, 109, 222, 126, 76, 0, 244, 126, 113, 187, 214, 240, 210, 75, 248, 196, 145, 7, 140, 238, 221, 113, 115, 176, 47, 59, 153, 57, 240, 179, 84, 173, 181, 224, 61, 125, 213, 62, 4, 11, 0, 255, 82, 161, 248, 112, 243, 15, 70, 19, 43, 54, 84, 134, 52, 21, 101, 19, 74, 32, 11, 137, 203, 16, 24, 165, 93, 194, 150, 208, 60, 157, 172, 128, 232, 71, 20, 181, 157, 12, 100, 60, 228, 59, 134, 61, 195, 215, 121, 195, 124, 27, 91, 54, 253, 225, 234, 7, 143, 76, 152, 182, 251, 226, 35, 196, 169, 103, 107, 187, 185, 244, 197, 19, 55, 7, 22, 177, 121, 130, 25, 75, 143, 109, 255, 240, 195, 237, 186, 14, 72, 56, 177, 82, 3, 37, 80, 6, 23, 246, 64, 138, 115, 132, 67, 188, 5, 77, 96, 106, 239, 7, 22, 54, 135, 64, 227, 211, 41, 40, 182, 160, 73, 131, 6, 1, 12, 42, 27, 84, 86, 58, 56, 18, 71, 182, 158, 30, 142, 76, 190, 188, 191, 2, 75, 78, 10, 242, 253, 211, 5, 148, 117, 225, 208, 235, 140, 224, 84, 190, 85, 41, 3, 251, 86, 165, 52, 156, 190, 111, 149, 129, 241, 99, 115, 135, 118, 248, 203, 72, 92, 159, 154, 37, 218, 36, 112, 46, 165, 6, 98, 32, 25, 206, 58, 168, 3, 93, 122, 220, 99, 253, 204, 65, 85, 197, 230, 33, 185, 33, 2, 255, 50, 5, 241, 47, 178, 59, 10, 29, 76, 160, 172, 182, 246, 45, 162, 159, 77, 56, 29, 246, 224, 89, 35, 161, 101, 230, 190, 104, 89, 100, 210, 211, 190, 200, 217, 154, 136, 52, 168, 189, 81, 52, 245, 205, 125, 251, 174, 127, 244, 145, 32, 238, 162, 227, 211, 103, 7, 247, 244, 34, 8, 80, 74, 127, 126, 82, 198, 168, 75, 92, 218, 214, 167, 16, 237, 155, 251, 191, 149, 202, 79, 134, 184, 120, 130, 136, 203, 225, 35, 218, 1, 125, 251, 222, 249, 188, 246, 0, 54, 147, 236, 221, 79, 123, 74, 229, 123, 80, 13, 140, 131, 176, 115, 108, 15, 27, 203, 134, 112, 75, 7, 232, 153, 106, 25, 7, 182, 98, 0, 228, 210, 120, 225, 126, 118, 246, 254, 192, 227, 242, 81, 204, 215, 10, 79, 124, 65, 182, 99, 0, 188, 82, 46, 7, 149, 236, 157, 40, 127, 170, 139, 140, 176, 240, 28, 227, 69, 97, 128, 180, 191, 102, 68, 192, 167, 253, 221, 160, 134, 244, 211, 146, 10, 106, 201, 30, 124, 127, 191, 54, 84, 170, 254, 73, 155, 103, 156, 113, 39, 1, 168, 231, 170, 59, 132, 188, 155, 253, 164, 85, 137, 60, 193, 72, 2, 69, 86, 136, 108, 40, 80, 7, 3, 24, 210, 160, 82, 212, 199, 161, 52, 246, 189, 238, 113, 104, 23, 247, 70, 16, 49, 217, 0, 226, 40, 76, 66, 20, 68, 217, 226, 227, 109, 38, 115, 114, 161, 71, 183, 116, 244, 161, 215, 204, 40, 199, 206, 77, 218, 37, 175, 226, 32, 156, 243, 42, 54, 224, 184, 135, 181, 5, 111, 105, 79, 106, 15, 227, 219, 180, 6, 228, 163, 157, 171, 165, 235, 58, 47, 148, 182, 157, 24, 34, 173, 235, 188, 50, 228, 99, 104, 5, 144, 15, 41, 53, 162, 173, 155, 35, 218, 74, 161, 201, 82, 64, 86, 8, 179, 8, 38, 95, 23, 205, 29, 30, 110, 110, 255, 253, 25, 62, 112, 127, 140, 123, 212, 159, 129, 58, 100, 183, 251, 136, 47, 121, 57, 1, 143, 62, 180, 105, 39, 158, 123, 241, 196, 145, 143, 191, 216, 163, 189, 249, 210, 95, 181, 46, 60, 159, 182, 63, 82, 117, 215, 27, 188, 172, 235, 53, 94, 209, 249, 60, 159, 216, 245, 100, 112, 81, 247, 28, 247, 5, 189, 198, 61, 217, 136, 60, 14, 74, 223, 129, 239, 125, 179, 199, 200, 59, 163, 163, 1, 72, 205, 136, 141, 49, 26, 107, 238, 61, 250, 4, 148, 246, 63, 250, 42, 177, 205, 254, 70, 95, 59, 220, 103, 252, 183, 245, 26, 255, 84, 19, 42, 50, 205, 193, 22, 144, 229, 129, 38, 160, 79, 199, 134, 159, 164, 99, 61, 167, 96, 224, 158, 133, 167, 129, 102, 163, 207, 52, 252, 134, 58, 213, 223, 44, 80, 167, 184, 62, 15, 177, 162, 79, 14, 56, 187, 215, 76, 184, 85, 148, 173, 38, 11, 87, 64, 38, 107, 67, 177, 6, 204, 161, 22, 167, 209, 21, 133, 43, 192, 183, 68, 150, 51, 135, 166, 35, 213, 102, 163, 102, 219, 28, 54, 135, 61, 190, 103, 227, 163, 122, 78, 11, 162, 93, 215, 75, 251, 159, 155, 41, 34, 138, 72, 175, 153, 9, 70, 7, 224, 193, 121, 145, 159, 214, 251, 112, 86, 175, 62, 164, 138, 14, 40, 114, 139, 138, 178, 60, 196, 152, 153, 112, 47, 250, 246, 113, 120, 195, 175, 233, 66, 120, 252, 185, 209, 133, 222, 115, 160, 238, 251, 116, 95, 159, 41, 48, 148, 110, 97, 183, 42, 15, 85, 119, 128, 9, 162, 96, 250, 147, 170, 132, 17, 25, 194, 92, 196, 227, 201, 215, 155, 11, 175, 229, 33, 226, 60, 113, 40, 41, 219, 61, 239, 48, 104, 167, 59, 35, 116, 64, 47, 202, 106, 49, 131, 9, 76, 241, 241, 138, 153, 172, 60, 130, 35, 109, 179, 123, 209, 199, 74, 3, 223, 126, 135, 187, 240, 133, 23, 2, 175, 214, 16, 191, 97, 173, 188, 37, 112, 6, 219, 215, 181, 59, 112, 125, 136, 215, 216, 41, 238, 183, 200, 121, 110, 133, 73, 7, 184, 30, 13, 149, 56, 13, 5, 34, 33, 31, 6, 202, 145, 58, 172, 129, 26, 53, 156, 44, 159, 70, 82, 155, 18, 122, 182, 214, 184, 209, 252, 36, 41, 111, 54, 217, 236, 54, 230, 220, 43, 88, 70, 135, 182, 249, 40, 230, 98, 205, 35, 129, 231, 143, 225, 62, 157, 93, 28, 230, 163, 79, 84, 41, 215, 136, 118, 32, 108, 97, 233, 124, 11, 123, 130, 34, 108, 157, 60, 127, 56, 229, 52, 223, 194, 129, 165, 119, 116, 232, 207, 169, 167, 255, 156, 26, 122, 14, 88, 186, 9, 78, 231, 57, 91, 133, 199, 105, 210, 31, 35, 179, 110, 109, 138, 180, 82, 84, 171, 66, 149, 200, 234, 92, 4, 32, 161, 238, 96, 201, 57, 155, 73, 166, 124, 45, 50, 114, 198, 7, 74, 152, 237, 212, 211, 125, 73, 43, 59, 111, 148, 86, 110, 220, 184, 81, 123, 125, 227, 70, 64, 152, 203, 127, 196, 51, 148, 66, 48, 67, 170, 63, 153, 166, 186, 46, 162, 97, 36, 2, 39, 219, 28, 204, 156, 72, 81, 86, 156, 78, 159, 211, 91, 17, 135, 103, 44, 88, 240, 179, 82, 88, 231, 126, 217, 249, 211, 187, 56, 158, 246, 213, 252, 71, 92, 41, 234, 72, 244, 59, 169, 142, 137, 6, 216, 62, 12, 26, 131, 143, 219, 67, 121, 175, 207, 165, 172, 215, 63, 214, 185, 95, 166, 140, 215, 79, 82, 65, 88, 161, 189, 143, 45, 240, 51, 152, 161, 100, 191, 120, 60, 72, 158, 86, 3, 153, 24, 10, 141, 238, 240, 95, 35, 160, 177, 121, 127, 239, 90, 87, 44, 88, 240, 179, 246, 126, 157, 251, 81, 170, 245, 109, 58, 227, 225, 63, 226, 76, 163, 77, 102, 96, 56, 209, 120, 126, 24, 54, 218, 236, 244, 52, 230, 216, 42, 124, 78, 175, 211, 225, 194, 153, 63, 255, 188, 96, 1, 255, 49, 17, 39, 190, 251, 179, 227, 101, 55, 48, 24, 193, 127, 100, 63, 136, 231, 237, 144, 229, 247, 88, 45, 8, 18, 212, 245, 72, 81, 46, 32, 231, 201, 54, 151, 205, 65, 153, 235, 195, 3, 148, 29, 30, 42, 223, 130, 5, 63, 179, 131, 61, 198, 203, 29, 26, 56, 177, 22, 150, 240, 31, 121, 178, 254, 158, 52, 191, 59, 248, 158, 137, 161, 23, 224, 48, 104, 52, 222, 16, 238, 173, 43, 244, 107, 201, 130, 5, 63, 31, 237, 49, 162, 137, 17, 67, 75, 245, 107, 207, 242, 100, 49, 182, 118, 168, 60, 168, 87, 175, 135, 30, 166, 250, 161, 133, 222, 37, 70, 56, 226, 111, 26, 227, 131, 167, 243, 94, 237, 217, 126, 223, 139, 112, 147, 246, 62, 255, 68, 248, 12, 212, 251, 205, 22, 51, 67, 41, 204, 53, 99, 25, 2, 133, 147, 229, 139, 1, 113, 8, 109, 53, 19, 141, 140, 129, 156, 195, 76, 106, 128, 200, 71, 6, 124, 114, 232, 56, 210, 102, 179, 203, 230, 164, 66, 180, 203, 21, 50, 247, 241, 108, 103, 182, 29, 223, 75, 36, 237, 50, 241, 72, 18, 206, 214, 118, 39, 105, 239, 75, 171, 147, 58, 18, 89, 90, 224, 179, 196, 142, 164, 206, 235, 122, 182, 195, 14, 211, 253, 54, 114, 144, 142, 137, 86, 100, 178, 79, 136, 250, 255, 209, 30, 59, 216, 201, 180, 197, 104, 143, 45, 212, 38, 91, 68, 187, 34, 218, 166, 183, 143, 165, 39, 117, 36, 225, 255, 132, 27, 169, 197, 39, 117, 36, 5, 254, 66, 237, 156, 202, 10, 217, 70, 113, 102, 144, 236, 119, 49, 35, 76, 230, 104, 104, 68, 8, 186, 176, 113, 115, 66, 33, 82, 56, 86, 221, 58, 12, 23, 173, 190, 247, 190, 182, 182, 123, 239, 103, 133, 247, 175, 109, 187, 247, 190, 115, 219, 238, 33, 14, 241, 59, 0, 117, 172, 240, 57, 82, 195, 241, 18, 226, 245, 192, 188, 4, 235, 40, 50, 226, 80, 234, 99, 239, 139, 35, 78, 138, 240, 152, 9, 225, 241, 132, 254, 251, 29, 34, 62, 175, 127, 80, 235, 54, 62, 52, 230, 42, 36, 117, 127, 164, 254, 77, 228, 247, 116, 64, 6, 228, 67, 5, 84, 195, 24, 208, 38, 61, 230, 158, 50, 203, 63, 44, 22, 25, 153, 93, 51, 104, 53, 163, 108, 165, 28, 57, 106, 43, 68, 69, 13, 9, 133, 229, 29, 210, 96, 183, 216, 184, 162, 72, 11, 227, 77, 113, 92, 146, 134, 54, 56, 48, 58, 122, 104, 180, 161, 233, 12, 131, 40, 140, 106, 119, 96, 180, 20, 189, 173, 223, 202, 6, 170, 135, 142, 113, 135, 68, 60, 108, 120, 15, 246, 87, 73, 207, 71, 71, 72, 164, 39, 13, 31, 53, 170, 170, 178, 176, 32, 211, 147, 224, 36, 99, 155, 81, 99, 70, 141, 25, 237, 31, 49, 172, 178, 186, 170, 186, 116, 80, 65, 69, 97, 69, 110, 182, 39, 63, 51, 63, 37, 217, 153, 145, 144, 17, 31, 23, 229, 136, 118, 4, 231, 206, 217, 107, 238, 114, 203, 4, 186, 96, 139, 235, 247, 162, 220, 239, 69, 146, 109, 147, 175, 126, 104, 207, 117, 215, 238, 217, 115, 253, 131, 179, 235, 31, 224, 35, 175, 137, 252, 107, 145, 248, 235, 225, 135, 175, 127, 104, 78, 253, 3, 39, 170, 36, 255, 101, 157, 207, 178, 47, 94, 125, 112, 207, 209, 151, 31, 122, 228, 165, 197, 104, 219, 112, 131, 246, 189, 246, 93, 215, 161, 87, 30, 138, 188, 244, 157, 246, 253, 226, 158, 133, 190, 211, 190, 223, 174, 196, 255, 242, 63, 52, 151, 8, 9, 252, 35, 193, 219, 141, 220, 248, 128, 219, 0, 96, 24, 132, 114, 227, 147, 152, 179, 251, 120, 194, 249, 159, 126, 200, 63, 98, 83, 2, 143, 209, 115, 12, 156, 252, 35, 246, 156, 120, 78, 37, 254, 41, 115, 137, 35, 160, 216, 107, 14, 131, 198, 126, 132, 148, 221, 238, 227, 62, 187, 143, 59, 207, 251, 244, 131, 79, 206, 251, 244, 131, 96, 101, 122, 133, 8, 117, 176, 152, 85, 241, 143, 40, 125, 210, 19, 50, 199, 178, 66, 51, 83, 17, 89, 149, 246, 62, 230, 45, 162, 104, 29, 252, 58, 237, 26, 237, 154, 58, 252, 29, 254, 142, 218, 189, 22, 22, 179, 150, 200, 242, 85, 136, 85, 136, 107, 49, 79, 123, 127, 49, 94, 161, 157, 183, 15, 215, 226, 218, 58, 173, 70, 171, 1, 0, 9, 142, 3, 240, 38, 225, 43, 99, 134, 104, 176, 27, 49, 26, 82, 173, 42, 25, 158, 177, 22, 138, 170, 52, 68, 4, 172, 194, 22, 139, 108, 226, 250, 34, 114, 43, 200, 68, 17, 2, 75, 35, 239, 138, 213, 148, 25, 19, 99, 177, 80, 95, 99, 236, 49, 118, 91, 156, 37, 218, 18, 29, 101, 149, 204, 146, 89, 85, 68, 191, 163, 104, 85, 85, 248, 156, 156, 147, 31, 22, 134, 126, 29, 63, 178, 55, 233, 72, 34, 198, 31, 217, 151, 120, 36, 233, 200, 17, 188, 146, 59, 169, 221, 93, 223, 104, 235, 34, 255, 162, 126, 130, 82, 195, 246, 40, 31, 2, 135, 28, 161, 25, 156, 84, 175, 120, 92, 129, 26, 210, 45, 216, 30, 165, 230, 166, 155, 104, 92, 181, 218, 238, 143, 120, 172, 252, 45, 112, 40, 9, 174, 79, 226, 183, 164, 201, 234, 29, 180, 208, 75, 218, 233, 207, 243, 154, 253, 150, 136, 186, 100, 244, 161, 86, 75, 100, 246, 92, 182, 32, 23, 14, 35, 216, 93, 60, 73, 25, 11, 42, 196, 130, 139, 226, 179, 186, 98, 245, 248, 172, 193, 5, 217, 68, 42, 91, 208, 94, 109, 38, 49, 54, 187, 205, 106, 14, 198, 99, 150, 173, 9, 133, 40, 236, 211, 236, 113, 152, 224, 35, 162, 207, 205, 165, 197, 224, 72, 112, 225, 30, 237, 122, 92, 133, 43, 165, 192, 187, 197, 215, 188, 43, 41, 177, 37, 59, 222, 145, 101, 118, 23, 30, 199, 227, 90, 137, 86, 130, 252, 97, 92, 239, 29, 155, 49, 105, 143, 118, 89, 214, 232, 116, 96, 224, 98, 7, 184, 87, 41, 6, 11, 68, 67, 153, 127, 16, 69, 75, 229, 194, 131, 78, 166, 115, 98, 50, 226, 160, 140, 228, 211, 5, 160, 59, 67, 106, 4, 136, 178, 130, 5, 44, 164, 70, 170, 214, 228, 66, 172, 240, 84, 160, 207, 70, 170, 1, 122, 108, 76, 210, 236, 248, 237, 21, 55, 222, 168, 37, 227, 82, 118, 160, 179, 153, 101, 5, 190, 88, 216, 137, 115, 241, 10, 26, 154, 11, 217, 93, 236, 143, 74, 49, 100, 128, 207, 63, 216, 56, 131, 102, 245, 64, 103, 30, 18, 146, 175, 171, 64, 246, 167, 135, 206, 160, 103, 202, 141, 20, 169, 42, 47, 83, 177, 166, 20, 218, 43, 194, 70, 68, 100, 27, 233, 113, 134, 48, 211, 204, 156, 11, 87, 47, 30, 57, 123, 168, 219, 51, 97, 205, 180, 205, 172, 84, 155, 82, 186, 240, 230, 229, 149, 99, 215, 220, 120, 70, 193, 124, 246, 155, 214, 172, 180, 242, 9, 249, 133, 227, 6, 39, 225, 111, 87, 252, 146, 53, 110, 249, 216, 250, 149, 53, 233, 102, 177, 246, 70, 177, 187, 56, 83, 198, 130, 3, 210, 200, 79, 34, 14, 57, 179, 145, 68, 64, 89, 226, 100, 161, 35, 201, 32, 181, 26, 161, 197, 155, 66, 185, 53, 102, 98, 99, 86, 166, 55, 43, 203, 35, 90, 166, 51, 32, 114, 232, 40, 143, 215, 13, 213, 176, 220, 72, 236, 242, 167, 69, 183, 94, 113, 231, 111, 118, 63, 244, 252, 223, 222, 92, 180, 170, 125, 146, 246, 119, 188, 238, 208, 130, 182, 243, 231, 30, 146, 46, 91, 113, 227, 214, 155, 239, 251, 251, 107, 95, 163, 226, 89, 62, 56, 112, 252, 227, 229, 51, 23, 111, 37, 158, 128, 176, 140, 29, 224, 37, 98, 94, 178, 252, 30, 178, 40, 159, 100, 232, 137, 211, 133, 239, 192, 12, 178, 129, 23, 51, 225, 144, 172, 137, 133, 246, 202, 202, 42, 164, 224, 136, 192, 31, 79, 152, 50, 7, 7, 5, 142, 176, 163, 218, 12, 31, 59, 176, 252, 150, 75, 113, 219, 234, 213, 154, 73, 251, 39, 17, 100, 29, 187, 139, 15, 86, 198, 146, 6, 5, 133, 144, 237, 207, 180, 32, 103, 72, 71, 58, 194, 240, 174, 41, 36, 89, 102, 98, 99, 65, 94, 94, 150, 68, 147, 28, 226, 177, 106, 168, 155, 217, 70, 136, 119, 71, 130, 75, 161, 255, 11, 56, 62, 39, 23, 115, 238, 125, 234, 198, 43, 30, 122, 179, 243, 248, 3, 187, 142, 226, 173, 183, 45, 155, 53, 109, 201, 92, 219, 236, 81, 27, 150, 44, 188, 112, 210, 217, 210, 45, 115, 86, 45, 217, 116, 230, 172, 205, 103, 46, 186, 125, 245, 85, 237, 171, 111, 191, 230, 140, 249, 67, 199, 142, 170, 40, 157, 124, 77, 213, 180, 145, 13, 243, 167, 250, 125, 181, 190, 254, 230, 5, 68, 6, 66, 14, 100, 189, 192, 137, 98, 104, 243, 211, 106, 204, 70, 83, 40, 248, 251, 76, 22, 49, 47, 24, 108, 106, 176, 233, 44, 120, 130, 152, 129, 14, 227, 156, 158, 45, 92, 116, 235, 21, 119, 60, 190, 251, 161, 23, 254, 46, 38, 6, 93, 218, 106, 125, 98, 102, 47, 239, 184, 248, 230, 251, 190, 126, 245, 107, 237, 68, 198, 138, 193, 172, 228, 147, 229, 51, 151, 108, 161, 9, 128, 70, 246, 29, 47, 147, 253, 96, 165, 12, 72, 192, 80, 102, 51, 35, 206, 57, 233, 116, 67, 65, 153, 201, 147, 35, 97, 63, 171, 91, 172, 19, 79, 16, 26, 119, 226, 118, 237, 37, 28, 182, 26, 135, 107, 71, 216, 119, 157, 205, 93, 93, 205, 157, 193, 57, 159, 196, 191, 225, 149, 82, 205, 41, 246, 60, 214, 224, 30, 107, 18, 251, 134, 127, 211, 220, 76, 99, 149, 192, 151, 242, 51, 165, 215, 132, 252, 24, 228, 47, 138, 56, 8, 11, 85, 33, 210, 84, 244, 217, 239, 232, 85, 81, 210, 218, 4, 62, 189, 107, 47, 159, 206, 151, 46, 91, 134, 176, 108, 25, 61, 4, 135, 153, 196, 210, 149, 21, 16, 13, 202, 19, 42, 98, 89, 33, 58, 115, 88, 69, 121, 124, 149, 83, 204, 56, 75, 191, 247, 153, 103, 238, 93, 61, 111, 30, 155, 49, 117, 22, 150, 236, 125, 24, 7, 205, 154, 182, 224, 227, 251, 119, 125, 76, 182, 223, 76, 194, 19, 145, 207, 218, 43, 40, 76, 52, 35, 203, 145, 138, 242, 156, 157, 244, 232, 170, 121, 243, 148, 132, 105, 179, 180, 183, 31, 222, 171, 29, 159, 53, 117, 193, 39, 247, 223, 255, 49, 189, 183, 141, 29, 96, 223, 200, 147, 33, 14, 234, 15, 198, 114, 193, 22, 39, 61, 150, 57, 101, 150, 223, 33, 130, 136, 54, 71, 196, 111, 152, 193, 34, 67, 27, 64, 228, 245, 160, 29, 115, 28, 196, 121, 227, 51, 109, 138, 53, 153, 34, 252, 56, 43, 125, 101, 225, 243, 138, 182, 142, 243, 238, 120, 187, 35, 127, 232, 166, 115, 216, 1, 118, 229, 133, 151, 191, 164, 21, 225, 190, 201, 67, 118, 104, 115, 104, 62, 56, 140, 208, 14, 242, 36, 54, 123, 64, 30, 93, 31, 230, 209, 13, 189, 120, 180, 229, 215, 242, 104, 237, 224, 64, 60, 26, 225, 14, 237, 117, 30, 197, 102, 131, 25, 50, 253, 233, 138, 44, 113, 9, 234, 130, 99, 192, 145, 177, 137, 130, 19, 152, 193, 236, 180, 73, 22, 218, 231, 249, 108, 106, 69, 182, 205, 231, 188, 227, 219, 111, 241, 226, 111, 181, 215, 103, 177, 223, 15, 154, 211, 208, 245, 234, 44, 26, 219, 122, 237, 117, 246, 28, 155, 13, 73, 80, 238, 47, 141, 66, 64, 171, 200, 11, 76, 150, 235, 147, 140, 180, 53, 162, 95, 98, 87, 193, 39, 10, 187, 213, 36, 72, 74, 244, 230, 201, 22, 66, 226, 201, 57, 37, 194, 101, 205, 161, 168, 170, 162, 122, 156, 245, 190, 11, 167, 78, 188, 238, 224, 153, 183, 223, 112, 249, 117, 123, 103, 109, 44, 191, 61, 13, 127, 167, 189, 190, 124, 193, 178, 49, 35, 206, 95, 115, 95, 237, 138, 33, 151, 181, 53, 174, 159, 157, 179, 230, 60, 58, 88, 34, 157, 67, 59, 200, 254, 200, 102, 131, 39, 210, 47, 196, 125, 82, 191, 144, 250, 144, 92, 104, 144, 27, 115, 189, 94, 143, 55, 75, 177, 8, 185, 64, 161, 92, 34, 28, 67, 116, 47, 79, 167, 67, 13, 158, 58, 175, 77, 176, 110, 26, 251, 217, 205, 87, 14, 247, 109, 190, 238, 201, 121, 151, 93, 186, 12, 107, 190, 194, 168, 151, 31, 152, 161, 29, 95, 109, 226, 121, 143, 206, 93, 52, 165, 101, 90, 123, 217, 242, 172, 55, 111, 31, 83, 250, 244, 134, 235, 104, 77, 185, 180, 215, 185, 151, 213, 156, 66, 46, 214, 10, 185, 56, 177, 151, 92, 180, 156, 84, 46, 106, 175, 247, 145, 139, 98, 44, 106, 78, 37, 23, 107, 35, 251, 175, 203, 69, 203, 127, 47, 23, 181, 87, 78, 42, 23, 181, 131, 156, 177, 217, 167, 39, 23, 235, 67, 114, 177, 33, 36, 23, 45, 41, 133, 85, 255, 165, 92, 188, 179, 95, 177, 136, 176, 76, 123, 157, 151, 176, 154, 126, 100, 98, 109, 67, 120, 37, 4, 101, 162, 101, 96, 153, 168, 189, 222, 91, 38, 106, 7, 249, 96, 54, 123, 32, 153, 88, 31, 146, 137, 13, 186, 76, 180, 36, 23, 102, 255, 31, 147, 137, 143, 158, 158, 72, 236, 59, 39, 39, 151, 137, 245, 33, 153, 216, 16, 146, 137, 150, 148, 194, 236, 255, 86, 38, 174, 25, 64, 36, 146, 76, 236, 86, 121, 25, 206, 4, 43, 228, 250, 179, 194, 210, 144, 140, 110, 133, 66, 87, 39, 55, 70, 138, 67, 139, 187, 80, 238, 95, 28, 254, 98, 72, 67, 132, 73, 192, 120, 37, 204, 57, 133, 44, 180, 184, 10, 171, 130, 178, 144, 145, 40, 36, 89, 216, 189, 145, 159, 9, 159, 253, 122, 89, 104, 113, 21, 186, 122, 202, 194, 75, 67, 162, 144, 100, 161, 230, 102, 233, 108, 243, 41, 101, 161, 118, 86, 63, 178, 80, 115, 227, 9, 182, 249, 84, 178, 144, 21, 247, 39, 11, 181, 215, 217, 55, 184, 12, 226, 160, 33, 44, 11, 61, 83, 102, 249, 237, 161, 92, 117, 117, 65, 215, 157, 137, 70, 92, 155, 186, 16, 189, 134, 239, 244, 20, 134, 150, 147, 10, 67, 237, 245, 62, 194, 80, 248, 183, 74, 109, 2, 27, 122, 234, 201, 24, 139, 170, 103, 33, 165, 77, 80, 10, 71, 70, 167, 191, 76, 106, 53, 96, 195, 33, 13, 38, 149, 201, 242, 80, 145, 95, 44, 139, 2, 174, 8, 23, 221, 102, 56, 105, 65, 218, 67, 102, 2, 3, 69, 98, 202, 114, 221, 0, 170, 191, 146, 194, 154, 132, 66, 201, 44, 239, 93, 88, 84, 54, 130, 44, 221, 197, 121, 55, 101, 235, 180, 197, 103, 198, 83, 124, 119, 115, 106, 40, 190, 123, 48, 251, 27, 153, 43, 120, 108, 87, 241, 65, 129, 155, 240, 157, 171, 158, 75, 140, 185, 255, 146, 35, 218, 119, 242, 209, 59, 180, 138, 199, 95, 124, 134, 61, 169, 1, 59, 18, 24, 110, 244, 157, 127, 37, 127, 11, 57, 100, 27, 7, 8, 86, 194, 254, 88, 125, 66, 56, 23, 118, 190, 133, 130, 197, 48, 142, 108, 185, 9, 185, 138, 212, 26, 18, 116, 214, 133, 96, 181, 86, 19, 119, 20, 241, 209, 135, 6, 67, 194, 90, 1, 208, 10, 173, 253, 62, 163, 151, 28, 33, 108, 227, 114, 178, 60, 25, 233, 105, 54, 39, 69, 149, 177, 217, 51, 163, 204, 233, 164, 130, 17, 135, 33, 244, 84, 124, 28, 36, 124, 61, 68, 72, 4, 54, 138, 15, 241, 37, 254, 234, 198, 182, 185, 115, 255, 241, 143, 127, 252, 99, 102, 19, 91, 211, 181, 171, 181, 245, 165, 151, 94, 122, 233, 181, 103, 86, 200, 111, 62, 80, 218, 214, 118, 205, 198, 189, 27, 227, 46, 124, 190, 118, 223, 62, 207, 157, 23, 205, 218, 184, 119, 227, 180, 119, 254, 66, 116, 46, 193, 146, 238, 175, 76, 20, 7, 195, 2, 118, 200, 135, 6, 76, 8, 218, 228, 166, 164, 167, 49, 85, 26, 129, 92, 29, 137, 168, 20, 230, 51, 11, 22, 32, 179, 200, 245, 14, 100, 19, 221, 226, 118, 148, 52, 2, 163, 123, 222, 118, 160, 204, 148, 250, 230, 96, 21, 197, 81, 38, 166, 70, 155, 153, 130, 170, 152, 94, 180, 112, 108, 53, 66, 51, 13, 105, 176, 162, 197, 194, 22, 198, 200, 68, 228, 67, 67, 174, 142, 85, 16, 5, 209, 60, 42, 186, 213, 132, 170, 25, 7, 120, 52, 150, 142, 98, 68, 5, 242, 194, 24, 148, 229, 49, 114, 163, 219, 95, 65, 221, 137, 162, 205, 219, 128, 53, 208, 115, 35, 122, 62, 215, 220, 236, 247, 2, 52, 76, 242, 87, 15, 31, 90, 82, 148, 151, 227, 205, 244, 100, 164, 166, 184, 147, 93, 78, 187, 45, 90, 236, 124, 179, 60, 113, 102, 61, 40, 148, 211, 33, 121, 188, 54, 155, 206, 59, 189, 193, 237, 134, 176, 129, 169, 162, 185, 9, 122, 53, 232, 177, 173, 197, 38, 61, 40, 51, 104, 123, 40, 182, 134, 46, 87, 208, 253, 60, 115, 9, 46, 250, 246, 125, 237, 99, 237, 151, 162, 172, 61, 15, 183, 223, 63, 69, 90, 54, 185, 226, 170, 43, 87, 172, 56, 242, 230, 155, 227, 198, 167, 21, 55, 239, 90, 95, 95, 95, 61, 178, 233, 156, 213, 143, 222, 62, 103, 199, 21, 77, 147, 234, 134, 12, 153, 120, 245, 218, 195, 55, 94, 37, 31, 125, 254, 157, 191, 118, 7, 246, 179, 111, 110, 186, 249, 150, 13, 39, 142, 39, 92, 126, 57, 254, 181, 253, 86, 231, 35, 237, 218, 144, 51, 206, 176, 182, 220, 85, 55, 100, 104, 86, 117, 146, 183, 100, 243, 180, 243, 183, 165, 118, 20, 249, 202, 60, 67, 18, 82, 135, 92, 48, 247, 188, 91, 8, 15, 233, 254, 72, 26, 34, 127, 3, 94, 184, 194, 31, 159, 128, 170, 226, 66, 89, 149, 144, 120, 38, 134, 226, 112, 20, 152, 80, 54, 163, 196, 101, 105, 185, 5, 121, 148, 149, 145, 47, 225, 114, 195, 134, 179, 186, 193, 80, 26, 71, 17, 78, 154, 7, 10, 168, 178, 162, 182, 246, 255, 144, 94, 116, 12, 54, 138, 108, 231, 100, 248, 153, 233, 73, 75, 181, 121, 61, 54, 187, 205, 230, 245, 68, 19, 129, 199, 123, 68, 156, 129, 12, 238, 173, 242, 229, 150, 87, 121, 171, 124, 241, 122, 172, 119, 238, 81, 61, 138, 211, 229, 81, 241, 130, 217, 232, 219, 250, 248, 179, 219, 53, 247, 236, 199, 125, 171, 153, 188, 203, 119, 233, 42, 252, 102, 203, 3, 247, 93, 162, 253, 97, 41, 171, 242, 5, 190, 146, 25, 190, 230, 99, 73, 218, 178, 218, 189, 126, 86, 189, 97, 82, 222, 180, 141, 44, 161, 232, 224, 4, 173, 125, 227, 212, 188, 250, 13, 68, 28, 36, 81, 148, 26, 129, 99, 185, 192, 3, 133, 48, 223, 63, 39, 26, 37, 112, 197, 48, 89, 98, 245, 28, 13, 45, 71, 6, 166, 202, 172, 21, 84, 48, 155, 84, 115, 107, 20, 154, 16, 77, 205, 81, 104, 50, 225, 66, 171, 98, 225, 104, 194, 169, 222, 204, 164, 68, 145, 39, 184, 208, 91, 152, 150, 146, 232, 73, 242, 80, 98, 242, 40, 139, 26, 220, 106, 69, 43, 46, 17, 196, 150, 104, 164, 162, 28, 124, 101, 46, 53, 72, 4, 190, 178, 42, 204, 20, 218, 145, 211, 193, 114, 137, 80, 196, 229, 153, 114, 253, 61, 39, 158, 192, 11, 46, 95, 251, 39, 228, 187, 253, 179, 19, 166, 141, 92, 113, 203, 186, 165, 27, 177, 243, 171, 127, 61, 240, 245, 191, 46, 159, 211, 126, 118, 243, 54, 10, 4, 195, 155, 134, 253, 101, 63, 186, 146, 86, 103, 189, 122, 197, 197, 19, 186, 10, 63, 122, 6, 29, 31, 62, 171, 125, 187, 236, 149, 237, 215, 190, 178, 237, 122, 33, 19, 161, 251, 43, 182, 94, 169, 129, 98, 24, 5, 131, 253, 197, 37, 222, 76, 151, 42, 113, 48, 33, 237, 101, 0, 56, 82, 98, 6, 100, 140, 28, 126, 144, 128, 14, 100, 83, 135, 15, 205, 47, 41, 146, 148, 164, 66, 187, 34, 172, 253, 171, 108, 186, 183, 23, 1, 46, 170, 145, 165, 183, 202, 21, 116, 5, 203, 181, 83, 34, 163, 18, 137, 172, 166, 156, 155, 236, 227, 179, 61, 19, 92, 153, 254, 185, 67, 71, 77, 247, 57, 211, 234, 46, 156, 203, 99, 51, 51, 220, 21, 131, 243, 98, 230, 196, 151, 55, 44, 31, 235, 175, 205, 138, 146, 58, 124, 227, 10, 227, 81, 182, 56, 210, 18, 60, 217, 241, 50, 147, 162, 92, 49, 107, 99, 82, 162, 241, 178, 7, 63, 189, 180, 170, 168, 105, 211, 212, 233, 143, 239, 187, 127, 252, 249, 181, 87, 156, 61, 166, 116, 202, 162, 178, 204, 225, 91, 86, 142, 27, 185, 233, 185, 75, 17, 124, 107, 182, 94, 119, 134, 57, 175, 172, 178, 40, 101, 228, 134, 39, 55, 208, 92, 222, 4, 96, 138, 19, 103, 40, 46, 112, 67, 54, 204, 241, 207, 74, 65, 84, 61, 2, 203, 50, 161, 138, 106, 187, 177, 75, 180, 34, 163, 163, 29, 6, 173, 64, 1, 161, 103, 138, 112, 172, 102, 37, 138, 203, 146, 60, 57, 49, 145, 224, 234, 236, 44, 111, 102, 70, 122, 90, 106, 162, 59, 209, 157, 156, 20, 25, 29, 47, 90, 233, 13, 79, 135, 149, 62, 78, 250, 3, 89, 244, 145, 111, 137, 87, 185, 231, 161, 151, 94, 126, 240, 193, 151, 95, 126, 176, 105, 233, 226, 89, 179, 22, 47, 124, 155, 53, 253, 114, 241, 83, 71, 216, 166, 131, 47, 98, 243, 107, 15, 62, 244, 202, 209, 135, 246, 30, 221, 184, 113, 227, 133, 27, 54, 108, 216, 184, 65, 43, 236, 232, 144, 86, 116, 222, 34, 31, 237, 188, 75, 90, 120, 98, 8, 48, 248, 152, 125, 161, 124, 38, 226, 229, 57, 160, 206, 63, 193, 30, 103, 229, 42, 96, 61, 168, 164, 221, 240, 45, 22, 147, 98, 150, 56, 191, 84, 150, 8, 41, 198, 153, 244, 29, 60, 147, 66, 152, 108, 50, 1, 152, 28, 38, 71, 188, 141, 242, 156, 88, 204, 186, 21, 142, 85, 73, 162, 176, 139, 34, 230, 191, 199, 70, 7, 230, 54, 175, 141, 123, 185, 13, 255, 240, 51, 219, 249, 51, 182, 253, 253, 246, 155, 222, 185, 111, 223, 89, 251, 216, 23, 172, 117, 195, 134, 192, 205, 236, 11, 109, 6, 62, 28, 72, 97, 94, 205, 141, 111, 4, 126, 36, 121, 184, 29, 64, 190, 93, 254, 22, 220, 224, 243, 15, 78, 142, 99, 164, 136, 211, 89, 25, 74, 173, 61, 142, 129, 20, 17, 118, 130, 148, 53, 55, 184, 179, 109, 142, 44, 155, 170, 184, 11, 125, 21, 158, 80, 112, 65, 36, 217, 149, 101, 43, 39, 23, 9, 91, 156, 236, 220, 142, 211, 180, 71, 240, 108, 76, 197, 84, 60, 91, 123, 4, 167, 5, 254, 137, 190, 175, 159, 127, 238, 27, 244, 237, 196, 24, 188, 5, 139, 112, 171, 182, 69, 251, 131, 182, 92, 251, 215, 206, 157, 95, 226, 19, 221, 152, 169, 125, 218, 13, 218, 228, 191, 238, 36, 249, 197, 72, 126, 201, 211, 248, 149, 144, 14, 131, 193, 239, 31, 57, 200, 203, 100, 37, 145, 38, 190, 158, 212, 99, 14, 210, 22, 82, 26, 100, 166, 180, 2, 173, 76, 153, 18, 195, 32, 74, 205, 122, 0, 20, 9, 167, 230, 218, 114, 179, 242, 188, 113, 170, 66, 57, 0, 20, 149, 152, 58, 165, 208, 174, 34, 143, 23, 138, 3, 232, 244, 100, 58, 157, 14, 87, 66, 130, 175, 12, 61, 101, 21, 21, 229, 185, 65, 171, 107, 197, 233, 116, 72, 231, 204, 159, 49, 99, 231, 163, 87, 118, 54, 223, 114, 75, 115, 231, 149, 87, 118, 206, 190, 185, 203, 28, 155, 84, 254, 225, 153, 223, 4, 110, 224, 96, 75, 174, 124, 191, 181, 245, 131, 242, 164, 219, 38, 101, 215, 52, 53, 92, 178, 119, 239, 165, 13, 51, 102, 52, 92, 186, 55, 58, 250, 139, 140, 228, 138, 137, 248, 66, 244, 151, 233, 201, 21, 117, 117, 21, 201, 233, 95, 146, 194, 38, 195, 148, 238, 143, 148, 28, 129, 57, 155, 33, 21, 178, 97, 48, 60, 236, 143, 54, 169, 132, 2, 128, 3, 21, 17, 234, 140, 180, 48, 175, 9, 153, 172, 200, 76, 217, 66, 189, 98, 92, 100, 17, 29, 18, 76, 10, 42, 169, 44, 200, 159, 131, 154, 163, 151, 148, 231, 246, 190, 229, 123, 20, 245, 231, 246, 44, 165, 146, 90, 191, 45, 162, 172, 216, 146, 143, 17, 103, 61, 34, 192, 85, 81, 129, 215, 147, 156, 24, 23, 67, 144, 125, 232, 24, 140, 210, 180, 113, 1, 71, 200, 3, 236, 151, 132, 117, 120, 104, 175, 148, 123, 43, 129, 228, 207, 90, 94, 194, 217, 236, 247, 23, 223, 118, 209, 186, 107, 238, 124, 241, 241, 171, 47, 127, 8, 231, 173, 188, 102, 251, 164, 85, 139, 26, 106, 231, 221, 32, 16, 116, 182, 142, 253, 92, 186, 118, 102, 215, 154, 115, 102, 173, 93, 182, 105, 101, 243, 134, 214, 197, 183, 174, 189, 230, 210, 179, 238, 186, 117, 210, 236, 115, 87, 78, 121, 105, 214, 194, 218, 185, 245, 195, 166, 205, 16, 99, 56, 162, 251, 35, 101, 164, 24, 195, 76, 168, 132, 106, 152, 136, 51, 131, 106, 105, 178, 76, 137, 91, 81, 81, 173, 40, 43, 118, 148, 44, 241, 200, 165, 98, 180, 114, 165, 222, 45, 110, 178, 254, 111, 54, 7, 7, 178, 12, 76, 96, 145, 76, 150, 51, 41, 4, 141, 149, 66, 208, 80, 228, 31, 90, 140, 65, 149, 143, 4, 228, 232, 6, 176, 90, 113, 161, 57, 42, 52, 5, 244, 226, 202, 40, 68, 51, 110, 59, 221, 231, 205, 11, 193, 108, 30, 99, 54, 148, 162, 97, 68, 232, 237, 3, 86, 65, 28, 156, 13, 84, 131, 127, 72, 228, 195, 12, 84, 133, 130, 81, 245, 174, 164, 191, 71, 73, 169, 78, 3, 24, 55, 118, 196, 48, 95, 105, 126, 110, 102, 70, 90, 138, 59, 137, 132, 156, 41, 120, 64, 19, 173, 207, 55, 234, 216, 75, 130, 51, 194, 107, 163, 220, 67, 216, 12, 9, 55, 60, 61, 66, 120, 78, 242, 111, 239, 124, 86, 235, 94, 221, 134, 201, 135, 63, 222, 119, 211, 202, 243, 47, 158, 50, 117, 153, 214, 229, 43, 250, 241, 246, 121, 252, 149, 126, 105, 99, 238, 245, 130, 54, 228, 85, 231, 228, 188, 125, 199, 152, 178, 223, 93, 120, 117, 234, 133, 190, 217, 19, 15, 206, 219, 189, 181, 107, 233, 204, 182, 94, 116, 210, 188, 110, 229, 148, 151, 102, 46, 156, 56, 119, 210, 112, 157, 78, 102, 119, 127, 164, 212, 8, 58, 161, 120, 236, 5, 224, 199, 86, 127, 124, 57, 50, 181, 8, 21, 169, 16, 173, 74, 12, 202, 86, 73, 95, 112, 149, 20, 230, 74, 97, 210, 153, 160, 128, 85, 86, 172, 173, 193, 245, 102, 54, 155, 90, 192, 100, 26, 66, 6, 226, 150, 133, 96, 177, 140, 166, 137, 192, 133, 60, 76, 0, 169, 68, 0, 0, 38, 104, 239, 83, 197, 105, 60, 77, 132, 87, 30, 124, 218, 2, 42, 179, 168, 173, 39, 169, 165, 215, 179, 68, 60, 67, 196, 179, 148, 11, 22, 183, 157, 86, 13, 194, 193, 99, 12, 89, 104, 85, 70, 62, 106, 2, 179, 197, 100, 94, 62, 96, 21, 225, 7, 155, 117, 202, 241, 87, 15, 27, 58, 164, 170, 178, 194, 87, 58, 168, 56, 39, 43, 213, 237, 136, 183, 152, 122, 82, 206, 233, 145, 70, 54, 105, 212, 21, 229, 158, 76, 85, 160, 93, 58, 196, 116, 148, 56, 199, 115, 188, 124, 235, 109, 23, 173, 187, 250, 142, 23, 31, 191, 230, 242, 135, 112, 254, 153, 87, 95, 62, 233, 236, 133, 141, 19, 230, 93, 223, 89, 185, 180, 241, 113, 237, 219, 12, 15, 254, 73, 187, 233, 241, 215, 14, 220, 57, 65, 208, 10, 147, 251, 225, 31, 103, 77, 22, 116, 65, 252, 227, 221, 117, 185, 251, 95, 148, 241, 201, 11, 255, 120, 215, 21, 23, 223, 168, 211, 72, 105, 4, 141, 148, 35, 247, 91, 99, 80, 54, 97, 125, 25, 42, 180, 31, 36, 226, 40, 182, 160, 73, 146, 37, 147, 76, 210, 70, 50, 49, 41, 196, 141, 213, 22, 80, 85, 99, 102, 204, 61, 102, 181, 152, 236, 1, 218, 251, 62, 57, 224, 67, 52, 157, 165, 61, 31, 50, 7, 39, 167, 191, 71, 195, 243, 56, 168, 223, 103, 84, 80, 24, 109, 142, 140, 103, 123, 76, 160, 63, 165, 176, 16, 160, 176, 188, 176, 188, 116, 240, 160, 146, 30, 179, 103, 253, 149, 179, 87, 105, 183, 7, 65, 185, 109, 52, 99, 207, 242, 165, 198, 90, 54, 102, 172, 126, 213, 162, 6, 154, 177, 205, 9, 15, 254, 69, 43, 100, 77, 218, 93, 137, 250, 92, 245, 89, 195, 193, 185, 90, 20, 228, 245, 239, 222, 142, 12, 231, 108, 222, 172, 165, 105, 127, 215, 125, 197, 63, 81, 23, 240, 231, 65, 129, 12, 127, 42, 34, 212, 41, 136, 19, 35, 128, 32, 32, 28, 200, 22, 207, 148, 196, 66, 59, 114, 143, 211, 99, 191, 155, 109, 237, 144, 254, 208, 89, 220, 193, 166, 224, 90, 237, 253, 14, 237, 61, 170, 231, 106, 109, 29, 95, 171, 212, 128, 2, 241, 254, 88, 69, 102, 40, 65, 29, 0, 156, 103, 71, 197, 85, 136, 152, 109, 243, 218, 200, 22, 62, 248, 128, 82, 19, 216, 220, 113, 34, 77, 254, 172, 163, 87, 27, 8, 187, 39, 147, 168, 122, 25, 177, 142, 118, 95, 56, 83, 7, 55, 16, 38, 219, 108, 212, 138, 108, 26, 25, 142, 210, 159, 58, 58, 139, 165, 63, 116, 176, 173, 159, 96, 126, 7, 230, 105, 215, 0, 12, 208, 142, 137, 17, 237, 144, 201, 163, 25, 187, 181, 107, 48, 175, 3, 243, 181, 117, 108, 107, 135, 252, 217, 137, 180, 96, 59, 166, 192, 39, 234, 99, 252, 121, 48, 67, 158, 63, 155, 246, 119, 88, 47, 33, 131, 58, 114, 97, 233, 51, 38, 142, 208, 152, 112, 68, 187, 199, 62, 133, 109, 237, 224, 219, 58, 216, 214, 192, 230, 142, 174, 11, 245, 193, 161, 118, 105, 87, 99, 126, 112, 140, 174, 209, 219, 102, 38, 27, 2, 160, 179, 34, 204, 182, 101, 83, 163, 244, 161, 17, 197, 175, 161, 195, 120, 49, 66, 93, 214, 142, 192, 102, 170, 246, 223, 212, 62, 6, 215, 4, 254, 162, 63, 31, 77, 232, 174, 57, 168, 226, 48, 52, 247, 99, 100, 151, 76, 173, 11, 215, 79, 92, 160, 247, 59, 216, 23, 129, 148, 224, 123, 254, 160, 191, 167, 184, 227, 196, 111, 232, 85, 192, 96, 88, 247, 87, 252, 105, 165, 6, 156, 144, 65, 216, 126, 28, 74, 116, 2, 106, 100, 168, 11, 70, 47, 108, 5, 25, 81, 110, 214, 209, 84, 25, 167, 102, 101, 102, 209, 177, 162, 146, 82, 104, 143, 247, 144, 91, 47, 120, 50, 115, 130, 113, 73, 42, 202, 25, 201, 53, 111, 166, 200, 150, 94, 22, 191, 236, 6, 180, 190, 245, 87, 109, 95, 55, 108, 223, 133, 205, 111, 78, 95, 121, 164, 227, 5, 150, 178, 232, 204, 75, 63, 89, 60, 245, 242, 247, 228, 120, 44, 211, 110, 208, 158, 212, 86, 5, 190, 121, 230, 118, 84, 240, 192, 184, 180, 23, 144, 7, 86, 46, 215, 254, 176, 255, 130, 14, 92, 43, 218, 56, 30, 64, 186, 75, 228, 212, 48, 65, 169, 191, 196, 68, 6, 140, 52, 99, 226, 152, 134, 145, 232, 64, 20, 182, 140, 194, 123, 135, 145, 233, 130, 170, 144, 217, 120, 188, 68, 91, 205, 10, 244, 161, 51, 219, 131, 227, 241, 189, 125, 248, 78, 87, 53, 151, 181, 77, 135, 228, 216, 19, 223, 31, 58, 36, 229, 209, 124, 29, 212, 214, 201, 127, 18, 251, 165, 2, 127, 110, 80, 81, 223, 22, 65, 148, 100, 239, 132, 208, 227, 20, 83, 113, 135, 118, 14, 132, 156, 97, 210, 98, 57, 127, 241, 219, 129, 81, 218, 58, 229, 224, 29, 119, 252, 82, 167, 28, 252, 133, 86, 5, 32, 172, 209, 206, 149, 27, 68, 221, 85, 254, 114, 19, 99, 12, 177, 158, 236, 229, 144, 49, 129, 216, 247, 178, 197, 148, 132, 45, 166, 30, 73, 195, 230, 144, 105, 211, 89, 129, 116, 40, 233, 65, 244, 56, 215, 176, 93, 129, 107, 164, 243, 187, 30, 100, 205, 129, 177, 252, 127, 180, 115, 127, 203, 190, 229, 177, 119, 220, 17, 48, 117, 117, 253, 150, 170, 131, 37, 76, 145, 106, 217, 206, 83, 96, 197, 74, 240, 176, 115, 9, 255, 130, 41, 194, 54, 181, 59, 91, 123, 159, 149, 9, 187, 27, 151, 223, 17, 182, 221, 136, 120, 128, 163, 15, 63, 98, 107, 110, 15, 236, 208, 222, 87, 207, 248, 15, 153, 9, 3, 194, 104, 92, 135, 255, 225, 69, 96, 37, 123, 73, 241, 190, 102, 29, 218, 0, 162, 19, 162, 78, 187, 206, 10, 73, 191, 25, 125, 241, 236, 57, 91, 182, 204, 153, 187, 133, 157, 209, 188, 101, 235, 156, 217, 91, 183, 210, 64, 119, 15, 147, 150, 168, 37, 96, 130, 100, 191, 203, 196, 25, 129, 230, 68, 132, 34, 26, 43, 158, 103, 115, 136, 90, 114, 179, 131, 70, 186, 5, 5, 88, 129, 227, 113, 195, 42, 109, 210, 44, 237, 18, 252, 120, 218, 206, 192, 90, 229, 199, 255, 68, 177, 19, 212, 214, 115, 112, 168, 60, 86, 42, 4, 23, 100, 65, 41, 254, 254, 64, 20, 42, 2, 157, 181, 6, 61, 168, 36, 80, 155, 5, 152, 161, 16, 26, 166, 200, 168, 52, 71, 16, 55, 133, 0, 198, 169, 238, 96, 248, 177, 60, 221, 79, 175, 149, 224, 87, 133, 135, 139, 139, 147, 110, 225, 11, 182, 144, 210, 117, 83, 249, 152, 95, 85, 123, 236, 175, 172, 221, 246, 171, 106, 143, 255, 149, 181, 187, 127, 85, 237, 41, 191, 170, 246, 211, 173, 184, 89, 255, 231, 183, 102, 101, 103, 101, 103, 101, 101, 101, 154, 20, 61, 3, 166, 78, 56, 185, 74, 248, 176, 134, 7, 119, 153, 130, 193, 84, 86, 137, 221, 167, 14, 60, 176, 127, 142, 186, 121, 230, 198, 135, 15, 109, 105, 184, 175, 201, 127, 115, 211, 134, 189, 135, 182, 212, 223, 103, 182, 47, 156, 87, 50, 118, 254, 242, 198, 97, 231, 189, 229, 152, 124, 94, 78, 205, 188, 229, 141, 195, 207, 227, 105, 23, 95, 252, 198, 13, 119, 255, 109, 219, 101, 193, 239, 203, 180, 175, 221, 151, 204, 184, 98, 241, 252, 77, 43, 159, 112, 159, 117, 22, 253, 56, 11, 16, 87, 0, 40, 117, 210, 103, 32, 19, 39, 214, 73, 146, 49, 193, 25, 196, 145, 51, 178, 201, 161, 160, 149, 10, 89, 181, 251, 40, 231, 232, 138, 14, 169, 81, 250, 172, 83, 48, 89, 132, 107, 1, 228, 193, 34, 46, 119, 142, 223, 75, 140, 156, 140, 225, 24, 0, 107, 214, 143, 213, 24, 76, 13, 70, 131, 241, 218, 189, 180, 228, 61, 182, 114, 240, 102, 130, 56, 157, 167, 40, 92, 78, 155, 116, 187, 22, 56, 244, 148, 214, 213, 129, 115, 254, 253, 19, 206, 230, 235, 15, 33, 215, 2, 135, 186, 182, 243, 245, 63, 145, 149, 232, 79, 93, 219, 97, 160, 119, 181, 3, 189, 134, 150, 37, 237, 134, 128, 245, 124, 23, 218, 28, 244, 174, 10, 155, 215, 230, 160, 119, 85, 244, 126, 151, 124, 180, 107, 251, 33, 45, 128, 252, 16, 95, 223, 181, 253, 39, 109, 55, 206, 254, 137, 175, 167, 119, 69, 105, 239, 179, 108, 245, 50, 152, 7, 163, 253, 213, 20, 199, 176, 126, 140, 64, 11, 1, 153, 196, 80, 218, 34, 132, 135, 188, 41, 228, 61, 41, 115, 46, 200, 137, 116, 41, 153, 79, 157, 54, 181, 118, 124, 245, 136, 164, 188, 98, 133, 184, 92, 137, 92, 17, 145, 138, 157, 128, 180, 138, 242, 202, 42, 35, 116, 146, 66, 1, 236, 130, 153, 181, 13, 23, 111, 178, 119, 82, 84, 227, 188, 87, 202, 125, 22, 37, 73, 146, 152, 201, 233, 173, 168, 43, 117, 151, 101, 39, 152, 226, 221, 118, 139, 195, 102, 85, 36, 179, 57, 49, 61, 94, 229, 178, 162, 170, 178, 132, 228, 255, 200, 7, 46, 38, 169, 170, 170, 32, 191, 46, 117, 106, 203, 178, 37, 243, 114, 74, 207, 58, 115, 65, 93, 161, 45, 99, 80, 170, 35, 43, 213, 38, 199, 38, 59, 77, 30, 147, 236, 159, 191, 178, 208, 50, 178, 233, 204, 85, 103, 205, 25, 23, 123, 229, 160, 203, 111, 186, 97, 104, 184, 160, 61, 187, 103, 193, 152, 150, 75, 110, 188, 124, 37, 29, 71, 49, 24, 135, 92, 74, 151, 146, 32, 25, 42, 160, 222, 63, 49, 218, 202, 56, 36, 145, 232, 202, 203, 97, 170, 84, 238, 102, 138, 44, 69, 0, 145, 192, 36, 202, 124, 32, 1, 37, 108, 108, 5, 89, 81, 196, 8, 210, 142, 84, 86, 166, 230, 100, 101, 103, 229, 100, 101, 123, 104, 177, 216, 227, 124, 101, 233, 156, 118, 3, 21, 190, 56, 111, 230, 32, 150, 147, 155, 227, 205, 116, 58, 249, 0, 215, 199, 125, 62, 163, 109, 116, 67, 195, 220, 226, 101, 75, 63, 159, 209, 230, 111, 24, 234, 156, 83, 178, 116, 89, 127, 23, 217, 251, 56, 122, 72, 70, 86, 86, 209, 30, 179, 118, 120, 72, 70, 86, 178, 181, 112, 143, 89, 171, 233, 231, 34, 217, 50, 64, 162, 52, 76, 186, 44, 152, 195, 211, 76, 152, 57, 200, 146, 36, 159, 35, 68, 195, 26, 5, 37, 21, 233, 248, 107, 133, 137, 172, 27, 216, 76, 19, 73, 241, 22, 178, 243, 102, 56, 153, 48, 115, 145, 15, 15, 16, 182, 157, 188, 104, 48, 88, 2, 157, 105, 25, 255, 172, 74, 122, 33, 114, 47, 105, 141, 20, 154, 197, 238, 35, 37, 86, 245, 56, 89, 205, 242, 29, 103, 107, 79, 226, 185, 117, 218, 24, 252, 231, 242, 29, 203, 177, 78, 107, 175, 195, 35, 216, 160, 189, 134, 21, 29, 159, 118, 96, 185, 246, 122, 199, 167, 29, 192, 97, 45, 116, 72, 67, 165, 40, 221, 6, 218, 239, 31, 9, 146, 9, 245, 108, 104, 106, 159, 163, 83, 69, 28, 157, 14, 100, 247, 172, 4, 79, 99, 81, 255, 172, 229, 47, 119, 13, 13, 126, 58, 58, 58, 112, 109, 240, 3, 12, 202, 0, 164, 52, 105, 22, 112, 176, 208, 137, 177, 69, 232, 122, 10, 212, 157, 76, 116, 251, 42, 60, 78, 27, 162, 83, 118, 202, 227, 249, 251, 93, 14, 254, 143, 192, 46, 54, 167, 163, 3, 43, 240, 122, 188, 238, 174, 239, 217, 15, 16, 210, 155, 202, 34, 234, 54, 155, 212, 211, 170, 27, 179, 43, 178, 43, 16, 169, 238, 60, 54, 39, 176, 139, 255, 163, 203, 209, 209, 129, 127, 252, 129, 125, 127, 151, 182, 90, 91, 69, 117, 95, 1, 32, 213, 75, 119, 131, 20, 212, 162, 77, 170, 34, 113, 166, 96, 29, 85, 8, 56, 147, 97, 72, 157, 151, 101, 217, 44, 155, 227, 168, 225, 30, 143, 13, 145, 219, 16, 109, 82, 125, 87, 53, 254, 5, 255, 210, 161, 61, 161, 61, 193, 14, 227, 95, 180, 244, 192, 59, 248, 79, 108, 208, 158, 208, 108, 244, 216, 10, 204, 147, 106, 248, 122, 144, 133, 30, 19, 226, 152, 164, 229, 81, 189, 140, 182, 9, 113, 156, 16, 92, 242, 30, 245, 72, 53, 93, 79, 117, 240, 143, 217, 23, 90, 13, 155, 2, 148, 198, 68, 155, 194, 63, 83, 166, 128, 3, 166, 29, 136, 166, 147, 197, 250, 73, 143, 37, 138, 48, 74, 164, 222, 49, 82, 44, 47, 5, 228, 92, 224, 252, 196, 144, 144, 79, 117, 251, 83, 130, 119, 97, 75, 127, 183, 155, 15, 250, 178, 188, 113, 146, 146, 76, 97, 223, 40, 234, 115, 80, 52, 85, 148, 19, 151, 178, 217, 217, 209, 35, 55, 63, 58, 122, 214, 240, 188, 233, 178, 73, 90, 106, 214, 166, 72, 247, 227, 49, 220, 81, 91, 89, 80, 125, 134, 201, 198, 180, 27, 180, 45, 128, 240, 181, 54, 133, 189, 162, 238, 0, 7, 76, 57, 16, 45, 204, 13, 39, 61, 230, 18, 237, 10, 106, 178, 156, 179, 115, 128, 35, 242, 102, 58, 184, 94, 4, 200, 113, 26, 249, 225, 233, 119, 89, 123, 239, 155, 17, 173, 242, 137, 200, 231, 65, 33, 73, 144, 146, 175, 204, 198, 25, 127, 233, 150, 71, 70, 207, 26, 150, 55, 93, 50, 73, 75, 204, 234, 142, 19, 169, 154, 79, 91, 83, 91, 89, 88, 61, 221, 100, 67, 60, 11, 169, 175, 100, 64, 181, 91, 58, 198, 135, 157, 90, 111, 36, 59, 96, 233, 24, 219, 77, 106, 35, 32, 75, 213, 166, 176, 199, 69, 94, 80, 155, 63, 38, 172, 178, 197, 145, 202, 70, 14, 213, 44, 181, 163, 67, 221, 241, 211, 115, 122, 89, 241, 142, 224, 156, 50, 100, 219, 34, 94, 68, 10, 99, 120, 78, 157, 30, 122, 148, 61, 22, 248, 162, 131, 15, 235, 232, 220, 67, 69, 0, 232, 93, 226, 121, 146, 197, 200, 200, 164, 156, 49, 241, 188, 144, 197, 208, 83, 22, 83, 56, 102, 175, 141, 61, 222, 193, 118, 119, 116, 38, 72, 77, 84, 7, 75, 101, 187, 165, 99, 34, 199, 53, 181, 161, 151, 60, 167, 211, 158, 80, 27, 72, 144, 123, 88, 106, 7, 75, 9, 76, 81, 119, 116, 62, 220, 97, 140, 147, 232, 175, 222, 6, 67, 186, 158, 172, 13, 62, 27, 213, 178, 187, 67, 154, 209, 233, 50, 198, 76, 180, 65, 13, 235, 20, 156, 241, 109, 125, 27, 98, 51, 230, 85, 31, 15, 106, 203, 23, 29, 162, 49, 98, 72, 140, 49, 17, 117, 21, 248, 115, 117, 215, 99, 78, 3, 203, 185, 24, 24, 195, 1, 37, 236, 123, 172, 36, 233, 173, 242, 82, 187, 130, 13, 251, 233, 57, 49, 60, 193, 250, 196, 28, 169, 196, 49, 168, 190, 109, 244, 60, 240, 153, 100, 1, 39, 14, 84, 56, 244, 172, 12, 169, 109, 54, 79, 133, 116, 44, 240, 121, 71, 224, 11, 49, 93, 82, 83, 135, 65, 83, 234, 142, 112, 93, 253, 121, 198, 244, 211, 48, 143, 147, 6, 44, 69, 180, 44, 52, 238, 250, 152, 89, 133, 37, 139, 232, 167, 238, 104, 222, 223, 25, 75, 228, 70, 205, 168, 147, 90, 105, 12, 161, 81, 117, 39, 133, 219, 162, 169, 3, 96, 241, 210, 49, 254, 70, 200, 86, 230, 180, 253, 228, 244, 37, 65, 76, 94, 58, 214, 233, 163, 193, 235, 232, 208, 222, 23, 203, 3, 24, 94, 174, 77, 225, 227, 196, 250, 80, 33, 211, 159, 78, 186, 31, 180, 11, 175, 6, 60, 175, 159, 170, 144, 194, 95, 80, 68, 148, 203, 59, 58, 58, 180, 41, 230, 81, 63, 61, 71, 31, 8, 211, 13, 127, 131, 252, 221, 252, 133, 50, 82, 255, 251, 217, 66, 130, 190, 131, 164, 224, 6, 148, 50, 148, 40, 136, 72, 153, 254, 179, 5, 215, 20, 145, 145, 54, 69, 222, 212, 209, 209, 209, 85, 45, 234, 166, 118, 82, 211, 117, 76, 68, 98, 189, 86, 39, 55, 216, 64, 239, 90, 41, 66, 150, 13, 47, 231, 53, 218, 122, 106, 176, 244, 181, 152, 125, 162, 78, 166, 215, 249, 6, 200, 96, 5, 159, 127, 176, 36, 240, 56, 133, 188, 89, 101, 6, 203, 133, 58, 56, 147, 212, 193, 22, 82, 7, 39, 3, 88, 45, 4, 117, 232, 145, 131, 136, 127, 136, 234, 117, 6, 127, 44, 48, 165, 67, 188, 133, 165, 240, 55, 58, 58, 19, 228, 77, 90, 77, 87, 181, 212, 20, 226, 11, 250, 184, 232, 124, 129, 134, 7, 40, 139, 7, 99, 124, 166, 110, 53, 200, 89, 175, 30, 16, 105, 136, 60, 250, 21, 236, 241, 142, 192, 231, 44, 149, 15, 235, 56, 113, 49, 127, 190, 35, 68, 115, 124, 92, 228, 152, 0, 139, 196, 5, 56, 234, 176, 64, 207, 49, 169, 48, 198, 68, 59, 159, 143, 19, 99, 210, 36, 216, 143, 24, 19, 189, 206, 224, 152, 12, 241, 87, 16, 149, 225, 54, 224, 42, 202, 192, 229, 229, 17, 188, 68, 17, 188, 68, 4, 84, 178, 42, 98, 100, 130, 3, 99, 10, 113, 54, 172, 208, 73, 90, 188, 136, 197, 119, 116, 238, 225, 207, 99, 94, 199, 137, 139, 165, 175, 117, 94, 23, 47, 214, 140, 152, 83, 157, 215, 81, 186, 118, 182, 28, 184, 36, 137, 97, 161, 176, 246, 92, 154, 76, 3, 18, 39, 19, 170, 225, 51, 72, 69, 95, 42, 65, 238, 215, 53, 170, 35, 56, 38, 120, 185, 190, 166, 205, 224, 245, 103, 132, 178, 24, 73, 18, 146, 233, 55, 82, 117, 216, 179, 58, 250, 143, 208, 11, 34, 104, 220, 222, 245, 59, 226, 94, 198, 178, 166, 245, 65, 235, 78, 240, 83, 129, 190, 136, 241, 104, 135, 1, 72, 132, 234, 181, 197, 41, 10, 229, 204, 160, 106, 131, 193, 28, 164, 99, 129, 47, 68, 213, 29, 236, 49, 62, 76, 154, 209, 53, 74, 171, 145, 47, 10, 242, 90, 90, 215, 130, 63, 234, 180, 65, 124, 227, 244, 105, 195, 23, 164, 141, 212, 142, 192, 100, 65, 27, 252, 185, 19, 151, 24, 180, 193, 118, 243, 113, 61, 199, 129, 232, 155, 227, 76, 138, 182, 68, 237, 69, 222, 119, 28, 42, 130, 227, 208, 245, 91, 188, 92, 31, 7, 151, 142, 195, 1, 139, 23, 245, 133, 233, 66, 52, 85, 65, 138, 102, 177, 28, 184, 44, 139, 249, 18, 30, 227, 92, 62, 9, 93, 248, 4, 93, 8, 14, 18, 248, 156, 61, 78, 226, 147, 63, 215, 249, 176, 246, 126, 135, 244, 247, 19, 151, 244, 144, 63, 86, 49, 38, 212, 116, 10, 149, 178, 237, 100, 196, 161, 143, 122, 136, 58, 66, 210, 72, 151, 72, 130, 64, 130, 188, 68, 95, 247, 130, 231, 89, 201, 54, 147, 120, 52, 180, 171, 178, 194, 131, 199, 166, 61, 107, 23, 76, 208, 42, 137, 158, 232, 140, 208, 20, 193, 8, 233, 93, 58, 237, 104, 235, 13, 134, 24, 146, 127, 60, 226, 93, 102, 136, 37, 4, 147, 28, 117, 160, 93, 33, 188, 98, 27, 101, 90, 86, 37, 182, 28, 84, 89, 86, 103, 130, 170, 202, 45, 32, 171, 242, 100, 125, 203, 16, 71, 251, 38, 82, 82, 66, 212, 228, 181, 137, 9, 226, 53, 130, 162, 4, 179, 33, 229, 69, 250, 58, 72, 85, 145, 252, 70, 200, 162, 114, 127, 105, 164, 204, 85, 80, 207, 66, 204, 185, 52, 83, 196, 19, 165, 115, 246, 254, 5, 146, 206, 119, 82, 59, 12, 198, 195, 159, 251, 233, 57, 157, 245, 24, 124, 66, 200, 78, 43, 84, 248, 203, 36, 164, 40, 114, 52, 134, 189, 192, 73, 90, 214, 65, 3, 7, 225, 122, 169, 203, 82, 19, 201, 101, 122, 65, 72, 156, 104, 231, 235, 34, 69, 106, 18, 242, 158, 6, 146, 148, 58, 227, 61, 68, 119, 177, 48, 198, 63, 74, 33, 185, 210, 174, 234, 124, 201, 220, 135, 47, 153, 66, 124, 137, 140, 41, 122, 210, 160, 165, 7, 111, 242, 217, 200, 162, 66, 240, 39, 241, 238, 8, 22, 69, 20, 73, 173, 8, 245, 85, 151, 189, 86, 24, 234, 175, 36, 162, 19, 131, 217, 91, 178, 169, 50, 11, 73, 224, 190, 20, 99, 72, 97, 98, 138, 65, 73, 28, 84, 30, 133, 48, 238, 170, 230, 207, 135, 245, 8, 62, 204, 224, 54, 189, 196, 156, 34, 115, 174, 11, 186, 94, 51, 166, 75, 58, 170, 154, 36, 29, 169, 77, 186, 164, 35, 13, 130, 71, 244, 129, 232, 208, 239, 31, 169, 32, 151, 248, 54, 0, 83, 80, 143, 80, 145, 73, 18, 155, 169, 34, 99, 82, 139, 108, 102, 18, 11, 146, 62, 13, 162, 213, 66, 134, 2, 161, 206, 88, 194, 157, 33, 201, 167, 139, 191, 78, 31, 75, 13, 9, 192, 96, 175, 136, 223, 11, 146, 52, 244, 23, 157, 94, 132, 61, 116, 80, 219, 162, 86, 0, 95, 30, 97, 220, 163, 34, 153, 246, 244, 75, 49, 134, 166, 68, 163, 40, 150, 183, 174, 206, 240, 231, 186, 70, 209, 251, 34, 117, 58, 225, 243, 35, 180, 130, 118, 194, 199, 101, 25, 102, 210, 55, 8, 159, 31, 144, 251, 167, 121, 34, 70, 143, 83, 95, 206, 186, 14, 22, 146, 5, 60, 212, 135, 32, 45, 86, 251, 135, 11, 235, 228, 118, 25, 129, 155, 24, 83, 136, 15, 42, 170, 170, 204, 20, 71, 135, 102, 84, 21, 117, 114, 84, 20, 64, 84, 108, 84, 108, 76, 116, 164, 242, 96, 208, 161, 143, 70, 207, 103, 243, 80, 135, 240, 242, 14, 82, 125, 58, 112, 123, 168, 87, 68, 131, 180, 172, 131, 107, 46, 172, 99, 218, 41, 251, 138, 24, 65, 66, 89, 200, 247, 212, 98, 102, 98, 28, 77, 34, 35, 197, 76, 19, 134, 71, 178, 31, 24, 194, 24, 71, 157, 83, 26, 11, 61, 196, 46, 67, 11, 94, 231, 154, 193, 85, 31, 249, 126, 33, 1, 104, 197, 91, 204, 76, 5, 117, 27, 69, 68, 166, 37, 79, 223, 8, 45, 38, 197, 56, 145, 232, 131, 128, 68, 44, 250, 176, 118, 166, 47, 64, 220, 174, 43, 105, 77, 225, 145, 55, 180, 53, 178, 27, 51, 222, 79, 227, 111, 3, 23, 76, 246, 215, 171, 130, 27, 88, 4, 55, 48, 33, 87, 162, 153, 224, 7, 86, 50, 37, 131, 153, 86, 162, 135, 22, 115, 20, 51, 88, 130, 43, 193, 233, 176, 7, 189, 229, 123, 177, 134, 152, 94, 172, 193, 19, 193, 114, 13, 22, 161, 207, 143, 96, 187, 189, 121, 69, 136, 253, 10, 26, 252, 78, 231, 249, 3, 157, 85, 216, 108, 30, 155, 116, 140, 125, 39, 221, 213, 185, 48, 98, 110, 217, 119, 253, 238, 83, 169, 29, 196, 49, 216, 119, 157, 11, 34, 231, 225, 100, 101, 245, 61, 45, 213, 203, 150, 159, 164, 44, 91, 30, 184, 213, 216, 255, 46, 59, 73, 189, 72, 101, 151, 133, 75, 3, 196, 83, 221, 210, 49, 229, 53, 29, 143, 138, 5, 187, 176, 231, 75, 7, 47, 228, 66, 33, 12, 2, 31, 84, 193, 112, 24, 5, 99, 97, 2, 252, 226, 255, 201, 97, 97, 80, 226, 204, 183, 102, 201, 130, 227, 36, 68, 49, 54, 200, 85, 16, 157, 45, 139, 76, 234, 137, 49, 76, 26, 156, 84, 24, 155, 35, 203, 138, 36, 47, 79, 142, 99, 74, 169, 187, 200, 150, 43, 171, 38, 69, 93, 158, 18, 207, 76, 101, 169, 197, 246, 60, 217, 156, 102, 50, 47, 207, 204, 96, 105, 233, 233, 105, 51, 233, 59, 45, 189, 165, 194, 91, 238, 241, 201, 233, 105, 233, 147, 107, 106, 252, 254, 17, 35, 134, 12, 41, 47, 31, 60, 184, 168, 40, 47, 47, 43, 43, 35, 35, 37, 37, 49, 209, 225, 136, 139, 139, 138, 10, 130, 97, 53, 19, 106, 38, 140, 31, 231, 31, 235, 31, 59, 102, 244, 136, 81, 35, 70, 85, 143, 28, 50, 124, 200, 240, 97, 67, 203, 171, 202, 171, 42, 43, 6, 251, 6, 251, 202, 74, 139, 6, 21, 13, 42, 41, 206, 43, 204, 43, 44, 200, 207, 202, 205, 202, 205, 201, 206, 240, 102, 120, 51, 61, 41, 233, 41, 33, 83, 67, 135, 203, 225, 74, 112, 198, 217, 227, 236, 241, 182, 224, 26, 239, 3, 179, 85, 10, 86, 233, 115, 82, 148, 27, 140, 248, 182, 159, 230, 53, 35, 240, 36, 125, 222, 192, 55, 180, 165, 193, 255, 189, 54, 192, 239, 174, 55, 142, 189, 246, 198, 107, 111, 40, 202, 145, 35, 228, 81, 173, 157, 119, 228, 136, 118, 110, 240, 91, 41, 60, 189, 107, 218, 186, 23, 197, 63, 128, 172, 8, 58, 59, 245, 252, 78, 130, 201, 112, 6, 204, 132, 57, 208, 2, 139, 97, 57, 172, 132, 213, 208, 6, 231, 195, 6, 216, 12, 151, 192, 101, 112, 37, 236, 0, 48, 134, 34, 242, 99, 239, 245, 247, 255, 141, 107, 145, 195, 24, 249, 251, 117, 227, 31, 59, 221, 95, 47, 27, 255, 58, 125, 175, 191, 245, 250, 91, 175, 119, 189, 74, 95, 47, 203, 163, 95, 120, 129, 70, 78, 91, 71, 223, 47, 188, 160, 157, 251, 226, 139, 202, 191, 194, 191, 131, 247, 94, 124, 81, 94, 254, 127, 182, 156, 242, 61, 205, 213, 139, 47, 254, 220, 254, 130, 248, 71, 235, 210, 254, 43, 231, 13, 236, 21, 94, 39, 86, 120, 157, 189, 191, 79, 247, 154, 71, 255, 16, 139, 120, 227, 13, 188, 237, 13, 12, 254, 143, 45, 31, 232, 15, 173, 245, 24, 91, 70, 151, 186, 144, 120, 213, 255, 9, 122, 13, 234, 139, 8, 75, 0, 164, 53, 82, 244, 73, 120, 47, 237, 203, 150, 72, 209, 82, 116, 231, 191, 128, 69, 148, 87, 73, 227, 210, 45, 11, 150, 71, 28, 217, 75, 161, 35, 123, 89, 18, 149, 200, 122, 37, 217, 30, 155, 103, 137, 20, 221, 213, 196, 107, 187, 158, 162, 234, 22, 243, 90, 193, 203, 151, 224, 141, 108, 45, 191, 239, 52, 206, 184, 169, 41, 44, 6, 111, 100, 49, 1, 29, 19, 95, 130, 119, 177, 181, 124, 223, 175, 109, 143, 221, 104, 15, 139, 209, 102, 224, 68, 237, 73, 188, 139, 234, 92, 140, 181, 36, 47, 24, 152, 72, 124, 158, 26, 59, 181, 121, 108, 38, 58, 130, 3, 141, 250, 113, 2, 64, 249, 94, 254, 25, 24, 56, 253, 241, 100, 147, 67, 216, 57, 137, 49, 6, 140, 41, 9, 133, 62, 196, 19, 172, 139, 117, 202, 63, 159, 48, 247, 44, 175, 60, 193, 0, 203, 10, 237, 136, 39, 232, 166, 252, 115, 64, 10, 200, 208, 95, 157, 19, 123, 213, 105, 102, 202, 247, 84, 56, 248, 24, 64, 191, 245, 122, 16, 149, 239, 233, 62, 189, 157, 202, 48, 200, 192, 76, 254, 7, 233, 29, 49, 110, 166, 3, 170, 204, 145, 149, 21, 154, 85, 254, 7, 45, 71, 203, 197, 15, 208, 167, 29, 211, 222, 192, 50, 214, 165, 229, 224, 135, 248, 129, 150, 139, 101, 218, 27, 218, 49, 241, 236, 35, 240, 169, 148, 35, 98, 212, 184, 200, 126, 195, 176, 61, 39, 49, 5, 172, 57, 148, 140, 136, 193, 212, 62, 249, 215, 149, 132, 194, 236, 76, 16, 94, 2, 148, 56, 58, 210, 194, 156, 45, 254, 141, 22, 64, 233, 41, 58, 9, 253, 229, 233, 235, 143, 189, 122, 237, 142, 87, 95, 249, 20, 213, 3, 79, 161, 164, 117, 62, 253, 148, 214, 189, 248, 232, 53, 215, 191, 246, 218, 142, 171, 95, 7, 140, 104, 3, 217, 41, 232, 218, 49, 156, 23, 138, 146, 211, 235, 69, 61, 42, 239, 81, 169, 177, 95, 17, 235, 63, 86, 32, 184, 200, 177, 157, 80, 183, 118, 178, 34, 98, 237, 100, 134, 36, 75, 86, 179, 20, 43, 199, 210, 246, 63, 145, 208, 10, 15, 150, 81, 55, 232, 84, 215, 1, 250, 246, 228, 134, 223, 161, 26, 60, 214, 69, 70, 91, 219, 95, 118, 33, 62, 245, 52, 202, 90, 215, 83, 7, 180, 95, 116, 157, 56, 226, 93, 110, 218, 77, 211, 197, 118, 66, 67, 196, 166, 189, 93, 69, 137, 73, 237, 138, 216, 30, 6, 181, 20, 89, 114, 57, 227, 220, 54, 183, 209, 0, 147, 174, 137, 68, 54, 160, 202, 56, 74, 119, 58, 20, 106, 12, 91, 240, 15, 28, 175, 61, 253, 229, 95, 181, 223, 226, 216, 207, 30, 233, 10, 60, 241, 27, 77, 35, 69, 132, 173, 195, 49, 95, 127, 137, 227, 180, 223, 254, 245, 11, 237, 208, 134, 238, 199, 159, 8, 4, 126, 115, 80, 167, 137, 49, 184, 134, 117, 240, 23, 66, 243, 202, 105, 102, 177, 149, 166, 146, 65, 51, 185, 130, 179, 133, 52, 201, 172, 223, 121, 197, 136, 185, 116, 69, 180, 7, 91, 167, 173, 107, 155, 54, 109, 237, 218, 134, 243, 247, 61, 178, 97, 227, 190, 199, 240, 221, 181, 83, 166, 183, 181, 77, 155, 114, 238, 210, 199, 46, 216, 240, 200, 35, 23, 109, 216, 75, 239, 151, 49, 170, 251, 35, 53, 77, 207, 249, 239, 133, 65, 48, 28, 150, 249, 23, 43, 40, 153, 100, 147, 36, 211, 97, 11, 55, 11, 23, 7, 115, 172, 37, 134, 155, 36, 179, 137, 236, 220, 85, 38, 171, 173, 209, 65, 210, 139, 22, 164, 23, 69, 177, 195, 166, 150, 14, 206, 206, 162, 102, 86, 85, 12, 30, 94, 58, 188, 48, 63, 107, 80, 246, 160, 204, 140, 180, 212, 148, 228, 164, 196, 200, 166, 199, 41, 9, 133, 178, 145, 112, 214, 22, 7, 217, 17, 191, 185, 47, 222, 147, 97, 247, 41, 146, 55, 51, 203, 30, 209, 37, 79, 196, 111, 41, 74, 59, 162, 13, 199, 151, 113, 12, 142, 193, 151, 181, 42, 237, 41, 180, 226, 90, 237, 90, 173, 83, 235, 212, 174, 197, 85, 31, 174, 166, 236, 179, 249, 19, 87, 167, 107, 93, 207, 60, 163, 117, 189, 90, 86, 91, 235, 243, 77, 156, 136, 135, 202, 106, 39, 250, 124, 181, 181, 217, 248, 42, 142, 18, 207, 14, 211, 14, 107, 135, 180, 17, 29, 184, 10, 77, 40, 225, 57, 162, 18, 77, 187, 154, 213, 105, 199, 177, 228, 143, 157, 157, 184, 161, 150, 158, 240, 149, 79, 48, 190, 201, 215, 7, 139, 187, 63, 82, 71, 69, 140, 91, 179, 191, 201, 42, 51, 174, 72, 10, 151, 182, 0, 210, 206, 10, 213, 214, 104, 83, 20, 87, 64, 98, 138, 212, 26, 97, 172, 96, 17, 131, 149, 157, 149, 148, 24, 21, 133, 16, 28, 166, 244, 212, 68, 111, 146, 55, 202, 21, 229, 74, 112, 216, 227, 227, 98, 130, 3, 21, 211, 107, 160, 48, 65, 242, 149, 101, 85, 121, 153, 39, 131, 87, 69, 204, 189, 158, 3, 94, 252, 150, 138, 123, 14, 78, 215, 159, 210, 81, 122, 230, 25, 148, 210, 87, 79, 212, 222, 211, 222, 155, 120, 97, 249, 68, 49, 8, 44, 189, 66, 12, 75, 223, 209, 96, 159, 254, 177, 179, 243, 143, 52, 0, 15, 76, 40, 167, 78, 251, 106, 141, 111, 226, 69, 251, 186, 191, 82, 30, 144, 255, 2, 185, 224, 131, 5, 254, 121, 73, 137, 46, 103, 124, 92, 108, 140, 73, 101, 201, 209, 12, 85, 143, 194, 20, 200, 201, 242, 102, 202, 18, 71, 147, 172, 72, 245, 70, 146, 98, 66, 57, 105, 63, 13, 203, 205, 168, 200, 178, 210, 76, 185, 162, 68, 252, 110, 69, 158, 156, 159, 7, 48, 168, 56, 207, 151, 239, 131, 92, 200, 181, 37, 38, 218, 18, 45, 10, 185, 246, 230, 228, 170, 185, 85, 57, 185, 222, 10, 95, 101, 85, 110, 149, 171, 178, 170, 194, 231, 76, 112, 85, 185, 212, 4, 151, 207, 233, 85, 84, 151, 154, 171, 168, 78, 30, 49, 4, 124, 193, 134, 53, 143, 183, 62, 94, 151, 216, 213, 149, 88, 247, 120, 235, 227, 107, 54, 116, 108, 88, 243, 248, 138, 199, 130, 23, 30, 91, 241, 248, 154, 13, 147, 239, 252, 224, 131, 91, 119, 190, 255, 94, 82, 151, 179, 225, 241, 214, 199, 90, 47, 237, 184, 180, 245, 177, 214, 199, 27, 156, 93, 125, 46, 224, 149, 239, 221, 126, 235, 251, 239, 239, 188, 229, 67, 96, 176, 76, 91, 39, 239, 84, 106, 32, 1, 50, 201, 135, 198, 38, 51, 84, 176, 94, 36, 246, 87, 21, 220, 98, 162, 72, 3, 33, 59, 120, 78, 130, 35, 136, 234, 66, 11, 25, 100, 193, 228, 68, 23, 64, 90, 138, 43, 51, 49, 19, 18, 32, 193, 150, 144, 67, 59, 109, 61, 158, 135, 45, 43, 60, 211, 178, 64, 9, 121, 4, 191, 97, 7, 181, 106, 124, 94, 59, 161, 29, 196, 109, 232, 64, 7, 110, 211, 14, 106, 39, 240, 121, 173, 122, 202, 199, 56, 88, 123, 251, 189, 247, 180, 183, 177, 68, 91, 247, 115, 7, 58, 178, 113, 13, 130, 40, 114, 161, 246, 143, 110, 208, 118, 100, 107, 255, 232, 248, 153, 15, 194, 226, 15, 223, 197, 65, 218, 219, 239, 253, 81, 123, 131, 214, 63, 131, 194, 238, 3, 202, 76, 181, 68, 68, 11, 51, 29, 72, 117, 197, 72, 172, 140, 34, 171, 229, 102, 219, 85, 206, 19, 92, 241, 64, 26, 5, 131, 236, 74, 76, 144, 170, 42, 227, 115, 115, 178, 40, 121, 98, 212, 71, 218, 141, 57, 108, 254, 125, 59, 22, 126, 116, 176, 178, 86, 251, 70, 251, 183, 246, 147, 150, 85, 59, 1, 157, 24, 245, 81, 224, 195, 161, 184, 230, 15, 111, 190, 83, 53, 68, 187, 250, 173, 99, 31, 72, 159, 225, 198, 133, 59, 238, 13, 220, 147, 131, 103, 106, 237, 183, 30, 211, 254, 173, 165, 78, 168, 69, 23, 70, 161, 21, 63, 168, 29, 222, 245, 220, 31, 223, 31, 90, 133, 231, 190, 249, 230, 123, 67, 170, 12, 219, 84, 58, 211, 143, 150, 43, 33, 145, 108, 109, 93, 81, 200, 133, 100, 103, 132, 148, 51, 70, 178, 56, 17, 18, 19, 28, 153, 18, 157, 136, 219, 117, 179, 38, 202, 58, 18, 118, 130, 204, 181, 227, 253, 115, 86, 92, 59, 111, 125, 237, 89, 107, 218, 102, 204, 56, 119, 205, 89, 181, 235, 231, 239, 88, 49, 7, 151, 212, 93, 126, 201, 170, 27, 219, 46, 216, 176, 123, 247, 134, 11, 218, 110, 92, 125, 241, 229, 117, 218, 237, 250, 59, 229, 104, 169, 19, 50, 96, 176, 191, 56, 221, 105, 69, 137, 204, 107, 141, 220, 19, 128, 237, 148, 169, 130, 112, 81, 89, 166, 22, 100, 64, 70, 86, 118, 118, 86, 38, 161, 91, 30, 123, 232, 212, 216, 21, 226, 83, 170, 206, 174, 188, 153, 220, 206, 146, 154, 215, 188, 180, 230, 150, 123, 219, 203, 134, 149, 223, 222, 182, 246, 54, 223, 208, 178, 109, 247, 222, 114, 206, 203, 107, 103, 51, 166, 253, 243, 238, 117, 151, 220, 105, 91, 214, 124, 209, 67, 15, 93, 212, 188, 204, 118, 231, 37, 235, 238, 70, 91, 64, 232, 55, 117, 221, 159, 73, 235, 164, 103, 32, 137, 246, 187, 209, 134, 46, 146, 89, 64, 251, 104, 57, 103, 144, 20, 124, 153, 61, 120, 146, 30, 43, 41, 172, 165, 220, 62, 123, 76, 213, 216, 167, 110, 108, 123, 175, 178, 242, 253, 182, 27, 159, 26, 51, 100, 204, 108, 123, 218, 204, 164, 205, 45, 19, 166, 255, 253, 153, 235, 126, 171, 237, 199, 250, 223, 94, 247, 204, 223, 167, 79, 104, 217, 76, 73, 115, 16, 242, 186, 63, 229, 223, 202, 195, 65, 5, 229, 9, 137, 116, 34, 153, 33, 216, 227, 17, 152, 245, 5, 237, 207, 152, 241, 194, 17, 76, 213, 62, 207, 196, 91, 49, 1, 29, 184, 83, 91, 174, 125, 163, 125, 13, 8, 165, 221, 31, 241, 55, 229, 163, 144, 70, 56, 150, 195, 106, 225, 140, 20, 73, 198, 234, 195, 22, 165, 205, 122, 110, 7, 134, 83, 211, 82, 18, 19, 132, 6, 135, 54, 159, 211, 225, 34, 152, 67, 205, 173, 172, 40, 207, 205, 205, 201, 205, 201, 165, 116, 1, 193, 190, 224, 171, 139, 47, 25, 255, 193, 202, 113, 213, 179, 234, 18, 202, 83, 115, 181, 135, 39, 206, 127, 100, 221, 8, 44, 150, 110, 75, 190, 100, 73, 197, 174, 171, 86, 92, 86, 62, 125, 228, 200, 198, 177, 246, 108, 123, 113, 160, 88, 43, 173, 31, 49, 251, 178, 32, 205, 44, 209, 190, 145, 243, 229, 111, 33, 153, 78, 202, 146, 16, 185, 149, 68, 122, 125, 114, 116, 20, 72, 188, 78, 28, 242, 53, 235, 57, 42, 133, 31, 248, 66, 122, 104, 170, 55, 215, 235, 21, 71, 196, 54, 162, 26, 209, 4, 151, 13, 109, 14, 53, 252, 23, 155, 251, 208, 125, 123, 247, 158, 231, 247, 241, 193, 243, 23, 93, 185, 103, 239, 6, 223, 16, 254, 115, 215, 75, 95, 126, 123, 213, 220, 246, 68, 105, 57, 142, 239, 138, 237, 94, 220, 122, 217, 156, 139, 19, 248, 106, 136, 134, 64, 183, 79, 229, 74, 13, 36, 67, 14, 148, 193, 36, 88, 2, 107, 97, 19, 92, 1, 247, 131, 134, 25, 88, 130, 35, 76, 247, 143, 206, 132, 46, 120, 80, 100, 75, 138, 131, 127, 194, 1, 161, 208, 34, 172, 2, 4, 19, 16, 96, 76, 8, 188, 9, 7, 97, 22, 48, 72, 134, 4, 160, 51, 186, 40, 136, 129, 47, 225, 48, 48, 136, 39, 196, 17, 234, 131, 81, 182, 111, 206, 194, 216, 184, 216, 118, 39, 198, 97, 92, 187, 76, 154, 119, 59, 152, 185, 137, 155, 77, 91, 50, 80, 50, 185, 76, 146, 107, 75, 26, 130, 43, 201, 5, 73, 91, 32, 41, 193, 149, 148, 176, 41, 21, 221, 201, 74, 178, 91, 217, 18, 141, 74, 76, 178, 18, 179, 9, 98, 162, 146, 99, 162, 54, 121, 49, 74, 77, 142, 82, 55, 129, 26, 159, 172, 198, 111, 130, 120, 123, 114, 188, 125, 19, 216, 109, 201, 118, 219, 38, 7, 218, 108, 151, 102, 90, 210, 57, 179, 34, 55, 51, 222, 234, 65, 19, 184, 192, 228, 106, 77, 204, 102, 9, 201, 201, 9, 205, 244, 157, 144, 188, 48, 5, 147, 19, 146, 13, 27, 208, 59, 254, 203, 230, 69, 39, 187, 163, 183, 64, 180, 146, 28, 173, 108, 130, 255, 155, 141, 140, 254, 223, 61, 134, 177, 255, 47, 140, 97, 220, 255, 238, 49, 140, 255, 127, 97, 12, 237, 255, 187, 199, 208, 249, 255, 194, 24, 38, 252, 239, 30, 195, 196, 255, 23, 198, 48, 233, 127, 243, 24, 254, 47, 31, 190, 230, 240, 191, 209, 45, 228, 149, 1, 187, 97, 23, 92, 5, 87, 194, 185, 208, 6, 203, 96, 41, 148, 131, 15, 82, 128, 146, 146, 3, 14, 193, 74, 44, 195, 82, 44, 192, 60, 244, 162, 7, 83, 209, 141, 137, 152, 128, 86, 52, 67, 55, 102, 32, 96, 6, 252, 7, 126, 130, 63, 195, 167, 240, 1, 188, 11, 127, 128, 55, 224, 40, 188, 4, 143, 192, 94, 216, 5, 26, 236, 6, 13, 238, 129, 187, 224, 86, 184, 25, 174, 132, 251, 225, 42, 184, 31, 46, 132, 245, 208, 6, 155, 224, 92, 216, 4, 103, 193, 153, 176, 20, 214, 194, 50, 88, 11, 115, 97, 54, 76, 131, 41, 224, 135, 106, 240, 193, 36, 40, 135, 73, 144, 9, 25, 224, 134, 28, 72, 129, 28, 136, 6, 43, 0, 80, 186, 31, 82, 91, 20, 72, 40, 196, 178, 202, 42, 17, 92, 207, 112, 72, 87, 156, 142, 52, 230, 74, 112, 37, 112, 93, 173, 37, 133, 205, 229, 8, 187, 14, 200, 253, 60, 225, 74, 227, 72, 33, 54, 67, 233, 123, 171, 89, 85, 53, 143, 0, 46, 210, 24, 14, 80, 157, 40, 84, 194, 114, 75, 184, 94, 144, 12, 214, 177, 44, 193, 69, 217, 129, 19, 68, 224, 8, 97, 208, 30, 252, 162, 10, 114, 75, 184, 112, 114, 16, 49, 60, 115, 41, 150, 4, 53, 177, 170, 132, 87, 148, 87, 51, 241, 37, 210, 76, 186, 42, 43, 69, 221, 57, 185, 122, 184, 137, 24, 46, 28, 33, 244, 252, 195, 74, 78, 46, 189, 140, 172, 226, 115, 21, 69, 165, 223, 85, 101, 213, 140, 237, 203, 111, 202, 204, 201, 201, 47, 200, 105, 242, 12, 154, 145, 153, 153, 195, 45, 246, 216, 212, 65, 229, 153, 158, 220, 130, 52, 111, 126, 174, 61, 202, 100, 138, 81, 163, 20, 27, 79, 25, 220, 148, 145, 145, 89, 152, 159, 209, 88, 48, 178, 209, 149, 154, 85, 110, 205, 44, 30, 130, 201, 60, 202, 229, 72, 153, 80, 93, 96, 181, 197, 171, 142, 88, 179, 37, 214, 91, 238, 181, 5, 158, 201, 206, 242, 228, 229, 229, 201, 113, 25, 248, 138, 39, 167, 32, 53, 43, 55, 219, 105, 53, 153, 162, 149, 104, 37, 182, 235, 51, 135, 59, 101, 240, 168, 76, 201, 26, 107, 183, 186, 114, 188, 131, 6, 71, 219, 156, 246, 36, 188, 225, 174, 239, 166, 13, 30, 81, 148, 151, 154, 145, 30, 155, 59, 218, 151, 156, 18, 195, 98, 243, 198, 87, 70, 37, 196, 91, 147, 50, 75, 235, 99, 153, 172, 170, 108, 208, 202, 81, 229, 99, 134, 142, 42, 115, 230, 218, 163, 60, 121, 69, 137, 222, 188, 100, 243, 188, 51, 43, 162, 77, 206, 76, 183, 187, 178, 48, 197, 147, 235, 202, 200, 173, 44, 153, 214, 137, 9, 174, 132, 148, 100, 201, 153, 156, 22, 109, 77, 74, 180, 75, 102, 135, 205, 154, 98, 143, 78, 112, 37, 68, 71, 185, 221, 201, 41, 49, 229, 94, 84, 99, 98, 114, 44, 86, 25, 55, 58, 202, 82, 153, 26, 101, 29, 164, 154, 36, 228, 87, 101, 164, 102, 206, 25, 50, 180, 37, 61, 213, 150, 149, 149, 53, 183, 36, 202, 147, 83, 96, 207, 113, 184, 208, 87, 144, 148, 154, 155, 237, 78, 41, 44, 177, 88, 163, 77, 114, 140, 229, 63, 89, 25, 25, 211, 138, 203, 102, 100, 36, 167, 230, 39, 167, 54, 149, 78, 28, 19, 147, 154, 16, 163, 61, 102, 182, 69, 155, 188, 190, 17, 137, 49, 206, 88, 83, 108, 66, 146, 53, 57, 197, 110, 199, 179, 147, 29, 174, 164, 4, 183, 26, 23, 109, 198, 184, 2, 87, 106, 94, 182, 219, 93, 88, 108, 137, 138, 49, 201, 49, 150, 64, 76, 74, 82, 178, 59, 193, 237, 230, 49, 177, 81, 153, 25, 137, 121, 169, 113, 113, 246, 56, 233, 182, 49, 83, 156, 249, 30, 71, 122, 82, 114, 85, 85, 69, 82, 197, 121, 91, 175, 156, 116, 223, 191, 31, 95, 151, 229, 28, 52, 120, 112, 66, 70, 122, 69, 133, 228, 72, 78, 182, 152, 84, 89, 145, 25, 75, 25, 225, 243, 228, 228, 228, 212, 230, 12, 186, 237, 183, 175, 205, 175, 191, 249, 234, 75, 134, 186, 135, 13, 27, 145, 234, 45, 118, 231, 228, 69, 53, 76, 232, 250, 171, 51, 46, 57, 47, 145, 43, 138, 36, 41, 170, 164, 58, 18, 221, 49, 14, 187, 137, 194, 181, 168, 41, 92, 141, 77, 246, 229, 37, 39, 100, 21, 149, 68, 69, 177, 228, 34, 79, 60, 166, 22, 249, 128, 65, 186, 158, 119, 42, 1, 146, 225, 98, 191, 197, 140, 38, 102, 65, 51, 55, 114, 172, 229, 27, 1, 212, 252, 13, 86, 12, 121, 68, 43, 168, 199, 16, 195, 225, 13, 150, 80, 72, 170, 92, 48, 89, 209, 140, 38, 243, 242, 240, 67, 125, 74, 82, 100, 42, 127, 162, 203, 5, 224, 74, 118, 37, 39, 37, 10, 68, 193, 102, 247, 102, 218, 108, 81, 34, 62, 21, 133, 14, 50, 146, 230, 41, 78, 113, 148, 237, 177, 209, 225, 210, 163, 215, 94, 139, 85, 163, 166, 78, 44, 175, 27, 198, 172, 71, 180, 167, 113, 252, 17, 190, 167, 227, 98, 188, 52, 249, 178, 232, 133, 23, 222, 76, 153, 55, 187, 154, 100, 126, 228, 136, 216, 87, 165, 119, 127, 37, 21, 41, 53, 144, 13, 191, 15, 134, 94, 178, 196, 32, 25, 170, 83, 208, 42, 119, 232, 15, 153, 25, 145, 153, 188, 192, 152, 105, 161, 25, 77, 38, 191, 136, 103, 200, 91, 40, 211, 234, 240, 6, 170, 108, 161, 140, 136, 99, 66, 238, 198, 133, 96, 54, 15, 163, 240, 144, 166, 133, 208, 167, 184, 44, 15, 13, 133, 3, 162, 71, 78, 85, 122, 68, 100, 233, 230, 102, 127, 76, 182, 200, 168, 106, 179, 231, 101, 58, 44, 20, 10, 200, 145, 198, 40, 20, 16, 249, 197, 216, 68, 140, 227, 96, 224, 43, 61, 209, 24, 141, 148, 143, 111, 113, 20, 143, 46, 72, 200, 25, 189, 238, 140, 34, 204, 159, 239, 155, 50, 226, 55, 191, 57, 114, 237, 181, 88, 90, 117, 223, 75, 56, 101, 232, 84, 95, 34, 238, 80, 138, 206, 184, 160, 65, 106, 142, 55, 217, 230, 95, 116, 115, 71, 87, 83, 112, 232, 104, 47, 205, 137, 227, 155, 64, 165, 95, 42, 164, 147, 13, 132, 19, 37, 158, 192, 24, 147, 88, 61, 34, 175, 227, 8, 19, 201, 160, 169, 29, 8, 115, 166, 209, 221, 22, 113, 110, 66, 27, 229, 201, 222, 124, 155, 205, 65, 22, 111, 54, 51, 227, 100, 247, 224, 33, 30, 86, 69, 252, 202, 155, 169, 58, 131, 134, 233, 170, 226, 148, 64, 130, 46, 216, 142, 113, 218, 119, 24, 151, 81, 91, 125, 83, 231, 7, 143, 60, 249, 196, 21, 239, 94, 234, 95, 196, 197, 173, 46, 64, 248, 236, 206, 198, 205, 205, 53, 195, 119, 92, 122, 237, 3, 67, 222, 156, 246, 240, 218, 235, 39, 156, 245, 12, 181, 110, 74, 247, 71, 82, 166, 252, 173, 136, 69, 106, 39, 155, 37, 64, 224, 18, 242, 214, 144, 191, 134, 26, 196, 119, 85, 129, 239, 42, 2, 178, 140, 139, 51, 155, 16, 130, 167, 201, 209, 86, 83, 172, 57, 54, 24, 158, 212, 44, 96, 202, 96, 120, 210, 236, 12, 27, 243, 100, 216, 226, 232, 140, 147, 13, 213, 92, 248, 119, 252, 92, 209, 92, 152, 138, 218, 23, 218, 23, 135, 94, 202, 230, 22, 110, 234, 250, 79, 215, 79, 29, 236, 60, 118, 110, 224, 170, 192, 21, 172, 80, 208, 25, 235, 209, 166, 147, 159, 113, 80, 51, 34, 27, 160, 244, 211, 128, 190, 47, 239, 251, 106, 221, 174, 1, 24, 219, 209, 120, 93, 210, 217, 45, 177, 35, 254, 13, 177, 38, 186, 10, 127, 156, 216, 74, 95, 240, 233, 235, 183, 181, 105, 181, 129, 191, 152, 206, 49, 253, 75, 88, 190, 50, 186, 26, 124, 78, 221, 161, 77, 1, 48, 61, 171, 213, 106, 19, 76, 231, 208, 149, 200, 127, 236, 101, 69, 191, 196, 134, 134, 62, 71, 217, 123, 48, 87, 106, 131, 36, 169, 13, 54, 168, 169, 176, 94, 110, 130, 33, 120, 25, 212, 178, 61, 112, 9, 219, 3, 211, 164, 189, 112, 181, 180, 23, 86, 176, 237, 112, 212, 248, 166, 103, 165, 54, 152, 34, 181, 193, 113, 169, 13, 150, 73, 109, 112, 171, 212, 6, 233, 82, 27, 220, 39, 181, 193, 116, 169, 13, 182, 75, 109, 176, 152, 126, 179, 54, 56, 74, 31, 122, 86, 218, 11, 105, 226, 187, 13, 246, 152, 210, 33, 32, 55, 129, 93, 110, 130, 93, 242, 203, 112, 174, 252, 50, 236, 146, 155, 224, 25, 233, 115, 56, 172, 12, 133, 121, 244, 55, 47, 133, 141, 252, 103, 112, 200, 77, 112, 88, 106, 131, 93, 202, 30, 81, 118, 151, 184, 223, 4, 15, 24, 229, 228, 38, 88, 47, 125, 14, 14, 249, 101, 216, 45, 55, 65, 150, 122, 53, 36, 201, 77, 144, 34, 55, 65, 154, 244, 57, 44, 101, 67, 97, 149, 222, 95, 63, 127, 22, 198, 75, 109, 0, 108, 15, 156, 41, 181, 65, 139, 212, 6, 59, 37, 128, 38, 169, 45, 248, 97, 67, 69, 63, 230, 209, 117, 252, 5, 174, 101, 106, 247, 94, 9, 96, 39, 203, 130, 157, 202, 30, 216, 73, 215, 245, 178, 244, 187, 137, 209, 53, 128, 115, 217, 88, 49, 134, 215, 72, 0, 118, 249, 101, 176, 74, 0, 41, 244, 91, 2, 168, 99, 123, 33, 137, 57, 224, 183, 108, 47, 156, 75, 253, 23, 109, 217, 3, 63, 72, 109, 176, 79, 106, 131, 11, 164, 54, 184, 144, 230, 128, 57, 224, 89, 169, 13, 182, 178, 61, 48, 84, 254, 18, 98, 217, 79, 226, 61, 109, 210, 94, 241, 158, 89, 116, 141, 198, 149, 251, 96, 45, 213, 195, 119, 65, 61, 223, 5, 107, 217, 30, 88, 41, 181, 193, 141, 82, 61, 44, 97, 94, 184, 137, 237, 129, 7, 249, 46, 184, 131, 253, 3, 234, 104, 46, 148, 189, 112, 174, 254, 89, 45, 125, 14, 135, 244, 113, 239, 243, 49, 5, 219, 126, 46, 205, 67, 228, 135, 151, 194, 76, 49, 23, 165, 48, 156, 190, 229, 183, 33, 214, 152, 135, 222, 31, 105, 47, 156, 165, 207, 203, 238, 30, 159, 38, 72, 147, 155, 224, 1, 233, 115, 216, 170, 143, 123, 159, 143, 154, 10, 45, 145, 115, 97, 124, 240, 151, 238, 247, 241, 23, 168, 199, 95, 186, 239, 196, 95, 186, 255, 172, 211, 216, 206, 222, 229, 196, 223, 68, 95, 159, 195, 14, 49, 23, 145, 31, 154, 11, 154, 59, 234, 227, 28, 112, 74, 91, 161, 53, 244, 221, 36, 238, 181, 16, 29, 210, 251, 79, 242, 189, 75, 46, 135, 38, 234, 63, 189, 139, 198, 230, 20, 223, 68, 183, 77, 52, 30, 68, 83, 3, 125, 83, 189, 212, 31, 249, 101, 176, 73, 109, 160, 240, 82, 209, 215, 71, 121, 41, 204, 194, 95, 186, 127, 27, 241, 247, 70, 252, 5, 154, 244, 239, 157, 202, 42, 216, 200, 15, 137, 62, 149, 18, 157, 138, 247, 129, 248, 155, 198, 102, 23, 203, 130, 201, 250, 119, 240, 239, 123, 5, 237, 238, 98, 191, 19, 223, 54, 54, 5, 44, 17, 239, 238, 241, 45, 189, 3, 104, 252, 214, 219, 217, 212, 251, 219, 4, 48, 79, 189, 58, 56, 46, 180, 14, 122, 125, 51, 177, 46, 1, 206, 29, 232, 155, 214, 44, 27, 10, 73, 244, 205, 33, 248, 77, 127, 19, 189, 208, 252, 157, 238, 183, 88, 239, 47, 131, 85, 172, 119, 154, 95, 125, 221, 211, 218, 235, 253, 141, 47, 131, 73, 106, 131, 82, 99, 206, 121, 41, 12, 35, 186, 50, 250, 52, 208, 59, 250, 124, 235, 207, 211, 154, 33, 186, 21, 207, 127, 14, 59, 122, 247, 141, 237, 233, 254, 57, 248, 1, 7, 219, 3, 38, 182, 7, 126, 96, 123, 196, 154, 221, 19, 252, 187, 251, 24, 241, 71, 182, 7, 246, 179, 61, 221, 95, 203, 51, 33, 129, 13, 133, 123, 148, 241, 0, 10, 101, 74, 44, 214, 254, 168, 148, 117, 95, 162, 188, 15, 168, 200, 221, 55, 144, 35, 160, 190, 94, 119, 73, 43, 96, 191, 60, 11, 178, 116, 190, 185, 212, 88, 147, 68, 31, 68, 11, 242, 44, 112, 26, 60, 83, 246, 193, 148, 8, 126, 249, 128, 244, 4, 156, 67, 109, 148, 155, 96, 168, 170, 192, 62, 226, 151, 202, 163, 176, 73, 190, 11, 198, 241, 113, 226, 249, 161, 210, 221, 208, 196, 175, 131, 70, 169, 13, 102, 178, 31, 224, 144, 180, 0, 174, 161, 107, 210, 78, 65, 99, 209, 210, 185, 193, 251, 252, 50, 176, 82, 25, 26, 63, 246, 3, 28, 17, 244, 7, 144, 40, 221, 46, 190, 129, 223, 216, 173, 209, 61, 185, 29, 10, 165, 171, 97, 43, 255, 8, 154, 164, 239, 160, 137, 231, 131, 131, 234, 148, 7, 195, 76, 201, 7, 38, 121, 63, 140, 84, 70, 193, 74, 246, 3, 164, 209, 117, 122, 134, 190, 233, 26, 245, 81, 158, 9, 207, 40, 223, 64, 154, 52, 3, 246, 203, 207, 10, 25, 176, 94, 231, 75, 27, 37, 128, 41, 166, 117, 144, 104, 114, 194, 46, 229, 29, 112, 75, 15, 193, 46, 249, 89, 216, 35, 59, 161, 72, 126, 86, 244, 217, 46, 251, 197, 247, 46, 241, 252, 74, 24, 65, 117, 170, 139, 225, 34, 233, 29, 88, 37, 202, 62, 11, 123, 196, 115, 126, 176, 247, 144, 53, 193, 231, 31, 16, 124, 128, 198, 204, 9, 69, 138, 19, 198, 211, 152, 201, 223, 192, 46, 121, 6, 204, 86, 103, 192, 30, 117, 11, 236, 81, 102, 64, 154, 234, 20, 117, 236, 150, 159, 133, 253, 38, 167, 224, 127, 36, 11, 91, 164, 27, 97, 166, 228, 135, 157, 236, 27, 184, 74, 122, 24, 242, 168, 127, 242, 108, 144, 249, 195, 208, 36, 173, 135, 235, 232, 195, 183, 192, 78, 186, 39, 111, 129, 187, 165, 149, 112, 29, 149, 145, 102, 136, 239, 155, 36, 128, 22, 190, 7, 242, 37, 0, 171, 114, 35, 52, 9, 25, 115, 35, 92, 39, 21, 194, 2, 101, 37, 220, 173, 220, 8, 119, 203, 0, 57, 242, 179, 176, 83, 42, 132, 157, 146, 19, 230, 43, 52, 23, 51, 192, 31, 193, 35, 45, 226, 253, 78, 241, 252, 185, 36, 227, 136, 167, 226, 123, 221, 247, 169, 126, 24, 173, 208, 251, 219, 192, 34, 218, 65, 109, 2, 176, 82, 59, 248, 223, 97, 63, 175, 131, 61, 198, 90, 235, 253, 109, 172, 61, 9, 96, 139, 41, 248, 1, 202, 84, 134, 47, 195, 92, 124, 25, 206, 197, 151, 97, 5, 190, 12, 11, 120, 19, 140, 224, 77, 176, 132, 62, 162, 79, 109, 112, 19, 59, 6, 83, 77, 0, 191, 51, 1, 36, 225, 22, 72, 96, 239, 128, 147, 237, 129, 58, 90, 39, 18, 192, 113, 182, 7, 0, 155, 180, 90, 190, 11, 70, 240, 93, 224, 226, 187, 224, 66, 190, 11, 70, 241, 93, 176, 140, 239, 130, 58, 253, 119, 35, 223, 5, 147, 248, 46, 72, 96, 223, 192, 97, 246, 13, 236, 228, 187, 160, 77, 127, 230, 14, 67, 86, 254, 218, 231, 165, 54, 184, 138, 62, 166, 103, 69, 123, 65, 117, 194, 76, 70, 209, 24, 111, 132, 155, 76, 0, 31, 43, 217, 176, 93, 62, 8, 75, 148, 189, 48, 69, 217, 11, 35, 148, 189, 48, 155, 62, 38, 128, 187, 37, 128, 171, 35, 190, 167, 72, 32, 228, 208, 53, 252, 71, 24, 38, 125, 9, 227, 149, 155, 224, 160, 188, 31, 214, 72, 123, 97, 9, 54, 117, 103, 179, 161, 48, 90, 90, 2, 32, 239, 135, 115, 212, 119, 112, 133, 252, 44, 92, 75, 31, 86, 10, 81, 210, 18, 24, 39, 237, 133, 181, 210, 195, 176, 86, 90, 2, 101, 210, 18, 24, 47, 189, 3, 87, 144, 30, 32, 29, 99, 92, 58, 6, 95, 75, 199, 0, 164, 99, 44, 53, 248, 1, 232, 251, 221, 251, 131, 151, 7, 239, 209, 55, 94, 30, 190, 14, 240, 235, 175, 255, 55, 31, 182, 172, 199, 223, 239, 192, 18, 233, 29, 88, 194, 222, 13, 126, 72, 243, 85, 191, 129, 19, 145, 31, 254, 35, 100, 72, 239, 192, 35, 226, 163, 63, 199, 222, 133, 49, 166, 66, 140, 50, 173, 196, 98, 117, 6, 236, 83, 0, 150, 41, 0, 133, 146, 31, 202, 100, 63, 148, 73, 207, 66, 157, 228, 132, 60, 146, 147, 178, 38, 234, 13, 72, 201, 144, 46, 141, 131, 116, 122, 7, 233, 178, 48, 69, 168, 198, 5, 250, 127, 203, 224, 26, 76, 193, 7, 89, 35, 123, 131, 125, 205, 163, 120, 9, 191, 157, 31, 149, 44, 82, 142, 84, 47, 173, 149, 142, 74, 93, 242, 20, 249, 41, 5, 148, 249, 202, 243, 202, 9, 117, 129, 250, 162, 218, 101, 154, 101, 58, 199, 116, 187, 105, 191, 217, 97, 254, 157, 89, 179, 44, 178, 236, 183, 116, 89, 171, 173, 235, 173, 31, 70, 37, 68, 77, 137, 186, 54, 234, 213, 168, 159, 162, 71, 68, 175, 141, 126, 53, 198, 17, 179, 54, 230, 165, 216, 140, 216, 41, 177, 215, 199, 190, 24, 167, 196, 141, 143, 219, 24, 247, 212, 255, 71, 221, 183, 135, 71, 85, 93, 125, 175, 189, 51, 153, 36, 67, 64, 238, 162, 161, 184, 195, 16, 132, 228, 204, 152, 76, 38, 48, 64, 68, 230, 150, 100, 32, 100, 134, 153, 9, 153, 193, 91, 206, 204, 156, 36, 7, 38, 115, 226, 204, 153, 64, 168, 181, 214, 42, 210, 136, 168, 148, 90, 172, 86, 173, 181, 214, 162, 165, 103, 130, 34, 94, 75, 45, 90, 68, 188, 212, 182, 22, 111, 136, 151, 82, 181, 234, 235, 215, 143, 250, 246, 245, 245, 213, 239, 217, 251, 236, 51, 9, 8, 237, 219, 231, 123, 191, 63, 62, 121, 158, 172, 117, 246, 94, 123, 253, 214, 250, 173, 181, 247, 57, 73, 79, 103, 38, 190, 62, 241, 139, 73, 179, 39, 121, 38, 37, 38, 93, 49, 233, 243, 201, 125, 147, 239, 158, 50, 121, 202, 69, 83, 118, 77, 117, 78, 221, 56, 117, 100, 234, 23, 211, 154, 167, 245, 77, 123, 105, 250, 220, 233, 61, 211, 247, 157, 57, 227, 204, 236, 153, 15, 156, 249, 241, 12, 207, 12, 117, 198, 3, 51, 142, 159, 53, 227, 172, 213, 103, 237, 58, 235, 192, 217, 228, 236, 141, 103, 111, 60, 251, 222, 170, 9, 85, 153, 170, 231, 103, 218, 103, 198, 102, 190, 56, 243, 179, 175, 93, 241, 181, 207, 103, 205, 153, 213, 50, 43, 49, 235, 216, 57, 230, 115, 150, 159, 115, 219, 57, 71, 73, 61, 233, 32, 59, 201, 7, 213, 166, 234, 104, 245, 198, 234, 7, 170, 95, 159, 237, 155, 61, 98, 77, 88, 119, 205, 49, 205, 185, 100, 206, 147, 53, 205, 53, 119, 206, 61, 123, 238, 198, 185, 159, 159, 27, 62, 247, 254, 121, 19, 231, 101, 231, 237, 155, 63, 121, 126, 102, 254, 129, 218, 121, 181, 219, 235, 160, 110, 160, 238, 3, 97, 131, 240, 137, 109, 170, 237, 42, 219, 203, 246, 122, 251, 54, 251, 241, 243, 60, 231, 109, 175, 159, 94, 127, 160, 225, 74, 135, 167, 113, 102, 227, 171, 206, 109, 77, 87, 52, 29, 93, 16, 93, 112, 104, 97, 251, 194, 67, 174, 233, 174, 148, 235, 177, 69, 120, 209, 224, 226, 202, 197, 217, 197, 175, 47, 9, 45, 57, 220, 188, 188, 249, 230, 230, 15, 206, 31, 56, 255, 179, 165, 91, 47, 176, 92, 16, 190, 96, 199, 5, 239, 45, 91, 182, 236, 175, 238, 152, 231, 108, 207, 86, 111, 200, 251, 136, 143, 248, 182, 249, 193, 223, 227, 127, 186, 197, 213, 114, 79, 235, 196, 214, 109, 173, 127, 109, 219, 216, 246, 98, 192, 23, 216, 185, 188, 109, 249, 237, 43, 38, 175, 216, 218, 94, 222, 158, 110, 255, 112, 229, 64, 199, 172, 142, 187, 131, 209, 224, 193, 208, 213, 171, 236, 171, 14, 133, 107, 195, 55, 134, 63, 140, 180, 69, 246, 70, 167, 70, 19, 209, 157, 157, 229, 157, 106, 231, 187, 171, 219, 87, 255, 96, 245, 19, 93, 164, 107, 75, 215, 135, 177, 150, 216, 190, 248, 178, 248, 198, 248, 19, 241, 207, 215, 164, 215, 188, 120, 225, 228, 11, 67, 23, 222, 120, 225, 161, 139, 42, 47, 106, 187, 232, 230, 139, 201, 197, 55, 95, 226, 188, 100, 248, 210, 89, 151, 238, 184, 244, 189, 238, 88, 247, 126, 177, 86, 188, 39, 225, 72, 236, 74, 206, 73, 222, 147, 178, 164, 84, 201, 36, 221, 214, 211, 210, 243, 72, 239, 234, 222, 3, 125, 206, 190, 93, 242, 108, 121, 231, 218, 169, 107, 183, 174, 195, 235, 82, 235, 246, 167, 167, 167, 19, 233, 157, 233, 227, 253, 107, 51, 144, 89, 157, 121, 85, 89, 170, 124, 56, 176, 246, 50, 124, 217, 218, 44, 100, 179, 185, 242, 220, 218, 220, 17, 117, 129, 186, 73, 61, 146, 119, 228, 111, 207, 127, 60, 184, 108, 240, 246, 245, 83, 215, 95, 178, 254, 129, 13, 51, 54, 188, 58, 180, 96, 104, 235, 198, 242, 141, 151, 111, 60, 242, 245, 236, 215, 223, 187, 252, 242, 203, 63, 253, 198, 141, 87, 76, 189, 98, 207, 55, 133, 111, 238, 187, 210, 243, 173, 242, 111, 93, 121, 213, 200, 183, 93, 223, 222, 115, 117, 248, 234, 231, 175, 241, 92, 179, 247, 154, 163, 155, 202, 55, 205, 219, 20, 221, 164, 110, 218, 187, 233, 239, 215, 58, 175, 77, 93, 187, 239, 218, 35, 155, 103, 110, 222, 184, 121, 251, 230, 191, 126, 167, 253, 59, 119, 13, 79, 31, 190, 124, 248, 248, 117, 125, 215, 237, 220, 50, 97, 75, 243, 150, 13, 91, 30, 217, 242, 233, 245, 142, 235, 111, 191, 254, 240, 214, 201, 91, 155, 183, 94, 181, 117, 239, 13, 230, 27, 46, 185, 97, 235, 13, 135, 111, 156, 117, 99, 199, 141, 59, 110, 50, 221, 228, 187, 105, 207, 182, 201, 219, 210, 219, 94, 254, 238, 142, 239, 126, 190, 125, 195, 246, 61, 223, 115, 124, 239, 247, 55, 47, 184, 121, 195, 205, 127, 253, 254, 178, 239, 191, 190, 99, 241, 142, 171, 118, 236, 218, 241, 233, 45, 45, 183, 220, 127, 203, 167, 63, 216, 127, 171, 233, 214, 142, 91, 119, 222, 182, 248, 182, 219, 110, 59, 242, 195, 201, 63, 188, 251, 135, 239, 222, 30, 186, 253, 224, 29, 115, 238, 216, 116, 199, 225, 59, 103, 221, 185, 243, 71, 240, 163, 196, 143, 222, 190, 107, 246, 93, 91, 238, 58, 246, 99, 242, 227, 141, 63, 126, 228, 238, 250, 187, 183, 223, 253, 201, 79, 174, 252, 201, 193, 123, 150, 223, 179, 249, 158, 131, 63, 37, 63, 189, 250, 167, 247, 223, 107, 185, 183, 253, 222, 79, 126, 22, 251, 217, 214, 157, 120, 231, 134, 157, 79, 220, 103, 186, 111, 217, 125, 59, 238, 123, 250, 254, 137, 247, 39, 238, 223, 118, 255, 75, 63, 95, 250, 243, 43, 126, 254, 228, 46, 203, 46, 207, 174, 45, 187, 142, 253, 98, 249, 47, 110, 251, 197, 103, 218, 128, 246, 98, 161, 163, 112, 247, 136, 121, 196, 57, 114, 215, 200, 135, 187, 183, 237, 62, 246, 192, 204, 7, 110, 124, 16, 63, 216, 242, 224, 214, 7, 15, 238, 177, 236, 105, 222, 115, 243, 158, 151, 30, 50, 61, 228, 122, 232, 237, 189, 179, 247, 102, 247, 62, 178, 247, 248, 195, 29, 15, 223, 243, 8, 126, 36, 252, 200, 93, 143, 58, 30, 221, 250, 232, 177, 199, 122, 30, 123, 245, 241, 89, 143, 103, 30, 215, 30, 127, 239, 9, 251, 19, 27, 159, 248, 240, 151, 237, 191, 188, 123, 159, 125, 223, 134, 125, 71, 126, 37, 252, 170, 251, 87, 247, 252, 234, 243, 39, 19, 79, 62, 246, 235, 185, 191, 222, 252, 235, 247, 246, 59, 246, 15, 238, 223, 182, 127, 215, 254, 131, 251, 255, 254, 212, 217, 79, 45, 126, 42, 245, 212, 213, 79, 237, 125, 234, 189, 167, 231, 60, 221, 247, 244, 99, 79, 31, 253, 205, 236, 3, 229, 7, 230, 28, 104, 63, 144, 58, 112, 249, 129, 195, 7, 254, 254, 204, 172, 103, 18, 207, 236, 120, 230, 237, 131, 246, 131, 107, 14, 238, 58, 248, 217, 179, 243, 158, 245, 61, 59, 240, 236, 214, 103, 239, 127, 246, 147, 67, 206, 67, 107, 14, 221, 126, 232, 227, 231, 230, 60, 151, 121, 110, 239, 115, 239, 62, 239, 122, 254, 234, 231, 119, 61, 127, 240, 249, 79, 95, 152, 250, 130, 227, 133, 193, 23, 142, 191, 184, 246, 183, 221, 191, 61, 250, 82, 244, 165, 167, 127, 87, 255, 187, 203, 127, 119, 240, 247, 142, 223, 255, 254, 15, 239, 189, 252, 217, 31, 95, 252, 227, 241, 195, 147, 15, 187, 14, 175, 62, 188, 233, 240, 61, 135, 95, 127, 197, 254, 202, 37, 175, 108, 121, 101, 255, 43, 71, 95, 249, 252, 213, 89, 175, 250, 94, 75, 191, 182, 245, 181, 253, 175, 125, 246, 250, 246, 55, 76, 111, 36, 222, 184, 237, 141, 131, 111, 252, 253, 200, 188, 35, 109, 71, 182, 31, 57, 122, 228, 243, 55, 39, 191, 233, 120, 51, 250, 166, 250, 230, 205, 111, 62, 246, 230, 187, 71, 45, 71, 235, 143, 118, 31, 221, 114, 116, 228, 232, 209, 183, 44, 111, 45, 125, 107, 237, 91, 219, 223, 58, 248, 214, 241, 183, 103, 190, 221, 254, 246, 134, 183, 239, 124, 251, 229, 119, 204, 239, 52, 191, 51, 248, 206, 189, 239, 188, 254, 238, 172, 119, 163, 239, 94, 249, 238, 190, 119, 143, 255, 105, 246, 159, 18, 127, 186, 235, 79, 111, 31, 91, 112, 44, 116, 172, 231, 216, 229, 199, 182, 29, 219, 249, 231, 31, 188, 55, 248, 222, 241, 247, 43, 223, 95, 240, 126, 244, 253, 43, 222, 255, 193, 251, 35, 239, 239, 127, 255, 200, 251, 159, 124, 48, 225, 131, 208, 7, 131, 31, 220, 255, 193, 103, 127, 89, 252, 151, 151, 63, 140, 126, 248, 210, 71, 139, 63, 218, 244, 209, 190, 143, 45, 31, 55, 127, 28, 251, 248, 206, 143, 223, 61, 126, 244, 111, 237, 127, 123, 251, 211, 5, 159, 94, 249, 233, 163, 0, 128, 0, 240, 1, 116, 62, 204, 1, 0, 0, 12, 135, 209, 69, 112, 61, 0, 58, 62, 161, 146, 255, 97, 227, 44, 228, 0, 196, 117, 55, 255, 11, 10, 253, 137, 193, 109, 252, 193, 3, 202, 192, 203, 245, 18, 152, 3, 203, 185, 110, 2, 11, 123, 199, 129, 234, 165, 96, 133, 13, 92, 55, 67, 5, 220, 202, 245, 114, 152, 6, 119, 115, 189, 2, 206, 129, 135, 185, 110, 65, 30, 56, 204, 245, 74, 56, 3, 159, 205, 245, 241, 230, 171, 240, 124, 174, 79, 6, 75, 249, 125, 244, 179, 224, 77, 21, 0, 112, 85, 249, 131, 92, 71, 96, 170, 88, 200, 117, 12, 19, 42, 150, 112, 189, 4, 218, 42, 2, 92, 55, 193, 212, 138, 155, 184, 94, 10, 45, 21, 63, 225, 186, 25, 38, 87, 252, 133, 235, 229, 80, 91, 241, 95, 92, 175, 128, 165, 150, 115, 185, 110, 193, 87, 91, 36, 174, 87, 194, 172, 202, 247, 185, 62, 126, 188, 105, 124, 153, 87, 25, 24, 202, 202, 189, 125, 42, 153, 151, 156, 79, 28, 245, 13, 11, 72, 98, 136, 120, 228, 94, 41, 173, 172, 39, 115, 73, 155, 146, 238, 151, 114, 36, 144, 73, 218, 237, 196, 157, 78, 19, 102, 156, 35, 89, 41, 39, 101, 7, 165, 148, 189, 85, 9, 75, 189, 249, 180, 152, 229, 107, 230, 234, 43, 232, 130, 197, 164, 85, 33, 124, 118, 49, 243, 61, 122, 189, 90, 202, 230, 100, 37, 67, 28, 246, 250, 134, 250, 37, 68, 85, 123, 196, 188, 170, 244, 201, 25, 149, 204, 27, 108, 176, 187, 236, 141, 243, 79, 246, 124, 98, 52, 43, 178, 114, 206, 184, 22, 51, 41, 226, 237, 19, 179, 105, 41, 103, 132, 222, 170, 16, 57, 71, 68, 210, 151, 239, 23, 51, 114, 78, 149, 147, 36, 39, 102, 114, 182, 156, 148, 149, 123, 72, 143, 146, 81, 73, 143, 146, 37, 106, 159, 68, 131, 76, 139, 153, 222, 188, 216, 43, 217, 73, 64, 205, 145, 13, 182, 62, 137, 102, 41, 144, 156, 42, 245, 147, 245, 252, 130, 162, 164, 168, 171, 76, 82, 149, 7, 37, 186, 190, 63, 71, 148, 30, 178, 81, 202, 42, 2, 73, 138, 3, 178, 42, 166, 73, 80, 32, 105, 101, 189, 148, 77, 138, 57, 137, 164, 5, 210, 35, 247, 230, 179, 18, 81, 50, 146, 238, 194, 176, 11, 144, 30, 37, 77, 73, 166, 49, 248, 2, 29, 164, 193, 217, 84, 175, 71, 150, 150, 122, 229, 132, 156, 150, 213, 33, 146, 83, 197, 76, 74, 204, 166, 236, 164, 85, 57, 55, 71, 186, 90, 219, 73, 178, 79, 204, 138, 73, 85, 202, 146, 156, 164, 18, 57, 147, 76, 231, 83, 82, 142, 116, 102, 228, 164, 146, 146, 72, 187, 168, 202, 25, 129, 180, 102, 37, 105, 29, 3, 244, 14, 101, 229, 116, 90, 78, 18, 49, 61, 208, 39, 38, 36, 53, 71, 6, 210, 249, 28, 201, 13, 245, 39, 148, 180, 206, 94, 111, 86, 28, 232, 147, 147, 98, 154, 72, 105, 169, 95, 202, 168, 57, 123, 58, 159, 148, 83, 34, 141, 39, 103, 79, 42, 253, 167, 104, 148, 127, 161, 75, 44, 22, 159, 156, 83, 179, 114, 34, 175, 210, 170, 43, 61, 68, 237, 147, 115, 122, 182, 114, 142, 244, 42, 131, 82, 54, 35, 165, 104, 239, 81, 58, 116, 102, 228, 76, 47, 73, 203, 73, 41, 147, 163, 133, 233, 33, 67, 74, 158, 164, 20, 146, 81, 84, 34, 246, 102, 37, 137, 168, 138, 238, 134, 27, 9, 156, 12, 186, 142, 122, 73, 201, 185, 100, 90, 148, 251, 165, 172, 96, 172, 75, 25, 81, 72, 68, 201, 146, 126, 37, 37, 247, 12, 141, 134, 98, 183, 88, 194, 82, 209, 132, 6, 74, 185, 201, 231, 36, 34, 103, 72, 78, 201, 103, 147, 18, 99, 43, 33, 103, 196, 236, 144, 222, 0, 2, 89, 47, 171, 125, 212, 27, 149, 74, 94, 213, 189, 202, 73, 145, 102, 42, 16, 49, 43, 145, 1, 41, 219, 47, 171, 170, 148, 34, 3, 89, 101, 80, 78, 73, 41, 162, 246, 137, 234, 73, 169, 38, 149, 76, 74, 166, 139, 114, 108, 81, 191, 164, 46, 182, 88, 8, 33, 181, 228, 196, 160, 88, 207, 241, 104, 88, 197, 251, 243, 57, 149, 100, 37, 85, 148, 51, 204, 165, 152, 80, 6, 37, 146, 44, 22, 44, 163, 168, 114, 82, 18, 12, 174, 114, 42, 117, 48, 22, 45, 147, 58, 41, 148, 81, 230, 236, 167, 9, 65, 206, 140, 101, 193, 8, 97, 32, 171, 164, 242, 73, 233, 127, 60, 10, 194, 51, 75, 41, 201, 60, 237, 78, 198, 45, 93, 113, 158, 146, 37, 138, 218, 39, 101, 73, 191, 168, 74, 89, 89, 76, 231, 70, 57, 166, 5, 49, 26, 161, 24, 186, 145, 80, 135, 36, 179, 117, 116, 62, 35, 246, 75, 52, 154, 86, 69, 233, 77, 75, 172, 141, 73, 134, 159, 14, 116, 142, 49, 46, 171, 57, 146, 84, 50, 186, 35, 37, 155, 35, 253, 226, 16, 73, 72, 36, 159, 163, 229, 84, 136, 148, 73, 41, 217, 28, 235, 171, 129, 172, 210, 175, 168, 18, 141, 36, 149, 79, 170, 57, 146, 146, 178, 242, 160, 148, 34, 61, 89, 165, 95, 39, 32, 167, 244, 168, 235, 105, 153, 141, 174, 201, 13, 72, 73, 218, 54, 100, 32, 43, 211, 102, 202, 210, 134, 201, 232, 173, 147, 163, 71, 165, 221, 98, 241, 5, 34, 222, 118, 119, 96, 165, 63, 188, 152, 68, 219, 2, 17, 18, 9, 182, 68, 187, 220, 97, 63, 9, 68, 72, 40, 28, 92, 29, 240, 249, 125, 196, 19, 39, 209, 54, 63, 241, 6, 67, 241, 112, 160, 181, 45, 74, 218, 130, 237, 62, 127, 56, 66, 220, 29, 62, 226, 13, 118, 68, 195, 1, 79, 103, 52, 24, 142, 144, 217, 238, 8, 9, 68, 102, 179, 9, 119, 71, 156, 248, 99, 161, 176, 63, 18, 33, 193, 48, 9, 172, 12, 181, 7, 252, 62, 210, 229, 14, 135, 221, 29, 209, 128, 63, 34, 144, 64, 135, 183, 189, 211, 23, 232, 104, 21, 136, 167, 51, 74, 58, 130, 81, 210, 30, 88, 25, 136, 250, 125, 36, 26, 20, 24, 232, 87, 151, 145, 96, 11, 89, 233, 15, 123, 219, 220, 29, 81, 183, 39, 208, 30, 136, 198, 25, 94, 75, 32, 218, 65, 177, 90, 130, 97, 226, 38, 33, 119, 56, 26, 240, 118, 182, 187, 195, 36, 212, 25, 14, 5, 35, 126, 66, 211, 42, 38, 236, 179, 147, 64, 7, 233, 8, 18, 255, 106, 127, 71, 148, 68, 218, 220, 237, 237, 39, 101, 25, 236, 234, 240, 135, 105, 232, 39, 164, 232, 241, 147, 246, 128, 219, 211, 238, 215, 129, 58, 226, 196, 23, 8, 251, 189, 81, 154, 205, 168, 230, 13, 248, 252, 29, 81, 119, 187, 64, 34, 33, 191, 55, 64, 21, 127, 204, 191, 50, 212, 238, 14, 199, 5, 238, 51, 226, 95, 213, 233, 239, 136, 6, 220, 237, 196, 231, 94, 233, 110, 245, 71, 200, 188, 127, 194, 72, 40, 28, 244, 118, 134, 253, 43, 105, 200, 193, 22, 18, 233, 244, 68, 162, 129, 104, 103, 212, 79, 90, 131, 65, 31, 227, 57, 226, 15, 175, 14, 120, 253, 145, 37, 164, 61, 72, 153, 111, 33, 157, 17, 191, 64, 124, 238, 168, 155, 1, 135, 194, 193, 150, 64, 52, 178, 132, 234, 158, 206, 72, 128, 113, 22, 232, 136, 250, 195, 225, 206, 80, 52, 16, 236, 152, 79, 218, 130, 93, 254, 213, 254, 48, 241, 186, 59, 35, 126, 31, 35, 55, 216, 193, 234, 25, 109, 243, 7, 195, 113, 234, 148, 114, 192, 184, 23, 72, 87, 155, 63, 218, 230, 15, 83, 62, 25, 83, 110, 74, 65, 36, 26, 14, 120, 163, 99, 205, 130, 97, 18, 13, 134, 163, 99, 114, 36, 29, 254, 214, 246, 64, 171, 191, 195, 235, 167, 209, 4, 169, 151, 174, 64, 196, 63, 159, 184, 195, 129, 8, 53, 8, 232, 176, 93, 238, 56, 9, 118, 178, 148, 105, 137, 58, 35, 126, 93, 29, 211, 176, 2, 43, 36, 9, 180, 16, 183, 111, 117, 128, 134, 205, 141, 67, 193, 72, 36, 192, 219, 132, 81, 230, 109, 227, 116, 219, 71, 31, 30, 192, 11, 10, 12, 192, 16, 100, 65, 134, 94, 232, 3, 21, 8, 204, 131, 36, 204, 167, 31, 114, 11, 245, 208, 0, 11, 128, 64, 2, 134, 128, 128, 135, 217, 72, 144, 6, 5, 214, 3, 129, 185, 64, 160, 13, 20, 72, 67, 63, 72, 144, 3, 2, 1, 200, 64, 18, 236, 96, 7, 2, 110, 72, 67, 26, 200, 24, 207, 212, 34, 203, 44, 233, 219, 42, 131, 32, 65, 10, 236, 208, 10, 10, 132, 65, 130, 94, 200, 67, 26, 68, 200, 158, 132, 51, 247, 4, 12, 3, 97, 49, 16, 182, 146, 156, 180, 118, 241, 152, 184, 79, 53, 191, 154, 189, 41, 147, 3, 25, 20, 200, 48, 91, 59, 203, 178, 30, 150, 0, 1, 21, 84, 232, 1, 17, 242, 160, 130, 2, 125, 32, 67, 134, 51, 50, 8, 13, 96, 7, 23, 216, 161, 17, 230, 255, 211, 152, 255, 17, 55, 43, 24, 35, 185, 175, 204, 139, 144, 129, 20, 16, 240, 66, 31, 243, 152, 230, 227, 39, 122, 166, 200, 132, 175, 23, 129, 64, 31, 228, 161, 159, 173, 165, 99, 42, 200, 144, 4, 2, 57, 54, 146, 3, 27, 231, 90, 134, 30, 32, 208, 195, 114, 86, 185, 150, 101, 249, 246, 129, 84, 100, 146, 50, 148, 97, 57, 137, 44, 23, 90, 197, 0, 175, 219, 6, 176, 49, 91, 163, 150, 2, 67, 81, 65, 130, 126, 32, 176, 254, 164, 25, 35, 151, 84, 49, 42, 154, 59, 141, 142, 86, 221, 192, 239, 103, 158, 21, 22, 219, 70, 22, 167, 194, 86, 39, 65, 132, 1, 144, 65, 5, 145, 117, 80, 144, 141, 234, 249, 83, 43, 58, 79, 243, 34, 144, 102, 51, 61, 12, 59, 207, 122, 139, 176, 28, 165, 19, 162, 56, 217, 95, 128, 71, 144, 46, 86, 203, 224, 193, 7, 1, 232, 0, 2, 13, 224, 132, 38, 168, 231, 118, 122, 15, 208, 122, 244, 130, 12, 9, 144, 33, 205, 162, 27, 226, 28, 232, 217, 210, 154, 209, 126, 214, 217, 60, 151, 229, 214, 5, 173, 208, 206, 34, 208, 107, 42, 50, 22, 104, 14, 116, 165, 196, 252, 210, 30, 75, 66, 26, 242, 144, 226, 21, 239, 100, 213, 76, 130, 194, 70, 8, 180, 131, 200, 57, 164, 89, 181, 178, 60, 37, 88, 55, 38, 67, 47, 223, 193, 52, 35, 189, 3, 104, 166, 3, 172, 147, 18, 32, 241, 26, 14, 48, 20, 170, 229, 96, 8, 250, 33, 193, 56, 24, 219, 123, 189, 44, 70, 186, 142, 122, 161, 62, 8, 235, 105, 90, 101, 137, 245, 78, 14, 236, 204, 75, 18, 100, 150, 179, 193, 15, 29, 167, 17, 247, 255, 55, 79, 148, 255, 55, 103, 137, 5, 44, 224, 227, 61, 71, 109, 19, 108, 39, 27, 123, 93, 239, 52, 90, 107, 106, 49, 182, 182, 250, 117, 47, 40, 204, 83, 150, 117, 80, 170, 120, 238, 25, 221, 49, 182, 103, 104, 213, 122, 89, 87, 82, 166, 40, 55, 185, 226, 142, 161, 40, 67, 160, 64, 158, 237, 0, 186, 95, 51, 160, 48, 30, 232, 190, 162, 81, 83, 111, 244, 132, 25, 27, 205, 137, 158, 132, 147, 58, 195, 192, 51, 98, 161, 35, 57, 54, 43, 130, 204, 170, 147, 5, 225, 43, 120, 169, 175, 112, 65, 215, 210, 189, 71, 160, 159, 245, 23, 61, 25, 134, 78, 201, 138, 206, 38, 61, 61, 83, 167, 101, 212, 232, 155, 60, 223, 143, 52, 74, 218, 93, 52, 119, 186, 79, 165, 49, 54, 116, 223, 100, 216, 46, 24, 226, 40, 250, 9, 64, 163, 166, 124, 210, 8, 140, 216, 140, 107, 234, 71, 61, 33, 86, 202, 145, 88, 140, 128, 174, 21, 249, 190, 31, 96, 28, 244, 179, 149, 42, 175, 223, 0, 59, 83, 6, 89, 175, 234, 35, 212, 43, 93, 255, 143, 171, 74, 59, 153, 70, 45, 23, 145, 114, 99, 144, 40, 219, 42, 44, 102, 252, 16, 246, 175, 22, 200, 63, 100, 106, 244, 156, 59, 145, 155, 209, 61, 222, 207, 118, 166, 202, 187, 154, 158, 41, 52, 150, 209, 40, 69, 182, 91, 7, 139, 171, 78, 222, 97, 122, 135, 81, 118, 244, 222, 57, 177, 175, 104, 245, 140, 8, 78, 151, 91, 6, 82, 255, 132, 149, 83, 245, 156, 253, 95, 100, 129, 250, 58, 93, 47, 156, 204, 130, 94, 189, 20, 59, 109, 164, 255, 15, 184, 48, 178, 51, 214, 209, 189, 159, 100, 247, 103, 186, 171, 213, 49, 125, 107, 96, 156, 199, 251, 93, 225, 107, 168, 15, 122, 55, 87, 249, 93, 91, 228, 231, 243, 87, 251, 120, 116, 199, 140, 162, 157, 154, 245, 147, 43, 212, 193, 238, 213, 163, 120, 198, 122, 90, 143, 126, 126, 62, 244, 240, 187, 152, 2, 189, 236, 244, 31, 61, 141, 169, 157, 114, 202, 117, 163, 61, 46, 243, 243, 57, 201, 79, 146, 209, 136, 232, 202, 28, 207, 145, 86, 158, 222, 155, 140, 211, 35, 85, 60, 19, 41, 91, 41, 110, 59, 122, 94, 233, 28, 208, 147, 64, 229, 251, 125, 180, 59, 116, 188, 20, 103, 109, 144, 123, 235, 225, 43, 198, 118, 0, 221, 127, 61, 160, 194, 250, 226, 110, 62, 249, 172, 201, 177, 147, 36, 89, 60, 109, 116, 36, 153, 71, 177, 158, 237, 109, 250, 156, 72, 163, 28, 123, 234, 228, 138, 79, 149, 198, 125, 40, 0, 17, 240, 66, 59, 184, 33, 0, 43, 193, 15, 97, 246, 116, 26, 133, 54, 54, 67, 32, 2, 65, 104, 129, 40, 116, 129, 27, 194, 224, 103, 79, 91, 116, 60, 4, 97, 8, 194, 106, 8, 128, 15, 252, 224, 99, 119, 203, 56, 95, 73, 173, 188, 16, 132, 16, 196, 33, 12, 1, 104, 133, 54, 136, 178, 103, 201, 32, 180, 51, 251, 48, 243, 237, 134, 14, 182, 146, 218, 118, 64, 148, 217, 122, 160, 19, 162, 16, 228, 22, 179, 193, 205, 36, 141, 101, 246, 152, 21, 84, 82, 52, 63, 196, 88, 36, 126, 136, 48, 59, 186, 142, 70, 184, 18, 66, 208, 14, 1, 30, 153, 30, 123, 152, 173, 138, 178, 209, 8, 59, 127, 232, 115, 20, 205, 189, 147, 63, 83, 181, 178, 81, 61, 2, 218, 131, 65, 22, 53, 245, 179, 18, 2, 16, 229, 222, 104, 116, 194, 152, 76, 255, 59, 104, 132, 177, 72, 56, 195, 94, 104, 227, 179, 110, 240, 64, 128, 69, 26, 133, 248, 152, 252, 90, 216, 72, 71, 49, 175, 22, 198, 8, 157, 167, 204, 83, 255, 212, 179, 23, 58, 89, 229, 104, 206, 33, 232, 100, 239, 212, 211, 143, 149, 161, 81, 25, 213, 250, 106, 133, 125, 252, 9, 160, 131, 231, 72, 89, 92, 13, 126, 22, 15, 173, 55, 141, 173, 29, 218, 199, 100, 120, 170, 90, 6, 161, 139, 197, 23, 46, 178, 126, 250, 42, 122, 152, 23, 154, 37, 205, 183, 29, 252, 39, 100, 164, 87, 146, 198, 73, 35, 246, 66, 180, 88, 155, 83, 141, 121, 121, 199, 81, 36, 26, 39, 29, 143, 64, 136, 173, 12, 20, 71, 104, 95, 248, 121, 93, 40, 19, 113, 16, 78, 138, 147, 178, 180, 10, 58, 185, 39, 125, 37, 141, 194, 13, 43, 193, 13, 173, 188, 106, 243, 254, 47, 123, 132, 246, 102, 144, 213, 137, 230, 177, 146, 163, 25, 221, 16, 129, 78, 240, 64, 132, 225, 71, 153, 71, 202, 76, 43, 4, 33, 8, 190, 49, 253, 76, 99, 13, 179, 189, 230, 101, 113, 209, 223, 2, 219, 33, 88, 236, 121, 234, 171, 147, 213, 93, 224, 57, 80, 110, 70, 51, 214, 163, 208, 123, 42, 194, 126, 135, 212, 199, 105, 149, 34, 16, 24, 211, 103, 84, 167, 125, 78, 251, 183, 19, 66, 44, 50, 90, 213, 249, 124, 247, 118, 241, 94, 161, 171, 189, 224, 230, 168, 198, 142, 164, 146, 90, 143, 86, 85, 223, 35, 20, 45, 94, 140, 212, 232, 131, 209, 190, 167, 145, 118, 49, 75, 221, 62, 204, 35, 25, 219, 83, 238, 98, 23, 68, 120, 143, 121, 33, 122, 90, 111, 20, 145, 240, 14, 140, 158, 166, 142, 180, 251, 253, 236, 119, 31, 218, 213, 180, 50, 148, 93, 99, 109, 144, 199, 30, 134, 46, 182, 131, 252, 236, 119, 3, 218, 75, 1, 206, 89, 107, 49, 74, 35, 91, 186, 243, 169, 12, 242, 238, 208, 243, 53, 118, 81, 39, 223, 153, 163, 163, 167, 62, 97, 133, 49, 59, 146, 34, 180, 48, 4, 31, 171, 191, 193, 246, 137, 158, 67, 188, 23, 2, 39, 240, 96, 88, 209, 46, 163, 39, 206, 137, 221, 109, 231, 159, 213, 72, 197, 23, 31, 209, 207, 173, 255, 234, 127, 248, 0, 0, 46, 195, 244, 155, 204, 76, 80, 10, 102, 40, 131, 114, 168, 0, 11, 140, 131, 74, 24, 15, 19, 224, 12, 152, 8, 147, 96, 50, 76, 129, 169, 48, 13, 166, 195, 153, 48, 3, 232, 119, 33, 84, 193, 76, 248, 26, 204, 130, 115, 128, 64, 53, 204, 6, 43, 204, 129, 26, 152, 11, 231, 194, 60, 152, 15, 181, 80, 7, 2, 216, 192, 14, 231, 177, 95, 181, 28, 208, 200, 126, 149, 93, 0, 11, 193, 5, 139, 96, 49, 44, 129, 102, 56, 31, 150, 194, 5, 176, 140, 21, 215, 203, 182, 124, 11, 187, 145, 4, 96, 57, 172, 128, 118, 88, 201, 54, 95, 8, 86, 177, 91, 5, 221, 62, 171, 161, 11, 98, 16, 135, 53, 112, 33, 92, 4, 23, 195, 37, 112, 41, 116, 131, 136, 43, 224, 199, 112, 53, 92, 3, 143, 195, 205, 240, 30, 108, 130, 173, 112, 29, 252, 16, 126, 6, 119, 99, 11, 12, 195, 171, 240, 109, 248, 46, 30, 135, 43, 225, 122, 248, 62, 108, 134, 95, 195, 17, 60, 30, 110, 135, 157, 240, 55, 56, 14, 159, 194, 93, 240, 115, 120, 6, 126, 3, 187, 32, 1, 73, 184, 17, 82, 240, 44, 72, 112, 0, 14, 194, 11, 112, 8, 158, 131, 231, 225, 125, 232, 129, 223, 193, 139, 240, 91, 248, 5, 244, 194, 39, 112, 19, 188, 12, 191, 135, 63, 64, 31, 252, 5, 62, 130, 239, 192, 90, 144, 97, 29, 244, 67, 26, 50, 112, 39, 40, 112, 25, 187, 89, 231, 216, 163, 247, 32, 172, 135, 15, 96, 3, 108, 132, 33, 248, 58, 124, 3, 46, 135, 189, 240, 35, 248, 38, 92, 1, 87, 194, 183, 224, 67, 248, 24, 30, 65, 102, 180, 25, 149, 160, 171, 81, 25, 26, 134, 255, 130, 47, 144, 5, 125, 15, 141, 67, 55, 195, 151, 8, 208, 120, 68, 191, 60, 20, 161, 201, 232, 199, 232, 12, 116, 39, 154, 132, 238, 66, 83, 209, 79, 208, 68, 244, 35, 84, 133, 70, 224, 239, 240, 31, 104, 38, 218, 141, 39, 224, 51, 208, 108, 180, 7, 85, 163, 7, 81, 45, 250, 13, 170, 65, 143, 162, 57, 232, 97, 52, 23, 61, 9, 159, 193, 31, 145, 21, 61, 130, 234, 208, 1, 60, 17, 79, 66, 7, 145, 13, 61, 139, 39, 227, 41, 232, 60, 244, 28, 170, 71, 207, 195, 219, 240, 14, 114, 160, 63, 226, 169, 120, 26, 106, 68, 175, 160, 195, 104, 10, 186, 27, 45, 68, 111, 160, 38, 244, 26, 90, 128, 94, 7, 13, 10, 232, 2, 244, 1, 158, 142, 207, 68, 203, 208, 95, 208, 90, 244, 111, 104, 29, 250, 4, 254, 19, 62, 135, 119, 225, 79, 120, 6, 62, 11, 41, 232, 63, 81, 6, 125, 134, 134, 240, 126, 148, 195, 165, 232, 50, 92, 130, 54, 224, 95, 163, 44, 54, 33, 21, 63, 142, 190, 142, 159, 70, 223, 196, 7, 225, 81, 244, 45, 124, 8, 93, 133, 159, 131, 99, 240, 103, 244, 91, 184, 7, 159, 141, 171, 240, 76, 252, 53, 60, 11, 159, 131, 9, 174, 198, 179, 177, 21, 207, 193, 53, 120, 46, 62, 23, 207, 195, 243, 81, 57, 186, 142, 254, 159, 239, 208, 18, 244, 39, 92, 139, 235, 176, 128, 109, 112, 24, 222, 194, 118, 120, 13, 94, 135, 55, 224, 40, 188, 2, 111, 226, 243, 208, 23, 232, 135, 168, 20, 125, 7, 77, 71, 51, 208, 124, 180, 24, 173, 71, 46, 244, 20, 50, 161, 10, 116, 54, 174, 71, 211, 208, 183, 209, 153, 104, 0, 157, 139, 4, 100, 71, 13, 200, 137, 174, 64, 139, 208, 249, 200, 141, 210, 168, 31, 229, 81, 51, 170, 68, 75, 113, 3, 154, 135, 6, 209, 181, 232, 167, 232, 62, 244, 52, 254, 21, 218, 132, 190, 139, 10, 232, 86, 116, 15, 126, 17, 237, 68, 159, 163, 95, 163, 103, 208, 33, 236, 64, 175, 226, 103, 208, 81, 184, 21, 125, 132, 254, 134, 142, 163, 255, 192, 191, 68, 127, 70, 223, 71, 239, 161, 35, 104, 63, 222, 135, 222, 194, 79, 162, 55, 113, 35, 118, 226, 38, 188, 0, 47, 196, 46, 188, 8, 47, 198, 75, 112, 51, 62, 31, 47, 197, 23, 224, 101, 216, 141, 61, 216, 139, 125, 216, 143, 91, 112, 43, 110, 195, 1, 188, 28, 175, 192, 237, 120, 37, 238, 192, 65, 28, 194, 171, 112, 24, 71, 112, 20, 119, 226, 213, 184, 11, 199, 112, 28, 175, 193, 23, 226, 139, 240, 197, 248, 18, 124, 41, 238, 198, 34, 78, 224, 36, 78, 97, 9, 247, 224, 94, 220, 135, 101, 188, 22, 175, 195, 105, 220, 143, 51, 88, 193, 3, 248, 50, 156, 197, 57, 172, 226, 60, 30, 196, 235, 241, 6, 60, 132, 55, 226, 175, 227, 203, 241, 55, 240, 21, 248, 155, 248, 74, 252, 45, 124, 21, 254, 54, 190, 26, 95, 131, 55, 225, 107, 241, 102, 252, 29, 60, 140, 175, 195, 91, 240, 245, 120, 43, 190, 1, 223, 136, 111, 194, 219, 240, 119, 241, 118, 244, 13, 124, 0, 109, 196, 79, 161, 203, 241, 111, 208, 149, 248, 89, 24, 129, 221, 248, 123, 216, 12, 123, 224, 33, 216, 143, 222, 135, 7, 224, 65, 120, 10, 174, 130, 39, 225, 90, 184, 15, 158, 70, 191, 71, 255, 11, 158, 128, 95, 162, 251, 241, 205, 240, 24, 254, 62, 222, 129, 111, 193, 63, 192, 183, 226, 219, 240, 15, 241, 237, 248, 14, 124, 39, 254, 17, 190, 11, 255, 24, 223, 141, 127, 130, 239, 193, 63, 197, 247, 226, 159, 225, 157, 248, 62, 124, 63, 254, 57, 222, 133, 127, 129, 53, 92, 192, 35, 120, 55, 252, 59, 122, 17, 29, 67, 103, 225, 7, 240, 131, 120, 15, 108, 129, 59, 208, 207, 208, 219, 232, 191, 144, 134, 254, 138, 182, 162, 27, 209, 13, 232, 122, 180, 5, 221, 132, 182, 193, 14, 184, 23, 126, 0, 183, 192, 191, 225, 135, 240, 94, 248, 9, 108, 67, 239, 162, 39, 240, 99, 112, 27, 252, 20, 110, 64, 247, 194, 118, 248, 30, 218, 139, 62, 68, 143, 163, 199, 208, 10, 212, 142, 60, 200, 135, 188, 200, 143, 2, 104, 57, 106, 69, 109, 168, 5, 93, 130, 194, 168, 7, 245, 162, 53, 40, 132, 130, 40, 130, 36, 148, 66, 113, 180, 26, 117, 162, 40, 234, 66, 49, 116, 49, 90, 137, 58, 208, 42, 148, 64, 73, 116, 17, 234, 70, 34, 186, 16, 201, 168, 15, 93, 138, 159, 64, 119, 160, 237, 232, 5, 244, 49, 122, 9, 253, 111, 116, 59, 218, 133, 8, 154, 133, 206, 65, 191, 96, 95, 29, 246, 37, 6, 120, 24, 237, 64, 95, 67, 191, 68, 251, 208, 59, 232, 83, 244, 43, 244, 119, 244, 115, 244, 59, 244, 239, 232, 22, 244, 16, 186, 13, 253, 1, 189, 140, 30, 192, 15, 227, 71, 240, 163, 248, 121, 252, 66, 153, 219, 47, 38, 243, 170, 100, 118, 39, 178, 210, 160, 84, 234, 166, 255, 27, 175, 133, 253, 84, 149, 140, 146, 43, 115, 247, 139, 201, 172, 146, 41, 115, 43, 189, 74, 70, 90, 103, 113, 103, 229, 76, 47, 91, 98, 242, 72, 170, 56, 206, 155, 148, 179, 201, 124, 127, 79, 90, 218, 96, 241, 166, 20, 85, 76, 38, 165, 140, 90, 226, 237, 147, 205, 190, 164, 152, 85, 50, 102, 95, 50, 171, 136, 170, 217, 207, 0, 204, 126, 54, 104, 241, 23, 77, 203, 252, 58, 66, 137, 63, 211, 91, 230, 215, 81, 202, 252, 3, 57, 57, 173, 100, 42, 185, 100, 161, 148, 248, 85, 177, 220, 175, 138, 236, 194, 228, 207, 103, 149, 210, 86, 177, 191, 95, 28, 215, 58, 38, 132, 214, 162, 95, 115, 91, 131, 171, 169, 177, 145, 9, 167, 46, 154, 26, 204, 109, 14, 71, 253, 194, 70, 83, 91, 66, 204, 142, 107, 27, 93, 135, 3, 203, 205, 1, 22, 96, 89, 128, 39, 28, 208, 67, 49, 5, 20, 85, 172, 164, 63, 82, 178, 148, 149, 114, 114, 174, 130, 94, 176, 32, 204, 1, 85, 78, 167, 164, 113, 203, 71, 29, 149, 174, 16, 7, 6, 68, 115, 59, 99, 200, 220, 46, 246, 39, 82, 162, 185, 157, 229, 108, 106, 79, 41, 42, 94, 153, 55, 119, 232, 147, 29, 108, 20, 119, 228, 205, 65, 134, 60, 62, 216, 151, 207, 244, 138, 217, 124, 127, 90, 204, 171, 101, 65, 61, 14, 75, 176, 95, 234, 213, 115, 46, 11, 246, 203, 116, 168, 146, 75, 54, 56, 46, 152, 75, 139, 185, 62, 230, 178, 36, 212, 39, 227, 144, 92, 18, 202, 201, 230, 48, 27, 49, 135, 25, 72, 73, 184, 79, 41, 143, 180, 212, 55, 212, 215, 215, 215, 83, 197, 97, 40, 141, 134, 226, 52, 148, 38, 67, 89, 96, 40, 11, 13, 197, 101, 40, 139, 184, 194, 252, 81, 135, 13, 134, 231, 6, 99, 202, 97, 76, 57, 140, 41, 135, 1, 234, 48, 64, 29, 6, 168, 195, 0, 117, 24, 160, 14, 3, 212, 97, 128, 54, 26, 83, 141, 198, 84, 99, 113, 202, 0, 117, 26, 160, 78, 3, 212, 105, 128, 58, 13, 80, 167, 1, 234, 52, 64, 157, 134, 103, 167, 225, 217, 105, 120, 118, 26, 158, 155, 12, 207, 77, 134, 231, 38, 195, 115, 147, 225, 185, 137, 121, 54, 71, 24, 243, 227, 34, 99, 154, 34, 34, 247, 246, 139, 37, 81, 49, 111, 138, 38, 196, 172, 57, 202, 138, 82, 26, 237, 147, 84, 209, 220, 169, 23, 191, 243, 132, 226, 119, 242, 38, 236, 228, 251, 161, 83, 223, 7, 103, 112, 105, 180, 98, 37, 191, 102, 157, 80, 218, 73, 183, 166, 185, 147, 245, 164, 185, 75, 143, 162, 107, 52, 138, 138, 46, 99, 153, 185, 171, 55, 43, 14, 74, 56, 38, 143, 139, 143, 206, 155, 227, 108, 212, 188, 134, 173, 180, 172, 41, 238, 36, 211, 26, 26, 167, 200, 226, 44, 19, 37, 54, 93, 202, 94, 6, 177, 136, 163, 7, 133, 168, 135, 92, 33, 102, 20, 85, 74, 75, 178, 88, 38, 242, 35, 67, 44, 30, 25, 21, 98, 54, 171, 172, 79, 40, 106, 159, 174, 165, 148, 245, 25, 93, 75, 75, 61, 170, 133, 105, 236, 37, 145, 50, 166, 230, 7, 42, 184, 76, 101, 42, 139, 90, 34, 39, 153, 18, 146, 42, 150, 38, 210, 74, 114, 221, 184, 228, 104, 6, 150, 100, 49, 230, 146, 100, 159, 108, 166, 51, 105, 201, 148, 76, 231, 19, 230, 148, 206, 121, 74, 74, 171, 98, 89, 74, 22, 251, 149, 76, 106, 188, 65, 8, 227, 175, 44, 181, 46, 215, 39, 166, 164, 178, 84, 134, 121, 54, 75, 44, 99, 179, 196, 86, 90, 164, 162, 239, 50, 137, 159, 88, 82, 166, 183, 76, 226, 21, 146, 248, 137, 197, 37, 243, 56, 78, 186, 44, 47, 15, 138, 105, 41, 147, 148, 42, 164, 156, 42, 211, 151, 12, 82, 37, 146, 42, 150, 75, 252, 8, 169, 144, 54, 36, 211, 98, 127, 42, 145, 54, 247, 72, 253, 98, 90, 170, 232, 145, 211, 105, 41, 149, 80, 54, 88, 116, 45, 43, 37, 213, 113, 61, 242, 160, 36, 81, 98, 250, 114, 165, 189, 236, 208, 235, 29, 147, 118, 111, 49, 52, 115, 175, 50, 208, 39, 101, 77, 125, 244, 124, 235, 27, 53, 41, 237, 147, 196, 172, 90, 218, 167, 228, 115, 146, 89, 102, 121, 97, 121, 109, 153, 204, 207, 24, 57, 163, 74, 189, 89, 49, 157, 80, 139, 170, 58, 80, 73, 213, 108, 78, 74, 210, 151, 37, 42, 228, 204, 96, 34, 159, 78, 75, 42, 213, 168, 227, 180, 84, 41, 103, 6, 115, 253, 114, 90, 234, 17, 147, 82, 153, 204, 207, 75, 153, 158, 151, 242, 152, 243, 114, 210, 216, 11, 61, 105, 185, 120, 130, 202, 172, 91, 199, 173, 29, 19, 234, 58, 122, 130, 86, 174, 163, 111, 232, 100, 210, 98, 38, 37, 39, 205, 105, 214, 61, 230, 180, 126, 156, 166, 89, 65, 76, 233, 148, 162, 150, 165, 123, 88, 173, 76, 105, 57, 43, 150, 166, 149, 76, 111, 174, 44, 173, 178, 50, 154, 40, 155, 230, 126, 57, 67, 183, 65, 127, 62, 71, 223, 78, 162, 173, 57, 97, 140, 78, 105, 207, 48, 223, 227, 50, 226, 128, 146, 83, 179, 148, 62, 115, 134, 1, 224, 76, 222, 172, 48, 170, 198, 43, 39, 108, 78, 69, 103, 173, 84, 161, 39, 179, 69, 25, 61, 159, 21, 126, 62, 115, 201, 50, 172, 80, 50, 188, 114, 22, 101, 64, 202, 232, 28, 90, 148, 172, 218, 71, 249, 18, 211, 227, 148, 209, 243, 219, 60, 32, 229, 36, 85, 44, 25, 232, 147, 75, 6, 114, 242, 248, 203, 242, 138, 42, 209, 0, 178, 57, 41, 101, 206, 178, 64, 205, 89, 22, 220, 248, 172, 52, 152, 86, 122, 121, 34, 37, 217, 62, 165, 44, 171, 50, 42, 204, 57, 102, 55, 46, 55, 74, 169, 57, 39, 209, 23, 101, 42, 115, 210, 160, 148, 49, 218, 136, 145, 84, 154, 163, 39, 147, 153, 253, 108, 168, 40, 22, 179, 52, 55, 32, 166, 164, 146, 92, 62, 83, 162, 138, 121, 147, 74, 15, 45, 149, 225, 150, 170, 244, 208, 170, 84, 251, 178, 82, 177, 33, 89, 158, 101, 106, 86, 22, 123, 83, 25, 93, 166, 123, 116, 153, 85, 117, 153, 31, 48, 231, 117, 42, 243, 39, 80, 153, 215, 169, 28, 159, 207, 164, 164, 108, 46, 169, 100, 165, 84, 34, 93, 150, 207, 200, 244, 36, 229, 210, 167, 75, 55, 191, 118, 243, 107, 143, 131, 203, 70, 46, 23, 49, 217, 224, 112, 112, 169, 143, 55, 52, 46, 224, 114, 33, 151, 30, 46, 189, 186, 116, 54, 113, 201, 237, 154, 12, 201, 237, 23, 112, 127, 11, 184, 63, 175, 143, 75, 63, 151, 45, 186, 244, 213, 115, 217, 192, 37, 95, 231, 227, 235, 124, 78, 46, 57, 158, 143, 227, 248, 56, 142, 207, 197, 37, 207, 195, 231, 230, 146, 199, 235, 211, 227, 117, 52, 184, 184, 92, 196, 165, 155, 75, 221, 206, 225, 213, 199, 27, 23, 234, 241, 53, 46, 210, 113, 27, 221, 124, 220, 163, 251, 113, 114, 126, 157, 245, 13, 92, 58, 184, 108, 228, 210, 201, 101, 19, 151, 11, 184, 92, 200, 165, 139, 203, 69, 92, 186, 185, 244, 112, 105, 224, 248, 56, 142, 159, 227, 232, 124, 57, 27, 56, 126, 3, 199, 111, 224, 248, 13, 28, 191, 129, 227, 55, 112, 252, 6, 142, 223, 192, 241, 57, 15, 78, 206, 131, 147, 243, 224, 228, 60, 56, 27, 56, 126, 3, 199, 111, 224, 248, 13, 28, 223, 193, 241, 29, 28, 159, 247, 141, 147, 247, 141, 211, 193, 241, 29, 28, 223, 193, 241, 29, 28, 223, 193, 241, 29, 28, 223, 193, 241, 29, 28, 223, 193, 241, 29, 28, 223, 193, 241, 29, 28, 191, 145, 227, 55, 114, 252, 70, 142, 223, 200, 241, 27, 57, 126, 35, 199, 231, 125, 236, 108, 228, 248, 141, 28, 191, 145, 227, 55, 114, 252, 70, 142, 223, 200, 241, 27, 57, 126, 35, 199, 111, 228, 248, 78, 142, 239, 228, 248, 78, 142, 239, 228, 248, 78, 142, 207, 247, 135, 211, 201, 241, 157, 28, 223, 201, 241, 157, 28, 223, 201, 241, 157, 28, 223, 201, 241, 157, 28, 223, 201, 241, 157, 28, 191, 137, 227, 55, 113, 252, 38, 142, 223, 196, 241, 155, 56, 126, 19, 199, 231, 251, 210, 201, 247, 165, 179, 137, 227, 55, 113, 252, 38, 142, 223, 196, 241, 155, 56, 126, 19, 199, 111, 226, 248, 77, 28, 127, 17, 199, 95, 196, 240, 29, 245, 122, 159, 57, 234, 117, 158, 28, 245, 11, 235, 185, 116, 114, 201, 231, 23, 46, 224, 243, 11, 249, 188, 139, 207, 47, 226, 243, 110, 62, 239, 225, 243, 94, 62, 239, 227, 243, 134, 255, 22, 221, 191, 139, 227, 184, 120, 28, 46, 135, 30, 135, 171, 81, 143, 195, 197, 241, 93, 28, 223, 197, 241, 93, 28, 223, 197, 241, 93, 28, 223, 197, 241, 93, 28, 223, 197, 241, 93, 28, 223, 197, 241, 23, 233, 246, 13, 245, 186, 223, 6, 125, 223, 57, 26, 244, 125, 230, 112, 112, 62, 248, 57, 211, 226, 209, 207, 137, 22, 79, 61, 139, 175, 165, 165, 197, 87, 154, 207, 200, 74, 166, 44, 207, 159, 128, 242, 3, 236, 254, 83, 150, 231, 207, 170, 92, 26, 119, 254, 169, 39, 93, 179, 187, 70, 37, 31, 100, 23, 165, 121, 250, 160, 104, 206, 179, 71, 2, 243, 122, 253, 54, 182, 126, 244, 54, 86, 177, 222, 88, 107, 94, 207, 30, 85, 241, 6, 121, 220, 208, 152, 219, 220, 16, 27, 53, 111, 100, 43, 45, 27, 139, 79, 69, 229, 244, 13, 107, 123, 74, 81, 45, 76, 145, 250, 7, 212, 33, 211, 70, 73, 21, 233, 119, 120, 124, 249, 37, 156, 97, 252, 125, 242, 191, 245, 223, 31, 224, 15, 176, 29, 182, 151, 30, 0, 48, 45, 5, 248, 34, 95, 250, 209, 151, 175, 153, 86, 127, 249, 231, 47, 242, 99, 231, 204, 13, 163, 179, 102, 223, 41, 230, 235, 190, 50, 127, 49, 220, 2, 183, 64, 12, 98, 216, 247, 197, 33, 212, 243, 197, 33, 188, 230, 139, 61, 84, 26, 227, 102, 27, 126, 208, 236, 197, 119, 104, 32, 16, 13, 58, 99, 254, 56, 33, 43, 246, 194, 132, 85, 43, 52, 115, 100, 77, 76, 115, 86, 105, 243, 226, 221, 61, 100, 184, 51, 166, 225, 26, 241, 225, 114, 40, 135, 100, 210, 154, 168, 170, 174, 214, 32, 174, 129, 215, 234, 27, 1, 4, 222, 110, 143, 77, 67, 130, 70, 186, 123, 108, 26, 22, 172, 213, 214, 106, 155, 86, 34, 144, 212, 238, 146, 169, 211, 192, 227, 213, 166, 120, 73, 119, 183, 167, 128, 167, 122, 61, 133, 154, 18, 175, 134, 189, 209, 13, 68, 171, 180, 106, 216, 235, 21, 83, 154, 41, 180, 97, 4, 99, 236, 237, 246, 104, 213, 210, 204, 106, 58, 58, 50, 97, 26, 242, 204, 36, 26, 246, 90, 61, 35, 83, 208, 20, 111, 183, 199, 170, 65, 40, 38, 197, 71, 166, 35, 204, 0, 77, 130, 86, 82, 167, 77, 243, 198, 40, 158, 54, 221, 235, 229, 6, 85, 36, 69, 180, 125, 33, 205, 52, 119, 205, 200, 60, 52, 222, 235, 79, 250, 53, 179, 63, 86, 173, 149, 212, 196, 195, 23, 198, 170, 173, 213, 85, 195, 49, 162, 133, 66, 177, 106, 109, 89, 188, 138, 104, 46, 170, 185, 226, 113, 82, 208, 173, 197, 148, 54, 47, 20, 171, 230, 87, 68, 171, 167, 243, 245, 212, 114, 95, 40, 70, 122, 200, 240, 176, 72, 232, 135, 73, 118, 87, 17, 141, 208, 57, 11, 213, 22, 80, 109, 65, 119, 85, 119, 60, 30, 175, 210, 112, 141, 86, 233, 77, 106, 16, 142, 105, 192, 62, 121, 178, 90, 171, 244, 86, 173, 208, 102, 81, 171, 89, 43, 196, 189, 19, 33, 73, 45, 246, 150, 66, 34, 30, 79, 137, 113, 13, 213, 197, 227, 60, 131, 56, 73, 105, 211, 189, 86, 79, 220, 166, 149, 10, 196, 79, 52, 83, 141, 152, 34, 90, 153, 55, 20, 211, 202, 172, 30, 173, 220, 234, 169, 170, 174, 142, 107, 168, 219, 166, 153, 25, 221, 90, 73, 29, 73, 21, 202, 18, 30, 66, 39, 105, 186, 85, 122, 248, 244, 167, 86, 218, 237, 79, 106, 165, 181, 213, 68, 43, 247, 146, 97, 50, 172, 161, 186, 66, 125, 105, 141, 102, 154, 187, 42, 214, 29, 170, 18, 195, 241, 152, 53, 94, 29, 39, 218, 178, 72, 76, 67, 117, 85, 148, 23, 30, 138, 77, 43, 19, 180, 10, 111, 221, 8, 96, 189, 204, 229, 130, 86, 97, 245, 88, 137, 6, 86, 143, 168, 225, 68, 143, 134, 146, 26, 234, 214, 202, 106, 109, 90, 133, 64, 104, 180, 19, 188, 201, 189, 38, 72, 16, 234, 65, 91, 214, 29, 167, 38, 221, 62, 22, 173, 69, 24, 169, 152, 0, 94, 191, 167, 182, 186, 216, 56, 227, 132, 19, 27, 169, 82, 247, 130, 234, 172, 26, 120, 53, 83, 77, 55, 241, 15, 91, 69, 90, 84, 70, 54, 84, 209, 130, 104, 164, 74, 91, 86, 36, 76, 43, 169, 177, 138, 62, 29, 98, 252, 105, 150, 107, 115, 66, 49, 13, 170, 180, 101, 167, 90, 52, 129, 246, 191, 213, 179, 123, 124, 37, 148, 248, 67, 177, 234, 42, 107, 117, 188, 182, 218, 166, 157, 33, 20, 48, 246, 107, 41, 209, 103, 211, 38, 10, 26, 234, 38, 68, 59, 195, 187, 156, 102, 70, 180, 51, 172, 158, 184, 54, 145, 94, 133, 99, 68, 155, 200, 234, 53, 73, 32, 218, 68, 70, 10, 217, 107, 130, 228, 176, 85, 212, 38, 121, 187, 201, 112, 55, 209, 38, 89, 61, 86, 155, 54, 89, 88, 17, 141, 21, 76, 41, 95, 124, 142, 54, 94, 178, 110, 176, 105, 83, 132, 21, 171, 98, 43, 34, 250, 96, 85, 117, 124, 142, 54, 133, 141, 79, 21, 10, 48, 217, 219, 25, 43, 76, 158, 236, 213, 144, 232, 209, 38, 213, 209, 45, 167, 225, 26, 79, 225, 12, 250, 99, 34, 174, 241, 104, 104, 186, 149, 104, 37, 53, 161, 88, 129, 210, 169, 153, 106, 60, 195, 195, 132, 194, 78, 172, 173, 182, 106, 72, 52, 244, 42, 125, 158, 46, 193, 53, 108, 54, 174, 157, 225, 109, 213, 38, 122, 91, 187, 53, 124, 98, 177, 78, 83, 194, 2, 192, 20, 171, 79, 67, 94, 13, 150, 142, 32, 132, 88, 245, 166, 9, 80, 0, 236, 143, 198, 180, 201, 86, 15, 241, 107, 19, 172, 30, 109, 188, 85, 43, 237, 246, 144, 238, 135, 102, 204, 64, 48, 9, 166, 128, 199, 227, 161, 12, 76, 181, 122, 52, 36, 22, 166, 150, 215, 105, 215, 213, 85, 205, 142, 219, 180, 233, 66, 1, 166, 213, 217, 180, 51, 133, 2, 162, 114, 134, 80, 192, 84, 158, 37, 20, 74, 168, 60, 91, 40, 152, 168, 172, 18, 10, 165, 84, 206, 20, 10, 102, 42, 191, 38, 20, 202, 168, 156, 37, 20, 202, 169, 60, 71, 40, 84, 80, 89, 39, 88, 13, 254, 53, 115, 247, 138, 104, 204, 74, 236, 26, 186, 152, 238, 22, 155, 38, 140, 153, 156, 94, 156, 188, 76, 159, 180, 141, 153, 156, 91, 156, 204, 234, 147, 68, 0, 237, 140, 186, 211, 230, 169, 33, 241, 65, 61, 85, 154, 231, 216, 252, 170, 133, 2, 144, 58, 155, 54, 91, 40, 32, 42, 173, 66, 1, 83, 57, 71, 40, 148, 80, 89, 35, 20, 76, 84, 206, 21, 10, 165, 84, 158, 43, 20, 204, 84, 206, 19, 10, 101, 84, 206, 23, 10, 229, 84, 214, 10, 133, 10, 42, 237, 2, 105, 102, 13, 123, 158, 64, 186, 181, 25, 221, 196, 107, 213, 80, 183, 151, 30, 206, 116, 19, 218, 105, 207, 214, 11, 218, 121, 117, 218, 121, 181, 54, 173, 65, 32, 164, 149, 156, 166, 154, 86, 209, 101, 165, 7, 251, 63, 180, 168, 162, 217, 59, 140, 18, 23, 38, 152, 253, 180, 227, 180, 134, 218, 66, 41, 154, 230, 143, 213, 199, 89, 21, 27, 199, 208, 115, 58, 27, 167, 64, 154, 88, 228, 77, 2, 112, 27, 255, 87, 49, 53, 84, 119, 202, 88, 232, 56, 76, 127, 128, 221, 47, 125, 75, 173, 174, 130, 19, 77, 163, 185, 46, 16, 72, 51, 105, 61, 77, 252, 26, 120, 69, 151, 77, 91, 40, 216, 207, 108, 182, 105, 174, 127, 102, 170, 33, 111, 210, 101, 211, 22, 9, 5, 12, 211, 107, 136, 157, 180, 210, 35, 65, 195, 53, 129, 225, 225, 86, 107, 171, 85, 36, 177, 68, 21, 61, 117, 173, 158, 17, 23, 66, 211, 166, 214, 218, 180, 197, 130, 6, 211, 181, 51, 173, 30, 205, 84, 163, 153, 106, 152, 89, 161, 18, 60, 218, 56, 111, 157, 52, 108, 183, 18, 210, 60, 236, 178, 105, 75, 78, 52, 35, 118, 102, 72, 52, 179, 213, 99, 88, 19, 173, 155, 158, 41, 203, 86, 197, 118, 155, 72, 41, 169, 218, 109, 154, 91, 122, 118, 220, 67, 79, 90, 139, 151, 12, 91, 217, 10, 107, 75, 183, 102, 246, 158, 188, 93, 187, 233, 105, 167, 223, 149, 76, 222, 238, 148, 85, 43, 245, 138, 169, 80, 76, 51, 121, 197, 42, 173, 212, 219, 77, 79, 186, 147, 215, 136, 86, 66, 52, 211, 92, 107, 139, 232, 170, 178, 106, 22, 111, 11, 189, 99, 89, 188, 12, 165, 155, 156, 10, 196, 74, 81, 172, 154, 217, 219, 77, 139, 81, 90, 35, 106, 165, 95, 241, 170, 153, 230, 138, 244, 110, 69, 131, 40, 169, 233, 78, 241, 147, 116, 20, 43, 110, 211, 154, 13, 46, 8, 33, 90, 233, 92, 206, 133, 181, 217, 101, 211, 206, 47, 78, 105, 22, 54, 223, 98, 109, 165, 160, 180, 138, 75, 141, 57, 150, 140, 206, 180, 6, 209, 152, 157, 52, 91, 171, 217, 253, 22, 215, 112, 86, 75, 139, 165, 208, 204, 53, 90, 105, 77, 96, 236, 179, 139, 94, 196, 83, 180, 128, 149, 87, 203, 74, 91, 254, 130, 49, 145, 120, 141, 114, 117, 211, 7, 156, 147, 83, 54, 74, 188, 76, 176, 18, 59, 101, 177, 69, 59, 211, 27, 11, 85, 133, 227, 49, 210, 28, 183, 23, 234, 209, 212, 58, 155, 230, 62, 97, 54, 92, 21, 58, 97, 214, 115, 202, 181, 255, 104, 133, 87, 208, 22, 215, 157, 106, 145, 97, 224, 19, 180, 37, 117, 195, 132, 52, 211, 30, 27, 118, 157, 222, 84, 51, 123, 237, 90, 125, 157, 77, 243, 179, 148, 105, 27, 207, 213, 153, 23, 181, 74, 171, 71, 79, 157, 54, 168, 149, 52, 19, 187, 213, 197, 253, 183, 8, 5, 139, 169, 198, 99, 44, 249, 23, 91, 186, 245, 127, 170, 139, 105, 78, 244, 28, 107, 182, 186, 170, 170, 199, 244, 75, 117, 156, 199, 217, 42, 20, 96, 113, 157, 193, 74, 155, 80, 128, 37, 117, 213, 86, 206, 139, 213, 117, 34, 5, 1, 65, 131, 105, 250, 182, 31, 1, 186, 195, 167, 216, 181, 5, 181, 54, 109, 249, 105, 198, 87, 8, 5, 64, 83, 167, 104, 11, 107, 109, 90, 187, 160, 45, 170, 181, 105, 43, 41, 139, 126, 43, 177, 147, 150, 97, 171, 104, 176, 213, 33, 208, 134, 214, 86, 214, 217, 180, 160, 48, 2, 208, 82, 103, 211, 66, 194, 8, 32, 170, 172, 18, 70, 16, 27, 9, 11, 35, 136, 141, 68, 168, 77, 107, 157, 77, 139, 82, 27, 170, 116, 82, 27, 170, 172, 166, 54, 84, 233, 18, 118, 3, 128, 183, 206, 166, 197, 132, 221, 244, 209, 169, 206, 166, 197, 133, 221, 72, 31, 91, 35, 236, 70, 250, 216, 133, 212, 14, 81, 237, 34, 106, 199, 180, 139, 169, 29, 211, 46, 161, 118, 76, 187, 148, 98, 250, 235, 108, 90, 55, 197, 164, 138, 72, 49, 169, 146, 160, 152, 84, 73, 82, 155, 182, 58, 155, 150, 162, 54, 84, 145, 168, 13, 85, 122, 168, 13, 85, 122, 41, 30, 248, 234, 108, 90, 31, 197, 99, 154, 76, 241, 152, 182, 150, 226, 49, 109, 29, 181, 67, 84, 75, 83, 59, 166, 245, 83, 59, 166, 101, 168, 29, 211, 20, 161, 0, 205, 197, 2, 14, 176, 43, 109, 89, 157, 77, 187, 76, 87, 221, 117, 54, 45, 75, 73, 103, 87, 158, 58, 155, 150, 19, 10, 136, 219, 168, 186, 74, 109, 242, 204, 6, 113, 155, 65, 161, 0, 231, 23, 189, 174, 103, 87, 108, 197, 6, 93, 165, 43, 134, 116, 149, 154, 111, 20, 10, 136, 27, 124, 93, 87, 169, 193, 229, 186, 74, 13, 190, 33, 20, 96, 105, 209, 223, 21, 236, 138, 153, 127, 83, 87, 169, 249, 149, 186, 74, 205, 191, 37, 20, 16, 55, 184, 74, 87, 169, 193, 183, 117, 149, 26, 92, 45, 20, 224, 130, 162, 191, 107, 216, 21, 51, 223, 164, 171, 212, 252, 90, 93, 165, 230, 155, 133, 2, 226, 6, 223, 209, 85, 106, 48, 172, 171, 212, 224, 58, 97, 100, 28, 123, 178, 213, 204, 85, 35, 38, 92, 226, 143, 89, 171, 171, 170, 227, 113, 79, 157, 86, 46, 105, 37, 115, 66, 27, 140, 155, 181, 13, 86, 236, 133, 3, 225, 88, 1, 161, 173, 113, 13, 233, 199, 230, 64, 1, 202, 60, 123, 160, 195, 221, 80, 93, 10, 181, 244, 98, 89, 101, 216, 212, 98, 94, 80, 62, 183, 124, 70, 89, 169, 133, 15, 173, 198, 237, 166, 165, 102, 187, 249, 156, 82, 54, 84, 233, 121, 98, 234, 190, 73, 251, 198, 239, 171, 216, 103, 94, 70, 191, 112, 185, 182, 0, 147, 60, 79, 192, 178, 226, 63, 58, 246, 48, 148, 0, 248, 10, 115, 208, 230, 85, 49, 109, 217, 230, 24, 189, 78, 249, 10, 243, 232, 245, 222, 114, 208, 7, 192, 23, 175, 42, 156, 75, 135, 30, 41, 191, 18, 144, 105, 217, 230, 100, 212, 152, 160, 31, 32, 188, 172, 50, 138, 219, 76, 46, 243, 60, 243, 217, 165, 165, 19, 106, 247, 162, 47, 175, 209, 76, 215, 23, 48, 248, 118, 151, 166, 204, 224, 243, 1, 252, 159, 1, 0, 26, 96, 158, 168}
@@ -0,0 +1,256 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+// pdf creates searchable PDFs from page images and hOCR, with the
+// text laid invisibly over each image so that it can be searched
+// and copied
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"image"
+	"image/png"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/jung-kurt/gofpdf"
+	"rescribe.xyz/utils/pkg/hocr"
+)
+
+// DefaultDPI is the resolution assumed for page images if the hOCR
+// doesn't give one with scan_res
+const DefaultDPI = 300
+
+// PDF is a searchable PDF which is built up a page at a time
+type PDF struct {
+	// DPI is the resolution of page images which have no scan_res
+	// in their hOCR, which determines the size of the PDF pages
+	DPI   float64
+	fpdf  *gofpdf.Fpdf
+	pages int
+}
+
+// font returns the uncompressed font to embed in PDFs
+func font() ([]byte, error) {
+	r, err := zlib.NewReader(bytes.NewReader(fontBytes))
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+	return io.ReadAll(r)
+}
+
+// New returns a new, empty PDF
+func New() (*PDF, error) {
+	ttf, err := font()
+	if err != nil {
+		return nil, fmt.Errorf("Error decompressing font: %v", err)
+	}
+	f := gofpdf.New("P", "pt", "A4", "")
+	// The text is invisible, but the font still needs to cover
+	// all of the characters so that the text can be searched and
+	// copied correctly, so a UTF-8 font is embedded
+	f.AddUTF8FontFromBytes("goregular", "", ttf)
+	f.SetFont("goregular", "", 10)
+	f.SetAutoPageBreak(false, 0)
+	f.SetCreator("rescribe.xyz/utils", true)
+	return &PDF{DPI: DefaultDPI, fpdf: f}, f.Error()
+}
+
+// AddPage adds a page to the PDF, made up of the image in img,
+// with the text of the hOCR page laid invisibly over it. JPEG,
+// PNG and GIF images are embedded as they are, and any other
+// format which can be decoded is converted to PNG.
+func (p *PDF) AddPage(img io.Reader, pg hocr.Page) error {
+	b, err := io.ReadAll(img)
+	if err != nil {
+		return fmt.Errorf("Error reading image: %v", err)
+	}
+	cfg, format, err := image.DecodeConfig(bytes.NewReader(b))
+	if err != nil {
+		return fmt.Errorf("Error decoding image: %v", err)
+	}
+	var imgtype string
+	switch format {
+	case "jpeg":
+		imgtype = "JPG"
+	case "png":
+		imgtype = "PNG"
+	case "gif":
+		imgtype = "GIF"
+	default:
+		decoded, _, err := image.Decode(bytes.NewReader(b))
+		if err != nil {
+			return fmt.Errorf("Error decoding image: %v", err)
+		}
+		var buf bytes.Buffer
+		err = png.Encode(&buf, decoded)
+		if err != nil {
+			return fmt.Errorf("Error converting image to PNG: %v", err)
+		}
+		b = buf.Bytes()
+		imgtype = "PNG"
+	}
+
+	props, err := pg.Properties()
+	if err != nil {
+		return fmt.Errorf("Error parsing properties of page %s: %v", pg.Id, err)
+	}
+	dpi := p.DPI
+	if props.ScanRes[0] > 0 {
+		dpi = float64(props.ScanRes[0])
+	}
+	// scale converts from pixels to points
+	scale := 72 / dpi
+
+	w, h := float64(cfg.Width)*scale, float64(cfg.Height)*scale
+	p.pages++
+	name := fmt.Sprintf("page%d", p.pages)
+	p.fpdf.AddPageFormat("P", gofpdf.SizeType{Wd: w, Ht: h})
+	p.fpdf.RegisterImageOptionsReader(name, gofpdf.ImageOptions{ImageType: imgtype}, bytes.NewReader(b))
+	p.fpdf.ImageOptions(name, 0, 0, w, h, false, gofpdf.ImageOptions{ImageType: imgtype}, 0, "")
+
+	// text rendering mode 3 is invisible
+	p.fpdf.RawWriteStr("3 Tr\n")
+	for _, l := range pg.Lines() {
+		err = p.addLine(*l, scale)
+		if err != nil {
+			return err
+		}
+	}
+
+	return p.fpdf.Error()
+}
+
+// addLine adds the text of a line to the current page, scaling
+// each word to fit its bounding box, and placing it on the
+// baseline of the line
+func (p *PDF) addLine(l hocr.OcrLine, scale float64) error {
+	props, err := l.Properties()
+	if err != nil {
+		return fmt.Errorf("Error parsing properties of line %s: %v", l.Id, err)
+	}
+	lb := props.Bbox
+	if lb.Empty() {
+		return nil
+	}
+	size := props.Size
+	if size == 0 {
+		size = float64(lb.Dy())
+	}
+	// baseline returns the y position of the baseline at x
+	baseline := func(x int) float64 {
+		return float64(lb.Max.Y) + props.Baseline.Offset + props.Baseline.Slope*float64(x-lb.Min.X)
+	}
+
+	if len(l.Words) == 0 {
+		p.addWord(hocr.LineText(l), lb, baseline(lb.Min.X), size, scale)
+		return nil
+	}
+	for _, w := range l.Words {
+		wprops, err := w.Properties()
+		if err != nil {
+			return fmt.Errorf("Error parsing properties of word %s: %v", w.Id, err)
+		}
+		if wprops.Bbox.Empty() {
+			continue
+		}
+		var txt string
+		glyphs, err := w.Glyphs()
+		if err != nil {
+			// glyph information is optional, so the word is kept
+			// without it
+			fmt.Fprintf(os.Stderr, "Warning: word %s: %v\n", w.Id, err)
+			glyphs = hocr.TextGlyphs(w)
+		}
+		for _, g := range glyphs {
+			txt += g.Text
+		}
+		p.addWord(txt, wprops.Bbox, baseline(wprops.Bbox.Min.X), size, scale)
+	}
+	return nil
+}
+
+// addWord adds a word to the current page, with a font size of
+// size pixels, stretched horizontally to fill the box
+func (p *PDF) addWord(txt string, box image.Rectangle, y float64, size float64, scale float64) {
+	if txt == "" {
+		return
+	}
+	p.fpdf.SetFontSize(size * scale)
+	x := float64(box.Min.X) * scale
+	y = y * scale
+	natural := p.fpdf.GetStringWidth(txt)
+	if natural <= 0 {
+		return
+	}
+	p.fpdf.TransformBegin()
+	p.fpdf.TransformScaleX(float64(box.Dx())*scale/natural*100, x, y)
+	p.fpdf.Text(x, y, txt)
+	p.fpdf.TransformEnd()
+}
+
+// AddHocr adds each page of a hOCR document read from r to the
+// PDF, with page images opened from imgs, using the image names
+// embedded in the hOCR
+func (p *PDF) AddHocr(r io.Reader, imgs fs.FS) error {
+	return p.addHocr(r, imgs, func(pg hocr.Page) (string, error) {
+		props, err := pg.Properties()
+		if err != nil {
+			return "", err
+		}
+		if props.Image == "" {
+			return "", errors.New("No image found")
+		}
+		return props.Image, nil
+	})
+}
+
+// AddHocrCustomImg is a variant of AddHocr that uses the image
+// named imgname in imgs for every page, rather than the image
+// names embedded in the hOCR
+func (p *PDF) AddHocrCustomImg(r io.Reader, imgs fs.FS, imgname string) error {
+	return p.addHocr(r, imgs, func(pg hocr.Page) (string, error) { return imgname, nil })
+}
+
+func (p *PDF) addHocr(r io.Reader, imgs fs.FS, imgPath func(hocr.Page) (string, error)) error {
+	hr := hocr.NewReader(r)
+	for {
+		pg, err := hr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		imgpath, err := imgPath(pg)
+		if err != nil {
+			return fmt.Errorf("Error getting image for page %s: %v", pg.Id, err)
+		}
+		imgpath = path.Base(filepath.ToSlash(imgpath))
+		f, err := imgs.Open(imgpath)
+		if err != nil {
+			return fmt.Errorf("Error opening image %s: %v", imgpath, err)
+		}
+		err = p.AddPage(f, pg)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("Error adding page %s: %v", pg.Id, err)
+		}
+	}
+}
+
+// Write writes the PDF to w
+func (p *PDF) Write(w io.Writer) error {
+	if p.pages == 0 {
+		return errors.New("No pages added")
+	}
+	return p.fpdf.Output(w)
+}
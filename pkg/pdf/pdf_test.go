@@ -0,0 +1,89 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package pdf
+
+import (
+	"bytes"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+const bookHocr = `<html><body>
+<div class='ocr_page' id='page_1' title='image "p1.png"; bbox 0 0 600 800; scan_res 150 150'>
+ <span class='ocr_line' id='line_1_1' title='bbox 10 10 590 40; baseline 0 -5; x_size 30'>
+  <span class='ocrx_word' id='word_1_1' title='bbox 10 10 200 40; x_wconf 90'>Prima</span>
+  <span class='ocrx_word' id='word_1_2' title='bbox 220 10 590 40; x_wconf 90'>ſententia</span>
+ </span>
+</div>
+<div class='ocr_page' id='page_2' title='image "p2.jpg"; bbox 0 0 600 800'>
+ <span class='ocr_line' id='line_2_1' title='bbox 10 10 590 40'>Secunda</span>
+</div>
+</body></html>
+`
+
+func TestAddHocr(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 600, 800))
+	var p1, p2 bytes.Buffer
+	err := png.Encode(&p1, img)
+	if err != nil {
+		t.Fatalf("Error encoding png: %v", err)
+	}
+	err = jpeg.Encode(&p2, img, nil)
+	if err != nil {
+		t.Fatalf("Error encoding jpeg: %v", err)
+	}
+	imgs := fstest.MapFS{
+		"p1.png": {Data: p1.Bytes()},
+		"p2.jpg": {Data: p2.Bytes()},
+	}
+
+	p, err := New()
+	if err != nil {
+		t.Fatalf("Error creating PDF: %v", err)
+	}
+	p.fpdf.SetCompression(false)
+	err = p.AddHocr(strings.NewReader(bookHocr), imgs)
+	if err != nil {
+		t.Fatalf("Error adding hOCR: %v", err)
+	}
+	var out bytes.Buffer
+	err = p.Write(&out)
+	if err != nil {
+		t.Fatalf("Error writing PDF: %v", err)
+	}
+
+	s := out.String()
+	if !strings.HasPrefix(s, "%PDF-") {
+		t.Fatalf("Output is not a PDF")
+	}
+	if n := strings.Count(s, "/Type /Page\n"); n != 2 {
+		t.Errorf("Expected 2 pages, got %d", n)
+	}
+	// the first page is 150dpi, the second the default 300dpi
+	for _, box := range []string{"/MediaBox [0 0 288.00 384.00]", "/MediaBox [0 0 144.00 192.00]"} {
+		if !strings.Contains(s, box) {
+			t.Errorf("Expected page with %s", box)
+		}
+	}
+	if n := strings.Count(s, "3 Tr"); n != 2 {
+		t.Errorf("Expected invisible text on 2 pages, got %d", n)
+	}
+	if n := strings.Count(s, " Tj"); n != 3 {
+		t.Errorf("Expected 3 words of text, got %d", n)
+	}
+
+	p, err = New()
+	if err != nil {
+		t.Fatalf("Error creating PDF: %v", err)
+	}
+	err = p.AddHocr(strings.NewReader(bookHocr), fstest.MapFS{})
+	if err == nil {
+		t.Errorf("Expected an error for missing images")
+	}
+}
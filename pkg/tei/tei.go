@@ -0,0 +1,159 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+// tei converts hOCR to TEI XML, for use in editions of a text.
+// Each page becomes a <pb/>, with the name of the page image as its
+// facs, each paragraph a <p>, and each line starts with a <lb/>.
+// Words with a low confidence can be marked with <unclear>.
+package tei
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"rescribe.xyz/utils/pkg/hocr"
+	"rescribe.xyz/utils/pkg/normalize"
+)
+
+// Options control how hOCR is converted to TEI
+type Options struct {
+	// Title is used as the title of the document
+	Title string
+	// Unclear is the x_wconf below which a word is marked as
+	// <unclear>. Words without an x_wconf are never marked.
+	Unclear float64
+	// Profile is used to normalise the text, if it is set
+	Profile *normalize.Profile
+}
+
+// teiWriter builds a TEI document
+type teiWriter struct {
+	bytes.Buffer
+	opts Options
+}
+
+// text writes escaped, normalised text
+func (t *teiWriter) text(s string) {
+	if t.opts.Profile != nil {
+		s = t.opts.Profile.String(s)
+	}
+	xml.EscapeText(t, []byte(s))
+}
+
+// attr writes an attribute, if it has a value
+func (t *teiWriter) attr(name, value string) {
+	if value == "" {
+		return
+	}
+	fmt.Fprintf(t, ` %s="`, name)
+	xml.EscapeText(t, []byte(value))
+	t.WriteString(`"`)
+}
+
+// Write writes a hOCR document as TEI XML
+func Write(w io.Writer, h hocr.Hocr, opts Options) error {
+	t := &teiWriter{opts: opts}
+	t.WriteString(xml.Header)
+	t.WriteString(`<TEI xmlns="http://www.tei-c.org/ns/1.0">` + "\n")
+	t.WriteString("<teiHeader>\n<fileDesc>\n<titleStmt>\n<title>")
+	t.text(opts.Title)
+	t.WriteString("</title>\n</titleStmt>\n")
+	t.WriteString("<publicationStmt>\n<p>Converted from hOCR</p>\n</publicationStmt>\n")
+	t.WriteString("<sourceDesc>\n<p>")
+	if sys := h.MetaContent("ocr-system"); sys != "" {
+		t.WriteString("OCR by ")
+		t.text(sys)
+	} else {
+		t.WriteString("OCR")
+	}
+	t.WriteString("</p>\n</sourceDesc>\n</fileDesc>\n</teiHeader>\n")
+	t.WriteString("<text>\n<body>\n")
+
+	for i, p := range h.Pages {
+		err := t.page(p, i+1)
+		if err != nil {
+			return fmt.Errorf("Error converting page %d: %v", i+1, err)
+		}
+	}
+
+	t.WriteString("</body>\n</text>\n</TEI>\n")
+	_, err := t.WriteTo(w)
+	return err
+}
+
+// page writes a page, numbered n
+func (t *teiWriter) page(p hocr.Page, n int) error {
+	props, err := p.Properties()
+	if err != nil {
+		return err
+	}
+	t.WriteString("<pb")
+	if props.Image != "" {
+		t.attr("facs", filepath.Base(props.Image))
+	}
+	t.attr("n", fmt.Sprintf("%d", n))
+	t.WriteString("/>\n")
+
+	for _, par := range p.Paragraphs() {
+		if len(par.Lines) == 0 {
+			continue
+		}
+		t.WriteString("<p")
+		t.attr("xml:lang", par.Lang)
+		t.WriteString(">\n")
+		hyphenated := false
+		for _, l := range par.Lines {
+			t.WriteString("<lb")
+			if hyphenated {
+				t.attr("break", "no")
+			}
+			t.WriteString("/>")
+			err = t.line(l)
+			if err != nil {
+				return err
+			}
+			t.WriteString("\n")
+			_, hyphenated = hocr.TrimHyphen(hocr.LineText(l))
+		}
+		t.WriteString("</p>\n")
+	}
+	return nil
+}
+
+// line writes the words of a line, marking any with a low
+// confidence as unclear
+func (t *teiWriter) line(l hocr.OcrLine) error {
+	if len(l.Words) == 0 {
+		t.text(hocr.LineText(l))
+		return nil
+	}
+	var words []hocr.OcrWord
+	for _, w := range l.Words {
+		if strings.TrimSpace(hocr.WordText(w)) != "" {
+			words = append(words, w)
+		}
+	}
+	for i, w := range words {
+		if i > 0 {
+			t.WriteString(" ")
+		}
+		props, err := w.Properties()
+		if err != nil {
+			return err
+		}
+		unclear := props.Has("x_wconf") && props.Wconf < t.opts.Unclear
+		if unclear {
+			t.WriteString("<unclear>")
+		}
+		t.text(hocr.WordText(w))
+		if unclear {
+			t.WriteString("</unclear>")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+// Copyright 2021 Nick White.
+// Use of this source code is governed by the GPLv3
+// license that can be found in the LICENSE file.
+
+package tei
+
+import (
+	"bytes"
+	"testing"
+
+	"rescribe.xyz/utils/pkg/hocr"
+	"rescribe.xyz/utils/pkg/normalize"
+)
+
+const testHocr = `<html><head>
+<meta name='ocr-system' content='tesseract 4.1.1' />
+</head><body>
+<div class='ocr_page' id='page_1' title='image "/tmp/book/0001.png"; bbox 0 0 600 800'>
+<p class='ocr_par' id='par_1_1' lang='lat'>
+<span class='ocr_line' id='line_1_1'><span class='ocrx_word' title='x_wconf 95'>Lorem</span> <span class='ocrx_word' title='x_wconf 40'>ip-</span></span>
+<span class='ocr_line' id='line_1_2'><span class='ocrx_word' title='x_wconf 90'>ſum</span> <span class='ocrx_word'>&amp;c</span></span>
+</p>
+</div>
+<div class='ocr_page' id='page_2' title='bbox 0 0 600 800'>
+<p class='ocr_par' id='par_2_1'>
+<span class='ocr_line' id='line_2_1'>dolor</span>
+</p>
+</div>
+</body></html>`
+
+func TestWrite(t *testing.T) {
+	h, err := hocr.Parse([]byte(testHocr))
+	if err != nil {
+		t.Fatalf("Error parsing hOCR: %v", err)
+	}
+
+	var buf bytes.Buffer
+	err = Write(&buf, h, Options{Title: "Lorem & ipsum", Unclear: 50, Profile: normalize.Modernised})
+	if err != nil {
+		t.Fatalf("Error writing TEI: %v", err)
+	}
+
+	expected := `<?xml version="1.0" encoding="UTF-8"?>
+<TEI xmlns="http://www.tei-c.org/ns/1.0">
+<teiHeader>
+<fileDesc>
+<titleStmt>
+<title>Lorem &amp; ipsum</title>
+</titleStmt>
+<publicationStmt>
+<p>Converted from hOCR</p>
+</publicationStmt>
+<sourceDesc>
+<p>OCR by tesseract 4.1.1</p>
+</sourceDesc>
+</fileDesc>
+</teiHeader>
+<text>
+<body>
+<pb facs="0001.png" n="1"/>
+<p xml:lang="lat">
+<lb/>Lorem <unclear>ip-</unclear>
+<lb break="no"/>sum &amp;c
+</p>
+<pb n="2"/>
+<p>
+<lb/>dolor
+</p>
+</body>
+</text>
+</TEI>
+`
+	if buf.String() != expected {
+		t.Errorf("Unexpected TEI:\n%s\nExpected:\n%s", buf.String(), expected)
+	}
+}